@@ -36,6 +36,7 @@ func (deploymentApi *assistantDeploymentApi) CreateAssistantApiDeployment(ctx co
 		deployment.GetApi().Mistake,
 		&deployment.GetApi().IdealTimeout,
 		&deployment.GetApi().IdealTimeoutMessage,
+		&deployment.GetApi().IdealTimeoutRetry,
 		&deployment.GetApi().MaxSessionDuration,
 		deployment.GetApi().GetInputAudio(),
 		deployment.GetApi().GetOutputAudio(),
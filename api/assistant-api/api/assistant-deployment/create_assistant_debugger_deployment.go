@@ -37,6 +37,7 @@ func (deploymentApi *assistantDeploymentApi) CreateAssistantDebuggerDeployment(c
 		deployment.GetDebugger().Mistake,
 		&deployment.GetDebugger().IdealTimeout,
 		&deployment.GetDebugger().IdealTimeoutMessage,
+		&deployment.GetDebugger().IdealTimeoutRetry,
 		&deployment.GetDebugger().MaxSessionDuration,
 		deployment.GetDebugger().GetInputAudio(),
 		deployment.GetDebugger().GetOutputAudio(),
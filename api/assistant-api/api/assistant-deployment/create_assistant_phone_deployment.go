@@ -38,6 +38,7 @@ func (deploymentApi *assistantDeploymentApi) CreateAssistantPhoneDeployment(ctx
 		deployment.GetPhone().Mistake,
 		&deployment.GetPhone().IdealTimeout,
 		&deployment.GetPhone().IdealTimeoutMessage,
+		&deployment.GetPhone().IdealTimeoutRetry,
 		&deployment.GetPhone().MaxSessionDuration,
 		deployment.GetPhone().GetPhoneProviderName(),
 		deployment.GetPhone().GetInputAudio(),
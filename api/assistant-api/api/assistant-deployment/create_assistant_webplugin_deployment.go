@@ -39,6 +39,7 @@ func (deploymentApi *assistantDeploymentApi) CreateAssistantWebpluginDeployment(
 		deployment.GetPlugin().Mistake,
 		&deployment.GetPlugin().IdealTimeout,
 		&deployment.GetPlugin().IdealTimeoutMessage,
+		&deployment.GetPlugin().IdealTimeoutRetry,
 		&deployment.GetPlugin().MaxSessionDuration,
 		deployment.GetPlugin().GetSuggestion(),
 		deployment.GetPlugin().GetHelpCenterEnabled(),
@@ -37,6 +37,7 @@ func (deploymentApi *assistantDeploymentApi) CreateAssistantWhatsappDeployment(c
 		deployment.GetWhatsapp().Mistake,
 		&deployment.GetWhatsapp().IdealTimeout,
 		&deployment.GetWhatsapp().IdealTimeoutMessage,
+		&deployment.GetWhatsapp().IdealTimeoutRetry,
 		&deployment.GetWhatsapp().MaxSessionDuration,
 		deployment.GetWhatsapp().GetWhatsappProviderName(),
 		deployment.GetWhatsapp().GetWhatsappOptions(),
@@ -30,6 +30,9 @@ type assistantApi struct {
 	assistantAnalysisService  internal_services.AssistantAnalysisService
 	assistantToolService      internal_services.AssistantToolService
 	assistantKnowledgeService internal_services.AssistantKnowledgeService
+	assistantLexiconService   internal_services.AssistantLexiconService
+	assistantEvaluatorService internal_services.AssistantEvaluatorService
+	assistantCampaignService  internal_services.AssistantCampaignService
 }
 
 type assistantGrpcApi struct {
@@ -59,6 +62,9 @@ func NewAssistantGRPCApi(config *config.AssistantConfig, logger commons.Logger,
 			assistantAnalysisService:  internal_assistant_service.NewAssistantAnalysisService(logger, postgres),
 			assistantToolService:      internal_assistant_service.NewAssistantToolService(logger, postgres, storage_files.NewStorage(config.AssetStoreConfig, logger)),
 			assistantKnowledgeService: internal_assistant_service.NewAssistantKnowledgeService(logger, postgres, storage_files.NewStorage(config.AssetStoreConfig, logger)),
+			assistantLexiconService:   internal_assistant_service.NewAssistantLexiconService(logger, postgres),
+			assistantEvaluatorService: internal_assistant_service.NewAssistantEvaluatorService(logger, postgres),
+			assistantCampaignService:  internal_assistant_service.NewAssistantCampaignService(logger, postgres),
 		},
 	}
 }
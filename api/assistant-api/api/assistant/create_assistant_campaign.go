@@ -0,0 +1,125 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package assistant_api
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	internal_campaign "github.com/rapidaai/api/assistant-api/internal/campaign"
+	internal_assistant_entity "github.com/rapidaai/api/assistant-api/internal/entity/assistants"
+	internal_services "github.com/rapidaai/api/assistant-api/internal/services"
+	"github.com/rapidaai/pkg/exceptions"
+	gorm_models "github.com/rapidaai/pkg/models/gorm"
+	"github.com/rapidaai/pkg/types"
+	"github.com/rapidaai/pkg/utils"
+	"github.com/rapidaai/protos"
+)
+
+// CreateAssistantCampaign implements protos.AssistantServiceServer.
+func (assistantApi *assistantGrpcApi) CreateAssistantCampaign(ctx context.Context, cacr *protos.CreateAssistantCampaignRequest) (*protos.GetAssistantCampaignResponse, error) {
+	iAuth, isAuthenticated := types.GetSimplePrincipleGRPC(ctx)
+	if !isAuthenticated || !iAuth.HasProject() {
+		assistantApi.logger.Errorf("unauthenticated request for CreateAssistantCampaign")
+		return exceptions.AuthenticationError[protos.GetAssistantCampaignResponse]()
+	}
+	// calls are placed in background and the telephony calls back with the credential of the campaign
+	if iAuth.Type() != "project" {
+		return exceptions.BadRequestError[protos.GetAssistantCampaignResponse]("Campaigns can only be created with a project credential.")
+	}
+	if len(cacr.GetContacts()) == 0 {
+		return exceptions.BadRequestError[protos.GetAssistantCampaignResponse]("Please provide the contacts of the campaign.")
+	}
+	for _, outcome := range cacr.GetRetryOutcomes() {
+		if !slices.Contains(internal_campaign.DefaultRetryOutcomes, outcome) {
+			return exceptions.BadRequestError[protos.GetAssistantCampaignResponse](fmt.Sprintf("Invalid retry outcome %s, expected one of %v.", outcome, internal_campaign.DefaultRetryOutcomes))
+		}
+	}
+
+	assistant, err := assistantApi.assistantService.Get(ctx,
+		iAuth,
+		cacr.GetAssistant().GetAssistantId(),
+		utils.GetVersionDefinition(cacr.GetAssistant().GetVersion()),
+		&internal_services.GetAssistantOption{
+			InjectPhoneDeployment: true,
+		})
+	if err != nil {
+		return exceptions.BadRequestError[protos.GetAssistantCampaignResponse]("Invalid assistant id, please check and try again.")
+	}
+	if !assistant.IsPhoneDeploymentEnable() {
+		return exceptions.BadRequestError[protos.GetAssistantCampaignResponse]("Phone deployment not enabled or incomplete, please check rapida console and update the deployment")
+	}
+
+	campaign := &internal_assistant_entity.AssistantCampaign{
+		Name:             cacr.GetName(),
+		AssistantId:      assistant.Id,
+		AssistantVersion: cacr.GetAssistant().GetVersion(),
+		FromNumber:       cacr.GetFromNumber(),
+		Concurrency:      max(cacr.GetConcurrency(), 1),
+		CallsPerSecond:   cacr.GetCallsPerSecond(),
+		Timezone:         cacr.GetTimezone(),
+		CallingHourStart: cacr.GetCallingHourStart(),
+		CallingHourEnd:   cacr.GetCallingHourEnd(),
+		MaxAttempts:      max(cacr.GetMaxAttempts(), 1),
+		RetryDelaySecond: cacr.GetRetryDelaySecond(),
+		RetryOutcomes:    cacr.GetRetryOutcomes(),
+	}
+	credential, err := internal_campaign.SealCredential(assistantApi.cfg.Secret, iAuth.GetCurrentToken())
+	if err != nil {
+		assistantApi.logger.Errorf("unable to seal campaign credential %v", err)
+		return exceptions.BadRequestError[protos.GetAssistantCampaignResponse]("Unable to create assistant campaign.")
+	}
+	campaign.CredentialToken = credential
+	if campaign.CallsPerSecond <= 0 {
+		campaign.CallsPerSecond = 1
+	}
+	if cacr.GetStartDate() != nil {
+		campaign.StartDate = gorm_models.TimeWrapper(cacr.GetStartDate().AsTime())
+	}
+	if err := campaign.ValidateCallingHours(); err != nil {
+		return exceptions.BadRequestError[protos.GetAssistantCampaignResponse]("Invalid calling hours, " + err.Error())
+	}
+
+	contacts := make([]*internal_assistant_entity.AssistantCampaignContact, 0, len(cacr.GetContacts()))
+	for _, c := range cacr.GetContacts() {
+		if utils.IsEmpty(c.GetToNumber()) {
+			return exceptions.BadRequestError[protos.GetAssistantCampaignResponse]("Please provide the to_number of every contact.")
+		}
+		contact := &internal_assistant_entity.AssistantCampaignContact{
+			ToNumber: c.GetToNumber(),
+			Timezone: c.GetTimezone(),
+		}
+		if _, err := campaign.NextCallingTime(time.Now(), contact.Timezone); err != nil {
+			return exceptions.BadRequestError[protos.GetAssistantCampaignResponse](fmt.Sprintf("Invalid timezone of contact %s.", c.GetToNumber()))
+		}
+		mtd, err := utils.AnyMapToInterfaceMap(c.GetMetadata())
+		if err != nil {
+			return exceptions.BadRequestError[protos.GetAssistantCampaignResponse](fmt.Sprintf("Illegal metadata of contact %s.", c.GetToNumber()))
+		}
+		args, err := utils.AnyMapToInterfaceMap(c.GetArgs())
+		if err != nil {
+			return exceptions.BadRequestError[protos.GetAssistantCampaignResponse](fmt.Sprintf("Illegal arguments of contact %s.", c.GetToNumber()))
+		}
+		opts, err := utils.AnyMapToInterfaceMap(c.GetOptions())
+		if err != nil {
+			return exceptions.BadRequestError[protos.GetAssistantCampaignResponse](fmt.Sprintf("Illegal options of contact %s.", c.GetToNumber()))
+		}
+		contact.Metadata, contact.Arguments, contact.Options = mtd, args, opts
+		contacts = append(contacts, contact)
+	}
+
+	campaign, err = assistantApi.assistantCampaignService.Create(ctx, iAuth, campaign, contacts)
+	if err != nil {
+		return exceptions.BadRequestError[protos.GetAssistantCampaignResponse]("Unable to create assistant campaign.")
+	}
+	out := &protos.AssistantCampaign{}
+	if err := utils.Cast(campaign, out); err != nil {
+		assistantApi.logger.Errorf("unable to cast the assistant campaign to the response object")
+	}
+	return utils.Success[protos.GetAssistantCampaignResponse, *protos.AssistantCampaign](out)
+}
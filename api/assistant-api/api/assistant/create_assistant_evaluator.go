@@ -0,0 +1,45 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package assistant_api
+
+import (
+	"context"
+
+	internal_evaluator_factory "github.com/rapidaai/api/assistant-api/internal/factory/evaluator"
+	"github.com/rapidaai/pkg/exceptions"
+	"github.com/rapidaai/pkg/types"
+	"github.com/rapidaai/pkg/utils"
+	"github.com/rapidaai/protos"
+)
+
+// CreateAssistantEvaluator implements protos.AssistantServiceServer.
+func (assistantApi *assistantGrpcApi) CreateAssistantEvaluator(ctx context.Context, cawr *protos.CreateAssistantEvaluatorRequest) (*protos.GetAssistantEvaluatorResponse, error) {
+	iAuth, isAuthenticated := types.GetSimplePrincipleGRPC(ctx)
+	if !isAuthenticated || !iAuth.HasProject() {
+		assistantApi.logger.Errorf("unauthenticated request for CreateAssistantEvaluator")
+		return exceptions.AuthenticationError[protos.GetAssistantEvaluatorResponse]()
+	}
+	if err := internal_evaluator_factory.ValidateEvaluator(cawr.GetType(), cawr.GetOptions()); err != nil {
+		return exceptions.BadRequestError[protos.GetAssistantEvaluatorResponse]("Invalid evaluator, " + err.Error())
+	}
+	we, err := assistantApi.assistantEvaluatorService.Create(
+		ctx,
+		iAuth,
+		cawr.GetAssistantId(),
+		cawr.GetName(),
+		cawr.GetType(),
+		&cawr.Description,
+		cawr.GetOptions())
+	if err != nil {
+		return exceptions.BadRequestError[protos.GetAssistantEvaluatorResponse]("Unable to create assistant evaluator.")
+	}
+	aEvaluator := &protos.AssistantEvaluator{}
+	err = utils.Cast(we, aEvaluator)
+	if err != nil {
+		assistantApi.logger.Errorf("unable to cast the assistant evaluator to the response object")
+	}
+	return utils.Success[protos.GetAssistantEvaluatorResponse, *protos.AssistantEvaluator](aEvaluator)
+}
@@ -0,0 +1,53 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package assistant_api
+
+import (
+	"context"
+
+	internal_synthesizers "github.com/rapidaai/api/assistant-api/internal/synthesizes"
+	"github.com/rapidaai/pkg/exceptions"
+	"github.com/rapidaai/pkg/types"
+	"github.com/rapidaai/pkg/utils"
+	"github.com/rapidaai/protos"
+)
+
+// CreateAssistantLexicon implements protos.AssistantServiceServer.
+func (assistantApi *assistantGrpcApi) CreateAssistantLexicon(ctx context.Context, cawr *protos.CreateAssistantLexiconRequest) (*protos.GetAssistantLexiconResponse, error) {
+	iAuth, isAuthenticated := types.GetSimplePrincipleGRPC(ctx)
+	if !isAuthenticated || !iAuth.HasProject() {
+		assistantApi.logger.Errorf("unauthenticated request for CreateAssistantLexicon")
+		return exceptions.AuthenticationError[protos.GetAssistantLexiconResponse]()
+	}
+	if err := internal_synthesizers.ValidateLexicon(
+		cawr.GetPhrase(),
+		cawr.GetMatchType(),
+		cawr.GetAlias(),
+		cawr.GetPhoneme(),
+		cawr.GetAlphabet()); err != nil {
+		return exceptions.BadRequestError[protos.GetAssistantLexiconResponse]("Invalid lexicon, " + err.Error())
+	}
+	wl, err := assistantApi.assistantLexiconService.Create(
+		ctx,
+		iAuth,
+		cawr.GetAssistantId(),
+		cawr.GetPhrase(),
+		cawr.GetMatchType(),
+		cawr.GetCaseSensitive(),
+		cawr.GetAlias(),
+		cawr.GetPhoneme(),
+		cawr.GetAlphabet(),
+		&cawr.Description)
+	if err != nil {
+		return exceptions.BadRequestError[protos.GetAssistantLexiconResponse]("Unable to create assistant lexicon.")
+	}
+	aLexicon := &protos.AssistantLexicon{}
+	err = utils.Cast(wl, aLexicon)
+	if err != nil {
+		assistantApi.logger.Errorf("unable to cast the assistant lexicon to the response object")
+	}
+	return utils.Success[protos.GetAssistantLexiconResponse, *protos.AssistantLexicon](aLexicon)
+}
@@ -34,6 +34,7 @@ func (assistantApi *assistantGrpcApi) CreateAssistantWebhook(ctx context.Context
 		cawr.GetRetryStatusCodes(),
 		cawr.GetMaxRetryCount(),
 		cawr.GetExecutionPriority(),
+		cawr.GetSecretCredentialId(),
 		&cawr.Description)
 	if err != nil {
 		return exceptions.BadRequestError[assistant_api.GetAssistantWebhookResponse]("Unable to create assistant webhook.")
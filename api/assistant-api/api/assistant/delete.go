@@ -151,3 +151,84 @@ func (assistantApi *assistantGrpcApi) DeleteAssistantKnowledge(ctx context.Conte
 	return utils.Success[assistant_api.GetAssistantKnowledgeResponse, *assistant_api.AssistantKnowledge](out)
 
 }
+
+func (assistantApi *assistantGrpcApi) DeleteAssistantLexicon(ctx context.Context, cer *assistant_api.DeleteAssistantLexiconRequest) (*assistant_api.GetAssistantLexiconResponse, error) {
+	iAuth, isAuthenticated := types.GetSimplePrincipleGRPC(ctx)
+	if !isAuthenticated {
+		assistantApi.logger.Errorf("unauthenticated request for DeleteAssistantLexiconRequest")
+		return utils.Error[assistant_api.GetAssistantLexiconResponse](
+			errors.New("unauthenticated request for DeleteAssistantLexiconRequest"),
+			"Please provider valid service credentials to perfom DeleteAssistantLexiconRequest, read docs @ docs.rapida.ai",
+		)
+	}
+	lexicon, err := assistantApi.assistantLexiconService.Delete(ctx,
+		iAuth,
+		cer.GetId(), cer.GetAssistantId())
+	if err != nil {
+		return utils.Error[assistant_api.GetAssistantLexiconResponse](
+			err,
+			"Unable to delete assistant lexicon, please try again in sometime",
+		)
+	}
+	out := &assistant_api.AssistantLexicon{}
+	err = utils.Cast(lexicon, out)
+	if err != nil {
+		assistantApi.logger.Errorf("unable to cast the assistant lexicon to the response object")
+	}
+	return utils.Success[assistant_api.GetAssistantLexiconResponse, *assistant_api.AssistantLexicon](out)
+
+}
+
+func (assistantApi *assistantGrpcApi) DeleteAssistantEvaluator(ctx context.Context, cer *assistant_api.DeleteAssistantEvaluatorRequest) (*assistant_api.GetAssistantEvaluatorResponse, error) {
+	iAuth, isAuthenticated := types.GetSimplePrincipleGRPC(ctx)
+	if !isAuthenticated {
+		assistantApi.logger.Errorf("unauthenticated request for DeleteAssistantEvaluatorRequest")
+		return utils.Error[assistant_api.GetAssistantEvaluatorResponse](
+			errors.New("unauthenticated request for DeleteAssistantEvaluatorRequest"),
+			"Please provider valid service credentials to perfom DeleteAssistantEvaluatorRequest, read docs @ docs.rapida.ai",
+		)
+	}
+	evaluator, err := assistantApi.assistantEvaluatorService.Delete(ctx,
+		iAuth,
+		cer.GetId(), cer.GetAssistantId())
+	if err != nil {
+		return utils.Error[assistant_api.GetAssistantEvaluatorResponse](
+			err,
+			"Unable to delete assistant evaluator, please try again in sometime",
+		)
+	}
+	out := &assistant_api.AssistantEvaluator{}
+	err = utils.Cast(evaluator, out)
+	if err != nil {
+		assistantApi.logger.Errorf("unable to cast the assistant evaluator to the response object")
+	}
+	return utils.Success[assistant_api.GetAssistantEvaluatorResponse, *assistant_api.AssistantEvaluator](out)
+
+}
+
+func (assistantApi *assistantGrpcApi) DeleteAssistantCampaign(ctx context.Context, cer *assistant_api.DeleteAssistantCampaignRequest) (*assistant_api.GetAssistantCampaignResponse, error) {
+	iAuth, isAuthenticated := types.GetSimplePrincipleGRPC(ctx)
+	if !isAuthenticated {
+		assistantApi.logger.Errorf("unauthenticated request for DeleteAssistantCampaignRequest")
+		return utils.Error[assistant_api.GetAssistantCampaignResponse](
+			errors.New("unauthenticated request for DeleteAssistantCampaignRequest"),
+			"Please provider valid service credentials to perfom DeleteAssistantCampaignRequest, read docs @ docs.rapida.ai",
+		)
+	}
+	campaign, err := assistantApi.assistantCampaignService.Delete(ctx,
+		iAuth,
+		cer.GetId(), cer.GetAssistantId())
+	if err != nil {
+		return utils.Error[assistant_api.GetAssistantCampaignResponse](
+			err,
+			"Unable to delete assistant campaign, please try again in sometime",
+		)
+	}
+	out := &assistant_api.AssistantCampaign{}
+	err = utils.Cast(campaign, out)
+	if err != nil {
+		assistantApi.logger.Errorf("unable to cast the assistant campaign to the response object")
+	}
+	return utils.Success[assistant_api.GetAssistantCampaignResponse, *assistant_api.AssistantCampaign](out)
+
+}
@@ -0,0 +1,41 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package assistant_api
+
+import (
+	"context"
+
+	"github.com/rapidaai/pkg/exceptions"
+	"github.com/rapidaai/pkg/types"
+	"github.com/rapidaai/pkg/utils"
+	"github.com/rapidaai/protos"
+)
+
+func (assistantApi *assistantGrpcApi) GetAllAssistantCampaign(ctx context.Context, cawr *protos.GetAllAssistantCampaignRequest) (*protos.GetAllAssistantCampaignResponse, error) {
+	iAuth, isAuthenticated := types.GetSimplePrincipleGRPC(ctx)
+	if !isAuthenticated || !iAuth.HasProject() {
+		assistantApi.logger.Errorf("unauthenticated request for GetAllAssistantCampaign")
+		return exceptions.AuthenticationError[protos.GetAllAssistantCampaignResponse]()
+	}
+	cnt, campaigns, err := assistantApi.assistantCampaignService.GetAll(ctx,
+		iAuth,
+		cawr.GetAssistantId(),
+		cawr.GetCriterias(),
+		cawr.GetPaginate())
+	if err != nil {
+		return exceptions.BadRequestError[protos.GetAllAssistantCampaignResponse]("Unable to get the assistant campaigns.")
+	}
+	out := []*protos.AssistantCampaign{}
+	err = utils.Cast(campaigns, &out)
+	if err != nil {
+		assistantApi.logger.Errorf("unable to cast assistant campaigns %v", err)
+	}
+
+	return utils.PaginatedSuccess[protos.GetAllAssistantCampaignResponse, []*protos.AssistantCampaign](
+		uint32(cnt),
+		cawr.GetPaginate().GetPage(),
+		out)
+}
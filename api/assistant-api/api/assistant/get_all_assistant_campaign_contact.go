@@ -0,0 +1,42 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package assistant_api
+
+import (
+	"context"
+
+	"github.com/rapidaai/pkg/exceptions"
+	"github.com/rapidaai/pkg/types"
+	"github.com/rapidaai/pkg/utils"
+	"github.com/rapidaai/protos"
+)
+
+// GetAllAssistantCampaignContact returns the contacts of the campaign with the outcome of their last attempt
+func (assistantApi *assistantGrpcApi) GetAllAssistantCampaignContact(ctx context.Context, cawr *protos.GetAllAssistantCampaignContactRequest) (*protos.GetAllAssistantCampaignContactResponse, error) {
+	iAuth, isAuthenticated := types.GetSimplePrincipleGRPC(ctx)
+	if !isAuthenticated || !iAuth.HasProject() {
+		assistantApi.logger.Errorf("unauthenticated request for GetAllAssistantCampaignContact")
+		return exceptions.AuthenticationError[protos.GetAllAssistantCampaignContactResponse]()
+	}
+	cnt, contacts, err := assistantApi.assistantCampaignService.GetAllContact(ctx,
+		iAuth,
+		cawr.GetAssistantCampaignId(),
+		cawr.GetCriterias(),
+		cawr.GetPaginate())
+	if err != nil {
+		return exceptions.BadRequestError[protos.GetAllAssistantCampaignContactResponse]("Unable to get the campaign contacts.")
+	}
+	out := []*protos.AssistantCampaignContact{}
+	err = utils.Cast(contacts, &out)
+	if err != nil {
+		assistantApi.logger.Errorf("unable to cast campaign contacts %v", err)
+	}
+
+	return utils.PaginatedSuccess[protos.GetAllAssistantCampaignContactResponse, []*protos.AssistantCampaignContact](
+		uint32(cnt),
+		cawr.GetPaginate().GetPage(),
+		out)
+}
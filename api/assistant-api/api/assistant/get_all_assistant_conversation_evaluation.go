@@ -0,0 +1,51 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package assistant_api
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rapidaai/pkg/exceptions"
+	"github.com/rapidaai/pkg/types"
+	"github.com/rapidaai/pkg/utils"
+	"github.com/rapidaai/protos"
+)
+
+// GetAllAssistantConversationEvaluation implements protos.AssistantServiceServer.
+func (assistantApi *assistantGrpcApi) GetAllAssistantConversationEvaluation(ctx context.Context, cawr *protos.GetAllAssistantConversationEvaluationRequest) (*protos.GetAllAssistantConversationEvaluationResponse, error) {
+	iAuth, isAuthenticated := types.GetSimplePrincipleGRPC(ctx)
+	if !isAuthenticated || !iAuth.HasProject() {
+		assistantApi.logger.Errorf("unauthenticated request for GetAllAssistantConversationEvaluation")
+		return exceptions.AuthenticationError[protos.GetAllAssistantConversationEvaluationResponse]()
+	}
+	criterias := cawr.GetCriterias()
+	if cawr.GetAssistantConversationId() > 0 {
+		criterias = append(criterias, &protos.Criteria{
+			Key:   "assistant_conversation_id",
+			Logic: "=",
+			Value: fmt.Sprintf("%d", cawr.GetAssistantConversationId()),
+		})
+	}
+	cnt, evaluations, err := assistantApi.conversactionService.GetAllConversationEvaluation(ctx,
+		iAuth,
+		cawr.GetAssistantId(),
+		criterias,
+		cawr.GetPaginate())
+	if err != nil {
+		return exceptions.BadRequestError[protos.GetAllAssistantConversationEvaluationResponse]("Unable to get the conversation evaluations.")
+	}
+	out := []*protos.AssistantConversationEvaluation{}
+	err = utils.Cast(evaluations, &out)
+	if err != nil {
+		assistantApi.logger.Errorf("unable to cast conversation evaluations %v", err)
+	}
+
+	return utils.PaginatedSuccess[protos.GetAllAssistantConversationEvaluationResponse, []*protos.AssistantConversationEvaluation](
+		uint32(cnt),
+		cawr.GetPaginate().GetPage(),
+		out)
+}
@@ -0,0 +1,41 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package assistant_api
+
+import (
+	"context"
+
+	"github.com/rapidaai/pkg/exceptions"
+	"github.com/rapidaai/pkg/types"
+	"github.com/rapidaai/pkg/utils"
+	"github.com/rapidaai/protos"
+)
+
+func (assistantApi *assistantGrpcApi) GetAllAssistantLexicon(ctx context.Context, cawr *protos.GetAllAssistantLexiconRequest) (*protos.GetAllAssistantLexiconResponse, error) {
+	iAuth, isAuthenticated := types.GetSimplePrincipleGRPC(ctx)
+	if !isAuthenticated || !iAuth.HasProject() {
+		assistantApi.logger.Errorf("unauthenticated request for GetAllAssistantLexicon")
+		return exceptions.AuthenticationError[protos.GetAllAssistantLexiconResponse]()
+	}
+	cnt, lexicons, err := assistantApi.assistantLexiconService.GetAll(ctx,
+		iAuth,
+		cawr.GetAssistantId(),
+		cawr.GetCriterias(),
+		cawr.GetPaginate())
+	if err != nil {
+		return exceptions.BadRequestError[protos.GetAllAssistantLexiconResponse]("Unable to get the assistant lexicons.")
+	}
+	out := []*protos.AssistantLexicon{}
+	err = utils.Cast(lexicons, &out)
+	if err != nil {
+		assistantApi.logger.Errorf("unable to cast assistant lexicons %v", err)
+	}
+
+	return utils.PaginatedSuccess[protos.GetAllAssistantLexiconResponse, []*protos.AssistantLexicon](
+		uint32(cnt),
+		cawr.GetPaginate().GetPage(),
+		out)
+}
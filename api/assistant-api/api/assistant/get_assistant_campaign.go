@@ -0,0 +1,36 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package assistant_api
+
+import (
+	"context"
+
+	"github.com/rapidaai/pkg/exceptions"
+	"github.com/rapidaai/pkg/types"
+	"github.com/rapidaai/pkg/utils"
+	"github.com/rapidaai/protos"
+)
+
+func (assistantApi *assistantGrpcApi) GetAssistantCampaign(ctx context.Context, gacr *protos.GetAssistantCampaignRequest) (*protos.GetAssistantCampaignResponse, error) {
+	iAuth, isAuthenticated := types.GetSimplePrincipleGRPC(ctx)
+	if !isAuthenticated || !iAuth.HasProject() {
+		assistantApi.logger.Errorf("unauthenticated request for GetAssistantCampaign")
+		return exceptions.AuthenticationError[protos.GetAssistantCampaignResponse]()
+	}
+	campaign, err := assistantApi.assistantCampaignService.Get(ctx, iAuth, gacr.GetId(), gacr.GetAssistantId())
+	if err != nil {
+		return utils.Error[protos.GetAssistantCampaignResponse](
+			err,
+			"Unable to get the campaign for given campaign id.",
+		)
+	}
+	out := &protos.AssistantCampaign{}
+	err = utils.Cast(campaign, out)
+	if err != nil {
+		assistantApi.logger.Errorf("unable to cast campaign %v", err)
+	}
+	return utils.Success[protos.GetAssistantCampaignResponse, *protos.AssistantCampaign](out)
+}
@@ -0,0 +1,54 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package assistant_api
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	internal_conversation_gorm "github.com/rapidaai/api/assistant-api/internal/entity/conversations"
+	"github.com/rapidaai/pkg/exceptions"
+	"github.com/rapidaai/pkg/types"
+	"github.com/rapidaai/pkg/utils"
+	"github.com/rapidaai/protos"
+)
+
+// GetAssistantEvaluationSummary implements protos.AssistantServiceServer.
+func (assistantApi *assistantGrpcApi) GetAssistantEvaluationSummary(ctx context.Context, request *protos.GetAssistantEvaluationSummaryRequest) (*protos.GetAssistantEvaluationSummaryResponse, error) {
+	iAuth, isAuthenticated := types.GetSimplePrincipleGRPC(ctx)
+	if !isAuthenticated || !iAuth.HasProject() {
+		assistantApi.logger.Errorf("unauthenticated request for GetAssistantEvaluationSummary")
+		return exceptions.AuthenticationError[protos.GetAssistantEvaluationSummaryResponse]()
+	}
+	criterias := request.GetCriterias()
+	if request.GetAssistantProviderModelId() > 0 {
+		criterias = append(criterias, &protos.Criteria{
+			Key:   "assistant_provider_model_id",
+			Logic: "=",
+			Value: fmt.Sprintf("%d", request.GetAssistantProviderModelId()),
+		})
+	}
+	if request.GetFrom() != nil {
+		criterias = append(criterias, &protos.Criteria{
+			Key:   "created_date",
+			Logic: ">=",
+			Value: request.GetFrom().AsTime().Format(time.RFC3339),
+		})
+	}
+	if request.GetTo() != nil {
+		criterias = append(criterias, &protos.Criteria{
+			Key:   "created_date",
+			Logic: "<",
+			Value: request.GetTo().AsTime().Format(time.RFC3339),
+		})
+	}
+	summaries, err := assistantApi.conversactionService.GetEvaluationSummary(ctx, iAuth, request.GetAssistantId(), criterias)
+	if err != nil {
+		return exceptions.BadRequestError[protos.GetAssistantEvaluationSummaryResponse]("Unable to get the evaluation summary for given assistant id.")
+	}
+	return utils.Success[protos.GetAssistantEvaluationSummaryResponse, []*internal_conversation_gorm.AssistantConversationEvaluationSummary](summaries)
+}
@@ -0,0 +1,36 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package assistant_api
+
+import (
+	"context"
+
+	"github.com/rapidaai/pkg/exceptions"
+	"github.com/rapidaai/pkg/types"
+	"github.com/rapidaai/pkg/utils"
+	"github.com/rapidaai/protos"
+)
+
+func (assistantApi *assistantGrpcApi) GetAssistantEvaluator(ctx context.Context, gawr *protos.GetAssistantEvaluatorRequest) (*protos.GetAssistantEvaluatorResponse, error) {
+	iAuth, isAuthenticated := types.GetSimplePrincipleGRPC(ctx)
+	if !isAuthenticated || !iAuth.HasProject() {
+		assistantApi.logger.Errorf("unauthenticated request for GetAssistantEvaluator")
+		return exceptions.AuthenticationError[protos.GetAssistantEvaluatorResponse]()
+	}
+	evaluator, err := assistantApi.assistantEvaluatorService.Get(ctx, iAuth, gawr.GetId(), gawr.GetAssistantId())
+	if err != nil {
+		return utils.Error[protos.GetAssistantEvaluatorResponse](
+			err,
+			"Unable to get the evaluator for given evaluator id.",
+		)
+	}
+	out := &protos.AssistantEvaluator{}
+	err = utils.Cast(evaluator, out)
+	if err != nil {
+		assistantApi.logger.Errorf("unable to cast evaluator %v", err)
+	}
+	return utils.Success[protos.GetAssistantEvaluatorResponse, *protos.AssistantEvaluator](out)
+}
@@ -0,0 +1,36 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package assistant_api
+
+import (
+	"context"
+
+	"github.com/rapidaai/pkg/exceptions"
+	"github.com/rapidaai/pkg/types"
+	"github.com/rapidaai/pkg/utils"
+	"github.com/rapidaai/protos"
+)
+
+func (assistantApi *assistantGrpcApi) GetAssistantLexicon(ctx context.Context, gawr *protos.GetAssistantLexiconRequest) (*protos.GetAssistantLexiconResponse, error) {
+	iAuth, isAuthenticated := types.GetSimplePrincipleGRPC(ctx)
+	if !isAuthenticated || !iAuth.HasProject() {
+		assistantApi.logger.Errorf("unauthenticated request for GetAssistantLexicon")
+		return exceptions.AuthenticationError[protos.GetAssistantLexiconResponse]()
+	}
+	lexicon, err := assistantApi.assistantLexiconService.Get(ctx, iAuth, gawr.GetId(), gawr.GetAssistantId())
+	if err != nil {
+		return utils.Error[protos.GetAssistantLexiconResponse](
+			err,
+			"Unable to get the lexicon for given lexicon id.",
+		)
+	}
+	out := &protos.AssistantLexicon{}
+	err = utils.Cast(lexicon, out)
+	if err != nil {
+		assistantApi.logger.Errorf("unable to cast lexicon %v", err)
+	}
+	return utils.Success[protos.GetAssistantLexiconResponse, *protos.AssistantLexicon](out)
+}
@@ -0,0 +1,30 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package assistant_api
+
+import (
+	"context"
+
+	internal_message_gorm "github.com/rapidaai/api/assistant-api/internal/entity/messages"
+	"github.com/rapidaai/pkg/exceptions"
+	"github.com/rapidaai/pkg/types"
+	"github.com/rapidaai/pkg/utils"
+	"github.com/rapidaai/protos"
+)
+
+// GetAssistantTurnLatency implements protos.AssistantServiceServer.
+func (assistantApi *assistantGrpcApi) GetAssistantTurnLatency(ctx context.Context, request *protos.GetAssistantTurnLatencyRequest) (*protos.GetAssistantTurnLatencyResponse, error) {
+	iAuth, isAuthenticated := types.GetSimplePrincipleGRPC(ctx)
+	if !isAuthenticated || !iAuth.HasProject() {
+		assistantApi.logger.Errorf("unauthenticated request for GetAssistantTurnLatency")
+		return exceptions.AuthenticationError[protos.GetAssistantTurnLatencyResponse]()
+	}
+	latencies, err := assistantApi.conversactionService.GetTurnLatency(ctx, iAuth, request.GetAssistantId(), request.GetCriterias())
+	if err != nil {
+		return exceptions.BadRequestError[protos.GetAssistantTurnLatencyResponse]("Unable to get the turn latency for given assistant id.")
+	}
+	return utils.Success[protos.GetAssistantTurnLatencyResponse, []*internal_message_gorm.AssistantConversationMessageMetricPercentile](latencies)
+}
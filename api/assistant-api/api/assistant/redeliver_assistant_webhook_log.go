@@ -0,0 +1,43 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package assistant_api
+
+import (
+	"context"
+	"errors"
+
+	"github.com/rapidaai/pkg/types"
+	"github.com/rapidaai/pkg/utils"
+	"github.com/rapidaai/protos"
+)
+
+// RedeliverAssistantWebhookLog queues a failed or dead lettered webhook delivery again.
+func (assistantApi *assistantGrpcApi) RedeliverAssistantWebhookLog(ctx context.Context, cepm *protos.RedeliverAssistantWebhookLogRequest) (*protos.GetAssistantWebhookLogResponse, error) {
+	iAuth, isAuthenticated := types.GetSimplePrincipleGRPC(ctx)
+	if !isAuthenticated || !iAuth.HasProject() {
+		assistantApi.logger.Errorf("unauthenticated request for RedeliverAssistantWebhookLog")
+		return utils.Error[protos.GetAssistantWebhookLogResponse](
+			errors.New("unauthenticated request for redeliver assistant webhook log"),
+			"Please provider valid service credentials to perform RedeliverAssistantWebhookLog, read docs @ docs.rapida.ai",
+		)
+	}
+	lg, err := assistantApi.assistantWebhookService.RedeliverLog(
+		ctx,
+		iAuth,
+		cepm.GetProjectId(), cepm.GetId())
+	if err != nil {
+		return utils.Error[protos.GetAssistantWebhookLogResponse](
+			err,
+			"Unable to redeliver the webhook, only failed deliveries can be redelivered.",
+		)
+	}
+	wl := &protos.AssistantWebhookLog{}
+	err = utils.Cast(lg, wl)
+	if err != nil {
+		assistantApi.logger.Errorf("unable to cast the assistant webhooklog to the response object")
+	}
+	return utils.Success[protos.GetAssistantWebhookLogResponse, *protos.AssistantWebhookLog](wl)
+}
@@ -0,0 +1,41 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package assistant_api
+
+import (
+	"context"
+
+	internal_assistant_entity "github.com/rapidaai/api/assistant-api/internal/entity/assistants"
+	"github.com/rapidaai/pkg/exceptions"
+	"github.com/rapidaai/pkg/types"
+	"github.com/rapidaai/pkg/utils"
+	"github.com/rapidaai/protos"
+)
+
+// UpdateAssistantCampaignState pauses, resumes or cancels the campaign
+func (assistantApi *assistantGrpcApi) UpdateAssistantCampaignState(ctx context.Context, uacr *protos.UpdateAssistantCampaignStateRequest) (*protos.GetAssistantCampaignResponse, error) {
+	iAuth, isAuthenticated := types.GetSimplePrincipleGRPC(ctx)
+	if !isAuthenticated || !iAuth.HasProject() {
+		assistantApi.logger.Errorf("unauthenticated request for UpdateAssistantCampaignState")
+		return exceptions.AuthenticationError[protos.GetAssistantCampaignResponse]()
+	}
+	state := internal_assistant_entity.CampaignState(uacr.GetState())
+	switch state {
+	case internal_assistant_entity.CAMPAIGN_RUNNING, internal_assistant_entity.CAMPAIGN_PAUSED, internal_assistant_entity.CAMPAIGN_CANCELLED:
+	default:
+		return exceptions.BadRequestError[protos.GetAssistantCampaignResponse]("Invalid campaign state, expected RUNNING, PAUSED or CANCELLED.")
+	}
+	campaign, err := assistantApi.assistantCampaignService.UpdateState(ctx, iAuth, uacr.GetId(), uacr.GetAssistantId(), state)
+	if err != nil {
+		return exceptions.BadRequestError[protos.GetAssistantCampaignResponse]("Unable to update the campaign, only running or paused campaigns can be updated.")
+	}
+	out := &protos.AssistantCampaign{}
+	err = utils.Cast(campaign, out)
+	if err != nil {
+		assistantApi.logger.Errorf("unable to cast the assistant campaign to the response object")
+	}
+	return utils.Success[protos.GetAssistantCampaignResponse, *protos.AssistantCampaign](out)
+}
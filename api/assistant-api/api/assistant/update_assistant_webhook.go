@@ -34,6 +34,7 @@ func (assistantApi *assistantGrpcApi) UpdateAssistantWebhook(ctx context.Context
 		cawr.GetRetryStatusCodes(),
 		cawr.GetMaxRetryCount(),
 		cawr.GetExecutionPriority(),
+		cawr.GetSecretCredentialId(),
 		&cawr.Description)
 	if err != nil {
 		return exceptions.BadRequestError[protos.GetAssistantWebhookResponse]("Unable to create assistant webhook.")
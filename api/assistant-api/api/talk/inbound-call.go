@@ -6,7 +6,9 @@
 package assistant_talk_api
 
 import (
+	"context"
 	"net/http"
+	"slices"
 	"strconv"
 	"time"
 
@@ -15,6 +17,7 @@ import (
 	internal_factory "github.com/rapidaai/api/assistant-api/internal/factory"
 	telephony "github.com/rapidaai/api/assistant-api/internal/factory/telephony"
 	internal_services "github.com/rapidaai/api/assistant-api/internal/services"
+	internal_telephony "github.com/rapidaai/api/assistant-api/internal/telephony"
 	"github.com/rapidaai/pkg/types"
 	type_enums "github.com/rapidaai/pkg/types/enums"
 	"github.com/rapidaai/pkg/utils"
@@ -130,6 +133,12 @@ func (cApi *ConversationApi) CallReciever(c *gin.Context) {
 	if _, err := cApi.assistantConversationService.ApplyConversationMetrics(c, iAuth, assistantId, conversation.Id, []*types.Metric{types.NewStatusMetric(type_enums.RECORD_CONNECTED)}); err != nil {
 		cApi.logger.Errorf("error while applying metrics %v", err)
 	}
+	// reference of call is needed to control the live call eg: transfer
+	if callReference, ok := _telephony.GetCallReference(c); ok {
+		if _, err := cApi.assistantConversationService.ApplyConversationMetadata(c, iAuth, assistantId, conversation.Id, []*types.Metadata{types.NewMetadata("telephony.call_reference", callReference)}); err != nil {
+			cApi.logger.Errorf("error while applying metadata %v", err)
+		}
+	}
 
 	if err := _telephony.ReceiveCall(c, iAuth, assistant.Id, clientNumber, conversation.Id); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{"error": "unable to initiate talker"})
@@ -195,3 +204,61 @@ func (cApi *ConversationApi) CallTalker(c *gin.Context) {
 		cApi.logger.Errorf("illegal while initiating talker %v", err)
 	}
 }
+
+// TransferInstruction serves the instruction of provider while the call is being transferred,
+// the whisper stage is requested by provider when destination answers a warm transfer.
+func (cApi *ConversationApi) TransferInstruction(c *gin.Context) {
+	auth, isAuthenticated := types.GetAuthPrinciple(c)
+	if !isAuthenticated {
+		cApi.logger.Debugf("illegal unable to authenticate")
+		c.JSON(http.StatusForbidden, gin.H{"error": "Unauthenticated request"})
+		return
+	}
+	assistantId, err := strconv.ParseUint(c.Param("assistantId"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid assistantId"})
+		return
+	}
+
+	_telephony, err := telephony.GetTelephony(telephony.Telephony(c.Param("telephony")), cApi.cfg, cApi.logger)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid telephony"})
+		return
+	}
+
+	transfer := internal_telephony.NewTransferFromQuery(c.Request.URL.Query())
+	if transfer.Destination == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Missing transfer destination"})
+		return
+	}
+	// the url is handed to the provider, destination must still be one the assistant transfers to
+	if !cApi.transferAllowed(c, auth, assistantId, transfer.Destination) {
+		cApi.logger.Warnf("rejecting transfer of assistant %d to %s which is not configured", assistantId, transfer.Destination)
+		c.JSON(http.StatusForbidden, gin.H{"error": "Transfer destination is not allowed"})
+		return
+	}
+	if err := _telephony.TransferInstruction(c, transfer, c.Query("stage") == "whisper"); err != nil {
+		cApi.logger.Errorf("unable to serve transfer instruction %v", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Unable to transfer call"})
+		return
+	}
+}
+
+// transferAllowed checks the destination against the destinations of transfer_call tools of the assistant
+func (cApi *ConversationApi) transferAllowed(ctx context.Context, auth types.SimplePrinciple, assistantId uint64, destination string) bool {
+	assistant, err := cApi.assistantService.Get(ctx, auth, assistantId, nil, &internal_services.GetAssistantOption{InjectTool: true})
+	if err != nil {
+		cApi.logger.Errorf("unable to get assistant %d for transfer %v", assistantId, err)
+		return false
+	}
+	for _, tool := range assistant.AssistantTools {
+		if tool.ExecutionMethod != "transfer_call" {
+			continue
+		}
+		destinations, err := internal_telephony.TransferDestinations(tool.GetOptions())
+		if err == nil && slices.Contains(destinations, destination) {
+			return true
+		}
+	}
+	return false
+}
@@ -7,13 +7,10 @@ package assistant_talk_api
 
 import (
 	"context"
-	"fmt"
+	"errors"
 
-	internal_factories "github.com/rapidaai/api/assistant-api/internal/factory"
-	telephony "github.com/rapidaai/api/assistant-api/internal/factory/telephony"
-	internal_services "github.com/rapidaai/api/assistant-api/internal/services"
+	internal_outbound_telephony "github.com/rapidaai/api/assistant-api/internal/telephony/outbound"
 	"github.com/rapidaai/pkg/types"
-	type_enums "github.com/rapidaai/pkg/types/enums"
 	"github.com/rapidaai/pkg/utils"
 	"github.com/rapidaai/protos"
 )
@@ -26,11 +23,6 @@ func (cApi *ConversationGrpcApi) CreatePhoneCall(ctx context.Context, ir *protos
 		return utils.AuthenticateError[protos.CreatePhoneCallResponse]()
 	}
 
-	toNumber := ir.GetToNumber()
-	if utils.IsEmpty(toNumber) {
-		return utils.ErrorWithCode[protos.CreatePhoneCallResponse](200, fmt.Errorf("missing to_phone parameter"), "Please provide the required to_phone parameter.")
-	}
-
 	mtd, err := utils.AnyMapToInterfaceMap(ir.GetMetadata())
 	if err != nil {
 		return utils.ErrorWithCode[protos.CreatePhoneCallResponse](200, err, "Illegal metadata for initialize request, please check and try again.")
@@ -46,171 +38,25 @@ func (cApi *ConversationGrpcApi) CreatePhoneCall(ctx context.Context, ir *protos
 		return utils.ErrorWithCode[protos.CreatePhoneCallResponse](200, err, "Illegal arguments for initialize request, please check and try again.")
 	}
 
-	assistant, err := cApi.assistantService.Get(ctx,
-		auth,
-		ir.GetAssistant().GetAssistantId(),
-		utils.GetVersionDefinition(ir.GetAssistant().GetVersion()),
-		&internal_services.GetAssistantOption{
-			InjectPhoneDeployment: true,
-		})
-	if err != nil {
-		cApi.logger.Debugf("illegal unable to find assistant %v", err)
-		return utils.ErrorWithCode[protos.CreatePhoneCallResponse](200, err, "Invalid assistant id, please check and try again.")
-	}
-
-	if !assistant.IsPhoneDeploymentEnable() {
-		cApi.logger.Debugf("illegal deployment for phone %v", err)
-		return utils.ErrorWithCode[protos.CreatePhoneCallResponse](200, err, "Phone deployment not enabled or incomplete, please check rapida console and update the deployment")
-	}
-
-	// creating conversation
-	conversation, err := cApi.assistantConversationService.
-		CreateConversation(
-			ctx,
-			auth,
-			internal_factories.Identifier(utils.PhoneCall, ctx, auth, toNumber),
-			assistant.Id,
-			assistant.AssistantProviderId,
-			type_enums.DIRECTION_OUTBOUND,
-			utils.PhoneCall,
-		)
-	if err != nil {
-		cApi.logger.Errorf("unable to create conversation %+v", err)
-		return utils.ErrorWithCode[protos.CreatePhoneCallResponse](200, err, "Unable to create conversation session, please check and try again.")
-	}
-	o, err := cApi.assistantConversationService.
-		ApplyConversationOption(
-			ctx, auth, assistant.Id, conversation.Id, opts,
-		)
-	if err != nil {
-		cApi.logger.Debugf("unable to create options %v", err)
-		return utils.ErrorWithCode[protos.CreatePhoneCallResponse](200, err, "Unable to create conversation options, please check and try again.")
-	}
-	conversation.Options = o
-	// updating arguments
-	arguments, err := cApi.assistantConversationService.
-		ApplyConversationArgument(
-			ctx, auth, assistant.Id, conversation.Id, args,
-		)
-	if err != nil {
-		cApi.logger.Debugf("unable to create argument %v", err)
-		return utils.ErrorWithCode[protos.CreatePhoneCallResponse](200, err, "Unable to create conversation arguments, please check and try again.")
-	}
-	conversation.Arguments = arguments
-	// updating metadata
-	metadatas, err := cApi.assistantConversationService.ApplyConversationMetadata(
-		ctx, auth, assistant.Id, conversation.Id,
-		types.NewMetadataList(mtd),
-	)
-	if err != nil {
-		cApi.logger.Debugf("unable to create metadatas %v", err)
-		return utils.ErrorWithCode[protos.CreatePhoneCallResponse](200, err, "Unable to create conversation metadata, please check and try again.")
-	}
-	conversation.Metadatas = metadatas
-	credentialID, err := assistant.
-		AssistantPhoneDeployment.
-		GetOptions().
-		GetUint64("rapida.credential_id")
-	if err != nil {
-		cApi.
-			assistantConversationService.
-			ApplyConversationMetrics(
-				ctx,
-				auth,
-				assistant.Id,
-				conversation.Id,
-				[]*types.Metric{types.NewStatusMetric(type_enums.RECORD_FAILED)},
-			)
-		return utils.ErrorWithCode[protos.CreatePhoneCallResponse](200, err, "Please check the credential for telephony, please check and try again.")
-	}
-	vltC, err := cApi.vaultClient.GetCredential(ctx, auth, credentialID)
-	if err != nil {
-		cApi.assistantConversationService.
-			ApplyConversationMetrics(
-				ctx, auth, assistant.Id, conversation.Id, []*types.Metric{types.NewStatusMetric(type_enums.RECORD_FAILED)},
-			)
-		return utils.ErrorWithCode[protos.CreatePhoneCallResponse](200, err, "Please check the credential for telephony, please check and try again.")
-	}
-
-	telephony, err := telephony.GetTelephony(
-		telephony.Telephony(
-			assistant.
-				AssistantPhoneDeployment.
-				TelephonyProvider),
-		cApi.cfg,
-		cApi.logger,
-	)
-	if err != nil {
-		cApi.
-			assistantConversationService.
-			ApplyConversationMetrics(
-				ctx, auth, assistant.Id, conversation.Id, []*types.Metric{types.NewStatusMetric(type_enums.RECORD_FAILED)},
-			)
-		return utils.ErrorWithCode[protos.CreatePhoneCallResponse](200, err, "Please check the configuration for telephony, please check and try again.")
-	}
-
-	fromPhone := ir.GetFromNumber()
-	if utils.IsEmpty(fromPhone) {
-		fromNumber, err := assistant.
-			AssistantPhoneDeployment.
-			GetOptions().
-			GetString("phone")
-		if err != nil {
-			cApi.assistantConversationService.
-				ApplyConversationMetrics(
-					ctx, auth, assistant.Id, conversation.Id, []*types.Metric{types.NewStatusMetric(type_enums.RECORD_FAILED)},
-				)
-			return utils.ErrorWithCode[protos.CreatePhoneCallResponse](200, fmt.Errorf("failed to get Twilio phone number"), "Unable to retrieve the default phone number.")
-		}
-		fromPhone = fromNumber
-	}
-
-	meta, metric, event, err := telephony.MakeCall(
-		auth,
-		toNumber,
-		fromPhone,
-		ir.GetAssistant().GetAssistantId(),
-		conversation.Id,
-		vltC,
-		assistant.
-			AssistantPhoneDeployment.
-			GetOptions(),
-	)
-	if err != nil {
-		cApi.logger.Errorf("telephony call return error %v", err)
-	}
-
-	if metric != nil {
-		metrics, err := cApi.assistantConversationService.
-			ApplyConversationMetrics(
-				ctx, auth, assistant.Id, conversation.Id, metric,
-			)
-		if err == nil {
-			conversation.Metrics = append(conversation.Metrics, metrics...)
-		}
-	}
-	if meta != nil {
-		mtds, err := cApi.assistantConversationService.
-			ApplyConversationMetadata(
-				ctx, auth, assistant.Id, conversation.Id, meta,
-			)
-		if err == nil {
-			conversation.Metadatas = append(conversation.Metadatas, mtds...)
-		}
-	}
-	if event != nil {
-		evts, err := cApi.assistantConversationService.
-			ApplyConversationTelephonyEvent(
-				ctx, auth, assistant.AssistantPhoneDeployment.TelephonyProvider, assistant.Id, conversation.Id, event,
-			)
-		if err == nil {
-			conversation.TelephonyEvents = append(conversation.TelephonyEvents, evts...)
+	conversation, err := cApi.caller.Call(ctx, auth, &internal_outbound_telephony.Request{
+		AssistantId:      ir.GetAssistant().GetAssistantId(),
+		AssistantVersion: ir.GetAssistant().GetVersion(),
+		ToNumber:         ir.GetToNumber(),
+		FromNumber:       ir.GetFromNumber(),
+		Metadata:         mtd,
+		Arguments:        args,
+		Options:          opts,
+	})
+	if conversation == nil {
+		var callErr *internal_outbound_telephony.Error
+		if errors.As(err, &callErr) {
+			return utils.ErrorWithCode[protos.CreatePhoneCallResponse](200, callErr.Err, callErr.Message)
 		}
+		return utils.ErrorWithCode[protos.CreatePhoneCallResponse](200, err, "Unable to create phone call, please check and try again.")
 	}
 
 	out := &protos.AssistantConversation{}
-	err = utils.Cast(conversation, out)
-	if err != nil {
+	if err := utils.Cast(conversation, out); err != nil {
 		cApi.logger.Errorf("unable to cast assistant conversation %v", err)
 	}
 	return utils.Success[protos.CreatePhoneCallResponse, *protos.AssistantConversation](out)
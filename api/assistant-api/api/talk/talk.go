@@ -13,6 +13,7 @@ import (
 	internal_grpc "github.com/rapidaai/api/assistant-api/internal/grpc"
 	internal_services "github.com/rapidaai/api/assistant-api/internal/services"
 	internal_assistant_service "github.com/rapidaai/api/assistant-api/internal/services/assistant"
+	internal_outbound_telephony "github.com/rapidaai/api/assistant-api/internal/telephony/outbound"
 	web_client "github.com/rapidaai/pkg/clients/web"
 	"github.com/rapidaai/pkg/commons"
 	"github.com/rapidaai/pkg/connectors"
@@ -34,6 +35,7 @@ type ConversationApi struct {
 	assistantConversationService internal_services.AssistantConversationService
 	assistantService             internal_services.AssistantService
 	vaultClient                  web_client.VaultClient
+	caller                       *internal_outbound_telephony.Caller
 }
 
 type ConversationGrpcApi struct {
@@ -47,17 +49,7 @@ func NewConversationGRPCApi(config *config.AssistantConfig, logger commons.Logge
 	vectordb connectors.VectorConnector,
 ) assistant_api.TalkServiceServer {
 	return &ConversationGrpcApi{
-		ConversationApi{
-			cfg:                          config,
-			logger:                       logger,
-			postgres:                     postgres,
-			redis:                        redis,
-			opensearch:                   opensearch,
-			assistantConversationService: internal_assistant_service.NewAssistantConversationService(logger, postgres, storage_files.NewStorage(config.AssetStoreConfig, logger)),
-			assistantService:             internal_assistant_service.NewAssistantService(config, logger, postgres, opensearch),
-			storage:                      storage_files.NewStorage(config.AssetStoreConfig, logger),
-			vaultClient:                  web_client.NewVaultClientGRPC(&config.AppConfig, logger, redis),
-		},
+		*NewConversationApi(config, logger, postgres, redis, opensearch, vectordb),
 	}
 }
 
@@ -67,15 +59,20 @@ func NewConversationApi(config *config.AssistantConfig, logger commons.Logger,
 	opensearch connectors.OpenSearchConnector,
 	vectordb connectors.VectorConnector,
 ) *ConversationApi {
+	assistantConversationService := internal_assistant_service.NewAssistantConversationService(logger, postgres, storage_files.NewStorage(config.AssetStoreConfig, logger))
+	assistantService := internal_assistant_service.NewAssistantService(config, logger, postgres, opensearch)
+	vaultClient := web_client.NewVaultClientGRPC(&config.AppConfig, logger, redis)
 	return &ConversationApi{
 		cfg:                          config,
 		logger:                       logger,
 		postgres:                     postgres,
 		redis:                        redis,
 		opensearch:                   opensearch,
-		assistantConversationService: internal_assistant_service.NewAssistantConversationService(logger, postgres, storage_files.NewStorage(config.AssetStoreConfig, logger)),
-		assistantService:             internal_assistant_service.NewAssistantService(config, logger, postgres, opensearch),
+		assistantConversationService: assistantConversationService,
+		assistantService:             assistantService,
 		storage:                      storage_files.NewStorage(config.AssetStoreConfig, logger),
+		vaultClient:                  vaultClient,
+		caller:                       internal_outbound_telephony.NewCaller(config, logger, assistantService, assistantConversationService, vaultClient),
 	}
 
 }
@@ -6,75 +6,197 @@
 package assistant_talk_api
 
 import (
-	"fmt"
+	"context"
+	"errors"
 	"net/http"
-	"net/url"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
-	"github.com/rapidaai/pkg/commons"
+	internal_assistant_entity "github.com/rapidaai/api/assistant-api/internal/entity/assistants"
+	internal_factory "github.com/rapidaai/api/assistant-api/internal/factory"
+	internal_whatsapp_factory "github.com/rapidaai/api/assistant-api/internal/factory/whatsapp"
+	internal_services "github.com/rapidaai/api/assistant-api/internal/services"
+	internal_whatsapp "github.com/rapidaai/api/assistant-api/internal/whatsapp"
+	"github.com/rapidaai/pkg/types"
+	"github.com/rapidaai/pkg/utils"
+	"github.com/rapidaai/protos"
 )
 
-// WhatsappReciever handles incoming WhatsApp messages and sends a response.
-// @Router /v1/whatsapp/:assistantId [post]
+// whatsappDeployment resolves the assistant, its whatsapp deployment and the vault credential of provider.
+func (cApi *ConversationApi) whatsappDeployment(c *gin.Context, iAuth types.SimplePrinciple) (internal_whatsapp.Whatsapp, *internal_assistant_entity.Assistant, *protos.VaultCredential, error) {
+	assistantId, err := strconv.ParseUint(c.Param("assistantId"), 10, 64)
+	if err != nil {
+		return nil, nil, nil, errors.New("invalid assistant ID")
+	}
+
+	provider := c.Param("telephony")
+	_whatsapp, err := internal_whatsapp_factory.GetWhatsapp(internal_whatsapp_factory.Whatsapp(provider), cApi.cfg, cApi.logger)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	assistant, err := cApi.assistantService.Get(c, iAuth, assistantId, utils.GetVersionDefinition("latest"), &internal_services.GetAssistantOption{InjectWhatsappDeployment: true})
+	if err != nil {
+		cApi.logger.Debugf("illegal unable to find assistant %v", err)
+		return nil, nil, nil, errors.New("unable to find assistant")
+	}
+
+	deployment := assistant.AssistantWhatsappDeployment
+	if deployment == nil || deployment.WhatsappProvider != provider {
+		return nil, nil, nil, errors.New("whatsapp deployment is not enabled for the provider")
+	}
+
+	credentialId, err := deployment.GetOptions().GetUint64(internal_whatsapp.CredentialOption)
+	if err != nil {
+		return nil, nil, nil, errors.New("whatsapp deployment does not have credential")
+	}
+	credential, err := cApi.vaultClient.GetCredential(c, iAuth, credentialId)
+	if err != nil {
+		cApi.logger.Errorf("unable to get whatsapp credential %v", err)
+		return nil, nil, nil, errors.New("unable to get whatsapp credential")
+	}
+	return _whatsapp, assistant, credential, nil
+}
+
+// WhatsappVerifier answers the subscription verification of the whatsapp webhook.
+// @Router /v1/talk/:telephony/whatsapp/:assistantId/:x-api-key [get]
+// @Summary Verify whatsapp webhook subscription
+// @Produce plain
+// @Success 200 {string} string
+// @Failure 403 {object} commons.Response
+func (cApi *ConversationApi) WhatsappVerifier(c *gin.Context) {
+	iAuth, isAuthenticated := types.GetAuthPrinciple(c)
+	if !isAuthenticated {
+		c.JSON(http.StatusForbidden, gin.H{"error": "Unauthenticated request"})
+		return
+	}
+	_whatsapp, _, credential, err := cApi.whatsappDeployment(c, iAuth)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	challenge, err := _whatsapp.Challenge(c, credential)
+	if err != nil {
+		c.JSON(http.StatusForbidden, gin.H{"error": "Invalid verification request"})
+		return
+	}
+	c.String(http.StatusOK, challenge)
+}
+
+// WhatsappReciever handles incoming WhatsApp messages, replies are sent asynchronously once the turn completes.
+// @Router /v1/talk/:telephony/whatsapp/:assistantId/:x-api-key [post]
 // @Summary Recieve whatsapp message and respond
 // @Produce json
 // @Success 200 {object} commons.Response
 // @Failure 500 {object} commons.Response
 func (cApi *ConversationApi) WhatsappReciever(c *gin.Context) {
-	assistantId := c.Param("assistantId") // Extract assistantId from URL
-	from := c.PostForm("From")            // Sender's WhatsApp number
-	body := c.PostForm("Body")            // Message content
-	// Logging the received message
-	fmt.Printf("Assistant ID: %s, Received message from %s: %s\n", assistantId, from, body)
+	iAuth, isAuthenticated := types.GetAuthPrinciple(c)
+	if !isAuthenticated {
+		cApi.logger.Debugf("illegal unable to authenticate")
+		c.JSON(http.StatusForbidden, gin.H{"error": "Unauthenticated request"})
+		return
+	}
 
-	// Responding with a simple text message
-	responseMessage := "Hello! Thanks for your message. We'll get back to you shortly."
+	body, err := c.GetRawData()
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Unable to read request"})
+		return
+	}
 
-	// Call a function to send WhatsApp message using Twilio
-	err := sendWhatsAppMessage(from, responseMessage)
+	_whatsapp, assistant, credential, err := cApi.whatsappDeployment(c, iAuth)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, commons.Response{
-			Code:    400,
-			Success: false,
-			Data:    &commons.ErrorMessage{},
-		})
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := _whatsapp.VerifySignature(c, body, credential); err != nil {
+		cApi.logger.Warnf("rejecting whatsapp webhook for assistant %d %v", assistant.Id, err)
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid signature"})
+		return
+	}
+
+	messages, err := _whatsapp.Receive(c, body)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid whatsapp message"})
 		return
 	}
 
-	c.JSON(http.StatusOK, commons.Response{
-		Code:    200,
-		Success: true,
+	// providers retry webhooks which are not acknowledged in time, turns are processed in background
+	ctx := context.WithoutCancel(c.Request.Context())
+	utils.Go(ctx, func() {
+		for _, msg := range messages {
+			claimed, err := internal_whatsapp.ClaimMessage(ctx, cApi.redis, assistant.Id, msg.Id)
+			if err != nil {
+				cApi.logger.Errorf("unable to claim whatsapp message %s %v", msg.Id, err)
+				continue
+			}
+			if !claimed {
+				cApi.logger.Debugf("dropping redelivered whatsapp message %s", msg.Id)
+				continue
+			}
+			if err := cApi.WhatsappTalker(ctx, iAuth, _whatsapp, assistant, credential, msg); err != nil {
+				cApi.logger.Errorf("illegal while processing whatsapp message %s %v", msg.Id, err)
+			}
+		}
 	})
+	c.Status(http.StatusOK)
 }
 
-// Helper function to send WhatsApp message using Twilio
-func sendWhatsAppMessage(to, body string) error {
-	accountSid := "YOUR_TWILIO_ACCOUNT_SID"
-	authToken := "YOUR_TWILIO_AUTH_TOKEN"
-	from := "whatsapp:+YOUR_TWILIO_NUMBER"
-
-	msgData := url.Values{}
-	msgData.Set("To", to)
-	msgData.Set("From", from)
-	msgData.Set("Body", body)
-	msgDataReader := *strings.NewReader(msgData.Encode())
-
-	client := &http.Client{}
-	req, _ := http.NewRequest("POST", "https://api.twilio.com/2010-04-01/Accounts/"+accountSid+"/Messages.json", &msgDataReader)
-	req.SetBasicAuth(accountSid, authToken)
-	req.Header.Add("Accept", "application/json")
-	req.Header.Add("Content-Type", "application/x-www-form-urlencoded")
-
-	resp, err := client.Do(req)
+// WhatsappTalker runs the turn of inbound message, the conversation of sender is resumed
+// when it has been active within the session window of deployment.
+func (cApi *ConversationApi) WhatsappTalker(
+	ctx context.Context,
+	iAuth types.SimplePrinciple,
+	_whatsapp internal_whatsapp.Whatsapp,
+	assistant *internal_assistant_entity.Assistant,
+	credential *protos.VaultCredential,
+	msg *internal_whatsapp.InboundMessage,
+) error {
+	start := time.Now()
+	sender := strings.TrimPrefix(msg.From, "whatsapp:")
+	identifier := internal_factory.Identifier(utils.Whatsapp, ctx, iAuth, sender)
+	window := internal_whatsapp.SessionWindow(assistant.AssistantWhatsappDeployment.GetOptions())
+
+	// the conversation is found or created by the turn, turns of the sender run one at a time
+	unlock, err := internal_whatsapp.LockSender(ctx, cApi.redis, assistant.Id, sender)
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
+	defer unlock()
 
-	if resp.StatusCode >= 300 {
-		return fmt.Errorf("failed to send message, status code: %d", resp.StatusCode)
+	var conversationId uint64
+	if conversation, err := cApi.assistantConversationService.GetActiveConversation(ctx, iAuth, identifier, assistant.Id, time.Now().Add(-window)); err == nil {
+		conversationId = conversation.Id
 	}
 
+	streamer := internal_whatsapp.NewTurnStreamer(ctx, cApi.logger, assistant.Id, conversationId, msg.Text,
+		func(ctx context.Context, text string) error {
+			return _whatsapp.Send(ctx, credential, msg, text)
+		})
+	talker, err := internal_factory.GetTalker(utils.Whatsapp, ctx, cApi.cfg, cApi.logger, cApi.postgres, cApi.opensearch, cApi.redis, cApi.storage, streamer)
+	if err != nil {
+		return err
+	}
+	if err := talker.Talk(ctx, iAuth, identifier); err != nil {
+		return err
+	}
+
+	// the turn only releases the session, the conversation ends once the window expires
+	conversation, err := cApi.assistantConversationService.GetActiveConversation(ctx, iAuth, identifier, assistant.Id, time.Now().Add(-window))
+	if err != nil {
+		return err
+	}
+	if err := internal_whatsapp.ScheduleSession(ctx, cApi.redis, &internal_whatsapp.Session{
+		OrganizationId:          conversation.OrganizationId,
+		ProjectId:               conversation.ProjectId,
+		AssistantId:             assistant.Id,
+		AssistantConversationId: conversation.Id,
+		Identifier:              identifier,
+	}, time.Now().Add(window)); err != nil {
+		return err
+	}
+	cApi.logger.Benchmark("ConversationApi.WhatsappTalker", time.Since(start))
 	return nil
 }
@@ -18,7 +18,15 @@ type AssistantConfig struct {
 	OpenSearchConfig    configs.OpenSearchConfig `mapstructure:"opensearch" validate:"required"`
 	WeaviateConfig      configs.WeaviateConfig   `mapstructure:"weaviate"`
 	AssetStoreConfig    configs.AssetStoreConfig `mapstructure:"asset_store" validate:"required"`
+	TelemetryConfig     configs.TelemetryConfig  `mapstructure:"telemetry"`
 	PublicAssistantHost string                   `mapstructure:"public_assistant_host" validate:"required"`
+	MCPConfig           MCPConfig                `mapstructure:"mcp"`
+}
+
+// MCPConfig of the mcp tools of assistants
+type MCPConfig struct {
+	// commands the stdio transport is allowed to run, stdio is disabled when empty
+	StdioCommands []string `mapstructure:"stdio_commands"`
 }
 
 // reading config and intializing configs for application
@@ -48,6 +56,17 @@ func InitConfig() (*viper.Viper, error) {
 }
 
 func setDefault(v *viper.Viper) {
+	v.SetDefault("TELEMETRY__EXPORTERS", "opensearch")
+	v.SetDefault("TELEMETRY__OTLP__PROTOCOL", "grpc")
+	v.SetDefault("TELEMETRY__OTLP__ENDPOINT", "localhost:4317")
+	v.SetDefault("TELEMETRY__OTLP__INSECURE", false)
+	v.SetDefault("TELEMETRY__OTLP__SERVICE_NAME", "assistant-api")
+	v.SetDefault("TELEMETRY__OTLP__HEADERS", "")
+	v.SetDefault("TELEMETRY__OTLP__URL_PATH", "")
+	v.SetDefault("TELEMETRY__OTLP__BATCH_SIZE", 512)
+	v.SetDefault("TELEMETRY__OTLP__BATCH_TIMEOUT", "5s")
+	v.SetDefault("TELEMETRY__OTLP__MAX_QUEUE_SIZE", 2048)
+	v.SetDefault("MCP__STDIO_COMMANDS", "")
 }
 
 // Getting application config from viper
@@ -12,9 +12,11 @@ import (
 	internal_conversation_gorm "github.com/rapidaai/api/assistant-api/internal/entity/conversations"
 	internal_knowledge_gorm "github.com/rapidaai/api/assistant-api/internal/entity/knowledges"
 	internal_adapter_tracing "github.com/rapidaai/api/assistant-api/internal/telemetry"
+	internal_telephony "github.com/rapidaai/api/assistant-api/internal/telephony"
 	endpoint_client "github.com/rapidaai/pkg/clients/endpoint"
 	integration_client "github.com/rapidaai/pkg/clients/integration"
 	web_client "github.com/rapidaai/pkg/clients/web"
+	"github.com/rapidaai/pkg/storages"
 	"github.com/rapidaai/pkg/types"
 	type_enums "github.com/rapidaai/pkg/types/enums"
 	"github.com/rapidaai/pkg/utils"
@@ -45,13 +47,10 @@ type Logger interface {
 	GetConversationLogs() []*protos.Message
 	CreateConversationMessageLog(messageid string, in, out *types.Message, metrics []*types.Metric) error
 	CreateConversationToolLog(messageid string, in, out map[string]interface{}, metrics []*types.Metric) error
-	CreateWebhookLog(
-		webhookID uint64, httpUrl, httpMethod, event string,
-		responseStatus int64,
-		timeTaken int64,
-		retryCount uint32,
-		status type_enums.RecordState,
-		request, response []byte) error
+	EnqueueWebhookLog(
+		webhook *internal_assistant_entity.AssistantWebhook,
+		event string,
+		request []byte) error
 	CreateToolLog(
 		toolId uint64,
 		messageId string,
@@ -62,8 +61,13 @@ type Logger interface {
 		request, response []byte,
 	) error
 
+	BeginConversationRecording(
+		extension string,
+	) (*internal_conversation_gorm.AssistantConversationRecording, *storages.Spool, error)
+
 	CreateConversationRecording(
-		body []byte,
+		recording *internal_conversation_gorm.AssistantConversationRecording,
+		spool *storages.Spool,
 	) error
 }
 
@@ -102,6 +106,15 @@ type Communication interface {
 	// current conversation
 	Conversation() *internal_conversation_gorm.AssistantConversation
 
+	// transfer the phone call to human or another number
+	TransferCall(ctx context.Context, transfer *internal_telephony.Transfer) error
+
+	// whether the phone call of the conversation can be transferred
+	CanTransferCall() bool
+
+	// commands the mcp tools are allowed to run with stdio transport
+	MCPStdioCommands() []string
+
 	// later will create an interface to move all the conversation
 	// idea is have custom history maintainer eg: database, inmemory
 	// local managing the histories for given conversation
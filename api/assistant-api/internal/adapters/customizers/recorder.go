@@ -6,414 +6,362 @@
 package internal_adapter_request_customizers
 
 import (
-	"bytes"
+	"context"
 	"encoding/binary"
 	"fmt"
-	"sort"
+	"io"
 	"sync"
 	"time"
 
+	internal_encoder "github.com/rapidaai/api/assistant-api/internal/encoder"
 	"github.com/rapidaai/pkg/commons"
 	protos "github.com/rapidaai/protos"
 )
 
-// AudioChunk represents a timestamped audio chunk
-type AudioChunk struct {
-	Data      []byte
-	Timestamp time.Time
-	IsSystem  bool // true for system audio, false for user audio
-	Config    *protos.AudioConfig
-	ID        int64 // Add unique identifier for each chunk
-}
+const (
+	// user and assistant mixed into a single channel
+	RECORDING_CHANNEL_MONO = "mono"
+	// user on the left channel and assistant on the right channel
+	RECORDING_CHANNEL_STEREO = "stereo"
+)
 
-type Recorder interface {
-	Initialize(userAudioConfig, systemAudioConfig *protos.AudioConfig) error
-	User(in []byte) error
-	Interrupt() error
-	System(out []byte) error
-	Persist() ([]byte, error)
-}
+const (
+	defaultRecordingFlushInterval = 5 * time.Second
 
-type recorder struct {
-	logger           commons.Logger
-	mu               sync.Mutex   // Ensure thread-safe access
-	audioChunks      []AudioChunk // All audio chunks with timestamps
-	interruptionTime *time.Time   // When interruption occurred (nil if no interruption)
-	userConfig       *protos.AudioConfig
-	systemConfig     *protos.AudioConfig
-	chunkIDCounter   int64 // Counter for unique chunk IDs
-}
+	// audio younger than this is kept in memory, late chunks and interruptions can still change it
+	recordingFlushDelay = time.Second
 
-func NewRecorder(logger commons.Logger) Recorder {
-	return &recorder{
-		logger:      logger,
-		audioChunks: []AudioChunk{},
-		mu:          sync.Mutex{},
-	}
-}
+	// interruptions within this window are a single interruption
+	recordingInterruptionWindow = 100 * time.Millisecond
+)
 
-func (r *recorder) Initialize(userConfig, systemConfig *protos.AudioConfig) error {
-	r.userConfig = userConfig
-	r.systemConfig = systemConfig
-	return nil
+// RecordingWriter takes the encoded recording as it is written, it is flushed to its storage
+// every flush interval and seeks back when the encoder patches its header
+type RecordingWriter interface {
+	io.WriteSeeker
+	Flush(ctx context.Context) error
 }
 
-func (r *recorder) User(in []byte) error {
-	r.mu.Lock()
-	defer r.mu.Unlock()
-
-	r.chunkIDCounter++
-	chunk := AudioChunk{
-		Data:      make([]byte, len(in)),
-		Timestamp: time.Now(),
-		IsSystem:  false,
-		Config:    r.userConfig,
-		ID:        r.chunkIDCounter,
-	}
-	copy(chunk.Data, in)
-	r.audioChunks = append(r.audioChunks, chunk)
-	return nil
-}
-func (r *recorder) Interrupt() error {
-	r.mu.Lock()
-	defer r.mu.Unlock()
-	interruptTime := time.Now()
-	// Check if the last interruption occurred very recently
-	if r.interruptionTime != nil {
-		elapsed := interruptTime.Sub(*r.interruptionTime)
-		if elapsed <= 100*time.Millisecond && elapsed >= 50*time.Millisecond {
-			// r.logger.Info("Interrupt ignored. Too soon after the previous interruption: ", elapsed)
-			// Update interruption time regardless of ignoring
-			r.interruptionTime = &interruptTime
-			return nil
-		}
-	}
+type RecordingOptions struct {
+	// mono or stereo, mono when empty
+	Channel string
 
-	// Update interruption time
-	r.interruptionTime = &interruptTime
-	// r.logger.Info("User interruption detected at ", interruptTime)
+	Writer RecordingWriter
 
-	// Remove system audio chunks that would "play" after interruption
-	r.removeInterruptedSystemAudio(interruptTime)
+	// Encoder creates the encoder of the recording on the writer
+	Encoder func(w io.Writer, sampleRate, channels uint32) (internal_encoder.Encoder, error)
 
-	return nil
+	// how often the encoded recording is flushed, 5 seconds when zero
+	FlushInterval time.Duration
 }
 
-func (r *recorder) System(out []byte) error {
-	r.mu.Lock()
-	defer r.mu.Unlock()
+// RecordingOutput describes the recording written on persist
+type RecordingOutput struct {
+	Codec      string
+	Channels   uint32
+	SampleRate uint32
+	Duration   time.Duration
 
-	r.chunkIDCounter++
-	chunk := AudioChunk{
-		Data:      make([]byte, len(out)),
-		Timestamp: time.Now(),
-		IsSystem:  true,
-		Config:    r.systemConfig,
-		ID:        r.chunkIDCounter,
-	}
-	copy(chunk.Data, out)
-	r.audioChunks = append(r.audioChunks, chunk)
-	return nil
+	// offsets from the start of the recording where the user interrupted the assistant
+	Interruptions []time.Duration
 }
 
-func (r *recorder) removeInterruptedSystemAudio(interruptTime time.Time) {
-	silencedCount := 0
-	modifiedCount := 0
-
-	// r.logger.Info("Processing interruption at", interruptTime)
-	adjustedInterruptTime := interruptTime
+type Recorder interface {
+	Initialize(userAudioConfig, systemAudioConfig *protos.AudioConfig, opts *RecordingOptions) error
+	User(in []byte) error
+	Interrupt() error
+	System(out []byte) error
+	Persist() (*RecordingOutput, error)
+}
 
-	for i := range r.audioChunks {
-		chunk := &r.audioChunks[i]
-		if !chunk.IsSystem {
-			continue // Only process system audio chunks
-		}
+const (
+	userChannel   = 0
+	systemChannel = 1
+)
 
-		// Calculate playback times for the chunk
-		playStartTime := r.calculateSystemAudioPlayTime(*chunk)
-		chunkDuration := r.calculateChunkDuration(*chunk)
-		playEndTime := playStartTime.Add(chunkDuration)
+// recorder lays user and assistant audio on a timeline from the start of the recording and
+// encodes it as it goes, so a long call is never held in memory.
+//
+// User audio is placed where it was captured, ending when it is received. Assistant audio
+// is placed when it is sent and queues behind the audio still playing, an interruption cuts
+// the queued assistant audio off at the moment of the interruption.
+type recorder struct {
+	logger commons.Logger
+	mu     sync.Mutex
 
-		// r.logger.Debug(fmt.Sprintf(
-		// 	"Chunk ID=%d: Plays from %v to %v (Duration: %.2fms, Format: %v, Sample Rate: %d)",
-		// 	chunk.ID, playStartTime, playEndTime, chunkDuration.Seconds()*1000, chunk.Config.Format, chunk.Config.SampleRate,
-		// ))
+	userConfig   *protos.AudioConfig
+	systemConfig *protos.AudioConfig
+	sampleRate   uint32
+	stereo       bool
 
-		// Analyze overlap with the interruption time
-		if playStartTime.After(adjustedInterruptTime) || playStartTime.Equal(adjustedInterruptTime) {
-			// r.logger.Debug(fmt.Sprintf("Chunk ID=%d: No interruption - plays after %v", chunk.ID, adjustedInterruptTime))
-			continue // Chunk starts playing after the interruption, keep it unchanged
-		}
+	writer  RecordingWriter
+	encoder internal_encoder.Encoder
+	start   time.Time
 
-		if playEndTime.Before(adjustedInterruptTime) {
-			// r.logger.Debug(fmt.Sprintf("Chunk ID=%d: No interruption - ends before %v", chunk.ID, adjustedInterruptTime))
-			continue // Chunk ends before the interruption, keep it unchanged
-		}
+	// samples of each channel from flushed on, positions are in samples from start
+	buffers   [2][]int32
+	ends      [2]int64
+	flushed   int64
+	encodeErr error
 
-		if playStartTime.Before(adjustedInterruptTime) && playEndTime.After(adjustedInterruptTime) {
-			// Overlap with interruption: trim or silence the chunk
-			keepDuration := adjustedInterruptTime.Sub(playStartTime)
-
-			if keepDuration > 0 {
-				// Calculate bytes to keep based on duration, sample rate, and format
-				trimmedData := r.trimAudioChunkData(chunk.Data, keepDuration, chunk.Config)
-				// r.logger.Debug(fmt.Sprintf(
-				// 	"Chunk ID=%d: Partially silenced. Keeping %.2fms, silencing %.2fms",
-				// 	chunk.ID,
-				// 	keepDuration.Seconds()*1000,
-				// 	(chunkDuration-keepDuration).Seconds()*1000,
-				// ))
-				chunk.Data = trimmedData
-				modifiedCount++
-			} else {
-				// Fully silence the chunk if no valid duration remains
-				r.logger.Debug(fmt.Sprintf("Chunk ID=%d: Fully silenced", chunk.ID))
-				chunk.Data = r.createSilentAudioData(len(chunk.Data))
-				silencedCount++
-			}
-			continue
-		}
-	}
+	interruptions []time.Duration
 
-	// r.logger.Info(fmt.Sprintf("Interruption processed: silenced %d chunks, modified %d chunks", silencedCount, modifiedCount))
+	stop chan struct{}
+	done chan struct{}
 }
 
-func (r *recorder) createSilentAudioData(byteLength int) []byte {
-	// Generate silence: zero bytes for the specified length
-	return make([]byte, byteLength)
+func NewRecorder(logger commons.Logger) Recorder {
+	return &recorder{
+		logger: logger,
+		mu:     sync.Mutex{},
+	}
 }
 
-func (r *recorder) trimAudioChunkData(data []byte, keepDuration time.Duration, config *protos.AudioConfig) []byte {
-	if config == nil || keepDuration <= 0 {
-		return []byte{}
+func (r *recorder) Initialize(userConfig, systemConfig *protos.AudioConfig, opts *RecordingOptions) error {
+	if opts == nil || opts.Writer == nil || opts.Encoder == nil {
+		return fmt.Errorf("recording writer and encoder are required")
 	}
-
-	// Calculate bytes per sample based on audio format
-	var bytesPerSample int
-	switch config.GetAudioFormat() {
-	case protos.AudioConfig_LINEAR16:
-		bytesPerSample = 2
-	case protos.AudioConfig_MuLaw8:
-		bytesPerSample = 1
-	default:
-		bytesPerSample = 2 // Default to 16-bit PCM
+	target := userConfig
+	if target == nil {
+		target = systemConfig
 	}
-
-	// Calculate bytes per frame (sample + channels)
-	bytesPerFrame := bytesPerSample * int(config.Channels)
-
-	// Calculate total samples to keep based on duration
-	samplesToKeep := int(keepDuration.Seconds() * float64(config.SampleRate))
-
-	// Calculate bytes to keep (ensure frame alignment)
-	bytesToKeep := samplesToKeep * bytesPerFrame
-	bytesToKeep = (bytesToKeep / bytesPerFrame) * bytesPerFrame // Round down to nearest frame boundary
-
-	if bytesToKeep > len(data) {
-		bytesToKeep = len(data) // Ensure data length is not exceeded
+	if target == nil || target.GetSampleRate() == 0 {
+		return fmt.Errorf("no valid audio configuration found")
 	}
 
-	if bytesToKeep <= 0 {
-		return []byte{} // Return empty if no valid data remains
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.encoder != nil {
+		return fmt.Errorf("recorder is already initialized")
 	}
 
-	// Return trimmed data
-	trimmedData := make([]byte, bytesToKeep)
-	copy(trimmedData, data[:bytesToKeep])
+	channels := uint32(1)
+	if opts.Channel == RECORDING_CHANNEL_STEREO {
+		channels = 2
+	}
+	encoder, err := opts.Encoder(opts.Writer, target.GetSampleRate(), channels)
+	if err != nil {
+		return err
+	}
 
-	return trimmedData
+	r.userConfig = userConfig
+	r.systemConfig = systemConfig
+	r.sampleRate = target.GetSampleRate()
+	r.stereo = channels == 2
+	r.writer = opts.Writer
+	r.encoder = encoder
+	r.start = time.Now()
+
+	interval := opts.FlushInterval
+	if interval <= 0 {
+		interval = defaultRecordingFlushInterval
+	}
+	r.stop = make(chan struct{})
+	r.done = make(chan struct{})
+	go r.flushing(r.stop, interval)
+	return nil
 }
 
-func (r *recorder) calculateSystemAudioPlayTime(targetChunk AudioChunk) time.Time {
-	var latestEndTime time.Time
-	isFirstSystemChunk := true
-
-	for _, chunk := range r.audioChunks {
-		if chunk.ID == targetChunk.ID {
-			break // Stop as soon as target chunk is reached
-		}
-
-		if chunk.IsSystem {
-			playStartTime := latestEndTime
-			if chunk.Timestamp.After(latestEndTime) {
-				playStartTime = chunk.Timestamp
+// flushing encodes settled audio and flushes the writer every interval
+func (r *recorder) flushing(stop <-chan struct{}, interval time.Duration) {
+	defer close(r.done)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			r.mu.Lock()
+			r.encode(r.offset(time.Now().Add(-recordingFlushDelay)))
+			r.mu.Unlock()
+			if err := r.writer.Flush(context.Background()); err != nil {
+				r.logger.Errorf("unable to flush recording %v", err)
 			}
-			latestEndTime = playStartTime.Add(r.calculateChunkDuration(chunk))
-			isFirstSystemChunk = false
 		}
 	}
+}
 
-	playStartTime := targetChunk.Timestamp
-	if !isFirstSystemChunk && latestEndTime.After(targetChunk.Timestamp) {
-		playStartTime = latestEndTime
-	}
-
-	return playStartTime
+// offset of the time from the start of the recording in samples
+func (r *recorder) offset(t time.Time) int64 {
+	return int64(t.Sub(r.start)) * int64(r.sampleRate) / int64(time.Second)
 }
 
-func (r *recorder) Persist() ([]byte, error) {
+func (r *recorder) User(in []byte) error {
 	r.mu.Lock()
 	defer r.mu.Unlock()
-
-	if len(r.audioChunks) == 0 {
-		// r.logger.Info("No audio chunks to persist")
-		return nil, fmt.Errorf("empty chunk of audio")
-	}
-
-	// Sort chunks by timestamp to maintain chronological order
-	sort.Slice(r.audioChunks, func(i, j int) bool {
-		return r.audioChunks[i].Timestamp.Before(r.audioChunks[j].Timestamp)
-	})
-
-	// Determine the target audio configuration
-	targetConfig := r.getTargetAudioConfig()
-	if targetConfig == nil {
-		return nil, fmt.Errorf("no valid audio configuration found")
+	if r.encoder == nil {
+		return nil
 	}
-
-	// Convert and merge all chunks
-	mergedAudio, err := r.mergeAudioChunks(targetConfig)
+	samples, err := r.convertToSamples(in, r.userConfig)
 	if err != nil {
-		r.logger.Error("Failed to merge audio chunks", err)
-		return nil, err
+		return err
 	}
+	at := max(r.offset(time.Now())-int64(len(samples)), r.ends[userChannel])
+	r.place(userChannel, at, samples)
+	return nil
+}
 
-	// Create WAV file
-	wavData, err := r.createWAVFile(mergedAudio, targetConfig)
+func (r *recorder) System(out []byte) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.encoder == nil {
+		return nil
+	}
+	samples, err := r.convertToSamples(out, r.systemConfig)
 	if err != nil {
-		r.logger.Error("Failed to create WAV file", err)
-		return nil, err
+		return err
 	}
-
-	// r.logger.Info(fmt.Sprintf("Persisted audio with %d chunks", len(r.audioChunks)))
-	return wavData, nil
+	at := max(r.offset(time.Now()), r.ends[systemChannel])
+	r.place(systemChannel, at, samples)
+	return nil
 }
 
-func (r *recorder) getTargetAudioConfig() *protos.AudioConfig {
-	if r.userConfig != nil {
-		return r.userConfig
+func (r *recorder) Interrupt() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.encoder == nil {
+		return nil
 	}
-	if r.systemConfig != nil {
-		return r.systemConfig
+	now := time.Now()
+	at := max(r.offset(now), r.flushed)
+
+	// assistant audio which would have played after the interruption is never heard
+	if r.ends[systemChannel] > at {
+		buffer := r.buffers[systemChannel]
+		if keep := int(at - r.flushed); keep < len(buffer) {
+			r.buffers[systemChannel] = buffer[:keep]
+		}
+		r.ends[systemChannel] = at
 	}
-	if len(r.audioChunks) > 0 && r.audioChunks[0].Config != nil {
-		return r.audioChunks[0].Config
+
+	elapsed := now.Sub(r.start)
+	if n := len(r.interruptions); n > 0 && elapsed-r.interruptions[n-1] <= recordingInterruptionWindow {
+		return nil
 	}
+	r.interruptions = append(r.interruptions, elapsed)
 	return nil
 }
 
-func (r *recorder) mergeAudioChunks(targetConfig *protos.AudioConfig) ([]byte, error) {
-	if len(r.audioChunks) == 0 {
-		return nil, fmt.Errorf("no audio chunks to merge")
+// place writes the samples of the channel at the position, audio before what is already
+// encoded is dropped
+func (r *recorder) place(channel int, at int64, samples []int32) {
+	if skip := r.flushed - at; skip > 0 {
+		if skip >= int64(len(samples)) {
+			return
+		}
+		samples = samples[skip:]
+		at = r.flushed
+	}
+	from := int(at - r.flushed)
+	buffer := r.buffers[channel]
+	if to := from + len(samples); to > len(buffer) {
+		buffer = append(buffer, make([]int32, to-len(buffer))...)
 	}
+	copy(buffer[from:], samples)
+	r.buffers[channel] = buffer
+	r.ends[channel] = at + int64(len(samples))
+}
 
-	// Calculate total duration and create timeline
-	startTime := r.audioChunks[0].Timestamp
-	var endTime time.Time
-	for _, chunk := range r.audioChunks {
-		chunkDuration := r.calculateChunkDuration(chunk)
-		chunkEndTime := chunk.Timestamp.Add(chunkDuration)
-		if chunkEndTime.After(endTime) {
-			endTime = chunkEndTime
+// encode the timeline up to the position and drop it from memory
+func (r *recorder) encode(upTo int64) {
+	n := int(upTo - r.flushed)
+	if n <= 0 || r.encodeErr != nil {
+		return
+	}
+	sample := func(channel, i int) int32 {
+		if i < len(r.buffers[channel]) {
+			return r.buffers[channel][i]
 		}
+		return 0
 	}
-
-	totalDuration := endTime.Sub(startTime)
-	totalSamples := uint32(totalDuration.Seconds() * float64(targetConfig.SampleRate))
-
-	// Create output buffer (16-bit samples)
-	outputSamples := make([]int32, totalSamples*targetConfig.Channels)
-
-	// Process each chunk and add to the output buffer
-	for _, chunk := range r.audioChunks {
-		err := r.addChunkToOutput(chunk, outputSamples, startTime, targetConfig)
-		if err != nil {
-			return nil, fmt.Errorf("failed to add chunk to output: %v", err)
+	var pcm []int16
+	if r.stereo {
+		pcm = make([]int16, n*2)
+		for i := 0; i < n; i++ {
+			pcm[i*2] = clamp(sample(userChannel, i))
+			pcm[i*2+1] = clamp(sample(systemChannel, i))
+		}
+	} else {
+		pcm = make([]int16, n)
+		for i := 0; i < n; i++ {
+			pcm[i] = clamp(sample(userChannel, i) + sample(systemChannel, i))
 		}
 	}
-
-	// Convert int32 samples to 16-bit PCM
-	pcmData := make([]byte, len(outputSamples)*2)
-	for i, sample := range outputSamples {
-		// Clamp to 16-bit range
-		if sample > 32767 {
-			sample = 32767
-		} else if sample < -32768 {
-			sample = -32768
+	if err := r.encoder.Encode(pcm); err != nil {
+		r.logger.Errorf("unable to encode recording %v", err)
+		r.encodeErr = err
+	}
+	for channel, buffer := range r.buffers {
+		if n >= len(buffer) {
+			r.buffers[channel] = buffer[:0]
+			continue
 		}
-		binary.LittleEndian.PutUint16(pcmData[i*2:], uint16(sample))
+		r.buffers[channel] = append(buffer[:0], buffer[n:]...)
 	}
-
-	return pcmData, nil
+	r.flushed = upTo
 }
 
-func (r *recorder) calculateChunkDuration(chunk AudioChunk) time.Duration {
-	if chunk.Config == nil {
-		return 0
+func clamp(sample int32) int16 {
+	if sample > 32767 {
+		return 32767
 	}
-	var bytesPerSample int
-	switch chunk.Config.GetAudioFormat() {
-	case protos.AudioConfig_LINEAR16:
-		bytesPerSample = 2
-	case protos.AudioConfig_MuLaw8:
-		bytesPerSample = 1
-	default:
-		bytesPerSample = 2 // default to 16-bit
+	if sample < -32768 {
+		return -32768
 	}
-
-	samples := len(chunk.Data) / (bytesPerSample * int(chunk.Config.GetChannels()))
-	duration := float64(samples) / float64(chunk.Config.SampleRate)
-	return time.Duration(duration * float64(time.Second))
+	return int16(sample)
 }
 
-func (r *recorder) addChunkToOutput(chunk AudioChunk, output []int32, startTime time.Time, targetConfig *protos.AudioConfig) error {
-	if chunk.Config == nil {
-		return fmt.Errorf("chunk has no audio configuration")
+// Persist encodes the rest of the recording and finishes it, assistant audio still queued
+// when the call ended is left out
+func (r *recorder) Persist() (*RecordingOutput, error) {
+	r.mu.Lock()
+	if r.encoder == nil {
+		r.mu.Unlock()
+		return nil, fmt.Errorf("recorder is not initialized")
 	}
-
-	// Convert chunk data to int32 samples
-	chunkSamples, err := r.convertToSamples(chunk.Data, chunk.Config)
-	if err != nil {
-		return err
+	stop := r.stop
+	r.stop = nil
+	r.mu.Unlock()
+	if stop == nil {
+		return nil, fmt.Errorf("recording is already persisted")
 	}
+	close(stop)
+	<-r.done
 
-	// Find the last non-zero sample in the output buffer
-	lastNonZeroIndex := len(output) - 1
-	for lastNonZeroIndex >= 0 && output[lastNonZeroIndex] == 0 {
-		lastNonZeroIndex--
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	end := max(r.ends[userChannel], min(r.ends[systemChannel], r.offset(time.Now())))
+	r.encode(end)
+	if r.flushed == 0 {
+		return nil, fmt.Errorf("empty chunk of audio")
 	}
-
-	// Determine where to start adding the new samples
-	var startIndex int
-	if chunk.IsSystem {
-		// For system audio, start right after the last non-zero sample
-		startIndex = lastNonZeroIndex + 1
-	} else {
-		// For user audio, use the timestamp-based offset
-		offsetDuration := chunk.Timestamp.Sub(startTime)
-		startIndex = int(offsetDuration.Seconds()*float64(targetConfig.SampleRate)) * int(targetConfig.Channels)
-	}
-
-	// Add samples to output buffer
-	for i, sample := range chunkSamples {
-		outputIndex := startIndex + i
-		if outputIndex >= 0 && outputIndex < len(output) {
-			if chunk.IsSystem {
-				// For system audio, simply place it
-				output[outputIndex] = sample
-			} else {
-				// For user audio, mix with existing audio
-				output[outputIndex] += sample
-			}
-		}
+	if r.encodeErr != nil {
+		return nil, r.encodeErr
+	}
+	if err := r.encoder.Close(); err != nil {
+		return nil, err
 	}
 
-	return nil
+	channels := uint32(1)
+	if r.stereo {
+		channels = 2
+	}
+	return &RecordingOutput{
+		Codec:         r.encoder.Codec(),
+		Channels:      channels,
+		SampleRate:    r.sampleRate,
+		Duration:      time.Duration(r.flushed * int64(time.Second) / int64(r.sampleRate)),
+		Interruptions: r.interruptions,
+	}, nil
 }
 
+// convertToSamples decodes the audio into mono samples at the sample rate of the recording
 func (r *recorder) convertToSamples(data []byte, config *protos.AudioConfig) ([]int32, error) {
+	if config == nil {
+		return nil, fmt.Errorf("chunk has no audio configuration")
+	}
 	var samples []int32
-
 	switch config.AudioFormat {
 	case protos.AudioConfig_LINEAR16:
 		samples = make([]int32, len(data)/2)
@@ -430,7 +378,35 @@ func (r *recorder) convertToSamples(data []byte, config *protos.AudioConfig) ([]
 		return nil, fmt.Errorf("unsupported audio format: %v", config.AudioFormat.String())
 	}
 
-	return samples, nil
+	if channels := int(config.GetChannels()); channels > 1 {
+		mono := make([]int32, len(samples)/channels)
+		for i := range mono {
+			var sum int32
+			for c := 0; c < channels; c++ {
+				sum += samples[i*channels+c]
+			}
+			mono[i] = sum / int32(channels)
+		}
+		samples = mono
+	}
+
+	if config.GetSampleRate() == 0 || config.GetSampleRate() == r.sampleRate || len(samples) == 0 {
+		return samples, nil
+	}
+	// linear interpolation is plenty for the recording of a call
+	resampled := make([]int32, int64(len(samples))*int64(r.sampleRate)/int64(config.GetSampleRate()))
+	ratio := float64(config.GetSampleRate()) / float64(r.sampleRate)
+	for i := range resampled {
+		position := float64(i) * ratio
+		index := int(position)
+		if index+1 >= len(samples) {
+			resampled[i] = samples[len(samples)-1]
+			continue
+		}
+		fraction := position - float64(index)
+		resampled[i] = int32(float64(samples[index])*(1-fraction) + float64(samples[index+1])*fraction)
+	}
+	return resampled, nil
 }
 
 func (r *recorder) muLawToLinear(muLawByte byte) int32 {
@@ -450,30 +426,3 @@ func (r *recorder) muLawToLinear(muLawByte byte) int32 {
 
 	return sample << 2 // Scale to 16-bit range
 }
-
-func (r *recorder) createWAVFile(pcmData []byte, config *protos.AudioConfig) ([]byte, error) {
-	var buf bytes.Buffer
-
-	// WAV header
-	// RIFF header
-	buf.Write([]byte("RIFF"))
-	binary.Write(&buf, binary.LittleEndian, uint32(36+len(pcmData))) // File size - 8
-	buf.Write([]byte("WAVE"))
-
-	// fmt chunk
-	buf.Write([]byte("fmt "))
-	binary.Write(&buf, binary.LittleEndian, uint32(16))                                  // fmt chunk size
-	binary.Write(&buf, binary.LittleEndian, uint16(1))                                   // PCM format
-	binary.Write(&buf, binary.LittleEndian, uint16(config.Channels))                     // Number of channels
-	binary.Write(&buf, binary.LittleEndian, uint32(config.SampleRate))                   // Sample rate
-	binary.Write(&buf, binary.LittleEndian, uint32(config.SampleRate*config.Channels*2)) // Byte rate
-	binary.Write(&buf, binary.LittleEndian, uint16(config.Channels*2))                   // Block align
-	binary.Write(&buf, binary.LittleEndian, uint16(16))                                  // Bits per sample
-
-	// data chunk
-	buf.Write([]byte("data"))
-	binary.Write(&buf, binary.LittleEndian, uint32(len(pcmData)))
-	buf.Write(pcmData)
-
-	return buf.Bytes(), nil
-}
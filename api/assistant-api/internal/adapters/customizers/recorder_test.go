@@ -0,0 +1,155 @@
+package internal_adapter_request_customizers
+
+import (
+	"context"
+	"encoding/binary"
+	"io"
+	"testing"
+	"time"
+
+	internal_encoder "github.com/rapidaai/api/assistant-api/internal/encoder"
+	internal_encoder_wav "github.com/rapidaai/api/assistant-api/internal/encoder/wav"
+	"github.com/rapidaai/pkg/commons"
+	protos "github.com/rapidaai/protos"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type memoryRecordingWriter struct {
+	data     []byte
+	position int64
+	flushes  int
+}
+
+func (w *memoryRecordingWriter) Write(p []byte) (int, error) {
+	if end := w.position + int64(len(p)); end > int64(len(w.data)) {
+		w.data = append(w.data, make([]byte, end-int64(len(w.data)))...)
+	}
+	copy(w.data[w.position:], p)
+	w.position += int64(len(p))
+	return len(p), nil
+}
+
+func (w *memoryRecordingWriter) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekStart:
+		w.position = offset
+	case io.SeekCurrent:
+		w.position += offset
+	case io.SeekEnd:
+		w.position = int64(len(w.data)) + offset
+	}
+	return w.position, nil
+}
+
+func (w *memoryRecordingWriter) Flush(ctx context.Context) error {
+	w.flushes++
+	return nil
+}
+
+func linear16(samples int, value int16) []byte {
+	data := make([]byte, samples*2)
+	for i := 0; i < samples; i++ {
+		binary.LittleEndian.PutUint16(data[i*2:], uint16(value))
+	}
+	return data
+}
+
+func newTestRecorder(t *testing.T, channel string) (Recorder, *memoryRecordingWriter) {
+	logger := commons.NewApplicationLoggerWithOptions(commons.EnableFile(false), commons.Level("error"))
+	logger.InitLogger()
+	config := &protos.AudioConfig{SampleRate: 8000, AudioFormat: protos.AudioConfig_LINEAR16, Channels: 1}
+	writer := &memoryRecordingWriter{}
+	recorder := NewRecorder(logger)
+	require.NoError(t, recorder.Initialize(config, config, &RecordingOptions{
+		Channel: channel,
+		Writer:  writer,
+		Encoder: func(w io.Writer, sampleRate, channels uint32) (internal_encoder.Encoder, error) {
+			return internal_encoder_wav.NewWavEncoder(logger, w, &internal_encoder.EncoderOptions{SampleRate: sampleRate, Channels: channels})
+		},
+		FlushInterval: 10 * time.Millisecond,
+	}))
+	return recorder, writer
+}
+
+func TestRecorderStereo(t *testing.T) {
+	recorder, writer := newTestRecorder(t, RECORDING_CHANNEL_STEREO)
+
+	// 20ms of user audio and 100ms of assistant audio, cut off by an interruption
+	require.NoError(t, recorder.User(linear16(160, 1000)))
+	require.NoError(t, recorder.System(linear16(800, 2000)))
+	time.Sleep(40 * time.Millisecond)
+	require.NoError(t, recorder.Interrupt())
+	require.NoError(t, recorder.Interrupt())
+
+	output, err := recorder.Persist()
+	require.NoError(t, err)
+	assert.Equal(t, "wav", output.Codec)
+	assert.Equal(t, uint32(2), output.Channels)
+	assert.Equal(t, uint32(8000), output.SampleRate)
+	require.Len(t, output.Interruptions, 1)
+	assert.GreaterOrEqual(t, output.Interruptions[0], 40*time.Millisecond)
+	assert.Greater(t, writer.flushes, 0)
+
+	require.Greater(t, len(writer.data), 44)
+	assert.Equal(t, "RIFF", string(writer.data[:4]))
+	assert.Equal(t, uint16(2), binary.LittleEndian.Uint16(writer.data[22:]))
+	pcm := writer.data[44:]
+	assert.Equal(t, uint32(len(pcm)), binary.LittleEndian.Uint32(writer.data[40:]))
+
+	var user, system int
+	for i := 0; i+4 <= len(pcm); i += 4 {
+		if int16(binary.LittleEndian.Uint16(pcm[i:])) == 1000 {
+			user++
+		}
+		if int16(binary.LittleEndian.Uint16(pcm[i+2:])) == 2000 {
+			system++
+		}
+	}
+	assert.Equal(t, 160, user)
+	// the assistant was heard until the interruption only
+	assert.Greater(t, system, 0)
+	assert.Less(t, system, 800)
+	assert.Equal(t, time.Duration(len(pcm)/4)*time.Second/8000, output.Duration)
+
+	_, err = recorder.Persist()
+	assert.Error(t, err)
+}
+
+func TestRecorderMono(t *testing.T) {
+	recorder, writer := newTestRecorder(t, RECORDING_CHANNEL_MONO)
+	time.Sleep(20 * time.Millisecond)
+	require.NoError(t, recorder.User(linear16(80, 1000)))
+	require.NoError(t, recorder.System(linear16(80, 30000)))
+	// assistant audio is kept as far as it played before the call ended
+	time.Sleep(20 * time.Millisecond)
+
+	output, err := recorder.Persist()
+	require.NoError(t, err)
+	assert.Equal(t, uint32(1), output.Channels)
+	assert.Empty(t, output.Interruptions)
+
+	// user audio ends when received, the assistant starts then, so they are not mixed
+	var user, system int
+	for i := 44; i+2 <= len(writer.data); i += 2 {
+		switch int16(binary.LittleEndian.Uint16(writer.data[i:])) {
+		case 1000:
+			user++
+		case 30000:
+			system++
+		}
+	}
+	assert.Equal(t, 80, user)
+	assert.Equal(t, 80, system)
+}
+
+func TestRecorderWithoutAudio(t *testing.T) {
+	recorder, _ := newTestRecorder(t, RECORDING_CHANNEL_MONO)
+	_, err := recorder.Persist()
+	assert.Error(t, err)
+
+	uninitialized := NewRecorder(commons.NewApplicationLoggerWithOptions(commons.EnableFile(false)))
+	assert.NoError(t, uninitialized.User(linear16(80, 1000)))
+	_, err = uninitialized.Persist()
+	assert.Error(t, err)
+}
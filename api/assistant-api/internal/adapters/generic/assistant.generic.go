@@ -92,6 +92,9 @@ func (gr *GenericRequestor) GetAssistant(
 		InjectTool:          true,
 		InjectAnalysis:      true,
 		InjectWebhook:       true,
+		InjectModerator:     true,
+		InjectLexicon:       true,
+		InjectEvaluator:     true,
 		InjectConversations: false,
 	}
 	switch gr.source {
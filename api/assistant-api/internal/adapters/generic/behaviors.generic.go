@@ -63,7 +63,13 @@ func (communication *GenericRequestor) OnGreet(ctx context.Context) error {
 		communication.logger.Warnf("empty greeting message, could be space in the table or argument contains space")
 		return nil
 	}
+	communication.say(ctx, greetingCnt)
+	return nil
+}
 
+// say delivers the content as assistant message without asking the llm, it is used for
+// greeting and idle timeout message where the content is given by deployment behavior
+func (communication *GenericRequestor) say(ctx context.Context, content string) {
 	message := communication.messaging.Create(type_enums.UserActor, "")
 	utils.Go(ctx, func() {
 		if err := communication.OnCreateMessage(ctx, message.GetId(), message); err != nil {
@@ -71,46 +77,45 @@ func (communication *GenericRequestor) OnGreet(ctx context.Context) error {
 		}
 	})
 
-	greetings := &types.Message{
+	reply := &types.Message{
 		Id:   message.GetId(),
 		Role: "assistant",
 		Contents: []*types.Content{{
 			ContentType:   commons.TEXT_CONTENT.String(),
 			ContentFormat: commons.TEXT_CONTENT_FORMAT_RAW.String(),
-			Content:       []byte(greetingCnt),
+			Content:       []byte(content),
 		}}}
 
 	if err := communication.Notify(ctx, &protos.AssistantConversationAssistantMessage{
 		Time:      timestamppb.Now(),
-		Id:        greetings.GetId(),
+		Id:        reply.GetId(),
 		Completed: true,
 		Message: &protos.AssistantConversationAssistantMessage_Text{
 			Text: &protos.AssistantConversationMessageTextContent{
-				Content: greetingCnt,
+				Content: content,
 			},
 		},
 	}); err != nil {
 		communication.logger.Tracef(ctx, "error while outputting chunk to the user: %w", err)
 	}
 
-	communication.AssistantCallback(ctx, greetings.GetId(), greetings, nil)
+	communication.AssistantCallback(ctx, reply.GetId(), reply, nil)
 	// audio processing
 	if communication.messaging.GetInputMode().Audio() {
-		if err := communication.Speak(greetings.GetId(), greetingCnt); err != nil {
-			communication.FinishSpeaking(greetings.GetId())
+		if err := communication.Speak(reply.GetId(), content); err != nil {
+			communication.FinishSpeaking(reply.GetId())
 		}
 	}
 	// Notify the response if there is no user message
 	if err := communication.Notify(ctx, &protos.AssistantConversationMessage{
-		MessageId:               greetings.GetId(),
+		MessageId:               reply.GetId(),
 		AssistantId:             communication.assistant.Id,
 		AssistantConversationId: communication.assistantConversation.Id,
-		Response:                greetings.ToProto(),
+		Response:                reply.ToProto(),
 	}); err != nil {
 		communication.logger.Tracef(ctx, "error while outputting chunk to the user: %w", err)
 	}
 	communication.messaging.Transition(internal_adapter_request_customizers.AgentCompleted)
-	return nil
 }
 
 func (communication *GenericRequestor) OnError(ctx context.Context, messageId string) error {
@@ -8,6 +8,7 @@ package internal_adapter_request_generic
 import (
 	"context"
 
+	internal_assistant_telemetry "github.com/rapidaai/api/assistant-api/internal/telemetry/assistant"
 	"github.com/rapidaai/pkg/types"
 	type_enums "github.com/rapidaai/pkg/types/enums"
 	"github.com/rapidaai/pkg/utils"
@@ -18,6 +19,12 @@ import (
 *  default executor or remote executor
  */
 func (talking *GenericRequestor) OnGenerationComplete(ctx context.Context, messageid string, ouput *types.Message, metrics []*types.Metric) error {
+	talking.markTurn(messageid, internal_assistant_telemetry.TurnFirstToken)
+	ouput = talking.completeOutput(ctx, messageid, ouput)
+	if !talking.messaging.GetInputMode().Audio() {
+		// nothing to speak, turn is over with the generation
+		talking.completeTurn(messageid)
+	}
 	utils.Go(talking.Context(), func() {
 		if err := talking.OnUpdateMessage(talking.Context(), messageid, ouput, type_enums.RECORD_COMPLETE); err != nil {
 			talking.logger.Errorf("Error in OnUpdateMessage: %v", err)
@@ -43,6 +50,11 @@ func (talking *GenericRequestor) OnGenerationComplete(ctx context.Context, messa
 
 /**/
 func (talking *GenericRequestor) OnGeneration(ctx context.Context, messageid string, out *types.Message) error {
+	talking.markTurn(messageid, internal_assistant_telemetry.TurnFirstToken)
+	out, ok := talking.moderateOutput(ctx, messageid, out)
+	if !ok {
+		return nil
+	}
 	return talking.Output(ctx, messageid, out, false, nil)
 }
 
@@ -85,6 +97,7 @@ func (talking *GenericRequestor) UserCallback(ctx context.Context, messageid str
 			talking.logger.Errorf("Error in OnMessageMetadata: %v", err)
 		}
 	})
+	talking.markTurn(messageid, internal_assistant_telemetry.TurnGenerationRequested)
 	if err := talking.assistantExecutor.User(ctx, messageid, in, talking); err != nil {
 		talking.OnError(ctx, messageid)
 		return nil
@@ -0,0 +1,86 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_adapter_request_generic
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	internal_conversation_gorm "github.com/rapidaai/api/assistant-api/internal/entity/conversations"
+	internal_evaluator "github.com/rapidaai/api/assistant-api/internal/evaluator"
+	internal_evaluator_factory "github.com/rapidaai/api/assistant-api/internal/factory/evaluator"
+)
+
+// Evaluate runs the evaluators of the assistant on the completed conversation and stores the results,
+// an evaluator which fails is logged and skipped so the others are still recorded.
+func (gr *GenericRequestor) Evaluate(ctx context.Context) {
+	if gr.assistant == nil || gr.assistantConversation == nil || len(gr.assistant.AssistantEvaluators) == 0 {
+		return
+	}
+	start := time.Now()
+	conversation := gr.evaluationConversation()
+	evaluations := make([]*internal_conversation_gorm.AssistantConversationEvaluation, 0, len(gr.assistant.AssistantEvaluators))
+	for _, e := range gr.assistant.AssistantEvaluators {
+		evaluator, err := internal_evaluator_factory.GetEvaluator(gr.logger, gr.Auth(), gr.deploymentClient, e)
+		if err != nil {
+			gr.logger.Errorf("unable to initialize evaluator %d: %v", e.Id, err)
+			continue
+		}
+		result, err := evaluator.Evaluate(ctx, conversation)
+		if err != nil {
+			gr.logger.Errorf("evaluator %s failed for conversation %d: %v", evaluator.Name(), gr.assistantConversation.Id, err)
+			continue
+		}
+		evaluations = append(evaluations, &internal_conversation_gorm.AssistantConversationEvaluation{
+			AssistantEvaluatorId: e.Id,
+			Name:                 evaluator.Name(),
+			Type:                 evaluator.Type(),
+			Score:                result.Score,
+			Passed:               result.Passed,
+			Reason:               result.Reason,
+		})
+	}
+	if _, err := gr.conversationService.CreateConversationEvaluations(
+		ctx, gr.Auth(),
+		gr.assistant.Id,
+		gr.assistantConversation.AssistantProviderModelId,
+		gr.assistantConversation.Id,
+		evaluations,
+	); err != nil {
+		gr.logger.Errorf("unable to store evaluations of conversation %d: %v", gr.assistantConversation.Id, err)
+	}
+	gr.logger.Benchmark("GenericRequestor.Evaluate", time.Since(start))
+}
+
+// evaluationConversation is what the evaluators see of the conversation
+func (gr *GenericRequestor) evaluationConversation() *internal_evaluator.Conversation {
+	gr.evaluationMu.Lock()
+	toolCalls := slices.Clone(gr.toolCalls)
+	metrics := slices.Clone(gr.turnMetrics)
+	gr.evaluationMu.Unlock()
+	return &internal_evaluator.Conversation{
+		Messages:  slices.Clone(gr.GetHistories()),
+		ToolCalls: toolCalls,
+		Metrics:   metrics,
+		EndedBy:   gr.endedBy(toolCalls),
+		Duration:  time.Since(gr.StartedAt),
+	}
+}
+
+// endedBy tells who ended the conversation, supervisor records the reason when it ends the session
+// and the assistant ends it with end of conversation tool, otherwise the user has left.
+func (gr *GenericRequestor) endedBy(toolCalls []*internal_evaluator.ToolCall) string {
+	if _, ok := gr.GetMetadata()["talk.end_reason"]; ok {
+		return internal_evaluator.EndedBySystem
+	}
+	for _, call := range toolCalls {
+		if call.Success && call.ExecutionMethod == "end_of_conversation" {
+			return internal_evaluator.EndedByAgent
+		}
+	}
+	return internal_evaluator.EndedByUser
+}
@@ -0,0 +1,684 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_adapter_request_generic
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	internal_telemetry "github.com/rapidaai/api/assistant-api/internal/telemetry"
+	internal_transformer "github.com/rapidaai/api/assistant-api/internal/transformer"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/types"
+	"github.com/rapidaai/pkg/utils"
+	"github.com/rapidaai/protos"
+)
+
+// Failover chain is configured with audio options of the deployment, every
+// fallback provider is listed with its position and carries its own provider
+// specific options, anything else (microphone.*, speaker.*) is shared.
+//
+//	fallback.1.provider             = cartesia
+//	fallback.1.rapida.credential_id = 2123
+//	fallback.1.listen.model         = ink-whisper
+//	fallback.timeout                = 5000
+//	fallback.replay                 = 5000
+//	fallback.min_speech             = 1000
+const (
+	failoverOptionPrefix     = "fallback."
+	failoverDefaultTimeout   = 5 * time.Second
+	failoverDefaultReplay    = 5 * time.Second
+	failoverDefaultMinSpeech = time.Second
+	// speaking rate used to estimate how much audio a sentence produces, kept on
+	// the fast side so a sentence is never held back longer than it is spoken
+	failoverCharactersPerSecond = 20
+)
+
+type failoverProvider struct {
+	provider string
+	options  utils.Option
+}
+
+// failoverProviders returns the primary provider followed by configured fallbacks in order.
+func failoverProviders(primary string, options utils.Option) []*failoverProvider {
+	providers := []*failoverProvider{{provider: primary, options: utils.Option{}}}
+	shared := utils.Option{}
+	for k, v := range options {
+		if strings.HasPrefix(k, failoverOptionPrefix) {
+			continue
+		}
+		providers[0].options[k] = v
+		if !strings.HasPrefix(k, "listen.") && !strings.HasPrefix(k, "speak.") && k != "rapida.credential_id" {
+			shared[k] = v
+		}
+	}
+	for i := 1; ; i++ {
+		prefix := fmt.Sprintf("%s%d.", failoverOptionPrefix, i)
+		provider, err := options.GetString(prefix + "provider")
+		if err != nil || provider == "" {
+			break
+		}
+		fallbackOptions := utils.Option{}
+		for k, v := range shared {
+			fallbackOptions[k] = v
+		}
+		for k, v := range options {
+			if strings.HasPrefix(k, prefix) && k != prefix+"provider" {
+				fallbackOptions[strings.TrimPrefix(k, prefix)] = v
+			}
+		}
+		providers = append(providers, &failoverProvider{provider: provider, options: fallbackOptions})
+	}
+	return providers
+}
+
+func failoverDuration(options utils.Option, key string, def time.Duration) time.Duration {
+	if ms, err := options.GetFloat64(failoverOptionPrefix + key); err == nil && ms > 0 {
+		return time.Duration(ms) * time.Millisecond
+	}
+	return def
+}
+
+// failoverSwitch is reported every time the chain moves to the next provider
+type failoverSwitch func(from, to string, reason string)
+
+// transcriptExpecter is implemented by speech to text transformers which watch
+// for stalled transcription once user speech is detected
+type transcriptExpecter interface {
+	ExpectTranscript(at time.Time)
+}
+
+// failoverSwitch records every provider switch as a span and conversation metric
+func (gr *GenericRequestor) failoverSwitch(stage utils.RapidaStage, metric string) failoverSwitch {
+	return func(from, to string, reason string) {
+		ctx, span, _ := gr.Tracer().StartSpan(gr.Context(), stage,
+			internal_telemetry.KV{K: "from", V: internal_telemetry.StringValue(from)},
+			internal_telemetry.KV{K: "to", V: internal_telemetry.StringValue(to)},
+			internal_telemetry.KV{K: "reason", V: internal_telemetry.StringValue(reason)},
+		)
+		span.EndSpan(ctx, stage)
+		gr.AddMetric(gr.Auth(), types.NewMetric(metric, to, utils.Ptr(fmt.Sprintf("switched from %s, %s", from, reason))))
+	}
+}
+
+type speechToTextFactory func(
+	provider *failoverProvider,
+	onTranscript func(transcript string, confidence float64, language string, isCompleted bool) error,
+) (internal_transformer.SpeechToTextTransformer, error)
+
+// failoverSpeechToText keeps the user audio of the ongoing utterance and moves to the
+// next provider when the active one fails to accept audio or stops transcribing
+// while the user is speaking, buffered audio is replayed to the new provider.
+type failoverSpeechToText struct {
+	mu sync.Mutex
+	// serializes sending audio with switching provider, replayed audio always goes first
+	switching sync.Mutex
+	logger    commons.Logger
+	ctx       context.Context
+	cancel    context.CancelFunc
+
+	providers    []*failoverProvider
+	factory      speechToTextFactory
+	onSwitch     failoverSwitch
+	onTranscript func(string, float64, string, bool) error
+
+	current int
+	active  internal_transformer.SpeechToTextTransformer
+
+	timeout   time.Duration
+	replay    []byte
+	maxReplay int
+	// voice activity shorter than minSpeech is not confirmed speech, noise blips
+	// which a healthy provider rightly ignores never arm the watchdog
+	minSpeech    time.Duration
+	speechSince  time.Time
+	lastActivity time.Time
+	// set once speech is confirmed and cleared on any response from provider
+	expectingSince time.Time
+	// every provider of the chain has failed
+	exhausted bool
+}
+
+func newFailoverSpeechToText(
+	ctx context.Context,
+	logger commons.Logger,
+	audioConfig *protos.AudioConfig,
+	providers []*failoverProvider,
+	options utils.Option,
+	factory speechToTextFactory,
+	onTranscript func(string, float64, string, bool) error,
+	onSwitch failoverSwitch,
+) *failoverSpeechToText {
+	ct, cancel := context.WithCancel(ctx)
+	return &failoverSpeechToText{
+		logger:       logger,
+		ctx:          ct,
+		cancel:       cancel,
+		providers:    providers,
+		factory:      factory,
+		onSwitch:     onSwitch,
+		onTranscript: onTranscript,
+		timeout:      failoverDuration(options, "timeout", failoverDefaultTimeout),
+		minSpeech:    failoverDuration(options, "min_speech", failoverDefaultMinSpeech),
+		maxReplay:    int(failoverDuration(options, "replay", failoverDefaultReplay).Seconds() * float64(bytesPerSecond(audioConfig))),
+	}
+}
+
+func bytesPerSecond(audioConfig *protos.AudioConfig) int {
+	if audioConfig.GetSampleRate() == 0 {
+		// linear16 16khz mono
+		return 32000
+	}
+	sampleWidth := 2
+	if audioConfig.GetAudioFormat() == protos.AudioConfig_MuLaw8 {
+		sampleWidth = 1
+	}
+	channels := max(int(audioConfig.GetChannels()), 1)
+	return int(audioConfig.GetSampleRate()) * channels * sampleWidth
+}
+
+func (fst *failoverSpeechToText) Name() string {
+	fst.mu.Lock()
+	defer fst.mu.Unlock()
+	if fst.active == nil {
+		return "failover-speech-to-text"
+	}
+	return fst.active.Name()
+}
+
+// Initialize connects the first provider of the chain which can be initialized.
+func (fst *failoverSpeechToText) Initialize() error {
+	fst.mu.Lock()
+	defer fst.mu.Unlock()
+	for ; fst.current < len(fst.providers); fst.current++ {
+		transformer, err := fst.connect(fst.providers[fst.current])
+		if err != nil {
+			fst.logger.Errorf("failover-stt: unable to initialize %s %+v", fst.providers[fst.current].provider, err)
+			if fst.current+1 < len(fst.providers) {
+				fst.onSwitch(fst.providers[fst.current].provider, fst.providers[fst.current+1].provider, err.Error())
+			}
+			continue
+		}
+		fst.active = transformer
+		go fst.watch()
+		return nil
+	}
+	return fmt.Errorf("failover-stt: none of the speech to text providers could be initialized")
+}
+
+func (fst *failoverSpeechToText) connect(provider *failoverProvider) (internal_transformer.SpeechToTextTransformer, error) {
+	var transformer internal_transformer.SpeechToTextTransformer
+	transformer, err := fst.factory(provider, func(transcript string, confidence float64, language string, isCompleted bool) error {
+		fst.mu.Lock()
+		if transformer != fst.active {
+			// late transcript of a provider which has been replaced
+			fst.mu.Unlock()
+			return nil
+		}
+		fst.expectingSince, fst.speechSince = time.Time{}, time.Time{}
+		if isCompleted {
+			fst.replay = fst.replay[:0]
+		}
+		fst.mu.Unlock()
+		return fst.onTranscript(transcript, confidence, language, isCompleted)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := transformer.Initialize(); err != nil {
+		return nil, err
+	}
+	return transformer, nil
+}
+
+// ExpectTranscript reports voice activity of the user, once the activity has
+// lasted for minSpeech the speech is confirmed and the active provider has to
+// respond within timeout or it is considered stalled.
+func (fst *failoverSpeechToText) ExpectTranscript(at time.Time) {
+	fst.mu.Lock()
+	defer fst.mu.Unlock()
+	if fst.speechSince.IsZero() || at.Sub(fst.lastActivity) > fst.timeout {
+		// first activity since the last transcript or the previous one faded out
+		fst.speechSince = at
+	}
+	fst.lastActivity = at
+	if fst.expectingSince.IsZero() && at.Sub(fst.speechSince) >= fst.minSpeech {
+		fst.expectingSince = at
+	}
+}
+
+// watch fails over when confirmed speech gets no transcript within timeout,
+// it stops once the chain is exhausted as there is nothing left to switch to.
+func (fst *failoverSpeechToText) watch() {
+	ticker := time.NewTicker(fst.timeout / 4)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-fst.ctx.Done():
+			return
+		case <-ticker.C:
+			fst.mu.Lock()
+			active, exhausted := fst.active, fst.exhausted
+			stalled := !fst.expectingSince.IsZero() && time.Since(fst.expectingSince) > fst.timeout
+			fst.mu.Unlock()
+			if exhausted {
+				return
+			}
+			if stalled && !fst.failover(active, fmt.Sprintf("no transcript within %v", fst.timeout)) {
+				return
+			}
+		}
+	}
+}
+
+func (fst *failoverSpeechToText) Transform(ctx context.Context, in []byte, opts *internal_transformer.SpeechToTextOption) error {
+	fst.switching.Lock()
+	fst.mu.Lock()
+	fst.replay = append(fst.replay, in...)
+	if overflow := len(fst.replay) - fst.maxReplay; overflow > 0 {
+		fst.replay = append(fst.replay[:0], fst.replay[overflow:]...)
+	}
+	active := fst.active
+	fst.mu.Unlock()
+	if active == nil {
+		fst.switching.Unlock()
+		return fmt.Errorf("failover-stt: no active speech to text provider")
+	}
+	err := active.Transform(ctx, in, opts)
+	fst.switching.Unlock()
+
+	if err != nil && !fst.failover(active, err.Error()) {
+		return err
+	}
+	return nil
+}
+
+// failover replaces failed transformer with the next provider of the chain,
+// returns false when there is nothing left to fail over to.
+func (fst *failoverSpeechToText) failover(failed internal_transformer.SpeechToTextTransformer, reason string) bool {
+	fst.switching.Lock()
+	defer fst.switching.Unlock()
+
+	fst.mu.Lock()
+	if failed != fst.active || fst.exhausted || fst.ctx.Err() != nil {
+		// someone else already failed over, nothing is left or the call is over
+		switched := fst.active != failed && fst.active != nil
+		fst.mu.Unlock()
+		return switched
+	}
+	current := fst.current
+	fst.mu.Unlock()
+
+	from := fst.providers[current].provider
+	for current+1 < len(fst.providers) {
+		current++
+		next := fst.providers[current]
+		fst.logger.Warnf("failover-stt: switching from %s to %s, reason: %s", from, next.provider, reason)
+		fst.onSwitch(from, next.provider, reason)
+		transformer, err := fst.connect(next)
+		if err != nil {
+			fst.logger.Errorf("failover-stt: unable to initialize %s %+v", next.provider, err)
+			from, reason = next.provider, err.Error()
+			continue
+		}
+
+		fst.mu.Lock()
+		fst.current = current
+		fst.active = transformer
+		if !fst.expectingSince.IsZero() {
+			fst.expectingSince = time.Now()
+		}
+		replay := append([]byte(nil), fst.replay...)
+		fst.mu.Unlock()
+		go failed.Close(fst.ctx)
+
+		// the utterance in progress is replayed so the new provider does not miss it
+		if len(replay) > 0 {
+			if err := transformer.Transform(fst.ctx, replay, nil); err != nil {
+				fst.logger.Warnf("failover-stt: unable to replay audio to %s %+v", next.provider, err)
+			}
+		}
+		return true
+	}
+
+	fst.mu.Lock()
+	fst.current = current
+	fst.exhausted = true
+	fst.expectingSince, fst.speechSince = time.Time{}, time.Time{}
+	fst.mu.Unlock()
+	fst.logger.Errorf("failover-stt: %s failed and no fallback provider is left, reason: %s", from, reason)
+	return false
+}
+
+func (fst *failoverSpeechToText) Close(ctx context.Context) error {
+	fst.cancel()
+	fst.mu.Lock()
+	active := fst.active
+	fst.mu.Unlock()
+	if active != nil {
+		return active.Close(ctx)
+	}
+	return nil
+}
+
+type textToSpeechFactory func(
+	provider *failoverProvider,
+	onSpeech func(contextId string, audio []byte) error,
+	onComplete func(contextId string) error,
+) (internal_transformer.TextToSpeechTransformer, error)
+
+// failoverTextToSpeech moves to the next provider when the active one fails to
+// accept text or stops producing audio for a context being spoken. providers do
+// not report which sentence an audio chunk belongs to, audio is attributed to the
+// sentences of the context in order using their estimated spoken duration. sentences
+// which have not been fully spoken are replayed to the new provider.
+type failoverTextToSpeech struct {
+	mu sync.Mutex
+	// serializes sending text with switching provider, replayed text always goes first
+	switching sync.Mutex
+	logger    commons.Logger
+	ctx       context.Context
+	cancel    context.CancelFunc
+
+	providers  []*failoverProvider
+	factory    textToSpeechFactory
+	onSwitch   failoverSwitch
+	onSpeech   func(string, []byte) error
+	onComplete func(string) error
+
+	current        int
+	active         internal_transformer.TextToSpeechTransformer
+	timeout        time.Duration
+	bytesPerSecond int
+
+	// context being spoken and the sentences which are not acknowledged by audio yet
+	contextId  string
+	sentences  []*failoverSentence
+	completing bool
+	// audio attributed to the first unacknowledged sentence, heard is set once a
+	// chunk arrives while it is first and not only the rest of the previous sentence
+	spoken time.Duration
+	heard  bool
+	// armed while sentences are unacknowledged, moved forward with every audio chunk
+	awaitingSince time.Time
+	// every provider of the chain has failed
+	exhausted bool
+}
+
+// failoverSentence is the text sent to the provider with its estimated spoken duration
+type failoverSentence struct {
+	text     string
+	duration time.Duration
+}
+
+func newFailoverSentence(text string) *failoverSentence {
+	return &failoverSentence{
+		text:     text,
+		duration: time.Duration(len([]rune(text))) * time.Second / failoverCharactersPerSecond,
+	}
+}
+
+func newFailoverTextToSpeech(
+	ctx context.Context,
+	logger commons.Logger,
+	audioConfig *protos.AudioConfig,
+	providers []*failoverProvider,
+	options utils.Option,
+	factory textToSpeechFactory,
+	onSpeech func(string, []byte) error,
+	onComplete func(string) error,
+	onSwitch failoverSwitch,
+) *failoverTextToSpeech {
+	ct, cancel := context.WithCancel(ctx)
+	return &failoverTextToSpeech{
+		logger:         logger,
+		ctx:            ct,
+		cancel:         cancel,
+		providers:      providers,
+		factory:        factory,
+		onSwitch:       onSwitch,
+		onSpeech:       onSpeech,
+		onComplete:     onComplete,
+		timeout:        failoverDuration(options, "timeout", failoverDefaultTimeout),
+		bytesPerSecond: bytesPerSecond(audioConfig),
+	}
+}
+
+func (ftt *failoverTextToSpeech) Name() string {
+	ftt.mu.Lock()
+	defer ftt.mu.Unlock()
+	if ftt.active == nil {
+		return "failover-text-to-speech"
+	}
+	return ftt.active.Name()
+}
+
+// Initialize connects the first provider of the chain which can be initialized.
+func (ftt *failoverTextToSpeech) Initialize() error {
+	ftt.mu.Lock()
+	defer ftt.mu.Unlock()
+	for ; ftt.current < len(ftt.providers); ftt.current++ {
+		transformer, err := ftt.connect(ftt.providers[ftt.current])
+		if err != nil {
+			ftt.logger.Errorf("failover-tts: unable to initialize %s %+v", ftt.providers[ftt.current].provider, err)
+			if ftt.current+1 < len(ftt.providers) {
+				ftt.onSwitch(ftt.providers[ftt.current].provider, ftt.providers[ftt.current+1].provider, err.Error())
+			}
+			continue
+		}
+		ftt.active = transformer
+		go ftt.watch()
+		return nil
+	}
+	return fmt.Errorf("failover-tts: none of the text to speech providers could be initialized")
+}
+
+func (ftt *failoverTextToSpeech) connect(provider *failoverProvider) (internal_transformer.TextToSpeechTransformer, error) {
+	var transformer internal_transformer.TextToSpeechTransformer
+	transformer, err := ftt.factory(provider,
+		func(contextId string, audio []byte) error {
+			ftt.mu.Lock()
+			if transformer != ftt.active {
+				ftt.mu.Unlock()
+				return nil
+			}
+			if contextId == ftt.contextId {
+				ftt.acknowledgeLocked(time.Duration(len(audio)) * time.Second / time.Duration(ftt.bytesPerSecond))
+			}
+			ftt.mu.Unlock()
+			return ftt.onSpeech(contextId, audio)
+		},
+		func(contextId string) error {
+			ftt.mu.Lock()
+			if transformer != ftt.active {
+				ftt.mu.Unlock()
+				return nil
+			}
+			if contextId == ftt.contextId {
+				ftt.sentences = nil
+				ftt.spoken, ftt.heard = 0, false
+				ftt.completing = false
+				ftt.awaitingSince = time.Time{}
+			}
+			ftt.mu.Unlock()
+			return ftt.onComplete(contextId)
+		})
+	if err != nil {
+		return nil, err
+	}
+	if err := transformer.Initialize(); err != nil {
+		return nil, err
+	}
+	return transformer, nil
+}
+
+// acknowledgeLocked attributes audio to the unacknowledged sentences in order,
+// every sentence the audio covers is dropped and the deadline restarts for the rest.
+func (ftt *failoverTextToSpeech) acknowledgeLocked(audio time.Duration) {
+	ftt.spoken += audio
+	ftt.heard = ftt.heard || audio > 0
+	for len(ftt.sentences) > 0 && ftt.spoken >= ftt.sentences[0].duration {
+		ftt.spoken -= ftt.sentences[0].duration
+		ftt.sentences = ftt.sentences[1:]
+		ftt.heard = false
+	}
+	if len(ftt.sentences) == 0 {
+		ftt.spoken, ftt.heard = 0, false
+		ftt.awaitingSince = time.Time{}
+		return
+	}
+	ftt.awaitingSince = time.Now()
+}
+
+// watch fails over when unacknowledged sentences get no audio within timeout, a
+// sentence which got part of its audio before provider went quiet was shorter than
+// estimated and is acknowledged instead. it stops once the chain is exhausted.
+func (ftt *failoverTextToSpeech) watch() {
+	ticker := time.NewTicker(ftt.timeout / 4)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ftt.ctx.Done():
+			return
+		case <-ticker.C:
+			ftt.mu.Lock()
+			if ftt.exhausted {
+				ftt.mu.Unlock()
+				return
+			}
+			active, stalled := ftt.active, false
+			if !ftt.awaitingSince.IsZero() && time.Since(ftt.awaitingSince) > ftt.timeout {
+				if ftt.heard {
+					ftt.sentences, ftt.spoken, ftt.heard = ftt.sentences[1:], 0, false
+					ftt.acknowledgeLocked(0)
+				} else {
+					stalled = true
+				}
+			}
+			ftt.mu.Unlock()
+			if stalled && !ftt.failover(active, fmt.Sprintf("no audio within %v", ftt.timeout)) {
+				return
+			}
+		}
+	}
+}
+
+func (ftt *failoverTextToSpeech) Transform(ctx context.Context, in string, opts *internal_transformer.TextToSpeechOption) error {
+	ftt.switching.Lock()
+	ftt.mu.Lock()
+	if opts.ContextId != ftt.contextId {
+		// new message or user interrupted, nothing of previous context is replayed
+		ftt.contextId = opts.ContextId
+		ftt.sentences = nil
+		ftt.spoken, ftt.heard = 0, false
+		ftt.completing = false
+		ftt.awaitingSince = time.Time{}
+	}
+	if in != "" {
+		ftt.sentences = append(ftt.sentences, newFailoverSentence(in))
+		if ftt.awaitingSince.IsZero() {
+			ftt.awaitingSince = time.Now()
+		}
+	}
+	if opts.IsComplete {
+		ftt.completing = true
+	}
+	active := ftt.active
+	ftt.mu.Unlock()
+	if active == nil {
+		ftt.switching.Unlock()
+		return fmt.Errorf("failover-tts: no active text to speech provider")
+	}
+	err := active.Transform(ctx, in, opts)
+	ftt.switching.Unlock()
+
+	if err != nil && !ftt.failover(active, err.Error()) {
+		return err
+	}
+	return nil
+}
+
+// failover replaces failed transformer with the next provider of the chain,
+// returns false when there is nothing left to fail over to.
+func (ftt *failoverTextToSpeech) failover(failed internal_transformer.TextToSpeechTransformer, reason string) bool {
+	ftt.switching.Lock()
+	defer ftt.switching.Unlock()
+
+	ftt.mu.Lock()
+	if failed != ftt.active || ftt.exhausted || ftt.ctx.Err() != nil {
+		switched := ftt.active != failed && ftt.active != nil
+		ftt.mu.Unlock()
+		return switched
+	}
+	current := ftt.current
+	ftt.mu.Unlock()
+
+	from := ftt.providers[current].provider
+	for current+1 < len(ftt.providers) {
+		current++
+		next := ftt.providers[current]
+		ftt.logger.Warnf("failover-tts: switching from %s to %s, reason: %s", from, next.provider, reason)
+		ftt.onSwitch(from, next.provider, reason)
+		transformer, err := ftt.connect(next)
+		if err != nil {
+			ftt.logger.Errorf("failover-tts: unable to initialize %s %+v", next.provider, err)
+			from, reason = next.provider, err.Error()
+			continue
+		}
+
+		ftt.mu.Lock()
+		ftt.current = current
+		ftt.active = transformer
+		// the sentence in progress is spoken again from its start by the new provider
+		ftt.spoken, ftt.heard = 0, false
+		if len(ftt.sentences) > 0 {
+			ftt.awaitingSince = time.Now()
+		}
+		contextId, completing := ftt.contextId, ftt.completing
+		sentences := make([]string, 0, len(ftt.sentences))
+		for _, sentence := range ftt.sentences {
+			sentences = append(sentences, sentence.text)
+		}
+		ftt.mu.Unlock()
+		go failed.Close(ftt.ctx)
+
+		for _, sentence := range sentences {
+			if err := transformer.Transform(ftt.ctx, sentence, &internal_transformer.TextToSpeechOption{ContextId: contextId}); err != nil {
+				ftt.logger.Warnf("failover-tts: unable to replay text to %s %+v", next.provider, err)
+			}
+		}
+		if completing {
+			if err := transformer.Transform(ftt.ctx, "", &internal_transformer.TextToSpeechOption{ContextId: contextId, IsComplete: true}); err != nil {
+				ftt.logger.Warnf("failover-tts: unable to complete context on %s %+v", next.provider, err)
+			}
+		}
+		return true
+	}
+
+	ftt.mu.Lock()
+	ftt.current = current
+	ftt.exhausted = true
+	ftt.sentences = nil
+	ftt.spoken, ftt.heard = 0, false
+	ftt.awaitingSince = time.Time{}
+	ftt.mu.Unlock()
+	ftt.logger.Errorf("failover-tts: %s failed and no fallback provider is left, reason: %s", from, reason)
+	return false
+}
+
+func (ftt *failoverTextToSpeech) Close(ctx context.Context) error {
+	ftt.cancel()
+	ftt.mu.Lock()
+	active := ftt.active
+	ftt.mu.Unlock()
+	if active != nil {
+		return active.Close(ctx)
+	}
+	return nil
+}
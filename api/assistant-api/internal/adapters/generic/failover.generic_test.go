@@ -0,0 +1,297 @@
+package internal_adapter_request_generic
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	internal_transformer "github.com/rapidaai/api/assistant-api/internal/transformer"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/utils"
+	"github.com/rapidaai/protos"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeSpeechToText struct {
+	mu           sync.Mutex
+	name         string
+	err          error
+	audio        []byte
+	onTranscript func(string, float64, string, bool) error
+}
+
+func (f *fakeSpeechToText) Name() string      { return f.name }
+func (f *fakeSpeechToText) Initialize() error { return nil }
+func (f *fakeSpeechToText) Close(context.Context) error {
+	return nil
+}
+func (f *fakeSpeechToText) Transform(_ context.Context, in []byte, _ *internal_transformer.SpeechToTextOption) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.err != nil {
+		return f.err
+	}
+	f.audio = append(f.audio, in...)
+	return nil
+}
+func (f *fakeSpeechToText) received() []byte {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]byte(nil), f.audio...)
+}
+
+type fakeTextToSpeech struct {
+	mu         sync.Mutex
+	name       string
+	err        error
+	texts      []string
+	completed  bool
+	onSpeech   func(string, []byte) error
+	onComplete func(string) error
+}
+
+func (f *fakeTextToSpeech) Name() string      { return f.name }
+func (f *fakeTextToSpeech) Initialize() error { return nil }
+func (f *fakeTextToSpeech) Close(context.Context) error {
+	return nil
+}
+func (f *fakeTextToSpeech) Transform(_ context.Context, in string, opts *internal_transformer.TextToSpeechOption) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.err != nil {
+		return f.err
+	}
+	if in != "" {
+		f.texts = append(f.texts, in)
+	}
+	if opts.IsComplete {
+		f.completed = true
+	}
+	return nil
+}
+func (f *fakeTextToSpeech) received() ([]string, bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]string(nil), f.texts...), f.completed
+}
+
+type failoverSwitches struct {
+	mu       sync.Mutex
+	switches []string
+}
+
+func (s *failoverSwitches) record(from, to, _ string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.switches = append(s.switches, from+"->"+to)
+}
+
+func (s *failoverSwitches) get() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.switches...)
+}
+
+func failoverTestLogger() commons.Logger {
+	logger := commons.NewApplicationLoggerWithOptions(commons.EnableFile(false), commons.Level("error"))
+	logger.InitLogger()
+	return logger
+}
+
+func newTestFailoverSpeechToText(t *testing.T, fakes map[string]*fakeSpeechToText, options utils.Option) (*failoverSpeechToText, *failoverSwitches) {
+	switches := &failoverSwitches{}
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	fst := newFailoverSpeechToText(ctx, failoverTestLogger(),
+		&protos.AudioConfig{SampleRate: 16000, AudioFormat: protos.AudioConfig_LINEAR16, Channels: 1},
+		failoverProviders("primary", utils.Option{"fallback.1.provider": "secondary"}),
+		options,
+		func(provider *failoverProvider, onTranscript func(string, float64, string, bool) error) (internal_transformer.SpeechToTextTransformer, error) {
+			fake := fakes[provider.provider]
+			fake.onTranscript = onTranscript
+			return fake, nil
+		},
+		func(string, float64, string, bool) error { return nil },
+		switches.record,
+	)
+	require.NoError(t, fst.Initialize())
+	return fst, switches
+}
+
+func TestFailoverSpeechToTextStallReplaysAudio(t *testing.T) {
+	primary, secondary := &fakeSpeechToText{name: "primary"}, &fakeSpeechToText{name: "secondary"}
+	fst, switches := newTestFailoverSpeechToText(t, map[string]*fakeSpeechToText{"primary": primary, "secondary": secondary},
+		utils.Option{"fallback.timeout": 40, "fallback.min_speech": 20})
+
+	require.NoError(t, fst.Transform(context.Background(), []byte("hello"), nil))
+	// sustained speech confirms the utterance, primary never transcribes it
+	now := time.Now()
+	fst.ExpectTranscript(now)
+	fst.ExpectTranscript(now.Add(30 * time.Millisecond))
+
+	require.Eventually(t, func() bool { return len(switches.get()) == 1 }, time.Second, 5*time.Millisecond)
+	assert.Equal(t, []string{"primary->secondary"}, switches.get())
+	assert.Equal(t, []byte("hello"), secondary.received())
+	assert.Equal(t, "secondary", fst.Name())
+}
+
+func TestFailoverSpeechToTextIgnoresNoise(t *testing.T) {
+	primary, secondary := &fakeSpeechToText{name: "primary"}, &fakeSpeechToText{name: "secondary"}
+	fst, switches := newTestFailoverSpeechToText(t, map[string]*fakeSpeechToText{"primary": primary, "secondary": secondary},
+		utils.Option{"fallback.timeout": 40, "fallback.min_speech": 100})
+
+	// short bursts of voice activity far apart are not confirmed speech
+	now := time.Now()
+	fst.ExpectTranscript(now)
+	fst.ExpectTranscript(now.Add(500 * time.Millisecond))
+	time.Sleep(120 * time.Millisecond)
+	assert.Empty(t, switches.get())
+	assert.Equal(t, "primary", fst.Name())
+}
+
+func TestFailoverSpeechToTextTranscriptDisarms(t *testing.T) {
+	primary, secondary := &fakeSpeechToText{name: "primary"}, &fakeSpeechToText{name: "secondary"}
+	fst, switches := newTestFailoverSpeechToText(t, map[string]*fakeSpeechToText{"primary": primary, "secondary": secondary},
+		utils.Option{"fallback.timeout": 40, "fallback.min_speech": 20})
+
+	now := time.Now()
+	fst.ExpectTranscript(now)
+	fst.ExpectTranscript(now.Add(30 * time.Millisecond))
+	require.NoError(t, primary.onTranscript("hello", 0.9, "en", true))
+	time.Sleep(120 * time.Millisecond)
+	assert.Empty(t, switches.get())
+}
+
+func TestFailoverSpeechToTextExhausted(t *testing.T) {
+	primary := &fakeSpeechToText{name: "primary", err: errors.New("connection closed")}
+	secondary := &fakeSpeechToText{name: "secondary", err: errors.New("connection closed")}
+	fst, switches := newTestFailoverSpeechToText(t, map[string]*fakeSpeechToText{"primary": primary, "secondary": secondary},
+		utils.Option{"fallback.timeout": 40, "fallback.min_speech": 20})
+
+	// primary fails and secondary takes over
+	require.NoError(t, fst.Transform(context.Background(), []byte("a"), nil))
+	assert.Equal(t, []string{"primary->secondary"}, switches.get())
+	// nothing is left once secondary fails as well
+	assert.Error(t, fst.Transform(context.Background(), []byte("b"), nil))
+
+	fst.mu.Lock()
+	exhausted := fst.exhausted
+	fst.mu.Unlock()
+	assert.True(t, exhausted)
+	// stalls after exhaustion do not try to switch again
+	now := time.Now()
+	fst.ExpectTranscript(now)
+	fst.ExpectTranscript(now.Add(30 * time.Millisecond))
+	time.Sleep(80 * time.Millisecond)
+	assert.Len(t, switches.get(), 1)
+}
+
+func newTestFailoverTextToSpeech(t *testing.T, fakes map[string]*fakeTextToSpeech) (*failoverTextToSpeech, *failoverSwitches) {
+	switches := &failoverSwitches{}
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	ftt := newFailoverTextToSpeech(ctx, failoverTestLogger(),
+		&protos.AudioConfig{SampleRate: 16000, AudioFormat: protos.AudioConfig_LINEAR16, Channels: 1},
+		failoverProviders("primary", utils.Option{"fallback.1.provider": "secondary"}),
+		utils.Option{"fallback.timeout": 40},
+		func(provider *failoverProvider, onSpeech func(string, []byte) error, onComplete func(string) error) (internal_transformer.TextToSpeechTransformer, error) {
+			fake := fakes[provider.provider]
+			fake.onSpeech, fake.onComplete = onSpeech, onComplete
+			return fake, nil
+		},
+		func(string, []byte) error { return nil },
+		func(string) error { return nil },
+		switches.record,
+	)
+	require.NoError(t, ftt.Initialize())
+	return ftt, switches
+}
+
+// audioFor returns linear16 16khz audio as long as the sentence is estimated to be spoken
+func audioFor(sentence string) []byte {
+	return make([]byte, int(newFailoverSentence(sentence).duration.Seconds()*32000)+2)
+}
+
+func TestFailoverTextToSpeechReplaysUnspokenSentences(t *testing.T) {
+	primary, secondary := &fakeTextToSpeech{name: "primary"}, &fakeTextToSpeech{name: "secondary"}
+	ftt, switches := newTestFailoverTextToSpeech(t, map[string]*fakeTextToSpeech{"primary": primary, "secondary": secondary})
+
+	ctx := context.Background()
+	sentences := []string{"Hello there.", "Your order has shipped.", "Anything else?"}
+	for _, sentence := range sentences {
+		require.NoError(t, ftt.Transform(ctx, sentence, &internal_transformer.TextToSpeechOption{ContextId: "ctx-1"}))
+	}
+	require.NoError(t, ftt.Transform(ctx, "", &internal_transformer.TextToSpeechOption{ContextId: "ctx-1", IsComplete: true}))
+
+	// first sentence is spoken, the provider stalls on the second one
+	require.NoError(t, primary.onSpeech("ctx-1", audioFor(sentences[0])))
+
+	require.Eventually(t, func() bool { return len(switches.get()) == 1 }, time.Second, 5*time.Millisecond)
+	texts, completed := secondary.received()
+	assert.Equal(t, sentences[1:], texts)
+	assert.True(t, completed)
+}
+
+func TestFailoverTextToSpeechKeepsSentenceInProgress(t *testing.T) {
+	primary, secondary := &fakeTextToSpeech{name: "primary"}, &fakeTextToSpeech{name: "secondary"}
+	ftt, _ := newTestFailoverTextToSpeech(t, map[string]*fakeTextToSpeech{"primary": primary, "secondary": secondary})
+
+	ctx := context.Background()
+	require.NoError(t, ftt.Transform(ctx, "The first sentence is rather long.", &internal_transformer.TextToSpeechOption{ContextId: "ctx-1"}))
+	require.NoError(t, ftt.Transform(ctx, "Second.", &internal_transformer.TextToSpeechOption{ContextId: "ctx-1"}))
+	// a single chunk of the first sentence does not acknowledge anything else
+	require.NoError(t, primary.onSpeech("ctx-1", make([]byte, 640)))
+
+	ftt.mu.Lock()
+	pending, armed := len(ftt.sentences), !ftt.awaitingSince.IsZero()
+	ftt.mu.Unlock()
+	assert.Equal(t, 2, pending)
+	assert.True(t, armed)
+
+	// provider fails mid sentence, both sentences are replayed
+	primary.mu.Lock()
+	primary.err = errors.New("connection closed")
+	primary.mu.Unlock()
+	require.NoError(t, ftt.Transform(ctx, "Third.", &internal_transformer.TextToSpeechOption{ContextId: "ctx-1"}))
+	texts, _ := secondary.received()
+	assert.Equal(t, []string{"The first sentence is rather long.", "Second.", "Third."}, texts)
+}
+
+func TestFailoverTextToSpeechQuietAfterSpeechIsNotStall(t *testing.T) {
+	primary, secondary := &fakeTextToSpeech{name: "primary"}, &fakeTextToSpeech{name: "secondary"}
+	ftt, switches := newTestFailoverTextToSpeech(t, map[string]*fakeTextToSpeech{"primary": primary, "secondary": secondary})
+
+	// sentence turned out shorter than estimated, provider goes quiet after speaking it
+	require.NoError(t, ftt.Transform(context.Background(), "Okay, sounds good to me.", &internal_transformer.TextToSpeechOption{ContextId: "ctx-1"}))
+	require.NoError(t, primary.onSpeech("ctx-1", make([]byte, 3200)))
+	time.Sleep(150 * time.Millisecond)
+	assert.Empty(t, switches.get())
+
+	ftt.mu.Lock()
+	pending := len(ftt.sentences)
+	ftt.mu.Unlock()
+	assert.Zero(t, pending)
+}
+
+func TestFailoverTextToSpeechExhausted(t *testing.T) {
+	primary, secondary := &fakeTextToSpeech{name: "primary"}, &fakeTextToSpeech{name: "secondary"}
+	ftt, switches := newTestFailoverTextToSpeech(t, map[string]*fakeTextToSpeech{"primary": primary, "secondary": secondary})
+
+	// neither provider ever produces audio
+	require.NoError(t, ftt.Transform(context.Background(), "Hello.", &internal_transformer.TextToSpeechOption{ContextId: "ctx-1"}))
+	require.Eventually(t, func() bool {
+		ftt.mu.Lock()
+		defer ftt.mu.Unlock()
+		return ftt.exhausted
+	}, time.Second, 5*time.Millisecond)
+	time.Sleep(80 * time.Millisecond)
+	assert.Equal(t, []string{"primary->secondary"}, switches.get())
+
+	secondary.mu.Lock()
+	secondary.err = errors.New("connection closed")
+	secondary.mu.Unlock()
+	assert.Error(t, ftt.Transform(context.Background(), "Again.", &internal_transformer.TextToSpeechOption{ContextId: "ctx-1"}))
+}
@@ -7,13 +7,13 @@ package internal_adapter_request_generic
 
 import (
 	"context"
+	"sync"
 	"time"
 
 	"github.com/rapidaai/api/assistant-api/config"
 	internal_adapter_request_customizers "github.com/rapidaai/api/assistant-api/internal/adapters/customizers"
 	internal_streamers "github.com/rapidaai/api/assistant-api/internal/streamers"
 	internal_assistant_telemetry "github.com/rapidaai/api/assistant-api/internal/telemetry/assistant"
-	internal_assistant_telemetry_exporters "github.com/rapidaai/api/assistant-api/internal/telemetry/assistant/exporters"
 
 	internal_agent_embeddings "github.com/rapidaai/api/assistant-api/internal/agent/embedding"
 	internal_assistant_executors "github.com/rapidaai/api/assistant-api/internal/agent/executor"
@@ -23,6 +23,7 @@ import (
 	internal_assistant_entity "github.com/rapidaai/api/assistant-api/internal/entity/assistants"
 	internal_conversation_gorm "github.com/rapidaai/api/assistant-api/internal/entity/conversations"
 	internal_knowledge_gorm "github.com/rapidaai/api/assistant-api/internal/entity/knowledges"
+	internal_evaluator "github.com/rapidaai/api/assistant-api/internal/evaluator"
 	internal_services "github.com/rapidaai/api/assistant-api/internal/services"
 	internal_assistant_service "github.com/rapidaai/api/assistant-api/internal/services/assistant"
 	internal_knowledge_service "github.com/rapidaai/api/assistant-api/internal/services/knowledge"
@@ -67,7 +68,8 @@ type GenericRequestor struct {
 	textReranker  internal_agent_rerankers.TextReranking
 
 	// managing event
-	tracer internal_telemetry.VoiceAgentTracer
+	tracer      internal_telemetry.VoiceAgentTracer
+	turnLatency *internal_assistant_telemetry.TurnLatencyAggregator
 
 	// integration client
 	integrationClient integration_client.IntegrationServiceClient
@@ -92,7 +94,17 @@ type GenericRequestor struct {
 	synthesizers []internal_synthesizers.SentenceSynthesizer
 
 	recorder       internal_adapter_request_customizers.Recorder
+	recordingMu    sync.Mutex
+	recording      *internal_conversation_gorm.AssistantConversationRecording
+	recordingSpool *storages.Spool
 	templateParser parsers.StringTemplateParser
+	supervisor     *sessionSupervisor
+	guardrails     *guardrails
+
+	// collected while the conversation goes on for evaluators
+	evaluationMu sync.Mutex
+	toolCalls    []*internal_evaluator.ToolCall
+	turnMetrics  []*types.Metric
 
 	// executor
 	assistantExecutor internal_assistant_executors.AssistantExecutor
@@ -145,14 +157,13 @@ func NewGenericRequestor(
 		integrationClient: integration_client.NewIntegrationServiceClientGRPC(&config.AppConfig, logger, redis),
 
 		//
-		tracer: internal_assistant_telemetry.NewInMemoryTracer(logger,
-			internal_assistant_telemetry_exporters.NewOpensearchAssistantTraceExporter(
-				logger,
-				&config.AppConfig, opensearch,
-			)),
+		tracer:      internal_assistant_telemetry.NewInMemoryTracer(logger, traceExporters(logger, config, opensearch)...),
+		turnLatency: internal_assistant_telemetry.NewTurnLatencyAggregator(),
 
 		recorder:          internal_adapter_request_customizers.NewRecorder(logger),
 		messaging:         internal_adapter_request_customizers.NewMessaging(logger),
+		supervisor:        newSessionSupervisor(),
+		guardrails:        newGuardrails(),
 		assistantExecutor: internal_assistant_executors.NewAssistantExecutor(logger),
 
 		// will change
@@ -175,6 +186,10 @@ func (dm *GenericRequestor) Source() utils.RapidaSource {
 	return dm.source
 }
 
+func (dm *GenericRequestor) MCPStdioCommands() []string {
+	return dm.config.MCPConfig.StdioCommands
+}
+
 func (dm *GenericRequestor) Streamer() internal_streamers.Streamer {
 	return dm.streamer
 }
@@ -367,6 +382,7 @@ func (talking *GenericRequestor) ResumeConversation(
 	)
 	if err != nil {
 		talking.logger.Errorf("failed to get assistant conversation: %+v", err)
+		return nil, err
 	}
 
 	talking.assistantConversation = conversation
@@ -407,11 +423,23 @@ func (dm *GenericRequestor) GetHistories() []*types.Message {
 	return dm.histories
 }
 
+func (gr *GenericRequestor) BeginConversationRecording(
+	extension string,
+) (*internal_conversation_gorm.AssistantConversationRecording, *storages.Spool, error) {
+	recording, spool, err := gr.conversationService.BeginConversationRecording(gr.ctx, gr.auth, gr.assistant.Id, gr.assistantConversation.Id, extension)
+	if err != nil {
+		gr.logger.Errorf("unable to begin recording for the conversation id %d with error : %v", gr.assistantConversation.Id, err)
+		return nil, nil, err
+	}
+	return recording, spool, nil
+}
+
 func (gr *GenericRequestor) CreateConversationRecording(
-	body []byte,
+	recording *internal_conversation_gorm.AssistantConversationRecording,
+	spool *storages.Spool,
 ) error {
-	if _, err := gr.conversationService.CreateConversationRecording(gr.ctx, gr.auth, gr.assistant.Id, gr.assistantConversation.Id, body); err != nil {
-		gr.logger.Errorf("unable to create recording for the conversation id %d with error : %v", err)
+	if _, err := gr.conversationService.CreateConversationRecording(gr.ctx, gr.auth, recording, spool); err != nil {
+		gr.logger.Errorf("unable to create recording for the conversation id %d with error : %v", recording.AssistantConversationId, err)
 		return err
 	}
 	return nil
@@ -0,0 +1,292 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_adapter_request_generic
+
+import (
+	"context"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+	"unicode"
+
+	internal_moderator_factory "github.com/rapidaai/api/assistant-api/internal/factory/moderator"
+	internal_moderator "github.com/rapidaai/api/assistant-api/internal/moderator"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/types"
+	"github.com/rapidaai/pkg/utils"
+)
+
+// guardrails of session, the requestor is passed by value so it is always referenced by pointer
+type guardrails struct {
+	mu     sync.RWMutex
+	input  *internal_moderator.Guardrail
+	output *internal_moderator.Guardrail
+
+	// moderation of assistant messages being streamed
+	outputs map[string]*outputModeration
+}
+
+func newGuardrails() *guardrails {
+	return &guardrails{outputs: make(map[string]*outputModeration)}
+}
+
+func (g *guardrails) get(stage internal_moderator.Stage) *internal_moderator.Guardrail {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	if stage == internal_moderator.InputStage {
+		return g.input
+	}
+	return g.output
+}
+
+// streaming returns the moderation of message, it is created with the first chunk
+func (g *guardrails) streaming(messageId string) *outputModeration {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	o, ok := g.outputs[messageId]
+	if !ok {
+		o = &outputModeration{}
+		g.outputs[messageId] = o
+	}
+	return o
+}
+
+// completed removes the moderation of message
+func (g *guardrails) completed(messageId string) *outputModeration {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	o, ok := g.outputs[messageId]
+	if !ok {
+		return &outputModeration{}
+	}
+	delete(g.outputs, messageId)
+	return o
+}
+
+// ends of sentence, text is held till it ends so moderators see complete sentences
+var sentenceEnd = regexp.MustCompile(`[.!?]+["')\]]*\s+|[。！？]+\s*|\n+`)
+
+// text without end of sentence is moderated once it grows beyond the limit
+const maxPendingSentence = 400
+
+// outputModeration holds the streamed text of assistant message till sentences are complete,
+// complete sentences are moderated before they are delivered or spoken. Decisions of moderators
+// are collected over the message and recorded once.
+type outputModeration struct {
+	mu        sync.Mutex
+	pending   strings.Builder
+	delivered strings.Builder
+	// action which stopped the message, nothing more of message is delivered
+	stopped   internal_moderator.Action
+	decisions []*internal_moderator.Decision
+}
+
+// write adds the chunk and returns moderated text of the complete sentences to deliver
+func (o *outputModeration) write(ctx context.Context, guardrail *internal_moderator.Guardrail, chunk string) string {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if o.stopped != "" {
+		return ""
+	}
+	o.pending.WriteString(chunk)
+	pending := o.pending.String()
+	end := 0
+	for _, loc := range sentenceEnd.FindAllStringIndex(pending, -1) {
+		end = loc[1]
+	}
+	if end == 0 && len(pending) > maxPendingSentence {
+		end = strings.LastIndexFunc(pending, unicode.IsSpace) + 1
+	}
+	if end == 0 {
+		return ""
+	}
+	o.pending.Reset()
+	o.pending.WriteString(pending[end:])
+	return o.apply(ctx, guardrail, pending[:end])
+}
+
+// flush returns moderated text of whatever is held, message is complete
+func (o *outputModeration) flush(ctx context.Context, guardrail *internal_moderator.Guardrail) string {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	pending := o.pending.String()
+	o.pending.Reset()
+	if o.stopped != "" || strings.TrimSpace(pending) == "" {
+		return ""
+	}
+	return o.apply(ctx, guardrail, pending)
+}
+
+func (o *outputModeration) apply(ctx context.Context, guardrail *internal_moderator.Guardrail, text string) string {
+	result := guardrail.Apply(ctx, text)
+	o.record(result.Decisions)
+	switch {
+	case result.Blocked:
+		o.stopped = internal_moderator.BLOCK
+		return ""
+	case result.Replaced:
+		o.stopped = internal_moderator.REPLACE
+	}
+	o.delivered.WriteString(result.Content)
+	return result.Content
+}
+
+// record keeps the strictest action of every moderator over the sentences of message
+func (o *outputModeration) record(decisions []*internal_moderator.Decision) {
+	for _, d := range decisions {
+		var existing *internal_moderator.Decision
+		for _, e := range o.decisions {
+			if e.Moderator == d.Moderator {
+				existing = e
+				break
+			}
+		}
+		if existing == nil {
+			o.decisions = append(o.decisions, &internal_moderator.Decision{
+				Moderator: d.Moderator, Action: d.Action, Categories: slices.Clone(d.Categories),
+			})
+			continue
+		}
+		if actionSeverity(d.Action) > actionSeverity(existing.Action) {
+			existing.Action = d.Action
+		}
+		for _, c := range d.Categories {
+			if !slices.Contains(existing.Categories, c) {
+				existing.Categories = append(existing.Categories, c)
+			}
+		}
+	}
+}
+
+func (o *outputModeration) content() string {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return o.delivered.String()
+}
+
+func (o *outputModeration) metrics() []*types.Metric {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return (&internal_moderator.Result{Decisions: o.decisions}).Metrics(internal_moderator.OutputStage)
+}
+
+func actionSeverity(action internal_moderator.Action) int {
+	switch action {
+	case internal_moderator.REDACT:
+		return 1
+	case internal_moderator.REPLACE:
+		return 2
+	case internal_moderator.BLOCK:
+		return 3
+	}
+	return 0
+}
+
+// initializeGuardrails builds the guardrails from the moderators of assistant
+func (gr *GenericRequestor) initializeGuardrails(ctx context.Context) {
+	if len(gr.assistant.AssistantModerators) == 0 {
+		return
+	}
+	input, output := internal_moderator_factory.GetGuardrails(ctx, gr.logger, gr.Auth(), gr.integrationClient, gr.vaultClient, gr.assistant.AssistantModerators)
+	gr.guardrails.mu.Lock()
+	defer gr.guardrails.mu.Unlock()
+	gr.guardrails.input, gr.guardrails.output = input, output
+}
+
+func (gr *GenericRequestor) moderate(ctx context.Context, stage internal_moderator.Stage, messageId, content string) *internal_moderator.Result {
+	result := gr.guardrails.get(stage).Apply(ctx, content)
+	if metrics := result.Metrics(stage); len(metrics) > 0 {
+		utils.Go(gr.Context(), func() {
+			gr.OnMessageMetric(gr.Context(), messageId, metrics)
+		})
+	}
+	return result
+}
+
+// moderateInput moderates the user message before it is given to the assistant, false is returned
+// when the message must not reach the assistant. Replaced message is answered with the safe response.
+func (gr *GenericRequestor) moderateInput(ctx context.Context, in *types.Message) bool {
+	if !gr.guardrails.get(internal_moderator.InputStage).Enabled() {
+		return true
+	}
+	content := in.String()
+	result := gr.moderate(ctx, internal_moderator.InputStage, in.GetId(), content)
+	switch {
+	case result.Blocked:
+		gr.logger.Warnf("user message %s is blocked by moderator", in.GetId())
+		return false
+	case result.Replaced:
+		gr.logger.Warnf("user message %s is answered with safe response by moderator", in.GetId())
+		gr.say(ctx, result.Content)
+		return false
+	case result.Content != content:
+		in.Contents = textContents(result.Content)
+	}
+	return true
+}
+
+// moderateOutput moderates the streamed chunk of assistant before it is delivered or spoken, text is
+// held till sentences are complete. false is returned when nothing of the chunk should be delivered yet.
+func (gr *GenericRequestor) moderateOutput(ctx context.Context, messageId string, out *types.Message) (*types.Message, bool) {
+	guardrail := gr.guardrails.get(internal_moderator.OutputStage)
+	if len(out.ToolCalls) > 0 || !guardrail.Enabled() {
+		return out, true
+	}
+	content := gr.guardrails.streaming(messageId).write(ctx, guardrail, out.String())
+	if content == "" {
+		return nil, false
+	}
+	return withContent(out, content), true
+}
+
+// completeOutput delivers the moderated text still held for the message before it is completed,
+// the completed message carries what is delivered for history and persistence.
+func (gr *GenericRequestor) completeOutput(ctx context.Context, messageId string, out *types.Message) *types.Message {
+	guardrail := gr.guardrails.get(internal_moderator.OutputStage)
+	if (out != nil && len(out.ToolCalls) > 0) || !guardrail.Enabled() {
+		return out
+	}
+	moderation := gr.guardrails.completed(messageId)
+	base := out
+	if base == nil {
+		base = &types.Message{Id: messageId, Role: "assistant"}
+	}
+	if content := moderation.flush(ctx, guardrail); content != "" {
+		if err := gr.Output(ctx, messageId, withContent(base, content), false, nil); err != nil {
+			gr.logger.Errorf("unable to output moderated text for the message %s", messageId)
+		}
+	}
+	switch moderation.stopped {
+	case internal_moderator.BLOCK:
+		gr.logger.Warnf("assistant message %s is blocked by moderator", messageId)
+	case internal_moderator.REPLACE:
+		gr.logger.Warnf("assistant message %s is replaced with safe response by moderator", messageId)
+	}
+	if metrics := moderation.metrics(); len(metrics) > 0 {
+		utils.Go(gr.Context(), func() {
+			gr.OnMessageMetric(gr.Context(), messageId, metrics)
+		})
+	}
+	if out == nil {
+		return nil
+	}
+	return withContent(out, moderation.content())
+}
+
+func textContents(content string) []*types.Content {
+	return []*types.Content{{
+		ContentType:   commons.TEXT_CONTENT.String(),
+		ContentFormat: commons.TEXT_CONTENT_FORMAT_RAW.String(),
+		Content:       []byte(content),
+	}}
+}
+
+func withContent(msg *types.Message, content string) *types.Message {
+	moderated := *msg
+	moderated.Contents = textContents(content)
+	return &moderated
+}
@@ -0,0 +1,171 @@
+package internal_adapter_request_generic
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	internal_moderator "github.com/rapidaai/api/assistant-api/internal/moderator"
+	internal_moderator_local "github.com/rapidaai/api/assistant-api/internal/moderator/local"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/types"
+	"github.com/rapidaai/pkg/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// countingModerator records every content given to the moderator
+type countingModerator struct {
+	internal_moderator.Moderator
+	contents []string
+}
+
+func (m *countingModerator) Moderate(ctx context.Context, content string) (*internal_moderator.Verdict, error) {
+	m.contents = append(m.contents, content)
+	return m.Moderator.Moderate(ctx, content)
+}
+
+// keywordModerator flags the content with the keyword, it is not able to redact
+type keywordModerator struct {
+	keyword string
+}
+
+func (m *keywordModerator) Name() string { return "keyword" }
+
+func (m *keywordModerator) Moderate(ctx context.Context, content string) (*internal_moderator.Verdict, error) {
+	if strings.Contains(strings.ToLower(content), m.keyword) {
+		return &internal_moderator.Verdict{Flagged: true, Categories: []string{m.keyword}}, nil
+	}
+	return &internal_moderator.Verdict{}, nil
+}
+
+func testGuardrail(t *testing.T, rules ...*internal_moderator.Rule) *internal_moderator.Guardrail {
+	logger := commons.NewApplicationLoggerWithOptions(commons.EnableFile(false), commons.Level("error"))
+	logger.InitLogger()
+	return internal_moderator.NewGuardrail(logger, internal_moderator.OutputStage, rules...)
+}
+
+// stream feeds the chunks as the executor streams them, it returns what reaches the speaker
+// chunk by chunk and the flush on completion
+func stream(guardrail *internal_moderator.Guardrail, chunks ...string) (*outputModeration, []string) {
+	moderation := &outputModeration{}
+	var delivered []string
+	for _, chunk := range chunks {
+		if content := moderation.write(context.Background(), guardrail, chunk); content != "" {
+			delivered = append(delivered, content)
+		}
+	}
+	if content := moderation.flush(context.Background(), guardrail); content != "" {
+		delivered = append(delivered, content)
+	}
+	return moderation, delivered
+}
+
+func TestOutputModerationRedactsSentences(t *testing.T) {
+	pii, err := internal_moderator_local.NewPIIModerator("pii", utils.Option{})
+	require.NoError(t, err)
+	moderator := &countingModerator{Moderator: pii}
+	guardrail := testGuardrail(t, internal_moderator.NewRule(moderator, map[string]interface{}{internal_moderator.ActionOption: "redact"}))
+
+	moderation, delivered := stream(guardrail,
+		"Sure", ", your card", " is 4111 1111", " 1111 1111.", " I have", " noted it.\n", "Anything", " else")
+
+	// card number split across chunks is moderated as one sentence and never reaches the speaker
+	assert.Equal(t, []string{"Sure, your card is [CREDIT_CARD]. ", "I have noted it.\n", "Anything else"}, delivered)
+	assert.Equal(t, []string{"Sure, your card is 4111 1111 1111 1111. ", "I have noted it.\n", "Anything else"}, moderator.contents)
+	assert.Equal(t, "Sure, your card is [CREDIT_CARD]. I have noted it.\nAnything else", moderation.content())
+
+	metrics := moderation.metrics()
+	require.Len(t, metrics, 1)
+	assert.Equal(t, "moderation.output.pii", metrics[0].Name)
+	assert.Equal(t, "redact", metrics[0].Value)
+	assert.Equal(t, "credit_card", metrics[0].Description)
+}
+
+func TestOutputModerationBlocks(t *testing.T) {
+	moderator := &countingModerator{Moderator: &keywordModerator{keyword: "weapon"}}
+	guardrail := testGuardrail(t, internal_moderator.NewRule(moderator, map[string]interface{}{}))
+
+	moderation, delivered := stream(guardrail, "Hello there. ", "Here is how to build", " a weapon. ", "Step one", " is easy.")
+	assert.Equal(t, []string{"Hello there. "}, delivered)
+	assert.Equal(t, "Hello there. ", moderation.content())
+	// nothing after the block is moderated or delivered
+	assert.Len(t, moderator.contents, 2)
+	assert.Equal(t, internal_moderator.BLOCK, moderation.stopped)
+
+	metrics := moderation.metrics()
+	require.Len(t, metrics, 1)
+	assert.Equal(t, "block", metrics[0].Value)
+	assert.Equal(t, "weapon", metrics[0].Description)
+}
+
+func TestOutputModerationReplaces(t *testing.T) {
+	guardrail := testGuardrail(t, internal_moderator.NewRule(&keywordModerator{keyword: "weapon"}, map[string]interface{}{
+		internal_moderator.ActionOption:       "replace",
+		internal_moderator.SafeResponseOption: "Let's talk about something else.",
+	}))
+
+	moderation, delivered := stream(guardrail, "Okay. ", "A weapon", " is made by", " this. ", "Then more.")
+	assert.Equal(t, []string{"Okay. ", "Let's talk about something else."}, delivered)
+	assert.Equal(t, "Okay. Let's talk about something else.", moderation.content())
+	assert.Equal(t, internal_moderator.REPLACE, moderation.stopped)
+}
+
+func TestOutputModerationHoldsTillSentenceEnds(t *testing.T) {
+	guardrail := testGuardrail(t, internal_moderator.NewRule(&keywordModerator{keyword: "weapon"}, map[string]interface{}{}))
+	moderation := &outputModeration{}
+
+	assert.Empty(t, moderation.write(context.Background(), guardrail, "The price is 3.5"))
+	assert.Empty(t, moderation.write(context.Background(), guardrail, " dollars!"))
+	assert.Equal(t, "The price is 3.5 dollars! ", moderation.write(context.Background(), guardrail, " Want"))
+
+	// text without end of sentence is moderated once it grows too long
+	long := strings.Repeat("word ", maxPendingSentence/5+1)
+	assert.Equal(t, "Want"+long, moderation.write(context.Background(), guardrail, long+"tail"))
+	assert.Equal(t, "tail", moderation.flush(context.Background(), guardrail))
+	assert.Empty(t, moderation.flush(context.Background(), guardrail))
+}
+
+func TestOutputModerationMetricsOncePerMessage(t *testing.T) {
+	pii, err := internal_moderator_local.NewPIIModerator("pii", utils.Option{})
+	require.NoError(t, err)
+	guardrail := testGuardrail(t,
+		internal_moderator.NewRule(pii, map[string]interface{}{internal_moderator.ActionOption: "redact"}),
+		internal_moderator.NewRule(&keywordModerator{keyword: "weapon"}, map[string]interface{}{}),
+	)
+
+	moderation, _ := stream(guardrail, "Mail jane@example.com now. ", "Call +1 415 555 0132 now. ", "All good.")
+	metrics := moderation.metrics()
+	require.Len(t, metrics, 2)
+	assert.Equal(t, "moderation.output.pii", metrics[0].Name)
+	assert.Equal(t, "redact", metrics[0].Value)
+	assert.Equal(t, "email,phone", metrics[0].Description)
+	assert.Equal(t, "moderation.output.keyword", metrics[1].Name)
+	assert.Equal(t, "allow", metrics[1].Value)
+}
+
+func TestGuardrailsOutputLifecycle(t *testing.T) {
+	g := newGuardrails()
+	first := g.streaming("m1")
+	assert.Same(t, first, g.streaming("m1"))
+	assert.Same(t, first, g.completed("m1"))
+	assert.NotSame(t, first, g.completed("m1"))
+	assert.Empty(t, g.outputs)
+}
+
+func TestModerateOutputChunks(t *testing.T) {
+	gr := &GenericRequestor{guardrails: newGuardrails()}
+	gr.guardrails.output = testGuardrail(t, internal_moderator.NewRule(&keywordModerator{keyword: "weapon"}, map[string]interface{}{}))
+
+	_, ok := gr.moderateOutput(context.Background(), "m1", withContent(&types.Message{Role: "assistant"}, "Hi"))
+	assert.False(t, ok)
+	out, ok := gr.moderateOutput(context.Background(), "m1", withContent(&types.Message{Role: "assistant"}, " there. How"))
+	require.True(t, ok)
+	assert.Equal(t, "Hi there. ", out.String())
+
+	// tool calls are never held
+	call := &types.Message{Role: "assistant", ToolCalls: []*types.ToolCall{{}}}
+	out, ok = gr.moderateOutput(context.Background(), "m1", call)
+	assert.True(t, ok)
+	assert.Same(t, call, out)
+}
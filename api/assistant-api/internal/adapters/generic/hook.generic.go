@@ -10,15 +10,11 @@ import (
 	"encoding/json"
 	"fmt"
 	"slices"
-	"strconv"
 	"strings"
-	"time"
 
 	internal_assistant_entity "github.com/rapidaai/api/assistant-api/internal/entity/assistants"
 	endpoint_client_builders "github.com/rapidaai/pkg/clients/endpoint/builders"
-	"github.com/rapidaai/pkg/clients/rest"
 	"github.com/rapidaai/pkg/types"
-	type_enums "github.com/rapidaai/pkg/types/enums"
 	"github.com/rapidaai/pkg/utils"
 	"github.com/rapidaai/protos"
 )
@@ -86,6 +82,7 @@ func (md *GenericRequestor) OnEndConversation() error {
 				md.Webhook(utils.ConversationCompleted.Get(), arguments, webhook)
 			}
 		}
+		md.Evaluate(md.Context())
 	})
 	return nil
 }
@@ -107,6 +104,10 @@ func (hk *GenericRequestor) Analysis(
 		return nil, err
 	}
 	if ivk.GetSuccess() {
+		// structured output of the endpoint is already parsed and validated against its schema
+		if output := ivk.GetOutput(); output != nil {
+			return output.AsMap(), nil
+		}
 		if data := ivk.GetData(); len(data) > 0 {
 			var contentData map[string]interface{}
 			if err := json.Unmarshal(data[0].Content, &contentData); err != nil {
@@ -121,68 +122,20 @@ func (hk *GenericRequestor) Analysis(
 	return nil, fmt.Errorf("empty response from endpoint")
 }
 
+// Webhook enqueues the delivery of the webhook, deliveries are retried with backoff
+// and signed by the delivery worker so they survive restart of the session.
 func (md *GenericRequestor) Webhook(
 	event string,
 	arguments map[string]interface{},
 	webhook *internal_assistant_entity.AssistantWebhook) {
 	utils.Go(md.Context(), func() {
-		startTime := time.Now()
-		var res *rest.APIResponse
-		var err error
-		var statusCode int
-
-		retryCount := uint32(0)
-		maxRetryCount := webhook.GetMaxRetryCount()
-		retryStatusCodes := webhook.GetRetryStatusCode()
-
-		for retryCount <= maxRetryCount {
-			res, err = md.webhook(md.Context(),
-				webhook.GetTimeoutSecond(),
-				webhook.GetUrl(),
-				webhook.GetMethod(),
-				webhook.GetHeaders(),
-				arguments,
-			)
-
-			if err != nil {
-				md.logger.Error("Webhook execution failed", "error", err)
-				statusCode = 500
-			} else {
-				statusCode = res.StatusCode
-				if !slices.Contains(retryStatusCodes, strconv.Itoa(statusCode)) {
-					break
-				}
-			}
-
-			retryCount++
-			if retryCount <= maxRetryCount {
-				time.Sleep(time.Second * 2)
-			}
-		}
-
-		c, serializeErr := utils.Serialize(arguments)
-		if serializeErr != nil {
-			md.logger.Error("Failed to serialize arguments", "error", serializeErr)
-		}
-
-		v, err := res.ToJSON()
+		c, err := utils.Serialize(arguments)
 		if err != nil {
-			md.logger.Error("Failed to convert response to JSON", "error", err)
+			md.logger.Error("Failed to serialize arguments", "error", err)
+			return
 		}
-		logErr := md.CreateWebhookLog(
-			webhook.Id,
-			webhook.HttpUrl,
-			webhook.HttpMethod,
-			event,
-			int64(statusCode),
-			int64(time.Since(startTime)),
-			uint32(retryCount),
-			type_enums.RECORD_COMPLETE,
-			c,
-			v,
-		)
-		if logErr != nil {
-			md.logger.Error("Failed to create webhook log", "error", logErr)
+		if err := md.EnqueueWebhookLog(webhook, event, c); err != nil {
+			md.logger.Error("Failed to enqueue webhook", "error", err)
 		}
 	})
 }
@@ -276,24 +229,3 @@ func (ae *GenericRequestor) analyze(
 		),
 	)
 }
-
-func (aw *GenericRequestor) webhook(
-	ctx context.Context,
-	timeout uint32,
-	baseUrl string,
-	method string,
-	headers map[string]string,
-	body map[string]interface{},
-) (*rest.APIResponse, error) {
-	client := rest.NewRestClientWithConfig(baseUrl, headers, timeout)
-	switch method {
-	case "POST":
-		return client.Post(ctx, "", body, headers)
-	case "PUT":
-		return client.Put(ctx, "", body, headers)
-	case "PATCH":
-		return client.Patch(ctx, "", body, headers)
-	default:
-		return client.Get(ctx, "", body, headers)
-	}
-}
@@ -12,6 +12,7 @@ import (
 
 	internal_adapter_request_customizers "github.com/rapidaai/api/assistant-api/internal/adapters/customizers"
 	internal_end_of_speech "github.com/rapidaai/api/assistant-api/internal/end_of_speech"
+	internal_assistant_telemetry "github.com/rapidaai/api/assistant-api/internal/telemetry/assistant"
 	"github.com/rapidaai/pkg/commons"
 	"github.com/rapidaai/pkg/types"
 	type_enums "github.com/rapidaai/pkg/types/enums"
@@ -28,6 +29,7 @@ func (lio *GenericRequestor) OnRecieveTranscript(ctx context.Context,
 	confidence float64,
 	language string,
 	isCompleted bool) (*types.Message, error) {
+	lio.supervisor.activity(true)
 	lio.OnInterrupt(ctx, "word")
 	if isCompleted {
 		msgi := lio.messaging.Create(
@@ -62,6 +64,10 @@ func (io *GenericRequestor) OnSilenceBreak(
 		return nil
 	}
 	io.messaging.Transition(internal_adapter_request_customizers.UserCompleted)
+	if !io.moderateInput(ctx, msg) {
+		io.messaging.Transition(internal_adapter_request_customizers.AgentCompleted)
+		return nil
+	}
 	if err := io.Notify(ctx,
 		&protos.AssistantConversationUserMessage{
 			Id: msg.GetId(),
@@ -76,6 +82,7 @@ func (io *GenericRequestor) OnSilenceBreak(
 		io.logger.Tracef(ctx, "might be returing processing the duplicate message so cut it out.")
 		return nil
 	}
+	io.markTurn(msg.GetId(), internal_assistant_telemetry.TurnEndOfSpeech)
 	io.messaging.Transition(internal_adapter_request_customizers.LLMGenerating)
 	return io.UserCallback(
 		ctx,
@@ -86,6 +93,7 @@ func (io *GenericRequestor) OnSilenceBreak(
 }
 
 func (io *GenericRequestor) OnInterrupt(ctx context.Context, source string) error {
+	io.supervisor.activity(true)
 	switch source {
 	case "word":
 		if err := io.messaging.Transition(internal_adapter_request_customizers.Interrupted); err != nil {
@@ -139,6 +147,7 @@ func (io *GenericRequestor) InputAudio(ctx context.Context, in []byte) error {
 }
 
 func (io *GenericRequestor) InputText(ctx context.Context, msg string) error {
+	io.supervisor.activity(true)
 	// mark it interrupted
 	io.messaging.Transition(internal_adapter_request_customizers.Interrupted)
 	//
@@ -184,6 +193,13 @@ func (io *GenericRequestor) OutputAudio(
 		// io.logger.Warnf("testing: illegal transition to speaking")
 		return nil
 	}
+	if len(v) > 0 {
+		io.markTurn(contextId, internal_assistant_telemetry.TurnFirstAudio)
+		io.supervisor.activity(false)
+	}
+	if len(v) > 0 || completed {
+		io.completeTurn(contextId)
+	}
 
 	if err := io.
 		Notify(
@@ -218,6 +234,7 @@ func (io *GenericRequestor) Output(ctx context.Context, contextId string, msg *t
 	if err := io.messaging.Transition(internal_adapter_request_customizers.AgentSpeaking); err != nil {
 		return nil
 	}
+	io.supervisor.activity(false)
 
 	// Notify the system of the assistant message
 	if err := io.Notify(ctx, &protos.AssistantConversationAssistantMessage{
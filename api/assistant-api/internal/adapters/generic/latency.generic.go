@@ -0,0 +1,56 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_adapter_request_generic
+
+import (
+	"fmt"
+	"time"
+
+	internal_assistant_telemetry "github.com/rapidaai/api/assistant-api/internal/telemetry/assistant"
+	"github.com/rapidaai/pkg/utils"
+)
+
+// markTurn records the event of the turn identified by message id
+func (gr *GenericRequestor) markTurn(messageId string, event internal_assistant_telemetry.TurnEvent) {
+	gr.turnLatency.Mark(messageId, event, time.Now())
+}
+
+// completeTurn stores latency breakdown of the turn as metrics of the message,
+// latency is described with the providers so the combinations can be compared.
+func (gr *GenericRequestor) completeTurn(messageId string) {
+	metrics := gr.turnLatency.Complete(messageId, gr.turnProviders())
+	if len(metrics) == 0 {
+		return
+	}
+	gr.evaluationMu.Lock()
+	gr.turnMetrics = append(gr.turnMetrics, metrics...)
+	gr.evaluationMu.Unlock()
+	utils.Go(gr.Context(), func() {
+		if err := gr.OnMessageMetric(gr.Context(), messageId, metrics); err != nil {
+			gr.logger.Errorf("unable to store turn latency for message %s %v", messageId, err)
+		}
+	})
+}
+
+// turnProviders returns speech to text, llm and text to speech providers as stt/llm/tts
+func (gr *GenericRequestor) turnProviders() string {
+	stt, llm, tts := "-", "-", "-"
+	if gr.assistant != nil {
+		llm = string(gr.assistant.AssistantProvider)
+		if gr.assistant.AssistantProviderModel != nil && gr.assistant.AssistantProviderModel.ModelProviderName != "" {
+			llm = gr.assistant.AssistantProviderModel.ModelProviderName
+		}
+	}
+	if gr.messaging.GetInputMode().Audio() {
+		if transformer, err := gr.GetSpeechToTextTransformer(); err == nil {
+			stt = transformer.AudioProvider
+		}
+		if transformer, err := gr.GetTextToSpeechTransformer(); err == nil {
+			tts = transformer.AudioProvider
+		}
+	}
+	return fmt.Sprintf("%s/%s/%s", stt, llm, tts)
+}
@@ -19,6 +19,7 @@ import (
 	internal_adapter_transformer_factory "github.com/rapidaai/api/assistant-api/internal/factory/transformer"
 	internal_vad_factory "github.com/rapidaai/api/assistant-api/internal/factory/vad"
 	internal_telemetry "github.com/rapidaai/api/assistant-api/internal/telemetry"
+	internal_assistant_telemetry "github.com/rapidaai/api/assistant-api/internal/telemetry/assistant"
 	internal_transformer "github.com/rapidaai/api/assistant-api/internal/transformer"
 	internal_vad "github.com/rapidaai/api/assistant-api/internal/vad"
 	"github.com/rapidaai/pkg/utils"
@@ -50,7 +51,7 @@ func (listening *GenericRequestor) listenTranscript(
 
 	//
 	if transcript != "" {
-		_, err := listening.OnRecieveTranscript(
+		msg, err := listening.OnRecieveTranscript(
 			ctx,
 			transcript,
 			confidence,
@@ -58,6 +59,8 @@ func (listening *GenericRequestor) listenTranscript(
 			isCompleted)
 		if err != nil {
 			listening.logger.Info("OnRecieveTranscript error %s", err)
+		} else if isCompleted {
+			listening.markTurn(msg.GetId(), internal_assistant_telemetry.TurnTranscribed)
 		}
 		err = listening.ListenText(
 			ctx,
@@ -78,41 +81,73 @@ func (listening *GenericRequestor) initializeSpeechToText(
 	transformerConfig *internal_assistant_entity.AssistantDeploymentAudio,
 	audioConfig *protos.AudioConfig,
 	options utils.Option) error {
+	providers := failoverProviders(transformerConfig.AudioProvider, options)
+	if len(providers) > 1 {
+		// credentials of fallback providers are resolved when the switch happens, after connect is over
+		fst := newFailoverSpeechToText(
+			listening.Context(), listening.logger, audioConfig, providers, options,
+			func(provider *failoverProvider, onTranscript func(string, float64, string, bool) error) (internal_transformer.SpeechToTextTransformer, error) {
+				return listening.createSpeechToText(listening.Context(), provider.provider, audioConfig, provider.options, onTranscript)
+			},
+			listening.listenTranscript,
+			listening.failoverSwitch(utils.AssistantListenFailoverStage, "SPEECH_TO_TEXT_FAILOVER"),
+		)
+		if err := fst.Initialize(); err != nil {
+			listening.logger.Errorf("unable to initilize transformer %v", err)
+			return err
+		}
+		listening.speechToTextTransformer = fst
+		return nil
+	}
+
+	atransformer, err := listening.createSpeechToText(ctx, transformerConfig.AudioProvider, audioConfig, options, listening.listenTranscript)
+	if err != nil {
+		return err
+	}
+	err = atransformer.Initialize()
+	if err != nil {
+		listening.logger.Errorf("unable to initilize transformer %v", err)
+		return err
+	}
+	listening.speechToTextTransformer = atransformer
+	return nil
+}
+
+func (listening *GenericRequestor) createSpeechToText(
+	ctx context.Context,
+	provider string,
+	audioConfig *protos.AudioConfig,
+	options utils.Option,
+	onTranscript func(string, float64, string, bool) error,
+) (internal_transformer.SpeechToTextTransformer, error) {
 	credentialId, err := options.GetUint64("rapida.credential_id")
 	if err != nil {
 		listening.logger.Errorf("unable to find credential from options %+v", err)
-		return err
+		return nil, err
 	}
 	credential, err := listening.
 		VaultCaller().
 		GetCredential(ctx, listening.Auth(), credentialId)
 	if err != nil {
 		listening.logger.Errorf("Api call to find credential failed %+v", err)
-		return err
+		return nil, err
 	}
 
 	atransformer, err := internal_adapter_transformer_factory.
 		GetSpeechToTextTransformer(
-			internal_adapter_transformer_factory.AudioTransformer(transformerConfig.AudioProvider),
+			internal_adapter_transformer_factory.AudioTransformer(provider),
 			listening.Context(), listening.logger, credential,
 			&internal_transformer.SpeechToTextInitializeOptions{
 				AudioConfig:  audioConfig,
-				OnTranscript: listening.listenTranscript,
+				OnTranscript: onTranscript,
 				ModelOptions: options,
 			},
 		)
-
 	if err != nil {
 		listening.logger.Errorf("unable to create input audio transformer with error %v", err)
-		return err
+		return nil, err
 	}
-	err = atransformer.Initialize()
-	if err != nil {
-		listening.logger.Errorf("unable to initilize transformer %v", err)
-		return err
-	}
-	listening.speechToTextTransformer = atransformer
-	return nil
+	return atransformer, nil
 }
 
 // Init initializes the audio talking system for a given assistant persona.
@@ -306,6 +341,21 @@ func (listening *GenericRequestor) afterAnalyze(
 		)
 		return listening.OnSilenceBreak(ctx)
 	case *internal_vad.VadResult:
+		if v.IsEnded() {
+			// end of turn is decided by end of speech, the segment is only recorded
+			span.EndSpan(ctx,
+				utils.AssistantUtteranceStage,
+				internal_telemetry.KV{
+					K: "activity_type",
+					V: internal_telemetry.StringValue("vad_end"),
+				},
+				internal_telemetry.KV{
+					K: "speech_duration",
+					V: internal_telemetry.FloatValue(v.GetDuration()),
+				},
+			)
+			return nil
+		}
 		span.EndSpan(ctx,
 			utils.AssistantUtteranceStage,
 			internal_telemetry.KV{
@@ -313,6 +363,10 @@ func (listening *GenericRequestor) afterAnalyze(
 				V: internal_telemetry.StringValue("vad"),
 			},
 		)
+		// user is speaking, transcript is expected from speech to text
+		if expecter, ok := listening.speechToTextTransformer.(transcriptExpecter); ok {
+			expecter.ExpectTranscript(time.Now())
+		}
 		// might be noise at first
 		if v.GetSpeechStartAt() < 3 {
 			listening.logger.Warn("interrupt: very early interruption")
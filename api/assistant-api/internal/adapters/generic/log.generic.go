@@ -6,6 +6,9 @@
 package internal_adapter_request_generic
 
 import (
+	internal_assistant_entity "github.com/rapidaai/api/assistant-api/internal/entity/assistants"
+	internal_evaluator "github.com/rapidaai/api/assistant-api/internal/evaluator"
+	internal_webhook "github.com/rapidaai/api/assistant-api/internal/webhook"
 	"github.com/rapidaai/pkg/types"
 	type_enums "github.com/rapidaai/pkg/types/enums"
 	protos "github.com/rapidaai/protos"
@@ -37,27 +40,18 @@ func (kr *GenericRequestor) CreateKnowledgeLog(knowledgeId uint64, retrievalMeth
 	return err
 }
 
-func (cr *GenericRequestor) CreateWebhookLog(
-
-	webhookID uint64, httpUrl, httpMethod, event string,
-	responseStatus int64,
-	timeTaken int64,
-	retryCount uint32,
-	status type_enums.RecordState,
-	request, response []byte) error {
-	_, err := cr.webhookService.CreateLog(
+func (cr *GenericRequestor) EnqueueWebhookLog(
+	webhook *internal_assistant_entity.AssistantWebhook,
+	event string,
+	request []byte) error {
+	_, err := cr.webhookService.EnqueueLog(
 		cr.ctx,
 		cr.auth,
-		webhookID,
-		cr.assistant.Id,
+		webhook,
 		cr.assistantConversation.Id,
-		httpUrl, httpMethod,
 		event,
-		responseStatus,
-		timeTaken,
-		retryCount,
-		status,
-		request, response)
+		internal_webhook.IdempotencyKey(webhook.Id, cr.assistantConversation.Id, event, request),
+		request)
 	return err
 }
 
@@ -131,6 +125,13 @@ func (cr *GenericRequestor) CreateToolLog(
 	status type_enums.RecordState,
 	timeTaken int64,
 	request, response []byte) error {
+	cr.evaluationMu.Lock()
+	cr.toolCalls = append(cr.toolCalls, &internal_evaluator.ToolCall{
+		Name:            toolName,
+		ExecutionMethod: executionMethod,
+		Success:         status == type_enums.RECORD_COMPLETE || status == type_enums.RECORD_SUCCESS,
+	})
+	cr.evaluationMu.Unlock()
 	_, err := cr.assistantToolService.CreateLog(
 		cr.Context(), cr.Auth(), cr.assistant.Id,
 		cr.assistantConversation.Id, toolId, messageId, toolName, timeTaken, executionMethod,
@@ -0,0 +1,91 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_adapter_request_generic
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	internal_adapter_request_customizers "github.com/rapidaai/api/assistant-api/internal/adapters/customizers"
+	internal_encoder "github.com/rapidaai/api/assistant-api/internal/encoder"
+	internal_encoder_factory "github.com/rapidaai/api/assistant-api/internal/factory/encoder"
+	"github.com/rapidaai/pkg/utils"
+	"github.com/rapidaai/protos"
+)
+
+// options of the conversation which shape the recording
+const (
+	recordingChannelOption = "recording.channel"
+	recordingCodecOption   = "recording.codec"
+	recordingBitrateOption = "recording.bitrate"
+)
+
+// InitializeRecording starts the recording of the call, the audio is encoded and uploaded
+// in parts while the call goes on
+func (talking *GenericRequestor) InitializeRecording(ctx context.Context, audioInConfig, audioOutConfig *protos.AudioConfig) error {
+	talking.recordingMu.Lock()
+	defer talking.recordingMu.Unlock()
+
+	options := utils.Option(talking.GetOptions())
+	channel, _ := options.GetString(recordingChannelOption)
+	if channel != internal_adapter_request_customizers.RECORDING_CHANNEL_STEREO {
+		channel = internal_adapter_request_customizers.RECORDING_CHANNEL_MONO
+	}
+	codec, _ := options.GetString(recordingCodecOption)
+	identifier := internal_encoder_factory.EncoderIdentifier(codec)
+	encoderOptions := utils.Option{}
+	if bitrate, err := options.GetUint64(recordingBitrateOption); err == nil {
+		encoderOptions["bitrate"] = bitrate
+	}
+
+	recording, spool, err := talking.BeginConversationRecording(internal_encoder_factory.GetExtension(identifier))
+	if err != nil {
+		return err
+	}
+	if err := talking.recorder.Initialize(audioInConfig, audioOutConfig, &internal_adapter_request_customizers.RecordingOptions{
+		Channel: channel,
+		Writer:  spool,
+		Encoder: func(w io.Writer, sampleRate, channels uint32) (internal_encoder.Encoder, error) {
+			return internal_encoder_factory.GetEncoder(identifier, talking.logger, w, &internal_encoder.EncoderOptions{
+				SampleRate: sampleRate,
+				Channels:   channels,
+				Options:    encoderOptions,
+			})
+		},
+	}); err != nil {
+		spool.Discard(ctx)
+		return err
+	}
+	talking.recording = recording
+	talking.recordingSpool = spool
+	return nil
+}
+
+// PersistRecording finishes the recording of the call and stores it with its metadata
+func (talking *GenericRequestor) PersistRecording(ctx context.Context) error {
+	talking.recordingMu.Lock()
+	defer talking.recordingMu.Unlock()
+	if talking.recording == nil {
+		return fmt.Errorf("recording is not initialized")
+	}
+	recording, spool := talking.recording, talking.recordingSpool
+	talking.recording, talking.recordingSpool = nil, nil
+
+	output, err := talking.recorder.Persist()
+	if err != nil {
+		spool.Discard(ctx)
+		return err
+	}
+	recording.Codec = output.Codec
+	recording.Channels = output.Channels
+	recording.SampleRate = output.SampleRate
+	recording.Duration = uint64(output.Duration.Milliseconds())
+	for _, interruption := range output.Interruptions {
+		recording.Interruptions = append(recording.Interruptions, uint64(interruption.Milliseconds()))
+	}
+	return talking.CreateConversationRecording(recording, spool)
+}
@@ -14,6 +14,8 @@ import (
 	internal_adapter_requests "github.com/rapidaai/api/assistant-api/internal/adapters"
 	internal_adapter_request_customizers "github.com/rapidaai/api/assistant-api/internal/adapters/customizers"
 	internal_assistant_entity "github.com/rapidaai/api/assistant-api/internal/entity/assistants"
+	internal_message_gorm "github.com/rapidaai/api/assistant-api/internal/entity/messages"
+	internal_services "github.com/rapidaai/api/assistant-api/internal/services"
 	internal_telemetry "github.com/rapidaai/api/assistant-api/internal/telemetry"
 	"github.com/rapidaai/pkg/types"
 	type_enums "github.com/rapidaai/pkg/types/enums"
@@ -26,26 +28,91 @@ import (
 // Disconnect handles the entire disconnection lifecycle for a conversation,
 // including closing listeners, speakers, persisting recordings, and exporting metrics.
 func (talking *GenericRequestor) Disconnect() {
+	talking.teardown(true)
+}
+
+// Release closes the session without ending the conversation, channels like whatsapp
+// keep the conversation across sessions and end it with EndConversation.
+func (talking *GenericRequestor) Release() {
+	talking.teardown(false)
+}
+
+// EndConversation ends the conversation outside of a session, it runs the end of
+// conversation once for channels which only release their sessions.
+func (talking *GenericRequestor) EndConversation(auth types.SimplePrinciple, identifier string, assistantId, assistantConversationId uint64) error {
+	talking.SetAuth(auth)
+	assistant, err := talking.GetAssistant(auth, assistantId, "latest")
+	if err != nil {
+		talking.logger.Errorf("unable to get assistant %d for ending conversation %+v", assistantId, err)
+		return err
+	}
+	conversation, err := talking.ResumeConversation(auth, assistant, assistantConversationId, identifier)
+	if err != nil {
+		return err
+	}
+	talking.StartedAt = time.Time(conversation.CreatedDate)
+	_, messages, err := talking.conversationService.GetAllConversationMessage(talking.Context(), auth, assistantConversationId, nil, nil, nil, internal_services.NewGetMessageOption())
+	if err != nil {
+		talking.logger.Errorf("unable to get messages of conversation %d %+v", assistantConversationId, err)
+		return err
+	}
+	for _, message := range messages {
+		talking.histories = append(talking.histories, conversationMessages(message)...)
+	}
+	talking.AddMetrics(talking.Auth(), talking.completedMetrics()...)
+	return talking.OnEndConversation()
+}
+
+// conversationMessages restores the request and response of the stored message
+func conversationMessages(message *internal_message_gorm.AssistantConversationMessage) []*types.Message {
+	out := make([]*types.Message, 0, 2)
+	for _, stored := range []map[string]interface{}{message.Request, message.Response} {
+		if len(stored) == 0 {
+			continue
+		}
+		msg := &types.Message{}
+		if err := utils.Cast(stored, msg); err != nil {
+			continue
+		}
+		msg.Id = message.MessageId
+		msg.Time = time.Time(message.CreatedDate)
+		out = append(out, msg)
+	}
+	return out
+}
+
+func (talking *GenericRequestor) completedMetrics() []*types.Metric {
+	return []*types.Metric{
+		{
+			Name:        type_enums.TIME_TAKEN.String(),
+			Value:       fmt.Sprintf("%d", int64(time.Since(talking.StartedAt))),
+			Description: "Time taken to complete conversation",
+		},
+		{
+			Name:        type_enums.STATUS.String(),
+			Value:       type_enums.RECORD_COMPLETE.String(),
+			Description: "Status of the given conversation",
+		},
+	}
+}
+
+// teardown closes the session, the conversation is completed only when end is set
+func (talking *GenericRequestor) teardown(end bool) {
+	// supervisor may have disconnected already when it ended the session
+	if !talking.supervisor.disconnect() {
+		return
+	}
 	ctx, span, _ := talking.Tracer().StartSpan(talking.Context(), utils.AssistantDisconnectStage)
 	start := time.Now()
+	talking.supervisor.close()
 	var wg sync.WaitGroup
-	wg.Add(1)
-	utils.Go(talking.Context(), func() {
-		defer wg.Done()
-		metrics := []*types.Metric{
-			{
-				Name:        type_enums.TIME_TAKEN.String(),
-				Value:       fmt.Sprintf("%d", int64(time.Since(talking.StartedAt))),
-				Description: "Time taken to complete conversation",
-			},
-			{
-				Name:        type_enums.STATUS.String(),
-				Value:       type_enums.RECORD_COMPLETE.String(),
-				Description: "Status of the given conversation",
-			},
-		}
-		talking.AddMetrics(talking.Auth(), metrics...)
-	})
+	if end {
+		wg.Add(1)
+		utils.Go(talking.Context(), func() {
+			defer wg.Done()
+			talking.AddMetrics(talking.Auth(), talking.completedMetrics()...)
+		})
+	}
 	wg.Add(1)
 	utils.Go(talking.Context(), func() {
 		defer wg.Done()
@@ -67,19 +134,15 @@ func (talking *GenericRequestor) Disconnect() {
 		}
 	})
 	wg.Wait()
-	talking.OnEndConversation()
-	utils.Go(talking.Context(), func() {
-		byt, err := talking.recorder.Persist()
-		if err != nil {
-			talking.logger.Tracef(ctx, "unable to persist the audio %+v", err)
-			return
-		}
-		err = talking.CreateConversationRecording(byt)
-		if err != nil {
-			talking.logger.Tracef(ctx, "unable to create conversation recording %+v", err)
-			return
-		}
-	})
+	if end {
+		talking.OnEndConversation()
+		utils.Go(talking.Context(), func() {
+			if err := talking.PersistRecording(ctx); err != nil {
+				talking.logger.Tracef(ctx, "unable to persist the recording %+v", err)
+				return
+			}
+		})
+	}
 	span.EndSpan(ctx, utils.AssistantDisconnectStage)
 	if err := talking.tracer.Export(ctx, talking.auth, &internal_telemetry.VoiceAgentExportOption{
 		AssistantId:              talking.assistant.Id,
@@ -160,6 +223,11 @@ func (talking *GenericRequestor) OnCreateSession(ctx context.Context, inCfg, str
 		return nil
 	})
 
+	wg.Go(func() error {
+		talking.initializeGuardrails(ctx)
+		return nil
+	})
+
 	wg.Go(func() error {
 		if err := talking.Notify(ctx,
 			&protos.AssistantConversationConfiguration{
@@ -178,7 +246,7 @@ func (talking *GenericRequestor) OnCreateSession(ctx context.Context, inCfg, str
 
 	utils.Go(ctx, func() {
 		if audioInConfig != nil && audioOutConfig != nil {
-			if err := talking.recorder.Initialize(audioInConfig, audioOutConfig); err != nil {
+			if err := talking.InitializeRecording(ctx, audioInConfig, audioOutConfig); err != nil {
 				talking.logger.Tracef(ctx, "unable to init recorder %+v", err)
 			}
 		}
@@ -226,6 +294,11 @@ func (talking *GenericRequestor) OnCreateSession(ctx context.Context, inCfg, str
 			talking.logger.Errorf("error while begin conversation error %+v", err)
 		}
 	})
+
+	// idle timeout and max session duration
+	utils.Go(ctx, func() {
+		talking.supervise(talking.Context())
+	})
 	return wg.Wait()
 }
 
@@ -262,6 +335,11 @@ func (talking *GenericRequestor) OnResumeSession(ctx context.Context, inCfg, str
 		return nil
 	})
 
+	wg.Go(func() error {
+		talking.initializeGuardrails(ctx)
+		return nil
+	})
+
 	// notify the configuration
 	wg.Go(func() error {
 		if err := talking.Notify(ctx, &protos.AssistantConversationConfiguration{
@@ -279,7 +357,7 @@ func (talking *GenericRequestor) OnResumeSession(ctx context.Context, inCfg, str
 
 	utils.Go(ctx, func() {
 		if audioOutConfig != nil && audioInConfig != nil {
-			if err := talking.recorder.Initialize(audioInConfig, audioOutConfig); err != nil {
+			if err := talking.InitializeRecording(ctx, audioInConfig, audioOutConfig); err != nil {
 				talking.logger.Tracef(ctx, "unable to init recorder %+v", err)
 			}
 		}
@@ -294,6 +372,10 @@ func (talking *GenericRequestor) OnResumeSession(ctx context.Context, inCfg, str
 			// changing to audio mode
 			talking.messaging.SwitchOutputMode(type_enums.AudioMode)
 		}
+		// whatsapp resumes the conversation on every message, greet only when it begins
+		if talking.source == utils.Whatsapp {
+			return nil
+		}
 		if err := talking.OnGreet(ctx); err != nil {
 			talking.logger.Errorf("unable to greet user with error %+v", err)
 		}
@@ -334,5 +416,10 @@ func (talking *GenericRequestor) OnResumeSession(ctx context.Context, inCfg, str
 	if err := talking.OnResumeConversation(); err != nil {
 		talking.logger.Errorf("Error while resume the conversation: %v", err)
 	}
+
+	// idle timeout and max session duration
+	utils.Go(ctx, func() {
+		talking.supervise(talking.Context())
+	})
 	return wg.Wait()
 }
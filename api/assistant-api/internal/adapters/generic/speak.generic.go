@@ -10,9 +10,11 @@ import (
 	"sync"
 	"time"
 
+	internal_assistant_entity "github.com/rapidaai/api/assistant-api/internal/entity/assistants"
 	internal_adapter_transformer_factory "github.com/rapidaai/api/assistant-api/internal/factory/transformer"
 	internal_synthesizers "github.com/rapidaai/api/assistant-api/internal/synthesizes"
 	internal_adapter_telemetry "github.com/rapidaai/api/assistant-api/internal/telemetry"
+	internal_assistant_telemetry "github.com/rapidaai/api/assistant-api/internal/telemetry/assistant"
 	internal_tokenizer "github.com/rapidaai/api/assistant-api/internal/tokenizer"
 	internal_transformer "github.com/rapidaai/api/assistant-api/internal/transformer"
 	"github.com/rapidaai/pkg/utils"
@@ -104,6 +106,7 @@ func (spk *GenericRequestor) ConnectSpeaker(ctx context.Context, audioOutConfig
 			K: "provider", V: internal_adapter_telemetry.StringValue(outputTransformer.AudioProvider),
 		},
 	)
+	providers := failoverProviders(outputTransformer.GetName(), speakerOpts)
 	//
 	var wg sync.WaitGroup
 	wg.Add(1)
@@ -118,40 +121,48 @@ func (spk *GenericRequestor) ConnectSpeaker(ctx context.Context, audioOutConfig
 		if formatter, err := internal_synthesizers.NewSentenceFormattingSynthesizer(spk.logger, internal_synthesizers.SynthesizerOptions{SpeakerOptions: speakerOpts}); err == nil {
 			spk.synthesizers = append(spk.synthesizers, formatter)
 		}
+		// lexicon goes last so normalizers never rewrite its tags
+		if lexicon, err := internal_synthesizers.NewSentenceLexiconSynthesizer(spk.logger, internal_synthesizers.SynthesizerOptions{
+			SpeakerOptions: speakerOpts,
+			Lexicons:       spk.Assistant().AssistantLexicons,
+			LexiconFormat:  lexiconFormat(outputTransformer.GetName(), len(providers) > 1),
+		}); err == nil {
+			spk.synthesizers = append(spk.synthesizers, lexicon)
+		}
 		spk.logger.Benchmark("speak.GetAudioOutputTransformer.synthesizers", time.Since(start))
 	})
 
 	wg.Add(1)
 	utils.Go(context, func() {
 		defer wg.Done()
-		opts := &internal_transformer.TextToSpeechInitializeOptions{
-			AudioConfig: audioOutConfig,
-			OnSpeech: func(contextId string, v []byte) error {
-				return spk.OutputAudio(contextId, v, false)
-			},
-			OnComplete: func(contextId string) error {
-				return spk.OutputAudio(contextId, nil, true)
-			},
-			ModelOptions: speakerOpts,
+		onSpeech := func(contextId string, v []byte) error {
+			return spk.OutputAudio(contextId, v, false)
 		}
-
-		credentialId, err := opts.ModelOptions.GetUint64("rapida.credential_id")
-		if err != nil {
-			spk.logger.Errorf("unable to find credential from options %+v", err)
-			return
+		onComplete := func(contextId string) error {
+			return spk.OutputAudio(contextId, nil, true)
 		}
-		credential, err := spk.
-			VaultCaller().
-			GetCredential(context, spk.Auth(), credentialId)
-		if err != nil {
-			spk.logger.Errorf("Api call to find credential failed %+v", err)
+
+		if len(providers) > 1 {
+			// credentials of fallback providers are resolved when the switch happens, after connect is over
+			ftt := newFailoverTextToSpeech(
+				spk.Context(), spk.logger, audioOutConfig, providers, speakerOpts,
+				func(provider *failoverProvider, onSpeech func(string, []byte) error, onComplete func(string) error) (internal_transformer.TextToSpeechTransformer, error) {
+					return spk.createTextToSpeech(spk.Context(), provider.provider, audioOutConfig, provider.options, onSpeech, onComplete)
+				},
+				onSpeech, onComplete,
+				spk.failoverSwitch(utils.AssistantSpeakFailoverStage, "TEXT_TO_SPEECH_FAILOVER"),
+			)
+			if err := ftt.Initialize(); err != nil {
+				spk.logger.Errorf("unable to initilize transformer %v", err)
+				return
+			}
+			spk.textToSpeechTransformer = ftt
+			spk.logger.Benchmark("speak.transformer.Initialize", time.Since(start))
 			return
 		}
 
-		spk.logger.Debugf("creating output audio transformer with options %+v and name %v", opts, outputTransformer.GetName())
-		atransformer, err := internal_adapter_transformer_factory.GetTextToSpeechTransformer(internal_adapter_transformer_factory.AudioTransformer(outputTransformer.GetName()), context, spk.logger, credential, opts)
+		atransformer, err := spk.createTextToSpeech(context, outputTransformer.GetName(), audioOutConfig, speakerOpts, onSpeech, onComplete)
 		if err != nil {
-			spk.logger.Errorf("unable to create input audio transformer with error %v", err)
 			return
 		}
 		spk.logger.Benchmark("speak.transformer.GetOutputAudioTransformer", time.Since(start))
@@ -168,6 +179,84 @@ func (spk *GenericRequestor) ConnectSpeaker(ctx context.Context, audioOutConfig
 	return nil
 }
 
+func (spk *GenericRequestor) createTextToSpeech(
+	ctx context.Context,
+	provider string,
+	audioOutConfig *protos.AudioConfig,
+	options utils.Option,
+	onSpeech func(string, []byte) error,
+	onComplete func(string) error,
+) (internal_transformer.TextToSpeechTransformer, error) {
+	opts := &internal_transformer.TextToSpeechInitializeOptions{
+		AudioConfig:  audioOutConfig,
+		OnSpeech:     onSpeech,
+		OnComplete:   onComplete,
+		ModelOptions: options,
+	}
+	if internal_adapter_transformer_factory.AudioTransformer(provider) == internal_adapter_transformer_factory.GOOGLE_SPEECH_SERVICE {
+		opts.Pronunciations = lexiconPronunciations(spk.Assistant().AssistantLexicons)
+	}
+
+	credentialId, err := opts.ModelOptions.GetUint64("rapida.credential_id")
+	if err != nil {
+		spk.logger.Errorf("unable to find credential from options %+v", err)
+		return nil, err
+	}
+	credential, err := spk.
+		VaultCaller().
+		GetCredential(ctx, spk.Auth(), credentialId)
+	if err != nil {
+		spk.logger.Errorf("Api call to find credential failed %+v", err)
+		return nil, err
+	}
+
+	spk.logger.Debugf("creating output audio transformer with options %+v and name %v", opts, provider)
+	atransformer, err := internal_adapter_transformer_factory.GetTextToSpeechTransformer(internal_adapter_transformer_factory.AudioTransformer(provider), ctx, spk.logger, credential, opts)
+	if err != nil {
+		spk.logger.Errorf("unable to create input audio transformer with error %v", err)
+		return nil, err
+	}
+	return atransformer, nil
+}
+
+// lexiconFormat is the way the provider reads pronunciation of lexicon, with failover the sentence
+// can reach any provider so it is kept to plain text
+func lexiconFormat(provider string, failover bool) internal_synthesizers.LexiconFormat {
+	if failover {
+		return internal_synthesizers.LexiconPlainText
+	}
+	switch internal_adapter_transformer_factory.AudioTransformer(provider) {
+	case internal_adapter_transformer_factory.AZURE_SPEECH_SERVICE:
+		return internal_synthesizers.LexiconFormat{
+			Alphabets: []string{internal_synthesizers.LEXICON_ALPHABET_IPA, internal_synthesizers.LEXICON_ALPHABET_X_SAMPA},
+			Sub:       true,
+		}
+	case internal_adapter_transformer_factory.ELEVENLABS:
+		return internal_synthesizers.LexiconFormat{
+			Alphabets: []string{internal_synthesizers.LEXICON_ALPHABET_IPA},
+		}
+	case internal_adapter_transformer_factory.GOOGLE_SPEECH_SERVICE:
+		return internal_synthesizers.LexiconFormat{CustomPronunciation: true}
+	}
+	return internal_synthesizers.LexiconPlainText
+}
+
+// lexiconPronunciations are phonemes of words for speakers which take them with the request
+func lexiconPronunciations(lexicons []*internal_assistant_entity.AssistantLexicon) []*internal_transformer.Pronunciation {
+	pronunciations := make([]*internal_transformer.Pronunciation, 0)
+	for _, lexicon := range lexicons {
+		if lexicon.GetPhoneme() == "" || lexicon.GetMatchType() == internal_synthesizers.LEXICON_MATCH_REGEX {
+			continue
+		}
+		pronunciations = append(pronunciations, &internal_transformer.Pronunciation{
+			Phrase:   lexicon.GetPhrase(),
+			Phoneme:  lexicon.GetPhoneme(),
+			Alphabet: lexicon.GetAlphabet(),
+		})
+	}
+	return pronunciations
+}
+
 func (spk *GenericRequestor) OnCompleteSentence(
 	ctx context.Context,
 	contextId string, output string) error {
@@ -189,6 +278,7 @@ func (spk *GenericRequestor) OnCompleteSentence(
 	for _, v := range spk.synthesizers {
 		output = v.Synthesize(spk.Context(), contextId, output)
 	}
+	spk.markTurn(contextId, internal_assistant_telemetry.TurnSpeechRequested)
 	span.AddAttributes(ctx,
 		internal_adapter_telemetry.KV{
 			K: "synthesize_script", V: internal_adapter_telemetry.StringValue(output),
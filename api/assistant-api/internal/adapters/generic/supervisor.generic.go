@@ -0,0 +1,204 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_adapter_request_generic
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	internal_assistant_entity "github.com/rapidaai/api/assistant-api/internal/entity/assistants"
+	"github.com/rapidaai/pkg/utils"
+	"github.com/rapidaai/protos"
+)
+
+// reasons recorded as talk.end_reason in conversation metadata when the session is ended by supervisor
+const (
+	EndReasonIdleTimeout        = "idle_timeout"
+	EndReasonMaxSessionDuration = "max_session_duration"
+
+	// idle timeout message is spoken once before hanging up when retry is not configured
+	defaultIdealTimeoutRetry = 1
+
+	// time given to the streamer to end the conversation before requestor disconnects itself
+	endSessionGrace = 5 * time.Second
+)
+
+// sessionSupervisor keeps the activity of session, the requestor is passed by value so
+// supervisor is always referenced by pointer
+type sessionSupervisor struct {
+	mu           sync.Mutex
+	lastActivity time.Time
+	retried      uint64
+	disconnected bool
+	stop         chan struct{}
+	once         sync.Once
+}
+
+func newSessionSupervisor() *sessionSupervisor {
+	return &sessionSupervisor{
+		lastActivity: time.Now(),
+		stop:         make(chan struct{}),
+	}
+}
+
+// activity restarts the idle window, retries are reset only when the user is active
+// as assistant speaking the idle timeout message is an activity as well.
+func (s *sessionSupervisor) activity(user bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.lastActivity = time.Now()
+	if user {
+		s.retried = 0
+	}
+}
+
+// idle tells whether there is no activity for the given timeout, and increments the retry
+// when it is not exhausted yet, exhausted is true when session should be ended.
+func (s *sessionSupervisor) idle(timeout time.Duration, retries uint64) (idle bool, exhausted bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if time.Since(s.lastActivity) < timeout {
+		return false, false
+	}
+	if s.retried >= retries {
+		return true, true
+	}
+	s.retried++
+	s.lastActivity = time.Now()
+	return true, false
+}
+
+// disconnect is true only for the first caller, session is disconnected either by
+// the streamer closing or by the supervisor ending it
+func (s *sessionSupervisor) disconnect() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.disconnected {
+		return false
+	}
+	s.disconnected = true
+	return true
+}
+
+func (s *sessionSupervisor) close() {
+	s.once.Do(func() { close(s.stop) })
+}
+
+// idleTimeout is configured in milliseconds
+func idleTimeout(behavior *internal_assistant_entity.AssistantDeploymentBehavior) time.Duration {
+	if behavior.IdealTimeout == nil {
+		return 0
+	}
+	return time.Duration(*behavior.IdealTimeout) * time.Millisecond
+}
+
+// maxSessionDuration is configured in milliseconds
+func maxSessionDuration(behavior *internal_assistant_entity.AssistantDeploymentBehavior) time.Duration {
+	if behavior.MaxSessionDuration == nil {
+		return 0
+	}
+	return time.Duration(*behavior.MaxSessionDuration) * time.Millisecond
+}
+
+func idleTimeoutRetry(behavior *internal_assistant_entity.AssistantDeploymentBehavior) uint64 {
+	if behavior.IdealTimeoutRetry == nil || *behavior.IdealTimeoutRetry == 0 {
+		return defaultIdealTimeoutRetry
+	}
+	return *behavior.IdealTimeoutRetry
+}
+
+// supervise watches the session against idle timeout and max session duration of deployment
+// behavior, it blocks until the session is ended or the requestor is disconnected.
+func (gr *GenericRequestor) supervise(ctx context.Context) {
+	// whatsapp conversation is resumed on every message, there is no session to supervise
+	if gr.source == utils.Whatsapp {
+		return
+	}
+	behavior, err := gr.GetBehavior()
+	if err != nil {
+		return
+	}
+	idle, maxDuration, retries := idleTimeout(behavior), maxSessionDuration(behavior), idleTimeoutRetry(behavior)
+	if idle == 0 && maxDuration == 0 {
+		return
+	}
+
+	var sessionEnd <-chan time.Time
+	if maxDuration > 0 {
+		timer := time.NewTimer(maxDuration)
+		defer timer.Stop()
+		sessionEnd = timer.C
+	}
+	ticker := time.NewTicker(250 * time.Millisecond)
+	defer ticker.Stop()
+
+	gr.supervisor.activity(true)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-gr.supervisor.stop:
+			return
+		case <-sessionEnd:
+			gr.logger.Infof("ending conversation %d after max session duration %v", gr.assistantConversation.Id, maxDuration)
+			gr.endSession(ctx, EndReasonMaxSessionDuration)
+			return
+		case <-ticker.C:
+			if idle == 0 {
+				continue
+			}
+			timedOut, exhausted := gr.supervisor.idle(idle, retries)
+			if !timedOut {
+				continue
+			}
+			if exhausted {
+				gr.logger.Infof("ending conversation %d after idle timeout %v", gr.assistantConversation.Id, idle)
+				gr.endSession(ctx, EndReasonIdleTimeout)
+				return
+			}
+			if behavior.IdealTimeoutMessage == nil {
+				continue
+			}
+			if content := gr.templateParser.Parse(*behavior.IdealTimeoutMessage, gr.GetArgs()); strings.TrimSpace(content) != "" {
+				gr.say(ctx, content)
+			}
+		}
+	}
+}
+
+// endSession records the reason in conversation metadata and asks the streamer to end the conversation,
+// telephony streamers hang up and close the session which disconnects the requestor. Other streamers
+// only forward the action to the client, requestor disconnects itself when the session is still open.
+func (gr *GenericRequestor) endSession(ctx context.Context, reason string) {
+	gr.SetMetadata(gr.Auth(), map[string]interface{}{"talk.end_reason": reason})
+	if err := gr.Notify(ctx, &protos.AssistantMessagingResponse_Action{
+		Action: &protos.AssistantConversationAction{
+			Name:   reason,
+			Action: protos.AssistantConversationAction_END_CONVERSATION,
+		},
+	}); err != nil {
+		gr.logger.Errorf("unable to end the conversation with reason %s: %v", reason, err)
+	}
+
+	grace := time.NewTimer(endSessionGrace)
+	defer grace.Stop()
+	select {
+	case <-gr.supervisor.stop:
+		return
+	case <-ctx.Done():
+		return
+	case <-grace.C:
+		gr.logger.Infof("conversation %d is still open after %s, disconnecting", gr.assistantConversation.Id, reason)
+		// whatsapp conversation ends once its session window expires
+		if gr.source == utils.Whatsapp {
+			gr.Release()
+			return
+		}
+		gr.Disconnect()
+	}
+}
@@ -0,0 +1,65 @@
+package internal_adapter_request_generic
+
+import (
+	"testing"
+	"time"
+
+	internal_assistant_entity "github.com/rapidaai/api/assistant-api/internal/entity/assistants"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSessionSupervisorIdle(t *testing.T) {
+	s := newSessionSupervisor()
+	timedOut, exhausted := s.idle(time.Hour, 2)
+	assert.False(t, timedOut)
+	assert.False(t, exhausted)
+
+	// every timeout retries until the retries are exhausted
+	for i := 0; i < 2; i++ {
+		timedOut, exhausted = s.idle(0, 2)
+		assert.True(t, timedOut)
+		assert.False(t, exhausted)
+	}
+	timedOut, exhausted = s.idle(0, 2)
+	assert.True(t, timedOut)
+	assert.True(t, exhausted)
+
+	// assistant speaking does not reset the retries, user does
+	s.activity(false)
+	_, exhausted = s.idle(0, 2)
+	assert.True(t, exhausted)
+	s.activity(true)
+	_, exhausted = s.idle(0, 2)
+	assert.False(t, exhausted)
+}
+
+func TestSessionSupervisorDisconnect(t *testing.T) {
+	s := newSessionSupervisor()
+	assert.True(t, s.disconnect())
+	assert.False(t, s.disconnect())
+
+	s.close()
+	s.close()
+	select {
+	case <-s.stop:
+	default:
+		t.Fatal("supervisor is not stopped")
+	}
+}
+
+func TestSessionSupervisorBehavior(t *testing.T) {
+	duration, timeout, retry := uint64(600000), uint64(5000), uint64(3)
+	behavior := &internal_assistant_entity.AssistantDeploymentBehavior{
+		MaxSessionDuration: &duration,
+		IdealTimeout:       &timeout,
+		IdealTimeoutRetry:  &retry,
+	}
+	assert.Equal(t, 10*time.Minute, maxSessionDuration(behavior))
+	assert.Equal(t, 5*time.Second, idleTimeout(behavior))
+	assert.Equal(t, uint64(3), idleTimeoutRetry(behavior))
+
+	empty := &internal_assistant_entity.AssistantDeploymentBehavior{}
+	assert.Equal(t, time.Duration(0), maxSessionDuration(empty))
+	assert.Equal(t, time.Duration(0), idleTimeout(empty))
+	assert.Equal(t, uint64(defaultIdealTimeoutRetry), idleTimeoutRetry(empty))
+}
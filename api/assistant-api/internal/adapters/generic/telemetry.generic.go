@@ -0,0 +1,70 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_adapter_request_generic
+
+import (
+	"context"
+	"sync"
+
+	"github.com/rapidaai/api/assistant-api/config"
+	internal_telemetry "github.com/rapidaai/api/assistant-api/internal/telemetry"
+	internal_assistant_telemetry_exporters "github.com/rapidaai/api/assistant-api/internal/telemetry/assistant/exporters"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/configs"
+	"github.com/rapidaai/pkg/connectors"
+)
+
+var (
+	// otlp exporter batches spans in background and holds the collector
+	// connection, it is shared across all the sessions of the process.
+	otlpExporterOnce sync.Once
+	otlpExporter     internal_assistant_telemetry_exporters.OtlpTraceExporter
+)
+
+// ShutdownTraceExporters flushes the spans pending in the shared exporters and
+// closes their collector connections, called once when the process stops.
+func ShutdownTraceExporters(ctx context.Context) error {
+	// waits for an exporter being created by a session and stops the later ones
+	// from creating it, the exporter is read only after the once
+	otlpExporterOnce.Do(func() {})
+	if otlpExporter == nil {
+		return nil
+	}
+	return otlpExporter.Shutdown(ctx)
+}
+
+// traceExporters returns the exporters configured for voice agent telemetry,
+// opensearch is used when nothing is configured as it backs telemetry listing.
+func traceExporters(
+	logger commons.Logger,
+	cfg *config.AssistantConfig,
+	opensearch connectors.OpenSearchConnector,
+) []internal_telemetry.TraceExporter {
+	telemetryConfig := cfg.TelemetryConfig
+	exporters := make([]internal_telemetry.TraceExporter, 0, 2)
+	if len(telemetryConfig.Exporters) == 0 || telemetryConfig.Enabled(configs.OPENSEARCH_EXPORTER) {
+		exporters = append(exporters,
+			internal_assistant_telemetry_exporters.NewOpensearchAssistantTraceExporter(logger, &cfg.AppConfig, opensearch))
+	}
+	if telemetryConfig.Enabled(configs.OTLP_EXPORTER) {
+		otlpExporterOnce.Do(func() {
+			exporter, err := internal_assistant_telemetry_exporters.NewOtlpAssistantTraceExporter(
+				context.Background(), logger, &telemetryConfig.Otlp)
+			if err != nil {
+				logger.Errorf("unable to initialize otlp trace exporter %v", err)
+				return
+			}
+			otlpExporter = exporter
+		})
+		if otlpExporter != nil {
+			exporters = append(exporters, otlpExporter)
+		}
+	}
+	if telemetryConfig.Enabled(configs.LOGGING_EXPORTER) {
+		exporters = append(exporters, internal_assistant_telemetry_exporters.NewLoggingAssistantTraceExporter(logger))
+	}
+	return exporters
+}
@@ -0,0 +1,119 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_adapter_request_generic
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	internal_telephony_factory "github.com/rapidaai/api/assistant-api/internal/factory/telephony"
+	internal_services "github.com/rapidaai/api/assistant-api/internal/services"
+	internal_telephony "github.com/rapidaai/api/assistant-api/internal/telephony"
+	"github.com/rapidaai/pkg/utils"
+	"github.com/rapidaai/protos"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// callReference gives the call identifier of provider for the current conversation, inbound calls
+// record the call reference while outbound calls record the reference given by provider while calling
+func (gr *GenericRequestor) callReference(ctx context.Context) (string, error) {
+	metadata := gr.metadata
+	if _, ok := metadata["telephony.call_reference"]; !ok {
+		conversation, err := gr.conversationService.Get(ctx, gr.auth, gr.assistant.Id, gr.assistantConversation.Id, &internal_services.GetConversationOption{InjectMetadata: true})
+		if err != nil {
+			return "", err
+		}
+		metadata = conversation.GetMetadatas()
+	}
+	for _, k := range []string{"telephony.call_reference", "telephony.conversation_reference"} {
+		if v, ok := metadata[k].(string); ok && v != "" {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("call reference is not available for the conversation")
+}
+
+// CanTransferCall tells whether the telephony of phone deployment can transfer the live call
+func (gr *GenericRequestor) CanTransferCall() bool {
+	if gr.source != utils.PhoneCall {
+		return false
+	}
+	deployment := gr.assistant.AssistantPhoneDeployment
+	if deployment == nil {
+		return false
+	}
+	tlp, err := internal_telephony_factory.GetTelephony(internal_telephony_factory.Telephony(deployment.TelephonyProvider), gr.config, gr.logger)
+	if err != nil {
+		return false
+	}
+	return tlp.CanTransfer()
+}
+
+// TransferCall transfers the live phone call to the destination through the telephony of deployment,
+// events of provider are recorded on the conversation and TRANSFER_CALL action is notified so the
+// streamer can release the call.
+func (gr *GenericRequestor) TransferCall(ctx context.Context, transfer *internal_telephony.Transfer) error {
+	start := time.Now()
+	if gr.source != utils.PhoneCall {
+		return fmt.Errorf("transfer is only supported for phone call")
+	}
+	deployment := gr.assistant.AssistantPhoneDeployment
+	if deployment == nil {
+		return fmt.Errorf("phone deployment is not enabled for assistant")
+	}
+
+	credentialId, err := deployment.GetOptions().GetUint64("rapida.credential_id")
+	if err != nil {
+		return fmt.Errorf("phone deployment does not have credential")
+	}
+	credential, err := gr.vaultClient.GetCredential(ctx, gr.auth, credentialId)
+	if err != nil {
+		return err
+	}
+	tlp, err := internal_telephony_factory.GetTelephony(internal_telephony_factory.Telephony(deployment.TelephonyProvider), gr.config, gr.logger)
+	if err != nil {
+		return err
+	}
+	callReference, err := gr.callReference(ctx)
+	if err != nil {
+		return err
+	}
+	if transfer.From == "" {
+		transfer.From, _ = deployment.GetOptions().GetString("phone")
+	}
+
+	_, events, err := tlp.TransferCall(gr.auth, gr.assistant.Id, gr.assistantConversation.Id, callReference, transfer, credential, deployment.GetOptions())
+	utils.Go(context.Background(), func() {
+		if _, err := gr.conversationService.ApplyConversationTelephonyEvent(context.Background(), gr.auth, deployment.TelephonyProvider, gr.assistant.Id, gr.assistantConversation.Id, events); err != nil {
+			gr.logger.Errorf("unable to record transfer events %v", err)
+		}
+	})
+	if err != nil {
+		gr.logger.Errorf("unable to transfer call %s with error %v", callReference, err)
+		return err
+	}
+	gr.SetMetadata(gr.auth, map[string]interface{}{
+		"telephony.transfer.destination": transfer.Destination,
+		"telephony.transfer.mode":        string(transfer.Mode),
+	})
+
+	args := map[string]*anypb.Any{}
+	for k, v := range map[string]string{"destination": transfer.Destination, "mode": string(transfer.Mode)} {
+		if a, err := anypb.New(wrapperspb.String(v)); err == nil {
+			args[k] = a
+		}
+	}
+	gr.logger.Benchmark("GenericRequestor.TransferCall", time.Since(start))
+	return gr.Notify(ctx, &protos.AssistantMessagingResponse_Action{
+		Action: &protos.AssistantConversationAction{
+			Name:   "transfer_call",
+			Action: protos.AssistantConversationAction_TRANSFER_CALL,
+			Args:   args,
+		},
+	})
+}
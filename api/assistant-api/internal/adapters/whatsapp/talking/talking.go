@@ -0,0 +1,109 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_adapter_request_talking_whatsapp
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/rapidaai/api/assistant-api/config"
+	internal_adapter_requests "github.com/rapidaai/api/assistant-api/internal/adapters"
+	internal_adapter_request_generic "github.com/rapidaai/api/assistant-api/internal/adapters/generic"
+	internal_streamers "github.com/rapidaai/api/assistant-api/internal/streamers"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/connectors"
+	"github.com/rapidaai/pkg/storages"
+	"github.com/rapidaai/pkg/types"
+	"github.com/rapidaai/pkg/utils"
+	"github.com/rapidaai/protos"
+)
+
+type whatsappTalking struct {
+	internal_adapter_request_generic.GenericRequestor
+	logger commons.Logger
+}
+
+func NewTalking(
+	ctx context.Context,
+	config *config.AssistantConfig,
+	logger commons.Logger,
+	postgres connectors.PostgresConnector,
+	opensearch connectors.OpenSearchConnector,
+	redis connectors.RedisConnector,
+	storage storages.Storage,
+	streamer internal_streamers.Streamer,
+) (internal_adapter_requests.Talking, error) {
+
+	return &whatsappTalking{
+		logger:           logger,
+		GenericRequestor: internal_adapter_request_generic.NewGenericRequestor(ctx, config, logger, utils.Whatsapp, postgres, opensearch, redis, storage, streamer),
+	}, nil
+}
+
+// Ender ends the whatsapp conversation once its session window has expired
+type Ender interface {
+	EndConversation(auth types.SimplePrinciple, identifier string, assistantId, assistantConversationId uint64) error
+}
+
+func NewEnder(
+	ctx context.Context,
+	config *config.AssistantConfig,
+	logger commons.Logger,
+	postgres connectors.PostgresConnector,
+	opensearch connectors.OpenSearchConnector,
+	redis connectors.RedisConnector,
+	storage storages.Storage,
+) Ender {
+	return &whatsappTalking{
+		logger:           logger,
+		GenericRequestor: internal_adapter_request_generic.NewGenericRequestor(ctx, config, logger, utils.Whatsapp, postgres, opensearch, redis, storage, nil),
+	}
+}
+
+// Talk runs one turn of the whatsapp conversation in text mode, the streamer gives the
+// configuration and the message of user and returns io.EOF once the reply is sent. The turn
+// only releases the session, the conversation is ended once its session window expires.
+func (talking *whatsappTalking) Talk(ctx context.Context, auth types.SimplePrinciple, identifier string) error {
+	talking.StartedAt = time.Now()
+	var initialized = false
+	for {
+		select {
+		case <-ctx.Done():
+			if initialized {
+				talking.Release()
+			}
+			return ctx.Err()
+		default:
+		}
+
+		req, err := talking.Streamer().Recv()
+		if err != nil {
+			if err == io.EOF {
+				if initialized {
+					talking.Release()
+				}
+				return nil
+			}
+			return fmt.Errorf("stream.Recv error: %w", err)
+		}
+		switch msg := req.GetRequest().(type) {
+		case *protos.AssistantMessagingRequest_Message:
+			if initialized {
+				if err := talking.Input(req.GetMessage()); err != nil {
+					talking.logger.Errorf("error while accepting input %v", err)
+				}
+			}
+		case *protos.AssistantMessagingRequest_Configuration:
+			if err := talking.Connect(ctx, auth, identifier, msg.Configuration); err != nil {
+				talking.logger.Errorf("unexpected error while connect assistant, might be problem in configuration %+v", err)
+				return fmt.Errorf("talking.Connect error: %w", err)
+			}
+			initialized = true
+		}
+	}
+}
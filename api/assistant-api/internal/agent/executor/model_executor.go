@@ -13,11 +13,14 @@ import (
 	"time"
 
 	internal_adapter_requests "github.com/rapidaai/api/assistant-api/internal/adapters"
+	internal_agent_history "github.com/rapidaai/api/assistant-api/internal/agent/history"
 	internal_agent_tool "github.com/rapidaai/api/assistant-api/internal/agent/tool"
 	internal_executors "github.com/rapidaai/api/assistant-api/internal/agent/tool"
 	internal_adapter_telemetry "github.com/rapidaai/api/assistant-api/internal/telemetry"
+	endpoint_client_builders "github.com/rapidaai/pkg/clients/endpoint/builders"
 	integration_client_builders "github.com/rapidaai/pkg/clients/integration/builders"
 	"github.com/rapidaai/pkg/commons"
+	token_tiktoken_calculators "github.com/rapidaai/pkg/tokens/calculators"
 	"github.com/rapidaai/pkg/types"
 	"github.com/rapidaai/pkg/utils"
 	"github.com/rapidaai/protos"
@@ -29,7 +32,7 @@ type modelAssistantExecutor struct {
 	toolExecutor       internal_executors.ToolExecutor
 	providerCredential *protos.VaultCredential
 	inputBuilder       integration_client_builders.InputChatBuilder
-	history            []*protos.Message
+	history            *internal_agent_history.History
 }
 
 func NewModelAssistantExecutor(
@@ -39,7 +42,8 @@ func NewModelAssistantExecutor(
 		logger:       logger,
 		inputBuilder: integration_client_builders.NewChatInputBuilder(logger),
 		toolExecutor: internal_agent_tool.NewToolExecutor(logger),
-		history:      make([]*protos.Message, 0),
+		history: internal_agent_history.NewHistory(logger,
+			&internal_agent_history.Config{Strategy: internal_agent_history.STRATEGY_FULL}, nil, nil),
 	}
 
 }
@@ -62,6 +66,7 @@ func (executor *modelAssistantExecutor) Initialize(ctx context.Context, communic
 			},
 		)
 	defer span.EndSpan(ctx, utils.AssistantAgentConnectStage)
+	executor.history = executor.newHistory(communication)
 	g, ctx := errgroup.WithContext(ctx)
 	var providerCredential *protos.VaultCredential
 	g.Go(func() error {
@@ -88,11 +93,11 @@ func (executor *modelAssistantExecutor) Initialize(ctx context.Context, communic
 	})
 
 	g.Go(func() error {
-		executor.history = append(executor.history, communication.GetConversationLogs()...)
+		executor.history.Append(communication.GetConversationLogs()...)
 		span.AddAttributes(
 			ctx,
 			internal_adapter_telemetry.KV{
-				K: "history_length", V: internal_adapter_telemetry.IntValue(len(executor.history)),
+				K: "history_length", V: internal_adapter_telemetry.IntValue(executor.history.Len()),
 			},
 		)
 		return nil
@@ -211,11 +216,17 @@ func (executor *modelAssistantExecutor) chat(
 
 func (executor *modelAssistantExecutor) llm(messageid string, communication internal_adapter_requests.Communication, in, out *types.Message, metrics []*types.Metric) error {
 	if in != nil {
-		executor.history = append(executor.history, in.ToProto())
+		executor.history.Append(in.ToProto())
 	}
 	if out != nil {
-		executor.history = append(executor.history, out.ToProto())
+		executor.history.Append(out.ToProto())
 	}
+	// older turns are summarized in background, the window keeps the history in limits meanwhile
+	utils.Go(context.Background(), func() {
+		if err := executor.history.Compact(context.Background()); err != nil {
+			executor.logger.Errorf("unable to summarize conversation history: %v", err)
+		}
+	})
 	// persist it to storage
 	utils.Go(context.Background(), func() {
 		communication.CreateConversationMessageLog(messageid, in, out, metrics)
@@ -233,11 +244,62 @@ func (executor *modelAssistantExecutor) Assistant(ctx context.Context, messageid
 func (executor *modelAssistantExecutor) User(ctx context.Context, messageid string, msg *types.Message, communication internal_adapter_requests.Communication) error {
 	ctx, span, _ := communication.Tracer().StartSpan(ctx, utils.AssistantAgentTextGenerationStage, internal_adapter_telemetry.MessageKV(messageid))
 	defer span.EndSpan(ctx, utils.AssistantAgentTextGenerationStage)
-	return executor.chat(ctx, messageid, communication, msg, executor.history...)
+	return executor.chat(ctx, messageid, communication, msg, executor.history.Messages()...)
 
 }
 
 func (executor *modelAssistantExecutor) Close(ctx context.Context, communication internal_adapter_requests.Communication) error {
-	executor.history = make([]*protos.Message, 0)
-	return nil
+	executor.history.Reset()
+	return executor.toolExecutor.Close(ctx)
+}
+
+// newHistory builds the history strategy configured on the assistant provider model,
+// misconfigured strategy falls back to full history so the conversation can go on.
+func (executor *modelAssistantExecutor) newHistory(communication internal_adapter_requests.Communication) *internal_agent_history.History {
+	options := communication.Assistant().AssistantProviderModel.GetOptions()
+	config, err := internal_agent_history.NewConfig(options)
+	if err != nil {
+		executor.logger.Errorf("invalid history strategy, sending full history: %v", err)
+		config = &internal_agent_history.Config{Strategy: internal_agent_history.STRATEGY_FULL}
+	}
+	model, _ := options.GetString("model.name")
+	return internal_agent_history.NewHistory(
+		executor.logger,
+		config,
+		token_tiktoken_calculators.NewTikTokenCounter(executor.logger, model),
+		func(ctx context.Context, summary string, messages []*protos.Message) (string, error) {
+			return executor.summarize(ctx, communication, config, summary, messages)
+		},
+	)
+}
+
+// summarize invokes the summary endpoint with the current summary and the turns to fold into it
+func (executor *modelAssistantExecutor) summarize(
+	ctx context.Context,
+	communication internal_adapter_requests.Communication,
+	config *internal_agent_history.Config,
+	summary string,
+	messages []*protos.Message,
+) (string, error) {
+	start := time.Now()
+	inputBuilder := endpoint_client_builders.NewInputInvokeBuilder(executor.logger)
+	ivk, err := communication.DeploymentCaller().Invoke(ctx, communication.Auth(), inputBuilder.Invoke(
+		&protos.EndpointDefinition{
+			EndpointId: config.EndpointId,
+			Version:    config.EndpointVersion,
+		},
+		inputBuilder.Arguments(map[string]interface{}{
+			"summary":  summary,
+			"messages": types.ToSimpleMessage(types.ToMessages(messages)),
+		}, nil),
+		nil, nil,
+	))
+	executor.logger.Benchmark("modelAssistantExecutor.summarize", time.Since(start))
+	if err != nil {
+		return "", err
+	}
+	if !ivk.GetSuccess() || len(ivk.GetData()) == 0 {
+		return "", fmt.Errorf("empty response from summary endpoint")
+	}
+	return string(ivk.GetData()[0].GetContent()), nil
 }
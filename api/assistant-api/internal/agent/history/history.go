@@ -0,0 +1,282 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_agent_history
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/tokens"
+	"github.com/rapidaai/pkg/types"
+	"github.com/rapidaai/pkg/utils"
+	"github.com/rapidaai/protos"
+)
+
+type Strategy string
+
+const (
+	// every message of the conversation is sent to the model
+	STRATEGY_FULL Strategy = "full"
+	// only the latest turns which fit in max turns and max tokens are sent
+	STRATEGY_WINDOW Strategy = "window"
+	// older turns are compressed into a summary by the summary endpoint
+	STRATEGY_SUMMARY Strategy = "summary"
+)
+
+// options of the assistant provider model
+const (
+	StrategyOption        = "history.strategy"
+	MaxTurnsOption        = "history.max_turns"
+	MaxTokensOption       = "history.max_tokens"
+	KeepTurnsOption       = "history.summary.keep_turns"
+	SummaryEndpointOption = "history.summary.endpoint_id"
+	SummaryVersionOption  = "history.summary.endpoint_version"
+)
+
+const defaultKeepTurns = 4
+
+type Config struct {
+	Strategy Strategy
+	// 0 is unlimited
+	MaxTurns  int
+	MaxTokens int
+	// turns which are kept as they are when the older ones are summarized
+	KeepTurns       int
+	EndpointId      uint64
+	EndpointVersion string
+}
+
+// NewConfig reads the history strategy from the options of the assistant provider model
+func NewConfig(opts utils.Option) (*Config, error) {
+	config := &Config{Strategy: STRATEGY_FULL, KeepTurns: defaultKeepTurns, EndpointVersion: "latest"}
+	if strategy, err := opts.GetString(StrategyOption); err == nil && strategy != "" {
+		config.Strategy = Strategy(strategy)
+	}
+	if maxTurns, err := opts.GetUint64(MaxTurnsOption); err == nil {
+		config.MaxTurns = int(maxTurns)
+	}
+	if maxTokens, err := opts.GetUint64(MaxTokensOption); err == nil {
+		config.MaxTokens = int(maxTokens)
+	}
+	if keepTurns, err := opts.GetUint64(KeepTurnsOption); err == nil && keepTurns > 0 {
+		config.KeepTurns = int(keepTurns)
+	}
+	if version, err := opts.GetString(SummaryVersionOption); err == nil && version != "" {
+		config.EndpointVersion = version
+	}
+	switch config.Strategy {
+	case STRATEGY_FULL:
+	case STRATEGY_WINDOW:
+		if config.MaxTurns == 0 && config.MaxTokens == 0 {
+			return nil, fmt.Errorf("%s or %s is required for window history", MaxTurnsOption, MaxTokensOption)
+		}
+	case STRATEGY_SUMMARY:
+		if config.MaxTurns == 0 && config.MaxTokens == 0 {
+			return nil, fmt.Errorf("%s or %s is required for summary history", MaxTurnsOption, MaxTokensOption)
+		}
+		endpointId, err := opts.GetUint64(SummaryEndpointOption)
+		if err != nil || endpointId == 0 {
+			return nil, fmt.Errorf("%s is required for summary history", SummaryEndpointOption)
+		}
+		config.EndpointId = endpointId
+	default:
+		return nil, fmt.Errorf("unsupported history strategy %s", config.Strategy)
+	}
+	return config, nil
+}
+
+// Summarizer folds the messages into the summary of the conversation so far
+type Summarizer func(ctx context.Context, summary string, messages []*protos.Message) (string, error)
+
+// History of the conversation which is sent to the model with every turn.
+// System messages are always kept, the rest is grouped into turns which start with a user message
+// so the tool calls of a turn and their results are never separated.
+type History struct {
+	logger     commons.Logger
+	config     *Config
+	counter    tokens.TokenCounter
+	summarizer Summarizer
+
+	mu          sync.Mutex
+	system      []*protos.Message
+	messages    []*protos.Message
+	summary     string
+	summarizing bool
+	// bumped on reset so a summary of the cleared history is discarded
+	generation uint64
+}
+
+func NewHistory(logger commons.Logger, config *Config, counter tokens.TokenCounter, summarizer Summarizer) *History {
+	return &History{
+		logger:     logger,
+		config:     config,
+		counter:    counter,
+		summarizer: summarizer,
+	}
+}
+
+// Append messages to the history
+func (h *History) Append(messages ...*protos.Message) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for _, msg := range messages {
+		if msg == nil {
+			continue
+		}
+		if msg.GetRole() == "system" {
+			h.system = append(h.system, msg)
+			continue
+		}
+		h.messages = append(h.messages, msg)
+	}
+}
+
+// Messages to send to the model for the next turn
+func (h *History) Messages() []*protos.Message {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	out := make([]*protos.Message, 0, len(h.system)+len(h.messages)+1)
+	out = append(out, h.system...)
+	if h.config.Strategy == STRATEGY_FULL {
+		return append(out, h.messages...)
+	}
+	if h.summary != "" {
+		out = append(out, h.summaryMessage())
+	}
+	for _, turn := range h.window(Turns(h.messages)) {
+		out = append(out, turn...)
+	}
+	return out
+}
+
+// Len is the number of messages in the history including the ones which are summarized away
+func (h *History) Len() int {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return len(h.system) + len(h.messages)
+}
+
+// Summary of the older turns of the conversation
+func (h *History) Summary() string {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.summary
+}
+
+// Reset clears the history
+func (h *History) Reset() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.system = nil
+	h.messages = nil
+	h.summary = ""
+	h.generation++
+}
+
+// Compact summarizes the older turns once the history is over the limits, the latest keep turns stay as they are.
+// The window keeps the history in limits while the summary is generated, so it is safe to call it in background.
+func (h *History) Compact(ctx context.Context) error {
+	h.mu.Lock()
+	if h.config.Strategy != STRATEGY_SUMMARY || h.summarizing || h.summarizer == nil {
+		h.mu.Unlock()
+		return nil
+	}
+	turns := Turns(h.messages)
+	if len(h.window(turns)) == len(turns) || len(turns) <= h.config.KeepTurns {
+		h.mu.Unlock()
+		return nil
+	}
+	older := make([]*protos.Message, 0)
+	for _, turn := range turns[:len(turns)-h.config.KeepTurns] {
+		older = append(older, turn...)
+	}
+	summary, generation := h.summary, h.generation
+	h.summarizing = true
+	h.mu.Unlock()
+
+	next, err := h.summarizer(ctx, summary, older)
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.summarizing = false
+	if err != nil {
+		return err
+	}
+	if strings.TrimSpace(next) == "" {
+		return fmt.Errorf("summary endpoint returned empty summary")
+	}
+	// messages are only appended, so the summarized ones are still at the beginning unless the
+	// history is reset meanwhile, a summary of messages which are gone would bring them back
+	if h.generation != generation || !hasPrefix(h.messages, older) {
+		return nil
+	}
+	h.messages = h.messages[len(older):]
+	h.summary = strings.TrimSpace(next)
+	return nil
+}
+
+func hasPrefix(messages, prefix []*protos.Message) bool {
+	if len(messages) < len(prefix) {
+		return false
+	}
+	for i, msg := range prefix {
+		if messages[i] != msg {
+			return false
+		}
+	}
+	return true
+}
+
+func (h *History) summaryMessage() *protos.Message {
+	return (&types.Message{
+		Role: "system",
+		Contents: []*types.Content{{
+			ContentType:   commons.TEXT_CONTENT.String(),
+			ContentFormat: commons.TEXT_CONTENT_FORMAT_RAW.String(),
+			Content:       []byte("Summary of the conversation so far:\n" + h.summary),
+		}},
+	}).ToProto()
+}
+
+// window returns the latest turns which fit in max turns and max tokens, the latest turn is always kept
+func (h *History) window(turns [][]*protos.Message) [][]*protos.Message {
+	start, used := len(turns), 0
+	if h.summary != "" && h.counter != nil {
+		used = h.counter.Count(types.ToMessage(h.summaryMessage()))
+	}
+	for start > 0 {
+		turn := turns[start-1]
+		if h.config.MaxTurns > 0 && len(turns)-start >= h.config.MaxTurns {
+			break
+		}
+		if h.config.MaxTokens > 0 && h.counter != nil {
+			size := h.counter.Count(types.ToMessages(turn)...)
+			if start < len(turns) && used+size > h.config.MaxTokens {
+				break
+			}
+			used += size
+		}
+		start--
+	}
+	return turns[start:]
+}
+
+// Turns groups the messages into turns, a turn starts with a user message and has the assistant replies,
+// tool calls and tool results which follow it. Messages before the first user message make their own turn.
+func Turns(messages []*protos.Message) [][]*protos.Message {
+	turns := make([][]*protos.Message, 0)
+	for _, msg := range messages {
+		if len(turns) == 0 || msg.GetRole() == "user" {
+			turns = append(turns, []*protos.Message{msg})
+			continue
+		}
+		turns[len(turns)-1] = append(turns[len(turns)-1], msg)
+	}
+	return turns
+}
@@ -0,0 +1,162 @@
+package internal_agent_history
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/types"
+	"github.com/rapidaai/pkg/utils"
+	"github.com/rapidaai/protos"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// every message counts as ten tokens
+type fixedCounter struct{}
+
+func (fixedCounter) Count(messages ...*types.Message) int { return 10 * len(messages) }
+
+func message(role, content string) *protos.Message {
+	return (&types.Message{Role: role, Contents: []*types.Content{{
+		ContentType:   commons.TEXT_CONTENT.String(),
+		ContentFormat: commons.TEXT_CONTENT_FORMAT_RAW.String(),
+		Content:       []byte(content),
+	}}}).ToProto()
+}
+
+func contents(messages []*protos.Message) []string {
+	out := make([]string, 0, len(messages))
+	for _, msg := range messages {
+		out = append(out, fmt.Sprintf("%s:%s", msg.GetRole(), types.OnlyStringContent(types.ToMessage(msg).GetContents())))
+	}
+	return out
+}
+
+// conversation with a greeting, three turns and a tool call in the second turn
+func conversation() []*protos.Message {
+	return []*protos.Message{
+		message("system", "prompt"),
+		message("assistant", "hello"),
+		message("user", "u1"),
+		message("assistant", "a1"),
+		message("user", "u2"),
+		message("assistant", "call"),
+		message("tool", "result"),
+		message("assistant", "a2"),
+		message("user", "u3"),
+		message("assistant", "a3"),
+	}
+}
+
+func TestNewConfig(t *testing.T) {
+	config, err := NewConfig(utils.Option{})
+	require.NoError(t, err)
+	assert.Equal(t, STRATEGY_FULL, config.Strategy)
+
+	config, err = NewConfig(utils.Option{StrategyOption: "summary", MaxTurnsOption: "6", SummaryEndpointOption: "42"})
+	require.NoError(t, err)
+	assert.Equal(t, 6, config.MaxTurns)
+	assert.Equal(t, uint64(42), config.EndpointId)
+	assert.Equal(t, defaultKeepTurns, config.KeepTurns)
+	assert.Equal(t, "latest", config.EndpointVersion)
+
+	for _, opts := range []utils.Option{
+		{StrategyOption: "window"},
+		{StrategyOption: "summary", MaxTokensOption: "2000"},
+		{StrategyOption: "truncate", MaxTurnsOption: "2"},
+	} {
+		_, err := NewConfig(opts)
+		assert.Error(t, err, opts)
+	}
+}
+
+func TestTurns(t *testing.T) {
+	turns := Turns(conversation()[1:])
+	require.Len(t, turns, 4)
+	assert.Equal(t, []string{"user:u2", "assistant:call", "tool:result", "assistant:a2"}, contents(turns[2]))
+}
+
+func TestFullHistory(t *testing.T) {
+	history := NewHistory(nil, &Config{Strategy: STRATEGY_FULL}, fixedCounter{}, nil)
+	history.Append(conversation()...)
+	assert.Len(t, history.Messages(), 10)
+}
+
+func TestWindowByTurns(t *testing.T) {
+	history := NewHistory(nil, &Config{Strategy: STRATEGY_WINDOW, MaxTurns: 2}, fixedCounter{}, nil)
+	history.Append(conversation()...)
+	assert.Equal(t, []string{
+		"system:prompt",
+		"user:u2", "assistant:call", "tool:result", "assistant:a2",
+		"user:u3", "assistant:a3",
+	}, contents(history.Messages()))
+}
+
+func TestWindowByTokens(t *testing.T) {
+	// the tool call turn takes 40 tokens and does not fit next to the latest turn
+	history := NewHistory(nil, &Config{Strategy: STRATEGY_WINDOW, MaxTokens: 50}, fixedCounter{}, nil)
+	history.Append(conversation()...)
+	assert.Equal(t, []string{"system:prompt", "user:u3", "assistant:a3"}, contents(history.Messages()))
+
+	// the latest turn is kept even when it is over the limit
+	history = NewHistory(nil, &Config{Strategy: STRATEGY_WINDOW, MaxTokens: 5}, fixedCounter{}, nil)
+	history.Append(conversation()...)
+	assert.Equal(t, []string{"system:prompt", "user:u3", "assistant:a3"}, contents(history.Messages()))
+}
+
+func TestSummary(t *testing.T) {
+	var folded []string
+	summarizer := func(ctx context.Context, summary string, messages []*protos.Message) (string, error) {
+		folded = contents(messages)
+		return summary + "greeted and answered u1", nil
+	}
+	history := NewHistory(nil, &Config{Strategy: STRATEGY_SUMMARY, MaxTurns: 3, KeepTurns: 2}, fixedCounter{}, summarizer)
+	history.Append(conversation()...)
+
+	require.NoError(t, history.Compact(context.Background()))
+	assert.Equal(t, []string{"assistant:hello", "user:u1", "assistant:a1"}, folded)
+	assert.Equal(t, "greeted and answered u1", history.Summary())
+	assert.Equal(t, []string{
+		"system:prompt",
+		"system:Summary of the conversation so far:\ngreeted and answered u1",
+		"user:u2", "assistant:call", "tool:result", "assistant:a2",
+		"user:u3", "assistant:a3",
+	}, contents(history.Messages()))
+
+	// within limits nothing is summarized
+	folded = nil
+	require.NoError(t, history.Compact(context.Background()))
+	assert.Nil(t, folded)
+}
+
+func TestSummaryFailureKeepsHistory(t *testing.T) {
+	summarizer := func(ctx context.Context, summary string, messages []*protos.Message) (string, error) {
+		return "", errors.New("endpoint unavailable")
+	}
+	history := NewHistory(nil, &Config{Strategy: STRATEGY_SUMMARY, MaxTurns: 2, KeepTurns: 1}, fixedCounter{}, summarizer)
+	history.Append(conversation()...)
+	assert.Error(t, history.Compact(context.Background()))
+	assert.Equal(t, "", history.Summary())
+	assert.Equal(t, 10, history.Len())
+	// window still applies
+	assert.Len(t, history.Messages(), 7)
+}
+
+func TestSummaryDiscardedAfterReset(t *testing.T) {
+	var history *History
+	summarizer := func(ctx context.Context, summary string, messages []*protos.Message) (string, error) {
+		// conversation is restarted while the summary is generated
+		history.Reset()
+		history.Append(message("system", "prompt"), message("user", "new"), message("assistant", "reply"))
+		return "greeted and answered u1", nil
+	}
+	history = NewHistory(nil, &Config{Strategy: STRATEGY_SUMMARY, MaxTurns: 3, KeepTurns: 2}, fixedCounter{}, summarizer)
+	history.Append(conversation()...)
+
+	require.NoError(t, history.Compact(context.Background()))
+	assert.Equal(t, "", history.Summary())
+	assert.Equal(t, []string{"system:prompt", "user:new", "assistant:reply"}, contents(history.Messages()))
+}
@@ -73,4 +73,7 @@ type ToolExecutor interface {
 		messageid string,
 		calls []*protos.ToolCall,
 		communication internal_adapter_requests.Communication) []*types.Content
+
+	// release the connections held by tools eg: mcp sessions
+	Close(ctx context.Context) error
 }
@@ -0,0 +1,120 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_agent_local_tool
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	internal_adapter_requests "github.com/rapidaai/api/assistant-api/internal/adapters"
+	internal_assistant_entity "github.com/rapidaai/api/assistant-api/internal/entity/assistants"
+	internal_telephony "github.com/rapidaai/api/assistant-api/internal/telephony"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/types"
+)
+
+// transferCallToolCaller transfers the phone call to one of the configured destinations,
+// the llm can only pick from tool.transfer_to so the call can not be sent to arbitrary numbers.
+//
+//	tool.transfer_to   = +14155550100,sip:support@example.com
+//	tool.transfer_mode = warm
+type transferCallToolCaller struct {
+	toolCaller
+	destinations []string
+	mode         internal_telephony.TransferMode
+}
+
+func (tc *transferCallToolCaller) argument(args string) (*internal_telephony.Transfer, error) {
+	transfer := &internal_telephony.Transfer{Mode: tc.mode}
+	var input map[string]interface{}
+	if err := json.Unmarshal([]byte(args), &input); err != nil {
+		tc.logger.Debugf("illegal input from llm for transfer %v", args)
+		return nil, fmt.Errorf("invalid arguments for transfer, destination is required")
+	}
+	if v, ok := input["destination"].(string); ok && v != "" {
+		if !slices.Contains(tc.destinations, v) {
+			return nil, fmt.Errorf("destination %s is not allowed for transfer", v)
+		}
+		transfer.Destination = v
+	}
+	if transfer.Destination == "" {
+		if len(tc.destinations) > 1 {
+			return nil, fmt.Errorf("destination is required, one of %s", strings.Join(tc.destinations, ", "))
+		}
+		transfer.Destination = tc.destinations[0]
+	}
+	if v, ok := input["mode"].(string); ok && v != "" {
+		transfer.Mode = internal_telephony.TransferMode(strings.ToLower(v))
+	}
+	if v, ok := input["summary"].(string); ok {
+		transfer.Summary = v
+	}
+	if !transfer.Mode.IsValid() {
+		return nil, fmt.Errorf("transfer mode %s is not supported", transfer.Mode)
+	}
+	if transfer.IsWarm() && strings.TrimSpace(transfer.Summary) == "" {
+		return nil, fmt.Errorf("summary is required for warm transfer")
+	}
+	return transfer, nil
+}
+
+func (tc *transferCallToolCaller) Call(
+	ctx context.Context,
+	messageId string,
+	args string,
+	communication internal_adapter_requests.Communication,
+) (map[string]interface{}, []*types.Metric) {
+	start := time.Now()
+	metrics := make([]*types.Metric, 0)
+	transfer, err := tc.argument(args)
+	if err != nil {
+		metrics = append(metrics, types.NewTimeTakenMetric(time.Since(start)))
+		return tc.Result(err.Error(), false), metrics
+	}
+	err = communication.TransferCall(ctx, transfer)
+	metrics = append(metrics, types.NewTimeTakenMetric(time.Since(start)))
+	if err != nil {
+		tc.logger.Errorf("unable to transfer call with error %v", err)
+		return tc.Result("Unable to transfer the call. Please try again later.", false), metrics
+	}
+	return tc.Result("Call transferred successfully.", true), metrics
+}
+
+func NewTransferCallToolCaller(
+	logger commons.Logger,
+	toolOptions *internal_assistant_entity.AssistantTool,
+	communication internal_adapter_requests.Communication,
+) (ToolCaller, error) {
+	// the tool is left out so the llm never offers a transfer which can not happen
+	if !communication.CanTransferCall() {
+		return nil, fmt.Errorf("transfer_call is not supported by the telephony of conversation")
+	}
+	opts := toolOptions.GetOptions()
+	destinations, err := internal_telephony.TransferDestinations(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	mode := internal_telephony.COLD_TRANSFER
+	if v, err := opts.GetString("tool.transfer_mode"); err == nil && v != "" {
+		mode = internal_telephony.TransferMode(strings.ToLower(v))
+	}
+	if !mode.IsValid() {
+		return nil, fmt.Errorf("tool.transfer_mode %s is not supported", mode)
+	}
+	return &transferCallToolCaller{
+		toolCaller: toolCaller{
+			logger:      logger,
+			toolOptions: toolOptions,
+		},
+		destinations: destinations,
+		mode:         mode,
+	}, nil
+}
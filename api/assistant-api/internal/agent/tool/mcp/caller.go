@@ -5,9 +5,24 @@
 // See LICENSE.md or contact sales@rapida.ai for commercial usage.
 package internal_agent_mcp_tool
 
-import internal_agent_local_tool "github.com/rapidaai/api/assistant-api/internal/agent/tool/local"
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
 
-// Just a placeholder for MCP specific tool caller interface
+	internal_adapter_requests "github.com/rapidaai/api/assistant-api/internal/adapters"
+	internal_agent_local_tool "github.com/rapidaai/api/assistant-api/internal/agent/tool/local"
+	internal_assistant_entity "github.com/rapidaai/api/assistant-api/internal/entity/assistants"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/types"
+	"github.com/rapidaai/pkg/utils"
+	protos "github.com/rapidaai/protos"
+)
+
+// MCPCaller connects the mcp server of assistant tool and exposes the tools of server
 type MCPCaller interface {
 
 	// name
@@ -15,4 +30,296 @@ type MCPCaller interface {
 
 	// list of tool callers will be returned
 	Tools() ([]internal_agent_local_tool.ToolCaller, error)
+
+	// close the session with server
+	Close() error
+}
+
+// options of assistant tool with mcp execution method
+//
+//	mcp.transport  = streamable_http | sse | stdio (default streamable_http)
+//	mcp.server_url = https://mcp.internal/mcp
+//	mcp.headers    = {"Authorization": "Bearer token"}
+//	mcp.command    = /opt/mcp/bin/crm-server
+//	mcp.args       = ["--readonly"]
+//	mcp.env        = {"API_KEY": "secret"}
+//
+// the command of stdio transport must be allowed by MCP__STDIO_COMMANDS of the service, the
+// tenant chooses the arguments so only commands which are safe with any argument are allowed
+const (
+	TransportOption = "mcp.transport"
+	ServerUrlOption = "mcp.server_url"
+	HeadersOption   = "mcp.headers"
+	CommandOption   = "mcp.command"
+	ArgsOption      = "mcp.args"
+	EnvOption       = "mcp.env"
+)
+
+const (
+	TransportStreamableHttp = "streamable_http"
+	TransportSSE            = "sse"
+	TransportStdio          = "stdio"
+)
+
+type mcpCaller struct {
+	ctx         context.Context
+	logger      commons.Logger
+	toolOptions *internal_assistant_entity.AssistantTool
+	client      *Client
+}
+
+// NewMCPCaller connects the mcp server of the tool, stdioCommands are the commands the server
+// allows for the stdio transport as the options of the tool are configured by the tenant
+func NewMCPCaller(
+	ctx context.Context,
+	logger commons.Logger,
+	toolOptions *internal_assistant_entity.AssistantTool,
+	stdioCommands []string,
+) (MCPCaller, error) {
+	initCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+	client, err := newTransportClient(initCtx, logger, toolOptions.GetOptions(), stdioCommands)
+	if err != nil {
+		return nil, err
+	}
+	if err := client.Initialize(initCtx); err != nil {
+		client.Close()
+		return nil, fmt.Errorf("unable to initialize mcp server of %s: %w", toolOptions.Name, err)
+	}
+	return &mcpCaller{
+		ctx:         ctx,
+		logger:      logger,
+		toolOptions: toolOptions,
+		client:      client,
+	}, nil
+}
+
+// newTransportClient builds the client of the transport configured in options
+func newTransportClient(ctx context.Context, logger commons.Logger, opts utils.Option, stdioCommands []string) (*Client, error) {
+	transport := TransportStreamableHttp
+	if v, err := opts.GetString(TransportOption); err == nil && strings.TrimSpace(v) != "" {
+		transport = strings.TrimSpace(v)
+	}
+	switch transport {
+	case TransportStreamableHttp, TransportSSE:
+		serverUrl, err := opts.GetString(ServerUrlOption)
+		if err != nil || serverUrl == "" {
+			return nil, fmt.Errorf("%s is required for mcp tool", ServerUrlOption)
+		}
+		headers := map[string]string{}
+		if err := jsonOption(opts, HeadersOption, &headers); err != nil {
+			return nil, err
+		}
+		if transport == TransportSSE {
+			return NewSSEClient(ctx, logger, serverUrl, headers)
+		}
+		return NewStreamableHttpClient(logger, serverUrl, headers), nil
+	case TransportStdio:
+		command, err := opts.GetString(CommandOption)
+		if err != nil || strings.TrimSpace(command) == "" {
+			return nil, fmt.Errorf("%s is required for mcp tool with stdio transport", CommandOption)
+		}
+		if !slices.Contains(stdioCommands, command) {
+			return nil, fmt.Errorf("command %s is not allowed for mcp tool with stdio transport", command)
+		}
+		args := []string{}
+		if err := jsonOption(opts, ArgsOption, &args); err != nil {
+			return nil, err
+		}
+		variables := map[string]string{}
+		if err := jsonOption(opts, EnvOption, &variables); err != nil {
+			return nil, err
+		}
+		env := make([]string, 0, len(variables))
+		for k, v := range variables {
+			env = append(env, k+"="+v)
+		}
+		return NewStdioClient(logger, command, args, env)
+	default:
+		return nil, fmt.Errorf("unsupported %s %s", TransportOption, transport)
+	}
+}
+
+// jsonOption decodes the json option when it is set
+func jsonOption(opts utils.Option, key string, v interface{}) error {
+	value, err := opts.GetString(key)
+	if err != nil || strings.TrimSpace(value) == "" {
+		return nil
+	}
+	if err := json.Unmarshal([]byte(value), v); err != nil {
+		return fmt.Errorf("%s is not valid json: %v", key, err)
+	}
+	return nil
+}
+
+func (m *mcpCaller) Name() string {
+	return m.toolOptions.Name
+}
+
+func (m *mcpCaller) Tools() ([]internal_agent_local_tool.ToolCaller, error) {
+	ctx, cancel := context.WithTimeout(m.ctx, 30*time.Second)
+	defer cancel()
+	tools, err := m.client.ListTools(ctx)
+	if err != nil {
+		return nil, err
+	}
+	callers := make([]internal_agent_local_tool.ToolCaller, 0, len(tools))
+	for _, tool := range tools {
+		callers = append(callers, &mcpToolCaller{
+			logger:      m.logger,
+			toolOptions: m.toolOptions,
+			client:      m.client,
+			tool:        tool,
+		})
+	}
+	return callers, nil
+}
+
+func (m *mcpCaller) Close() error {
+	return m.client.Close()
+}
+
+// mcpToolCaller is a single tool of mcp server, arguments of llm are forwarded as it is
+type mcpToolCaller struct {
+	logger      commons.Logger
+	toolOptions *internal_assistant_entity.AssistantTool
+	client      *Client
+	tool        *Tool
+}
+
+func (tc *mcpToolCaller) Id() uint64 {
+	return tc.toolOptions.Id
+}
+
+func (tc *mcpToolCaller) Name() string {
+	return tc.tool.Name
+}
+
+func (tc *mcpToolCaller) ExecutionMethod() string {
+	return tc.toolOptions.ExecutionMethod
+}
+
+func (tc *mcpToolCaller) Definition() (*protos.FunctionDefinition, error) {
+	parameters := schemaParameter(tc.tool.InputSchema)
+	if parameters.Type == "" {
+		parameters.Type = "object"
+	}
+	return &protos.FunctionDefinition{
+		Name:        tc.tool.Name,
+		Description: tc.tool.Description,
+		Parameters:  parameters,
+	}, nil
+}
+
+// schemaParameter maps json schema of mcp tool to function parameter, function property
+// has no nested properties so the schema of object properties is kept in description
+func schemaParameter(schema map[string]interface{}) *protos.FunctionParameter {
+	parameter := &protos.FunctionParameter{
+		Type:       schemaType(schema["type"]),
+		Properties: map[string]*protos.FunctionParameterProperty{},
+	}
+	if required, ok := schema["required"].([]interface{}); ok {
+		for _, r := range required {
+			if name, ok := r.(string); ok {
+				parameter.Required = append(parameter.Required, name)
+			}
+		}
+	}
+	properties, _ := schema["properties"].(map[string]interface{})
+	for name, v := range properties {
+		property, _ := v.(map[string]interface{})
+		parameter.Properties[name] = schemaProperty(property)
+	}
+	return parameter
+}
+
+func schemaProperty(schema map[string]interface{}) *protos.FunctionParameterProperty {
+	property := &protos.FunctionParameterProperty{
+		Type: schemaType(schema["type"]),
+	}
+	property.Description, _ = schema["description"].(string)
+	if enum, ok := schema["enum"].([]interface{}); ok {
+		for _, e := range enum {
+			property.Enum = append(property.Enum, fmt.Sprintf("%v", e))
+		}
+	}
+	switch property.Type {
+	case "array":
+		if items, ok := schema["items"].(map[string]interface{}); ok {
+			property.Items = schemaParameter(items)
+		}
+	case "object":
+		if _, ok := schema["properties"]; ok {
+			if nested, err := json.Marshal(schema); err == nil {
+				property.Description = strings.TrimSpace(property.Description + " Schema: " + string(nested))
+			}
+		}
+	}
+	return property
+}
+
+// schemaType picks the type of schema, first non null type of union
+func schemaType(v interface{}) string {
+	switch t := v.(type) {
+	case string:
+		return t
+	case []interface{}:
+		for _, e := range t {
+			if s, ok := e.(string); ok && s != "null" {
+				return s
+			}
+		}
+	}
+	return ""
+}
+
+func (tc *mcpToolCaller) Call(
+	ctx context.Context,
+	messageId string,
+	args string,
+	communication internal_adapter_requests.Communication,
+) (map[string]interface{}, []*types.Metric) {
+	start := time.Now()
+	arguments := map[string]interface{}{}
+	if strings.TrimSpace(args) != "" {
+		if err := json.Unmarshal([]byte(args), &arguments); err != nil {
+			tc.logger.Debugf("illegal input from llm for mcp tool %s %v", tc.tool.Name, args)
+			return tc.result("Invalid arguments, arguments must be a json object.", false), []*types.Metric{types.NewTimeTakenMetric(time.Since(start))}
+		}
+	}
+
+	result, err := tc.client.CallTool(ctx, tc.tool.Name, arguments)
+	metrics := []*types.Metric{types.NewTimeTakenMetric(time.Since(start))}
+	if err != nil {
+		tc.logger.Errorf("error while calling mcp tool %s %v", tc.tool.Name, err)
+		return tc.result("Unable to call the tool. Please try again later.", false), metrics
+	}
+	texts := make([]string, 0, len(result.Content))
+	for _, c := range result.Content {
+		if c.Type == "text" {
+			texts = append(texts, c.Text)
+		}
+	}
+	if result.IsError {
+		return tc.result(strings.Join(texts, "\n"), false), metrics
+	}
+	if result.StructuredContent != nil {
+		return tc.result(result.StructuredContent, true), metrics
+	}
+	return tc.result(strings.Join(texts, "\n"), true), metrics
+}
+
+func (tc *mcpToolCaller) result(data interface{}, success bool) map[string]interface{} {
+	if success {
+		return map[string]interface{}{
+			"data":    data,
+			"success": true,
+			"status":  "SUCCESS",
+		}
+	}
+	return map[string]interface{}{
+		"error":   data,
+		"success": false,
+		"status":  "FAIL",
+	}
 }
@@ -0,0 +1,170 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_agent_mcp_tool
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync/atomic"
+
+	"github.com/rapidaai/pkg/commons"
+)
+
+const (
+	jsonrpcVersion  = "2.0"
+	protocolVersion = "2025-03-26"
+)
+
+var ErrTransportClosed = errors.New("mcp transport is closed")
+
+type jsonrpcRequest struct {
+	JSONRPC string      `json:"jsonrpc"`
+	Id      *int64      `json:"id,omitempty"`
+	Method  string      `json:"method"`
+	Params  interface{} `json:"params,omitempty"`
+}
+
+type jsonrpcError struct {
+	Code    int             `json:"code"`
+	Message string          `json:"message"`
+	Data    json.RawMessage `json:"data,omitempty"`
+}
+
+func (e *jsonrpcError) Error() string {
+	return fmt.Sprintf("mcp error %d: %s", e.Code, e.Message)
+}
+
+// jsonrpcMessage is anything received from server, method is set for requests and
+// notifications of server which are not answer of the client requests.
+type jsonrpcMessage struct {
+	JSONRPC string          `json:"jsonrpc"`
+	Id      *int64          `json:"id,omitempty"`
+	Method  string          `json:"method,omitempty"`
+	Result  json.RawMessage `json:"result,omitempty"`
+	Error   *jsonrpcError   `json:"error,omitempty"`
+}
+
+func (m *jsonrpcMessage) isResponse() bool {
+	return m.Id != nil && m.Method == ""
+}
+
+// transport carries json-rpc messages between client and server
+type transport interface {
+	// sends the request and waits for the response with same id
+	Call(ctx context.Context, req *jsonrpcRequest) (*jsonrpcMessage, error)
+
+	// sends the notification, no response is expected
+	Notify(ctx context.Context, req *jsonrpcRequest) error
+
+	Close() error
+}
+
+type Tool struct {
+	Name        string                 `json:"name"`
+	Description string                 `json:"description,omitempty"`
+	InputSchema map[string]interface{} `json:"inputSchema"`
+}
+
+type Content struct {
+	Type     string `json:"type"`
+	Text     string `json:"text,omitempty"`
+	Data     string `json:"data,omitempty"`
+	MimeType string `json:"mimeType,omitempty"`
+}
+
+type CallToolResult struct {
+	Content           []*Content             `json:"content"`
+	StructuredContent map[string]interface{} `json:"structuredContent,omitempty"`
+	IsError           bool                   `json:"isError,omitempty"`
+}
+
+type ServerInfo struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+// Client speaks model context protocol with a server over the given transport
+type Client struct {
+	logger     commons.Logger
+	transport  transport
+	nextId     atomic.Int64
+	ServerInfo ServerInfo
+}
+
+func newClient(logger commons.Logger, transport transport) *Client {
+	return &Client{logger: logger, transport: transport}
+}
+
+func (c *Client) call(ctx context.Context, method string, params interface{}, result interface{}) error {
+	id := c.nextId.Add(1)
+	msg, err := c.transport.Call(ctx, &jsonrpcRequest{JSONRPC: jsonrpcVersion, Id: &id, Method: method, Params: params})
+	if err != nil {
+		return err
+	}
+	if msg.Error != nil {
+		return msg.Error
+	}
+	if result == nil {
+		return nil
+	}
+	return json.Unmarshal(msg.Result, result)
+}
+
+// Initialize negotiates the protocol with server, must be called before any other request
+func (c *Client) Initialize(ctx context.Context) error {
+	var result struct {
+		ProtocolVersion string     `json:"protocolVersion"`
+		ServerInfo      ServerInfo `json:"serverInfo"`
+	}
+	if err := c.call(ctx, "initialize", map[string]interface{}{
+		"protocolVersion": protocolVersion,
+		"capabilities":    map[string]interface{}{},
+		"clientInfo":      map[string]interface{}{"name": "rapida", "version": "1.0.0"},
+	}, &result); err != nil {
+		return err
+	}
+	c.ServerInfo = result.ServerInfo
+	c.logger.Debugf("mcp server %s %s initialized with protocol %s", result.ServerInfo.Name, result.ServerInfo.Version, result.ProtocolVersion)
+	return c.transport.Notify(ctx, &jsonrpcRequest{JSONRPC: jsonrpcVersion, Method: "notifications/initialized"})
+}
+
+// ListTools returns all the tools of server following the pagination cursor
+func (c *Client) ListTools(ctx context.Context) ([]*Tool, error) {
+	tools := make([]*Tool, 0)
+	cursor := ""
+	for {
+		var params map[string]interface{}
+		if cursor != "" {
+			params = map[string]interface{}{"cursor": cursor}
+		}
+		var result struct {
+			Tools      []*Tool `json:"tools"`
+			NextCursor string  `json:"nextCursor,omitempty"`
+		}
+		if err := c.call(ctx, "tools/list", params, &result); err != nil {
+			return nil, err
+		}
+		tools = append(tools, result.Tools...)
+		if result.NextCursor == "" {
+			return tools, nil
+		}
+		cursor = result.NextCursor
+	}
+}
+
+func (c *Client) CallTool(ctx context.Context, name string, arguments map[string]interface{}) (*CallToolResult, error) {
+	result := &CallToolResult{}
+	if err := c.call(ctx, "tools/call", map[string]interface{}{"name": name, "arguments": arguments}, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (c *Client) Close() error {
+	return c.transport.Close()
+}
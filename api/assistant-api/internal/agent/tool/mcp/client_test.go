@@ -0,0 +1,333 @@
+package internal_agent_mcp_tool
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	internal_assistant_entity "github.com/rapidaai/api/assistant-api/internal/entity/assistants"
+	"github.com/rapidaai/pkg/commons"
+	gorm_model "github.com/rapidaai/pkg/models/gorm"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testLogger() commons.Logger {
+	logger := commons.NewApplicationLoggerWithOptions(commons.EnableFile(false), commons.Level("error"))
+	logger.InitLogger()
+	return logger
+}
+
+// handle answers the request of client as a tiny mcp server with a single echo tool
+func handle(req *jsonrpcMessage, params json.RawMessage) interface{} {
+	switch req.Method {
+	case "initialize":
+		return map[string]interface{}{
+			"protocolVersion": protocolVersion,
+			"capabilities":    map[string]interface{}{"tools": map[string]interface{}{}},
+			"serverInfo":      map[string]interface{}{"name": "echo", "version": "0.1.0"},
+		}
+	case "tools/list":
+		var p struct {
+			Cursor string `json:"cursor"`
+		}
+		json.Unmarshal(params, &p)
+		if p.Cursor == "" {
+			return map[string]interface{}{
+				"tools": []interface{}{map[string]interface{}{
+					"name":        "echo",
+					"description": "Echo the text",
+					"inputSchema": map[string]interface{}{
+						"type":       "object",
+						"properties": map[string]interface{}{"text": map[string]interface{}{"type": "string", "description": "text to echo"}},
+						"required":   []string{"text"},
+					},
+				}},
+				"nextCursor": "2",
+			}
+		}
+		return map[string]interface{}{"tools": []interface{}{map[string]interface{}{"name": "fail", "inputSchema": map[string]interface{}{"type": "object"}}}}
+	case "tools/call":
+		var p struct {
+			Name      string                 `json:"name"`
+			Arguments map[string]interface{} `json:"arguments"`
+		}
+		json.Unmarshal(params, &p)
+		return map[string]interface{}{
+			"content": []interface{}{map[string]interface{}{"type": "text", "text": fmt.Sprintf("%v", p.Arguments["text"])}},
+			"isError": p.Name == "fail",
+		}
+	}
+	return nil
+}
+
+type serverRequest struct {
+	jsonrpcMessage
+	Params json.RawMessage `json:"params"`
+}
+
+func TestStreamableHttpClient(t *testing.T) {
+	for _, stream := range []bool{false, true} {
+		t.Run(fmt.Sprintf("stream=%v", stream), func(t *testing.T) {
+			var deleted bool
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+				if r.Method == http.MethodDelete {
+					deleted = r.Header.Get(sessionHeader) == "session-1"
+					return
+				}
+				req := &serverRequest{}
+				require.NoError(t, json.NewDecoder(r.Body).Decode(req))
+				if req.Method != "initialize" {
+					assert.Equal(t, "session-1", r.Header.Get(sessionHeader))
+				}
+				w.Header().Set(sessionHeader, "session-1")
+				if req.Id == nil {
+					w.WriteHeader(http.StatusAccepted)
+					return
+				}
+				resp, _ := json.Marshal(map[string]interface{}{"jsonrpc": jsonrpcVersion, "id": *req.Id, "result": handle(&req.jsonrpcMessage, req.Params)})
+				if !stream {
+					w.Header().Set("Content-Type", "application/json")
+					w.Write(resp)
+					return
+				}
+				w.Header().Set("Content-Type", "text/event-stream")
+				fmt.Fprintf(w, "event: message\ndata: {\"jsonrpc\":\"2.0\",\"method\":\"notifications/message\",\"params\":{}}\n\n")
+				fmt.Fprintf(w, "event: message\ndata: %s\n\n", resp)
+			}))
+			defer server.Close()
+
+			client := NewStreamableHttpClient(testLogger(), server.URL, map[string]string{"Authorization": "Bearer token"})
+			require.NoError(t, client.Initialize(context.Background()))
+			assert.Equal(t, "echo", client.ServerInfo.Name)
+
+			tools, err := client.ListTools(context.Background())
+			require.NoError(t, err)
+			require.Len(t, tools, 2)
+			assert.Equal(t, "echo", tools[0].Name)
+
+			result, err := client.CallTool(context.Background(), "echo", map[string]interface{}{"text": "hello"})
+			require.NoError(t, err)
+			assert.False(t, result.IsError)
+			assert.Equal(t, "hello", result.Content[0].Text)
+
+			require.NoError(t, client.Close())
+			assert.True(t, deleted)
+		})
+	}
+}
+
+func TestStdioClient(t *testing.T) {
+	client, err := NewStdioClient(testLogger(), os.Args[0], []string{"-test.run=TestStdioServerProcess"}, []string{"MCP_STDIO_SERVER=1"})
+	require.NoError(t, err)
+	defer client.Close()
+
+	require.NoError(t, client.Initialize(context.Background()))
+	tools, err := client.ListTools(context.Background())
+	require.NoError(t, err)
+	require.Len(t, tools, 2)
+
+	result, err := client.CallTool(context.Background(), "echo", map[string]interface{}{"text": "over stdio"})
+	require.NoError(t, err)
+	assert.Equal(t, "over stdio", result.Content[0].Text)
+}
+
+// TestStdioServerProcess is not a real test, it is the server process started by TestStdioClient
+func TestStdioServerProcess(t *testing.T) {
+	if os.Getenv("MCP_STDIO_SERVER") != "1" {
+		t.Skip("runs only as mcp server of TestStdioClient")
+	}
+	scanner := bufio.NewScanner(os.Stdin)
+	for scanner.Scan() {
+		req := &serverRequest{}
+		if err := json.Unmarshal(scanner.Bytes(), req); err != nil || req.Id == nil {
+			continue
+		}
+		resp, _ := json.Marshal(map[string]interface{}{"jsonrpc": jsonrpcVersion, "id": *req.Id, "result": handle(&req.jsonrpcMessage, req.Params)})
+		fmt.Fprintf(os.Stdout, "%s\n", resp)
+	}
+	os.Exit(0)
+}
+
+func TestMCPToolCaller(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		req := &serverRequest{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(req))
+		if req.Id == nil {
+			w.WriteHeader(http.StatusAccepted)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{"jsonrpc": jsonrpcVersion, "id": *req.Id, "result": handle(&req.jsonrpcMessage, req.Params)})
+	}))
+	defer server.Close()
+
+	tool := &internal_assistant_entity.AssistantTool{
+		Name:            "internal",
+		ExecutionMethod: "mcp",
+		ExecutionOptions: []*internal_assistant_entity.AssistantToolOption{
+			{Metadata: gorm_model.Metadata{Key: ServerUrlOption, Value: server.URL}},
+		},
+	}
+	caller, err := NewMCPCaller(context.Background(), testLogger(), tool, nil)
+	require.NoError(t, err)
+	callers, err := caller.Tools()
+	require.NoError(t, err)
+	require.Len(t, callers, 2)
+
+	definition, err := callers[0].Definition()
+	require.NoError(t, err)
+	assert.Equal(t, "echo", definition.Name)
+	assert.Equal(t, []string{"text"}, definition.Parameters.Required)
+	assert.Equal(t, "string", definition.Parameters.Properties["text"].Type)
+
+	out, _ := callers[0].Call(context.Background(), "msg-1", `{"text":"hi"}`, nil)
+	assert.Equal(t, map[string]interface{}{"data": "hi", "success": true, "status": "SUCCESS"}, out)
+	out, _ = callers[1].Call(context.Background(), "msg-1", `{"text":"boom"}`, nil)
+	assert.Equal(t, false, out["success"])
+	assert.Equal(t, "boom", out["error"])
+	out, _ = callers[0].Call(context.Background(), "msg-1", `not json`, nil)
+	assert.Equal(t, false, out["success"])
+}
+
+func TestSSEClient(t *testing.T) {
+	events := make(chan []byte, 8)
+	var closed = make(chan struct{})
+	mux := http.NewServeMux()
+	mux.HandleFunc("/sse", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+		w.Header().Set("Content-Type", "text/event-stream")
+		fmt.Fprintf(w, "event: endpoint\ndata: /messages?session=1\n\n")
+		w.(http.Flusher).Flush()
+		for {
+			select {
+			case e := <-events:
+				fmt.Fprintf(w, "event: message\ndata: %s\n\n", e)
+				w.(http.Flusher).Flush()
+			case <-r.Context().Done():
+				close(closed)
+				return
+			}
+		}
+	})
+	mux.HandleFunc("/messages", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "1", r.URL.Query().Get("session"))
+		req := &serverRequest{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(req))
+		w.WriteHeader(http.StatusAccepted)
+		if req.Id == nil {
+			return
+		}
+		resp, _ := json.Marshal(map[string]interface{}{"jsonrpc": jsonrpcVersion, "id": *req.Id, "result": handle(&req.jsonrpcMessage, req.Params)})
+		events <- []byte(`{"jsonrpc":"2.0","method":"notifications/message","params":{}}`)
+		events <- resp
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client, err := NewSSEClient(context.Background(), testLogger(), server.URL+"/sse", map[string]string{"Authorization": "Bearer token"})
+	require.NoError(t, err)
+	require.NoError(t, client.Initialize(context.Background()))
+	assert.Equal(t, "echo", client.ServerInfo.Name)
+
+	tools, err := client.ListTools(context.Background())
+	require.NoError(t, err)
+	require.Len(t, tools, 2)
+
+	result, err := client.CallTool(context.Background(), "echo", map[string]interface{}{"text": "over sse"})
+	require.NoError(t, err)
+	assert.Equal(t, "over sse", result.Content[0].Text)
+
+	require.NoError(t, client.Close())
+	<-closed
+	_, err = client.CallTool(context.Background(), "echo", map[string]interface{}{"text": "closed"})
+	assert.Error(t, err)
+}
+
+func TestMCPCallerTransport(t *testing.T) {
+	option := func(key, value string) *internal_assistant_entity.AssistantToolOption {
+		return &internal_assistant_entity.AssistantToolOption{Metadata: gorm_model.Metadata{Key: key, Value: value}}
+	}
+	t.Run("stdio", func(t *testing.T) {
+		args, _ := json.Marshal([]string{"-test.run=TestStdioServerProcess"})
+		tool := &internal_assistant_entity.AssistantTool{
+			Name: "local",
+			ExecutionOptions: []*internal_assistant_entity.AssistantToolOption{
+				option(TransportOption, TransportStdio),
+				option(CommandOption, os.Args[0]),
+				option(ArgsOption, string(args)),
+				option(EnvOption, `{"MCP_STDIO_SERVER":"1"}`),
+			},
+		}
+		caller, err := NewMCPCaller(context.Background(), testLogger(), tool, []string{os.Args[0]})
+		require.NoError(t, err)
+		defer caller.Close()
+		callers, err := caller.Tools()
+		require.NoError(t, err)
+		out, _ := callers[0].Call(context.Background(), "msg-1", `{"text":"hi"}`, nil)
+		assert.Equal(t, "hi", out["data"])
+
+		// the command is not allowed by the service
+		_, err = NewMCPCaller(context.Background(), testLogger(), tool, []string{"/opt/mcp/bin/crm-server"})
+		assert.ErrorContains(t, err, "not allowed")
+	})
+	t.Run("invalid", func(t *testing.T) {
+		for _, options := range [][]*internal_assistant_entity.AssistantToolOption{
+			{option(TransportOption, "websocket")},
+			{option(TransportOption, TransportStdio)},
+			{option(TransportOption, TransportStdio), option(CommandOption, "npx"), option(ArgsOption, "-y")},
+			{option(TransportOption, TransportSSE)},
+		} {
+			_, err := NewMCPCaller(context.Background(), testLogger(), &internal_assistant_entity.AssistantTool{ExecutionOptions: options}, nil)
+			assert.Error(t, err)
+		}
+	})
+}
+
+func TestStdioEnv(t *testing.T) {
+	t.Setenv("POSTGRES__AUTH__PASSWORD", "secret")
+	env := stdioEnv([]string{"API_KEY=key", "LD_PRELOAD=/tmp/x.so", "DYLD_INSERT_LIBRARIES=x", "PATH=/tmp", "=invalid"})
+	assert.Equal(t, []string{stdioPath, "API_KEY=key"}, env)
+}
+
+func TestDefinitionSchema(t *testing.T) {
+	schema := map[string]interface{}{}
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"type": "object",
+		"required": ["city"],
+		"properties": {
+			"city": {"type": "string", "description": "name of city"},
+			"unit": {"type": ["string", "null"], "enum": ["c", "f"]},
+			"days": {"type": "integer", "enum": [1, 3]},
+			"tags": {"type": "array", "items": {"type": "object", "required": ["k"], "properties": {"k": {"type": "string"}}}},
+			"filter": {"type": "object", "description": "filter", "properties": {"min": {"type": "number"}}}
+		}
+	}`), &schema))
+
+	caller := &mcpToolCaller{tool: &Tool{Name: "weather", Description: "weather of city", InputSchema: schema}}
+	definition, err := caller.Definition()
+	require.NoError(t, err)
+	p := definition.Parameters
+	assert.Equal(t, "object", p.Type)
+	assert.Equal(t, []string{"city"}, p.Required)
+	assert.Equal(t, "name of city", p.Properties["city"].Description)
+	assert.Equal(t, "string", p.Properties["unit"].Type)
+	assert.Equal(t, []string{"c", "f"}, p.Properties["unit"].Enum)
+	assert.Equal(t, []string{"1", "3"}, p.Properties["days"].Enum)
+	require.NotNil(t, p.Properties["tags"].Items)
+	assert.Equal(t, "object", p.Properties["tags"].Items.Type)
+	assert.Equal(t, []string{"k"}, p.Properties["tags"].Items.Required)
+	assert.Equal(t, "string", p.Properties["tags"].Items.Properties["k"].Type)
+	assert.Contains(t, p.Properties["filter"].Description, `"min":{"type":"number"}`)
+
+	caller = &mcpToolCaller{tool: &Tool{Name: "ping"}}
+	definition, err = caller.Definition()
+	require.NoError(t, err)
+	assert.Equal(t, "object", definition.Parameters.Type)
+}
@@ -0,0 +1,164 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_agent_mcp_tool
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rapidaai/pkg/commons"
+)
+
+const sessionHeader = "Mcp-Session-Id"
+
+// streamableHttpTransport posts every message to the endpoint of server, the server answers
+// either with a json body or with a stream of server sent events carrying the response.
+type streamableHttpTransport struct {
+	logger     commons.Logger
+	url        string
+	headers    map[string]string
+	httpClient *http.Client
+
+	mu        sync.Mutex
+	sessionId string
+}
+
+// NewStreamableHttpClient gives the client of server listening on url, headers are sent with every request
+func NewStreamableHttpClient(logger commons.Logger, url string, headers map[string]string) *Client {
+	return newClient(logger, &streamableHttpTransport{
+		logger:     logger,
+		url:        url,
+		headers:    headers,
+		httpClient: &http.Client{Timeout: 60 * time.Second},
+	})
+}
+
+func (t *streamableHttpTransport) post(ctx context.Context, req *jsonrpcRequest) (*http.Response, error) {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+	hr, err := http.NewRequestWithContext(ctx, http.MethodPost, t.url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	hr.Header.Set("Content-Type", "application/json")
+	hr.Header.Set("Accept", "application/json, text/event-stream")
+	for k, v := range t.headers {
+		hr.Header.Set(k, v)
+	}
+	t.mu.Lock()
+	if t.sessionId != "" {
+		hr.Header.Set(sessionHeader, t.sessionId)
+	}
+	t.mu.Unlock()
+
+	resp, err := t.httpClient.Do(hr)
+	if err != nil {
+		return nil, err
+	}
+	if sessionId := resp.Header.Get(sessionHeader); sessionId != "" {
+		t.mu.Lock()
+		t.sessionId = sessionId
+		t.mu.Unlock()
+	}
+	if resp.StatusCode >= 300 {
+		defer resp.Body.Close()
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return nil, fmt.Errorf("mcp server responded with status %d: %s", resp.StatusCode, strings.TrimSpace(string(msg)))
+	}
+	return resp, nil
+}
+
+func (t *streamableHttpTransport) Call(ctx context.Context, req *jsonrpcRequest) (*jsonrpcMessage, error) {
+	resp, err := t.post(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	mediaType, _, _ := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	if mediaType == "text/event-stream" {
+		return t.readEventStream(resp.Body, *req.Id)
+	}
+	msg := &jsonrpcMessage{}
+	if err := json.NewDecoder(resp.Body).Decode(msg); err != nil {
+		return nil, fmt.Errorf("invalid response from mcp server: %w", err)
+	}
+	return msg, nil
+}
+
+// readEventStream reads the server sent events until the response of request is received,
+// requests and notifications of server on the stream are ignored.
+func (t *streamableHttpTransport) readEventStream(body io.Reader, id int64) (*jsonrpcMessage, error) {
+	scanner := bufio.NewScanner(body)
+	scanner.Buffer(make([]byte, 64*1024), 8*1024*1024)
+	var data strings.Builder
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.HasPrefix(line, "data:") {
+			data.WriteString(strings.TrimPrefix(strings.TrimPrefix(line, "data:"), " "))
+			continue
+		}
+		if line != "" || data.Len() == 0 {
+			continue
+		}
+		msg := &jsonrpcMessage{}
+		err := json.Unmarshal([]byte(data.String()), msg)
+		data.Reset()
+		if err != nil {
+			t.logger.Warnf("ignoring invalid event from mcp server %v", err)
+			continue
+		}
+		if msg.isResponse() && *msg.Id == id {
+			return msg, nil
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return nil, fmt.Errorf("mcp server closed the stream without response")
+}
+
+func (t *streamableHttpTransport) Notify(ctx context.Context, req *jsonrpcRequest) error {
+	resp, err := t.post(ctx, req)
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
+
+// Close terminates the session on server when server has given one
+func (t *streamableHttpTransport) Close() error {
+	t.mu.Lock()
+	sessionId := t.sessionId
+	t.mu.Unlock()
+	if sessionId == "" {
+		return nil
+	}
+	hr, err := http.NewRequest(http.MethodDelete, t.url, nil)
+	if err != nil {
+		return err
+	}
+	for k, v := range t.headers {
+		hr.Header.Set(k, v)
+	}
+	hr.Header.Set(sessionHeader, sessionId)
+	resp, err := t.httpClient.Do(hr)
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
@@ -0,0 +1,223 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_agent_mcp_tool
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/rapidaai/pkg/commons"
+)
+
+// sseTransport is the legacy http+sse transport of protocol 2024-11-05, the client keeps
+// a server sent event stream open and the server announces on it the endpoint where
+// messages are posted, responses of the requests come back over the stream.
+type sseTransport struct {
+	logger     commons.Logger
+	headers    map[string]string
+	httpClient *http.Client
+	cancel     context.CancelFunc
+	endpoint   string
+
+	mu      sync.Mutex
+	pending map[int64]chan *jsonrpcMessage
+	closed  chan struct{}
+	once    sync.Once
+}
+
+// NewSSEClient opens the event stream of server listening on url and waits for the
+// endpoint of messages, headers are sent with every request
+func NewSSEClient(ctx context.Context, logger commons.Logger, serverUrl string, headers map[string]string) (*Client, error) {
+	streamCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	hr, err := http.NewRequestWithContext(streamCtx, http.MethodGet, serverUrl, nil)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	hr.Header.Set("Accept", "text/event-stream")
+	for k, v := range headers {
+		hr.Header.Set(k, v)
+	}
+	// the stream lives as long as the session, no client timeout
+	httpClient := &http.Client{}
+	resp, err := httpClient.Do(hr)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	if resp.StatusCode >= 300 {
+		defer resp.Body.Close()
+		cancel()
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return nil, fmt.Errorf("mcp server responded with status %d: %s", resp.StatusCode, strings.TrimSpace(string(msg)))
+	}
+
+	t := &sseTransport{
+		logger:     logger,
+		headers:    headers,
+		httpClient: httpClient,
+		cancel:     cancel,
+		pending:    make(map[int64]chan *jsonrpcMessage),
+		closed:     make(chan struct{}),
+	}
+	endpoint := make(chan string, 1)
+	go t.read(resp.Body, endpoint)
+	select {
+	case e, ok := <-endpoint:
+		if !ok {
+			t.Close()
+			return nil, fmt.Errorf("mcp server closed the stream without endpoint")
+		}
+		base, _ := url.Parse(serverUrl)
+		ref, err := url.Parse(e)
+		if err != nil {
+			t.Close()
+			return nil, fmt.Errorf("invalid endpoint from mcp server: %w", err)
+		}
+		t.endpoint = base.ResolveReference(ref).String()
+	case <-ctx.Done():
+		t.Close()
+		return nil, ctx.Err()
+	}
+	return newClient(logger, t), nil
+}
+
+// read dispatches the events of stream, the first endpoint event is handed over and
+// message events answering a pending request are delivered to the caller
+func (t *sseTransport) read(body io.ReadCloser, endpoint chan<- string) {
+	defer body.Close()
+	defer t.shutdown()
+	defer func() {
+		// no endpoint was received, unblock the connect
+		select {
+		case endpoint <- "":
+		default:
+		}
+		close(endpoint)
+	}()
+
+	scanner := bufio.NewScanner(body)
+	scanner.Buffer(make([]byte, 64*1024), 8*1024*1024)
+	var (
+		event string
+		data  strings.Builder
+	)
+	for scanner.Scan() {
+		line := scanner.Text()
+		switch {
+		case strings.HasPrefix(line, "event:"):
+			event = strings.TrimSpace(strings.TrimPrefix(line, "event:"))
+			continue
+		case strings.HasPrefix(line, "data:"):
+			data.WriteString(strings.TrimPrefix(strings.TrimPrefix(line, "data:"), " "))
+			continue
+		case line != "" || data.Len() == 0:
+			continue
+		}
+
+		payload := data.String()
+		data.Reset()
+		if event == "endpoint" {
+			event = ""
+			select {
+			case endpoint <- strings.TrimSpace(payload):
+			default:
+			}
+			continue
+		}
+		event = ""
+		msg := &jsonrpcMessage{}
+		if err := json.Unmarshal([]byte(payload), msg); err != nil {
+			t.logger.Warnf("ignoring invalid event from mcp server %v", err)
+			continue
+		}
+		if !msg.isResponse() {
+			continue
+		}
+		t.mu.Lock()
+		ch, ok := t.pending[*msg.Id]
+		delete(t.pending, *msg.Id)
+		t.mu.Unlock()
+		if ok {
+			ch <- msg
+		}
+	}
+	if err := scanner.Err(); err != nil && !strings.Contains(err.Error(), "context canceled") {
+		t.logger.Errorf("error while reading from mcp server %v", err)
+	}
+}
+
+func (t *sseTransport) post(ctx context.Context, req *jsonrpcRequest) error {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return err
+	}
+	hr, err := http.NewRequestWithContext(ctx, http.MethodPost, t.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	hr.Header.Set("Content-Type", "application/json")
+	for k, v := range t.headers {
+		hr.Header.Set(k, v)
+	}
+	resp, err := t.httpClient.Do(hr)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("mcp server responded with status %d: %s", resp.StatusCode, strings.TrimSpace(string(msg)))
+	}
+	return nil
+}
+
+func (t *sseTransport) Call(ctx context.Context, req *jsonrpcRequest) (*jsonrpcMessage, error) {
+	ch := make(chan *jsonrpcMessage, 1)
+	t.mu.Lock()
+	t.pending[*req.Id] = ch
+	t.mu.Unlock()
+	defer func() {
+		t.mu.Lock()
+		delete(t.pending, *req.Id)
+		t.mu.Unlock()
+	}()
+
+	if err := t.post(ctx, req); err != nil {
+		return nil, err
+	}
+	select {
+	case msg := <-ch:
+		return msg, nil
+	case <-t.closed:
+		return nil, ErrTransportClosed
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (t *sseTransport) Notify(ctx context.Context, req *jsonrpcRequest) error {
+	return t.post(ctx, req)
+}
+
+func (t *sseTransport) shutdown() {
+	t.once.Do(func() { close(t.closed) })
+}
+
+// Close ends the event stream, server ends the session with it
+func (t *sseTransport) Close() error {
+	t.cancel()
+	t.shutdown()
+	return nil
+}
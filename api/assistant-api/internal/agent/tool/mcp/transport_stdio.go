@@ -0,0 +1,164 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_agent_mcp_tool
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rapidaai/pkg/commons"
+)
+
+// stdioTransport runs the server as subprocess, messages are newline delimited json on stdin and stdout
+type stdioTransport struct {
+	logger commons.Logger
+	cmd    *exec.Cmd
+	stdin  io.WriteCloser
+
+	writeMu sync.Mutex
+	mu      sync.Mutex
+	pending map[int64]chan *jsonrpcMessage
+	closed  chan struct{}
+	once    sync.Once
+}
+
+// environment of the server process besides the variables of the tool, the environment of
+// current process holds the credentials of the service and is never inherited
+const stdioPath = "PATH=/usr/local/bin:/usr/bin:/bin"
+
+// NewStdioClient starts the server command with only the given env, loader variables are dropped
+// as they would let the tool inject code into the command
+func NewStdioClient(logger commons.Logger, command string, args []string, env []string) (*Client, error) {
+	cmd := exec.Command(command, args...)
+	cmd.Env = stdioEnv(env)
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return nil, err
+	}
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+	if err := cmd.Start(); err != nil {
+		return nil, err
+	}
+	t := &stdioTransport{
+		logger:  logger,
+		cmd:     cmd,
+		stdin:   stdin,
+		pending: make(map[int64]chan *jsonrpcMessage),
+		closed:  make(chan struct{}),
+	}
+	go t.read(stdout)
+	return newClient(logger, t), nil
+}
+
+func stdioEnv(env []string) []string {
+	out := []string{stdioPath}
+	for _, kv := range env {
+		name, _, _ := strings.Cut(kv, "=")
+		if name == "" || name == "PATH" || strings.HasPrefix(name, "LD_") || strings.HasPrefix(name, "DYLD_") {
+			continue
+		}
+		out = append(out, kv)
+	}
+	return out
+}
+
+func (t *stdioTransport) read(stdout io.Reader) {
+	defer t.shutdown()
+	reader := bufio.NewReader(stdout)
+	for {
+		line, err := reader.ReadBytes('\n')
+		if len(line) > 0 {
+			msg := &jsonrpcMessage{}
+			if jErr := json.Unmarshal(line, msg); jErr != nil {
+				t.logger.Warnf("ignoring invalid message from mcp server %v", jErr)
+			} else if msg.isResponse() {
+				t.mu.Lock()
+				ch, ok := t.pending[*msg.Id]
+				delete(t.pending, *msg.Id)
+				t.mu.Unlock()
+				if ok {
+					ch <- msg
+				}
+			}
+		}
+		if err != nil {
+			if err != io.EOF {
+				t.logger.Errorf("error while reading from mcp server %v", err)
+			}
+			return
+		}
+	}
+}
+
+func (t *stdioTransport) write(req *jsonrpcRequest) error {
+	b, err := json.Marshal(req)
+	if err != nil {
+		return err
+	}
+	t.writeMu.Lock()
+	defer t.writeMu.Unlock()
+	_, err = t.stdin.Write(append(b, '\n'))
+	return err
+}
+
+func (t *stdioTransport) Call(ctx context.Context, req *jsonrpcRequest) (*jsonrpcMessage, error) {
+	ch := make(chan *jsonrpcMessage, 1)
+	t.mu.Lock()
+	t.pending[*req.Id] = ch
+	t.mu.Unlock()
+	defer func() {
+		t.mu.Lock()
+		delete(t.pending, *req.Id)
+		t.mu.Unlock()
+	}()
+
+	if err := t.write(req); err != nil {
+		return nil, err
+	}
+	select {
+	case msg := <-ch:
+		return msg, nil
+	case <-t.closed:
+		return nil, ErrTransportClosed
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (t *stdioTransport) Notify(ctx context.Context, req *jsonrpcRequest) error {
+	return t.write(req)
+}
+
+func (t *stdioTransport) shutdown() {
+	t.once.Do(func() { close(t.closed) })
+}
+
+// Close closes stdin of server and kills it when it does not exit in time
+func (t *stdioTransport) Close() error {
+	err := t.stdin.Close()
+	done := make(chan struct{})
+	go func() {
+		t.cmd.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.cmd.Process.Kill()
+		<-done
+	}
+	t.shutdown()
+	return err
+}
@@ -14,6 +14,8 @@ import (
 
 	internal_requests "github.com/rapidaai/api/assistant-api/internal/adapters"
 	internal_agent_tools "github.com/rapidaai/api/assistant-api/internal/agent/tool/local"
+	internal_agent_mcp_tool "github.com/rapidaai/api/assistant-api/internal/agent/tool/mcp"
+	internal_assistant_entity "github.com/rapidaai/api/assistant-api/internal/entity/assistants"
 	internal_adapter_telemetry "github.com/rapidaai/api/assistant-api/internal/telemetry"
 
 	internal_tool_factory "github.com/rapidaai/api/assistant-api/internal/factory/tool"
@@ -29,6 +31,7 @@ type toolExecutor struct {
 	logger                 commons.Logger
 	tools                  map[string]internal_agent_tools.ToolCaller
 	availableToolFunctions []*protos.FunctionDefinition
+	mcpCallers             []internal_agent_mcp_tool.MCPCaller
 }
 
 func NewToolExecutor(
@@ -50,6 +53,10 @@ func (executor *toolExecutor) Initialize(
 
 	start := time.Now()
 	for _, tool := range communication.Assistant().AssistantTools {
+		if tool.ExecutionMethod == "mcp" {
+			executor.initializeMCP(ctx, span, tool, communication)
+			continue
+		}
 		caller, err := internal_tool_factory.GetToolAction(
 			executor.logger,
 			tool, communication)
@@ -57,26 +64,71 @@ func (executor *toolExecutor) Initialize(
 			executor.logger.Errorf("error while initialize tool action %s", err)
 			continue
 		}
-		span.AddAttributes(ctx,
-			internal_adapter_telemetry.KV{
-				K: caller.Name(),
-				V: internal_adapter_telemetry.StringValue(caller.ExecutionMethod()),
-			},
-		)
-		tlf, err := caller.Definition()
-		if err != nil {
-			executor.logger.Errorf("unable to generate tool definition %s", err)
-			continue
-		}
-		//
-		executor.tools[caller.Name()] = caller
-		executor.availableToolFunctions = append(executor.availableToolFunctions, tlf)
-
+		executor.register(ctx, span, caller)
 	}
 	executor.logger.Benchmark("ToolExecutor.Init", time.Since(start))
 	return nil
 }
 
+// initializeMCP registers every tool of the mcp server configured on the tool
+func (executor *toolExecutor) initializeMCP(
+	ctx context.Context,
+	span internal_adapter_telemetry.VoiceAgentTracer,
+	tool *internal_assistant_entity.AssistantTool,
+	communication internal_requests.Communication,
+) {
+	mcpCaller, err := internal_tool_factory.GetMCPCaller(ctx, executor.logger, tool, communication)
+	if err != nil {
+		executor.logger.Errorf("error while initialize mcp server %s", err)
+		return
+	}
+	executor.mcpCallers = append(executor.mcpCallers, mcpCaller)
+	callers, err := mcpCaller.Tools()
+	if err != nil {
+		executor.logger.Errorf("unable to list tools of mcp server %s %s", mcpCaller.Name(), err)
+		return
+	}
+	for _, caller := range callers {
+		executor.register(ctx, span, caller)
+	}
+}
+
+func (executor *toolExecutor) register(
+	ctx context.Context,
+	span internal_adapter_telemetry.VoiceAgentTracer,
+	caller internal_agent_tools.ToolCaller,
+) {
+	span.AddAttributes(ctx,
+		internal_adapter_telemetry.KV{
+			K: caller.Name(),
+			V: internal_adapter_telemetry.StringValue(caller.ExecutionMethod()),
+		},
+	)
+	// llm calls the tool by name, a second tool with same name would never be reached
+	if existing, ok := executor.tools[caller.Name()]; ok {
+		executor.logger.Errorf("ignoring tool %s of %s, name is already registered by tool %d", caller.Name(), caller.ExecutionMethod(), existing.Id())
+		return
+	}
+	tlf, err := caller.Definition()
+	if err != nil {
+		executor.logger.Errorf("unable to generate tool definition %s", err)
+		return
+	}
+	//
+	executor.tools[caller.Name()] = caller
+	executor.availableToolFunctions = append(executor.availableToolFunctions, tlf)
+}
+
+func (executor *toolExecutor) Close(ctx context.Context) error {
+	for _, mcpCaller := range executor.mcpCallers {
+		if err := mcpCaller.Close(); err != nil {
+			executor.logger.Warnf("unable to close mcp session %s %v", mcpCaller.Name(), err)
+		}
+	}
+	executor.mcpCallers = nil
+	return nil
+}
+
 func (executor *toolExecutor) GetFunctionDefinitions() []*protos.FunctionDefinition {
 	return executor.availableToolFunctions
 }
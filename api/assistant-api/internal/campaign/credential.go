@@ -0,0 +1,61 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_campaign
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+)
+
+// SealCredential encrypts the project credential of the campaign with the secret of the
+// service so it is never stored in plain text, aes-256-gcm keyed by sha256 of the secret
+func SealCredential(secret, token string) (string, error) {
+	gcm, err := credentialCipher(secret)
+	if err != nil {
+		return "", err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(gcm.Seal(nonce, nonce, []byte(token), nil)), nil
+}
+
+// OpenCredential decrypts the credential sealed with the same secret
+func OpenCredential(secret, sealed string) (string, error) {
+	gcm, err := credentialCipher(secret)
+	if err != nil {
+		return "", err
+	}
+	data, err := base64.StdEncoding.DecodeString(sealed)
+	if err != nil {
+		return "", err
+	}
+	if len(data) < gcm.NonceSize() {
+		return "", errors.New("invalid campaign credential")
+	}
+	token, err := gcm.Open(nil, data[:gcm.NonceSize()], data[gcm.NonceSize():], nil)
+	if err != nil {
+		return "", errors.New("invalid campaign credential")
+	}
+	return string(token), nil
+}
+
+func credentialCipher(secret string) (cipher.AEAD, error) {
+	if secret == "" {
+		return nil, errors.New("secret is required for campaign credential")
+	}
+	key := sha256.Sum256([]byte(secret))
+	block, err := aes.NewCipher(key[:])
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,34 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_campaign
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCredential(t *testing.T) {
+	sealed, err := SealCredential("secret", "project-key")
+	require.NoError(t, err)
+	assert.NotContains(t, sealed, "project-key")
+
+	again, err := SealCredential("secret", "project-key")
+	require.NoError(t, err)
+	assert.NotEqual(t, sealed, again, "nonce is random")
+
+	token, err := OpenCredential("secret", sealed)
+	require.NoError(t, err)
+	assert.Equal(t, "project-key", token)
+
+	_, err = OpenCredential("other", sealed)
+	assert.Error(t, err)
+	_, err = OpenCredential("secret", "project-key")
+	assert.Error(t, err)
+	_, err = SealCredential("", "project-key")
+	assert.Error(t, err)
+}
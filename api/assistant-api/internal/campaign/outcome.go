@@ -0,0 +1,66 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_campaign
+
+import (
+	"maps"
+	"slices"
+	"strings"
+
+	internal_assistant_entity "github.com/rapidaai/api/assistant-api/internal/entity/assistants"
+)
+
+// outcome of a call attempt
+const (
+	OUTCOME_COMPLETED = "completed"
+	OUTCOME_BUSY      = "busy"
+	OUTCOME_NO_ANSWER = "no-answer"
+	OUTCOME_FAILED    = "failed"
+)
+
+// outcomes which are retried when the campaign does not configure them
+var DefaultRetryOutcomes = []string{OUTCOME_BUSY, OUTCOME_NO_ANSWER, OUTCOME_FAILED}
+
+// call statuses of twilio, vonage and exotel which end the call
+var finalStatuses = map[string]string{
+	"completed":  OUTCOME_COMPLETED,
+	"busy":       OUTCOME_BUSY,
+	"no-answer":  OUTCOME_NO_ANSWER,
+	"timeout":    OUTCOME_NO_ANSWER,
+	"unanswered": OUTCOME_NO_ANSWER,
+	"failed":     OUTCOME_FAILED,
+	"rejected":   OUTCOME_FAILED,
+	"canceled":   OUTCOME_FAILED,
+	"cancelled":  OUTCOME_FAILED,
+}
+
+// FinalEvents returns the telephony events which end the call, in lower case
+func FinalEvents() []string {
+	return slices.Sorted(maps.Keys(finalStatuses))
+}
+
+// Outcome returns the outcome of the call from the telephony events of the conversation,
+// false when the call has not ended yet
+func Outcome(events []string) (string, bool) {
+	for _, evt := range events {
+		if outcome, ok := finalStatuses[strings.ToLower(evt)]; ok {
+			return outcome, true
+		}
+	}
+	return "", false
+}
+
+// Retry tells if the contact should be called again for the outcome of its last attempt
+func Retry(campaign *internal_assistant_entity.AssistantCampaign, contact *internal_assistant_entity.AssistantCampaignContact, outcome string) bool {
+	if outcome == OUTCOME_COMPLETED || contact.Attempts >= campaign.MaxAttempts {
+		return false
+	}
+	retryOutcomes := campaign.GetRetryOutcomes()
+	if len(retryOutcomes) == 0 {
+		retryOutcomes = DefaultRetryOutcomes
+	}
+	return slices.Contains(retryOutcomes, outcome)
+}
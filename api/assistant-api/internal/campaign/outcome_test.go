@@ -0,0 +1,89 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_campaign
+
+import (
+	"testing"
+	"time"
+
+	internal_assistant_entity "github.com/rapidaai/api/assistant-api/internal/entity/assistants"
+)
+
+func TestOutcome(t *testing.T) {
+	tests := []struct {
+		events  []string
+		outcome string
+		ended   bool
+	}{
+		{[]string{"api-call", "initiated", "ringing"}, "", false},
+		{[]string{"api-call", "in-progress", "completed"}, OUTCOME_COMPLETED, true},
+		{[]string{"api-call", "busy"}, OUTCOME_BUSY, true},
+		{[]string{"started", "timeout"}, OUTCOME_NO_ANSWER, true},
+		{[]string{"api-call", "Failed"}, OUTCOME_FAILED, true},
+		{[]string{"rejected"}, OUTCOME_FAILED, true},
+	}
+	for _, tt := range tests {
+		outcome, ended := Outcome(tt.events)
+		if outcome != tt.outcome || ended != tt.ended {
+			t.Errorf("Outcome(%v) = %q, %v, want %q, %v", tt.events, outcome, ended, tt.outcome, tt.ended)
+		}
+	}
+}
+
+func TestRetry(t *testing.T) {
+	campaign := &internal_assistant_entity.AssistantCampaign{MaxAttempts: 3}
+	contact := &internal_assistant_entity.AssistantCampaignContact{Attempts: 1}
+	if !Retry(campaign, contact, OUTCOME_BUSY) {
+		t.Error("busy should be retried with the default outcomes")
+	}
+	if Retry(campaign, contact, OUTCOME_COMPLETED) {
+		t.Error("completed call should not be retried")
+	}
+	contact.Attempts = 3
+	if Retry(campaign, contact, OUTCOME_BUSY) {
+		t.Error("exhausted attempts should not be retried")
+	}
+	contact.Attempts = 1
+	campaign.RetryOutcomes = []string{OUTCOME_NO_ANSWER}
+	if Retry(campaign, contact, OUTCOME_BUSY) {
+		t.Error("busy is not in the retry outcomes of the campaign")
+	}
+}
+
+func TestNextCallingTime(t *testing.T) {
+	campaign := &internal_assistant_entity.AssistantCampaign{
+		Timezone:         "Asia/Kolkata",
+		CallingHourStart: "09:00",
+		CallingHourEnd:   "18:00",
+	}
+	kolkata, _ := time.LoadLocation("Asia/Kolkata")
+	newYork, _ := time.LoadLocation("America/New_York")
+
+	within := time.Date(2025, 3, 10, 10, 30, 0, 0, kolkata)
+	if next, _ := campaign.NextCallingTime(within, ""); !next.Equal(within) {
+		t.Errorf("within calling hours got %v", next)
+	}
+
+	evening := time.Date(2025, 3, 10, 19, 0, 0, 0, kolkata)
+	want := time.Date(2025, 3, 11, 9, 0, 0, 0, kolkata)
+	if next, _ := campaign.NextCallingTime(evening, ""); !next.Equal(want) {
+		t.Errorf("after calling hours got %v, want %v", next, want)
+	}
+
+	// the timezone of the contact overrides the one of the campaign
+	if next, _ := campaign.NextCallingTime(within, "America/New_York"); !next.Equal(time.Date(2025, 3, 10, 9, 0, 0, 0, newYork)) {
+		t.Errorf("contact timezone got %v", next)
+	}
+
+	night := &internal_assistant_entity.AssistantCampaign{CallingHourStart: "20:00", CallingHourEnd: "02:00"}
+	late := time.Date(2025, 3, 10, 1, 0, 0, 0, time.UTC)
+	if next, _ := night.NextCallingTime(late, ""); !next.Equal(late) {
+		t.Errorf("window past midnight got %v", next)
+	}
+	if _, err := night.NextCallingTime(late, "Mars/Olympus"); err == nil {
+		t.Error("expected error for invalid timezone")
+	}
+}
@@ -0,0 +1,329 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_campaign
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"sync"
+	"time"
+
+	"github.com/rapidaai/api/assistant-api/config"
+	internal_assistant_entity "github.com/rapidaai/api/assistant-api/internal/entity/assistants"
+	internal_services "github.com/rapidaai/api/assistant-api/internal/services"
+	internal_assistant_service "github.com/rapidaai/api/assistant-api/internal/services/assistant"
+	internal_outbound_telephony "github.com/rapidaai/api/assistant-api/internal/telephony/outbound"
+	web_client "github.com/rapidaai/pkg/clients/web"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/connectors"
+	gorm_models "github.com/rapidaai/pkg/models/gorm"
+	storage_files "github.com/rapidaai/pkg/storages/file-storage"
+	"github.com/rapidaai/pkg/types"
+	type_enums "github.com/rapidaai/pkg/types/enums"
+	"github.com/rapidaai/pkg/utils"
+)
+
+const (
+	pollInterval = time.Second
+	dialingBatch = 100
+	// contacts claimed by a worker which died before placing the call are released after lease
+	claimLease = 5 * time.Minute
+	// calls without a final status from the telephony are considered failed after timeout
+	callTimeout = 2 * time.Hour
+)
+
+// metadata of the conversation linking it to the campaign
+const (
+	CampaignIdMetadata        = "rapida.campaign_id"
+	CampaignContactIdMetadata = "rapida.campaign_contact_id"
+)
+
+// DialingWorker places the calls of the running campaigns and tracks their outcome from the telephony events,
+// multiple workers can run across pods as the campaigns and contacts are claimed with skip locked.
+type DialingWorker struct {
+	logger          commons.Logger
+	campaignService internal_services.AssistantCampaignService
+	caller          *internal_outbound_telephony.Caller
+	// opens the credential of the campaigns
+	secret string
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func NewDialingWorker(
+	cfg *config.AssistantConfig,
+	logger commons.Logger,
+	postgres connectors.PostgresConnector,
+	redis connectors.RedisConnector,
+	opensearch connectors.OpenSearchConnector,
+) *DialingWorker {
+	return &DialingWorker{
+		logger:          logger,
+		secret:          cfg.Secret,
+		campaignService: internal_assistant_service.NewAssistantCampaignService(logger, postgres),
+		caller: internal_outbound_telephony.NewCaller(cfg, logger,
+			internal_assistant_service.NewAssistantService(cfg, logger, postgres, opensearch),
+			internal_assistant_service.NewAssistantConversationService(logger, postgres, storage_files.NewStorage(cfg.AssetStoreConfig, logger)),
+			web_client.NewVaultClientGRPC(&cfg.AppConfig, logger, redis),
+		),
+	}
+}
+
+// Start polls the campaigns in background until Stop is called.
+func (w *DialingWorker) Start(ctx context.Context) {
+	ctx, w.cancel = context.WithCancel(ctx)
+	w.wg.Add(1)
+	go func() {
+		defer w.wg.Done()
+		ticker := time.NewTicker(pollInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				w.poll(ctx)
+			}
+		}
+	}()
+}
+
+// Stop waits for the calls being placed, outcomes of the placed calls are resolved by any worker.
+func (w *DialingWorker) Stop(ctx context.Context) error {
+	if w.cancel != nil {
+		w.cancel()
+	}
+	done := make(chan struct{})
+	go func() {
+		w.wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (w *DialingWorker) poll(ctx context.Context) {
+	now := time.Now()
+	w.resolve(ctx, now)
+
+	campaigns, err := w.campaignService.GetAllRunning(ctx, now)
+	if err != nil {
+		return
+	}
+	var wg sync.WaitGroup
+	for _, campaign := range campaigns {
+		wg.Add(1)
+		utils.Go(ctx, func() {
+			defer wg.Done()
+			w.dial(ctx, campaign.Id, now)
+		})
+	}
+	wg.Wait()
+}
+
+// resolve settles the outcome of the dialing contacts which have a final status from the telephony,
+// the contacts of each campaign are paged by id so calls in progress never hold back the ones which ended
+func (w *DialingWorker) resolve(ctx context.Context, now time.Time) {
+	campaignIds, err := w.campaignService.GetAllDialingCampaign(ctx)
+	if err != nil {
+		return
+	}
+	for _, campaignId := range campaignIds {
+		w.resolveCampaign(ctx, campaignId, now)
+	}
+}
+
+func (w *DialingWorker) resolveCampaign(ctx context.Context, campaignId uint64, now time.Time) {
+	campaign, err := w.campaignService.GetById(ctx, campaignId)
+	if err != nil {
+		return
+	}
+	option := &internal_services.EndedContactOption{
+		FinalEvents:   FinalEvents(),
+		ClaimedBefore: now.Add(-claimLease),
+		DialedBefore:  now.Add(-callTimeout),
+	}
+	var cursor uint64
+	for {
+		contacts, err := w.campaignService.GetAllEndedContact(ctx, campaignId, cursor, option, dialingBatch)
+		if err != nil || len(contacts) == 0 {
+			return
+		}
+		conversationIds := make([]uint64, 0, len(contacts))
+		for _, contact := range contacts {
+			if contact.AssistantConversationId > 0 {
+				conversationIds = append(conversationIds, contact.AssistantConversationId)
+			}
+		}
+		events, err := w.campaignService.GetTelephonyEvents(ctx, conversationIds)
+		if err != nil {
+			return
+		}
+		for _, contact := range contacts {
+			w.resolveContact(ctx, campaign, contact, events[contact.AssistantConversationId], now)
+		}
+		if len(contacts) < dialingBatch {
+			return
+		}
+		cursor = contacts[len(contacts)-1].Id
+	}
+}
+
+func (w *DialingWorker) resolveContact(
+	ctx context.Context,
+	campaign *internal_assistant_entity.AssistantCampaign,
+	contact *internal_assistant_entity.AssistantCampaignContact,
+	events []string,
+	now time.Time,
+) {
+	dialedAt := time.Time(contact.UpdatedDate)
+	if contact.AssistantConversationId == 0 {
+		// the worker which claimed the contact never placed the call
+		if now.Sub(dialedAt) > claimLease {
+			contact.State = internal_assistant_entity.CONTACT_PENDING
+			contact.Attempts = max(contact.Attempts, 1) - 1
+			contact.NextAttemptDate = gorm_models.TimeWrapper(now)
+			w.update(ctx, contact)
+		}
+		return
+	}
+	outcome, ended := Outcome(events)
+	if !ended {
+		if now.Sub(dialedAt) < callTimeout {
+			return
+		}
+		outcome, contact.LastError = OUTCOME_FAILED, "no final call status received from telephony"
+	}
+	w.settle(ctx, campaign, contact, outcome, now)
+}
+
+// settle records the outcome of the attempt and schedules the retry
+func (w *DialingWorker) settle(
+	ctx context.Context,
+	campaign *internal_assistant_entity.AssistantCampaign,
+	contact *internal_assistant_entity.AssistantCampaignContact,
+	outcome string,
+	now time.Time,
+) {
+	contact.LastOutcome = outcome
+	switch {
+	case outcome == OUTCOME_COMPLETED:
+		contact.State = internal_assistant_entity.CONTACT_COMPLETED
+	case Retry(campaign, contact, outcome) && campaign.State == internal_assistant_entity.CAMPAIGN_CANCELLED:
+		contact.State = internal_assistant_entity.CONTACT_CANCELLED
+	case Retry(campaign, contact, outcome):
+		contact.State = internal_assistant_entity.CONTACT_PENDING
+		contact.NextAttemptDate = gorm_models.TimeWrapper(now.Add(time.Duration(campaign.RetryDelaySecond) * time.Second))
+	default:
+		contact.State = internal_assistant_entity.CONTACT_FAILED
+	}
+	w.update(ctx, contact)
+}
+
+func (w *DialingWorker) update(ctx context.Context, contact *internal_assistant_entity.AssistantCampaignContact) {
+	if _, err := w.campaignService.UpdateContact(ctx, contact, internal_assistant_entity.CONTACT_DIALING); err != nil {
+		w.logger.Errorf("unable to update campaign contact %d %v", contact.Id, err)
+	}
+}
+
+// dial places the calls of the campaign which are allowed by its concurrency and calls per second
+func (w *DialingWorker) dial(ctx context.Context, campaignId uint64, now time.Time) {
+	campaign, contacts, err := w.campaignService.ClaimContacts(ctx, campaignId, now)
+	if err != nil || campaign == nil {
+		return
+	}
+	if len(contacts) == 0 {
+		if completed, _ := w.campaignService.Complete(ctx, campaignId); completed {
+			w.logger.Infof("campaign %d completed", campaignId)
+		}
+		return
+	}
+	interval := time.Duration(float64(time.Second) / campaign.CallsPerSecond)
+	for i, contact := range contacts {
+		if i > 0 {
+			select {
+			case <-ctx.Done():
+				// claimed contacts are released after the lease
+				return
+			case <-time.After(interval):
+			}
+		}
+		w.call(context.WithoutCancel(ctx), campaign, contact)
+	}
+}
+
+func (w *DialingWorker) call(
+	ctx context.Context,
+	campaign *internal_assistant_entity.AssistantCampaign,
+	contact *internal_assistant_entity.AssistantCampaignContact,
+) {
+	metadata := make(map[string]interface{}, len(contact.Metadata)+2)
+	maps.Copy(metadata, contact.Metadata)
+	metadata[CampaignIdMetadata] = fmt.Sprintf("%d", campaign.Id)
+	metadata[CampaignContactIdMetadata] = fmt.Sprintf("%d", contact.Id)
+
+	principal, err := w.principal(campaign)
+	if err != nil {
+		w.logger.Errorf("unable to open credential of campaign %d %v", campaign.Id, err)
+		contact.State = internal_assistant_entity.CONTACT_FAILED
+		contact.LastOutcome = OUTCOME_FAILED
+		contact.LastError = err.Error()
+		w.update(ctx, contact)
+		return
+	}
+	conversation, err := w.caller.Call(ctx, principal, &internal_outbound_telephony.Request{
+		AssistantId:      campaign.AssistantId,
+		AssistantVersion: campaign.AssistantVersion,
+		ToNumber:         contact.ToNumber,
+		FromNumber:       campaign.FromNumber,
+		Metadata:         metadata,
+		Arguments:        contact.Arguments,
+		Options:          contact.Options,
+	})
+	if conversation == nil {
+		// the call could not be placed for the assistant or its deployment, retrying does not help
+		w.logger.Errorf("unable to call campaign contact %d %v", contact.Id, err)
+		contact.State = internal_assistant_entity.CONTACT_FAILED
+		contact.LastOutcome = OUTCOME_FAILED
+		var callErr *internal_outbound_telephony.Error
+		if errors.As(err, &callErr) {
+			contact.LastError = callErr.Message
+		} else if err != nil {
+			contact.LastError = err.Error()
+		}
+		w.update(ctx, contact)
+		return
+	}
+	contact.AssistantConversationId = conversation.Id
+	contact.LastError = ""
+	if err != nil {
+		contact.LastError = err.Error()
+		w.settle(ctx, campaign, contact, OUTCOME_FAILED, time.Now())
+		return
+	}
+	w.update(ctx, contact)
+}
+
+// principal of the project which created the campaign, the telephony calls back with its credential
+func (w *DialingWorker) principal(campaign *internal_assistant_entity.AssistantCampaign) (types.SimplePrinciple, error) {
+	token, err := OpenCredential(w.secret, campaign.CredentialToken)
+	if err != nil {
+		return nil, err
+	}
+	return &types.ProjectScope{
+		ProjectId:      &campaign.ProjectId,
+		OrganizationId: &campaign.OrganizationId,
+		Status:         type_enums.RECORD_ACTIVE.String(),
+		CurrentToken:   token,
+	}, nil
+}
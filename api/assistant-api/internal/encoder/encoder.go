@@ -0,0 +1,41 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_encoder
+
+import "github.com/rapidaai/pkg/utils"
+
+// Encoder compresses 16 bit pcm into a codec and writes it to its writer as it goes,
+// so nothing more than a frame of audio is held by the encoder.
+type Encoder interface {
+	// Codec of the encoder, wav, flac, opus or mp3
+	Codec() string
+
+	// Extension of the file the encoder writes
+	Extension() string
+
+	// Encode takes interleaved samples of all channels, left channel first
+	Encode(samples []int16) error
+
+	// Close writes the pending frame and finishes the stream, the writer is left open.
+	// Encoders which patch their header go back to it when the writer is an io.WriteSeeker.
+	Close() error
+}
+
+type EncoderOptions struct {
+	SampleRate uint32
+	Channels   uint32
+
+	// codec options, bitrate in kbps for lossy codecs
+	Options utils.Option
+}
+
+// Bitrate of lossy codecs in kbps, the default is given for a single channel
+func (eo *EncoderOptions) Bitrate(perChannel int) int {
+	if bitrate, err := eo.Options.GetUint64("bitrate"); err == nil && bitrate > 0 {
+		return int(bitrate)
+	}
+	return perChannel * int(eo.Channels)
+}
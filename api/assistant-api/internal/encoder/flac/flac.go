@@ -0,0 +1,143 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_encoder_flac
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/mewkiz/flac"
+	"github.com/mewkiz/flac/frame"
+	"github.com/mewkiz/flac/meta"
+	internal_encoder "github.com/rapidaai/api/assistant-api/internal/encoder"
+	"github.com/rapidaai/pkg/commons"
+)
+
+// samples of a channel in a frame
+const flacBlockSize = 4096
+
+type flacEncoder struct {
+	logger   commons.Logger
+	w        io.Writer
+	encoder  *flac.Encoder
+	opts     *internal_encoder.EncoderOptions
+	channels frame.Channels
+	pending  []int16
+}
+
+// writer hides Close of the underlying writer from flac encoder, which closes a closer
+type writer struct {
+	io.Writer
+}
+
+type writeSeeker struct {
+	io.WriteSeeker
+}
+
+// NewFlacEncoder writes lossless flac, stream info is completed on close when the writer can seek
+func NewFlacEncoder(logger commons.Logger, w io.Writer, opts *internal_encoder.EncoderOptions) (internal_encoder.Encoder, error) {
+	var channels frame.Channels
+	switch opts.Channels {
+	case 1:
+		channels = frame.ChannelsMono
+	case 2:
+		channels = frame.ChannelsLR
+	default:
+		return nil, fmt.Errorf("flac: unsupported number of channels %d", opts.Channels)
+	}
+	var out io.Writer = writer{w}
+	if ws, ok := w.(io.WriteSeeker); ok {
+		out = writeSeeker{ws}
+	}
+	encoder, err := flac.NewEncoder(out, &meta.StreamInfo{
+		BlockSizeMin:  16,
+		BlockSizeMax:  flacBlockSize,
+		SampleRate:    opts.SampleRate,
+		NChannels:     uint8(opts.Channels),
+		BitsPerSample: 16,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &flacEncoder{
+		logger:   logger,
+		w:        w,
+		encoder:  encoder,
+		opts:     opts,
+		channels: channels,
+		pending:  make([]int16, 0, flacBlockSize*int(opts.Channels)),
+	}, nil
+}
+
+func (enc *flacEncoder) Codec() string {
+	return "flac"
+}
+
+func (enc *flacEncoder) Extension() string {
+	return "flac"
+}
+
+func (enc *flacEncoder) Encode(samples []int16) error {
+	frameSize := flacBlockSize * int(enc.opts.Channels)
+	for len(samples) > 0 {
+		n := min(frameSize-len(enc.pending), len(samples))
+		enc.pending = append(enc.pending, samples[:n]...)
+		samples = samples[n:]
+		if len(enc.pending) == frameSize {
+			if err := enc.writeFrame(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (enc *flacEncoder) writeFrame() error {
+	nchannels := int(enc.opts.Channels)
+	blockSize := len(enc.pending) / nchannels
+	if blockSize == 0 {
+		return nil
+	}
+	f := &frame.Frame{
+		Header: frame.Header{
+			HasFixedBlockSize: true,
+			BlockSize:         uint16(blockSize),
+			SampleRate:        enc.opts.SampleRate,
+			Channels:          enc.channels,
+			BitsPerSample:     16,
+		},
+		Subframes: make([]*frame.Subframe, nchannels),
+	}
+	for channel := 0; channel < nchannels; channel++ {
+		samples := make([]int32, blockSize)
+		for i := range samples {
+			samples[i] = int32(enc.pending[i*nchannels+channel])
+		}
+		// prediction analysis of the encoder picks constant or fixed prediction over verbatim
+		f.Subframes[channel] = &frame.Subframe{
+			SubHeader: frame.SubHeader{Pred: frame.PredVerbatim},
+			Samples:   samples,
+			NSamples:  blockSize,
+		}
+	}
+	enc.pending = enc.pending[:0]
+	return enc.encoder.WriteFrame(f)
+}
+
+func (enc *flacEncoder) Close() error {
+	if err := enc.writeFrame(); err != nil {
+		return err
+	}
+	if err := enc.encoder.Close(); err != nil {
+		return err
+	}
+	// stream info is rewritten at the start, writes can go on at the end
+	if ws, ok := enc.w.(io.WriteSeeker); ok {
+		_, err := ws.Seek(0, io.SeekEnd)
+		return err
+	}
+	return nil
+}
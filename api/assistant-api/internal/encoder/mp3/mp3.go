@@ -0,0 +1,109 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_encoder_mp3
+
+/*
+#cgo LDFLAGS: -lmp3lame
+#include <lame/lame.h>
+*/
+import "C"
+
+import (
+	"fmt"
+	"io"
+	"unsafe"
+
+	internal_encoder "github.com/rapidaai/api/assistant-api/internal/encoder"
+	"github.com/rapidaai/pkg/commons"
+)
+
+// lame needs room for 7200 bytes of flush on top of 1.25 bytes a sample
+const mp3FlushSize = 7200
+
+type mp3Encoder struct {
+	logger commons.Logger
+	w      io.Writer
+	opts   *internal_encoder.EncoderOptions
+	lame   C.lame_t
+	buffer []byte
+}
+
+// NewMp3Encoder writes constant bitrate mp3 using lame
+func NewMp3Encoder(logger commons.Logger, w io.Writer, opts *internal_encoder.EncoderOptions) (internal_encoder.Encoder, error) {
+	if opts.Channels != 1 && opts.Channels != 2 {
+		return nil, fmt.Errorf("mp3: unsupported number of channels %d", opts.Channels)
+	}
+	lame := C.lame_init()
+	if lame == nil {
+		return nil, fmt.Errorf("mp3: failed to create encoder")
+	}
+	mode := C.MONO
+	if opts.Channels == 2 {
+		mode = C.JOINT_STEREO
+	}
+	C.lame_set_in_samplerate(lame, C.int(opts.SampleRate))
+	C.lame_set_num_channels(lame, C.int(opts.Channels))
+	C.lame_set_mode(lame, C.MPEG_mode(mode))
+	C.lame_set_brate(lame, C.int(opts.Bitrate(32)))
+	if code := C.lame_init_params(lame); code < 0 {
+		C.lame_close(lame)
+		return nil, fmt.Errorf("mp3: failed to initialize encoder %d", int(code))
+	}
+	return &mp3Encoder{
+		logger: logger,
+		w:      w,
+		opts:   opts,
+		lame:   lame,
+		buffer: make([]byte, mp3FlushSize),
+	}, nil
+}
+
+func (enc *mp3Encoder) Codec() string {
+	return "mp3"
+}
+
+func (enc *mp3Encoder) Extension() string {
+	return "mp3"
+}
+
+func (enc *mp3Encoder) Encode(samples []int16) error {
+	n := len(samples) / int(enc.opts.Channels)
+	if n == 0 {
+		return nil
+	}
+	if size := n*5/4 + mp3FlushSize; len(enc.buffer) < size {
+		enc.buffer = make([]byte, size)
+	}
+	pcm := (*C.short)(unsafe.Pointer(&samples[0]))
+	out := (*C.uchar)(unsafe.Pointer(&enc.buffer[0]))
+	var written C.int
+	if enc.opts.Channels == 1 {
+		written = C.lame_encode_buffer(enc.lame, pcm, nil, C.int(n), out, C.int(len(enc.buffer)))
+	} else {
+		written = C.lame_encode_buffer_interleaved(enc.lame, pcm, C.int(n), out, C.int(len(enc.buffer)))
+	}
+	if written < 0 {
+		return fmt.Errorf("mp3: failed to encode samples %d", int(written))
+	}
+	_, err := enc.w.Write(enc.buffer[:written])
+	return err
+}
+
+func (enc *mp3Encoder) Close() error {
+	if enc.lame == nil {
+		return nil
+	}
+	defer func() {
+		C.lame_close(enc.lame)
+		enc.lame = nil
+	}()
+	written := C.lame_encode_flush(enc.lame, (*C.uchar)(unsafe.Pointer(&enc.buffer[0])), C.int(len(enc.buffer)))
+	if written < 0 {
+		return fmt.Errorf("mp3: failed to flush encoder %d", int(written))
+	}
+	_, err := enc.w.Write(enc.buffer[:written])
+	return err
+}
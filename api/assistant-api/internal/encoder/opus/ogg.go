@@ -0,0 +1,121 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_encoder_opus
+
+import (
+	"encoding/binary"
+	"io"
+)
+
+const (
+	oggHeaderSize  = 27
+	oggMaxSegments = 255
+
+	oggContinued = 0x01
+	oggBeginning = 0x02
+	oggEnd       = 0x04
+)
+
+var oggCRCTable = func() [256]uint32 {
+	var table [256]uint32
+	for i := range table {
+		crc := uint32(i) << 24
+		for j := 0; j < 8; j++ {
+			if crc&0x80000000 != 0 {
+				crc = crc<<1 ^ 0x04c11db7
+			} else {
+				crc <<= 1
+			}
+		}
+		table[i] = crc
+	}
+	return table
+}()
+
+func oggCRC(crc uint32, p []byte) uint32 {
+	for _, b := range p {
+		crc = crc<<8 ^ oggCRCTable[byte(crc>>24)^b]
+	}
+	return crc
+}
+
+// oggWriter writes packets of a single logical stream into ogg pages (RFC 3533)
+type oggWriter struct {
+	w        io.Writer
+	serial   uint32
+	sequence uint32
+	flags    byte
+
+	packets  [][]byte
+	segments int
+	granule  uint64
+}
+
+func newOggWriter(w io.Writer, serial uint32) *oggWriter {
+	return &oggWriter{w: w, serial: serial, flags: oggBeginning}
+}
+
+// WritePacket adds the packet to the page, granule is the position at the end of the packet
+func (ow *oggWriter) WritePacket(packet []byte, granule uint64) error {
+	segments := len(packet)/255 + 1
+	if ow.segments+segments > oggMaxSegments {
+		if err := ow.Flush(); err != nil {
+			return err
+		}
+	}
+	ow.packets = append(ow.packets, packet)
+	ow.segments += segments
+	ow.granule = granule
+	return nil
+}
+
+// Flush writes the pending packets as a page
+func (ow *oggWriter) Flush() error {
+	if len(ow.packets) == 0 {
+		return nil
+	}
+	return ow.writePage(0)
+}
+
+// Close writes the pending packets as the last page of the stream
+func (ow *oggWriter) Close() error {
+	return ow.writePage(oggEnd)
+}
+
+func (ow *oggWriter) writePage(flags byte) error {
+	size := 0
+	lacing := make([]byte, 0, ow.segments)
+	for _, packet := range ow.packets {
+		size += len(packet)
+		for n := len(packet); ; n -= 255 {
+			if n < 255 {
+				lacing = append(lacing, byte(n))
+				break
+			}
+			lacing = append(lacing, 255)
+		}
+	}
+
+	page := make([]byte, oggHeaderSize, oggHeaderSize+len(lacing)+size)
+	copy(page, "OggS")
+	page[5] = ow.flags | flags
+	binary.LittleEndian.PutUint64(page[6:], ow.granule)
+	binary.LittleEndian.PutUint32(page[14:], ow.serial)
+	binary.LittleEndian.PutUint32(page[18:], ow.sequence)
+	page[26] = byte(len(lacing))
+	page = append(page, lacing...)
+	for _, packet := range ow.packets {
+		page = append(page, packet...)
+	}
+	binary.LittleEndian.PutUint32(page[22:], oggCRC(0, page))
+
+	ow.flags = 0
+	ow.sequence++
+	ow.packets = ow.packets[:0]
+	ow.segments = 0
+	_, err := ow.w.Write(page)
+	return err
+}
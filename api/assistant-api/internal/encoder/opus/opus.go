@@ -0,0 +1,206 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_encoder_opus
+
+/*
+#cgo LDFLAGS: -lopus
+#include <opus/opus.h>
+
+// opus_encoder_ctl is variadic which cgo can not call
+static int rapida_opus_set_bitrate(OpusEncoder *enc, opus_int32 bitrate) {
+	return opus_encoder_ctl(enc, OPUS_SET_BITRATE(bitrate));
+}
+
+static int rapida_opus_get_lookahead(OpusEncoder *enc, opus_int32 *lookahead) {
+	return opus_encoder_ctl(enc, OPUS_GET_LOOKAHEAD(lookahead));
+}
+*/
+import "C"
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math/rand"
+	"unsafe"
+
+	internal_encoder "github.com/rapidaai/api/assistant-api/internal/encoder"
+	"github.com/rapidaai/pkg/commons"
+)
+
+const (
+	// granule positions of ogg opus are always counted at 48kHz
+	opusGranuleRate = 48000
+	opusFrameMs     = 20
+	opusMaxPacket   = 4000
+
+	// a page is written about every second of audio
+	opusPacketsPerPage = 1000 / opusFrameMs
+)
+
+type opusEncoder struct {
+	logger  commons.Logger
+	opts    *internal_encoder.EncoderOptions
+	encoder *C.OpusEncoder
+	ogg     *oggWriter
+
+	frameSize int
+	preSkip   uint64
+	pending   []int16
+	packet    []byte
+
+	// samples of a channel given and encoded so far
+	samples uint64
+	encoded uint64
+	packets int
+}
+
+// NewOpusEncoder writes opus in an ogg container (RFC 7845), sample rate must be one opus
+// takes, 8, 12, 16, 24 or 48 kHz
+func NewOpusEncoder(logger commons.Logger, w io.Writer, opts *internal_encoder.EncoderOptions) (internal_encoder.Encoder, error) {
+	switch opts.SampleRate {
+	case 8000, 12000, 16000, 24000, 48000:
+	default:
+		return nil, fmt.Errorf("opus: unsupported sample rate %d", opts.SampleRate)
+	}
+	if opts.Channels != 1 && opts.Channels != 2 {
+		return nil, fmt.Errorf("opus: unsupported number of channels %d", opts.Channels)
+	}
+
+	var code C.int
+	encoder := C.opus_encoder_create(C.opus_int32(opts.SampleRate), C.int(opts.Channels), C.OPUS_APPLICATION_VOIP, &code)
+	if code != C.OPUS_OK {
+		return nil, fmt.Errorf("opus: failed to create encoder %s", C.GoString(C.opus_strerror(code)))
+	}
+	if code = C.rapida_opus_set_bitrate(encoder, C.opus_int32(opts.Bitrate(24)*1000)); code != C.OPUS_OK {
+		C.opus_encoder_destroy(encoder)
+		return nil, fmt.Errorf("opus: failed to set bitrate %s", C.GoString(C.opus_strerror(code)))
+	}
+	var lookahead C.opus_int32
+	if code = C.rapida_opus_get_lookahead(encoder, &lookahead); code != C.OPUS_OK {
+		C.opus_encoder_destroy(encoder)
+		return nil, fmt.Errorf("opus: failed to get lookahead %s", C.GoString(C.opus_strerror(code)))
+	}
+
+	frameSize := int(opts.SampleRate) * opusFrameMs / 1000
+	enc := &opusEncoder{
+		logger:    logger,
+		opts:      opts,
+		encoder:   encoder,
+		ogg:       newOggWriter(w, rand.Uint32()),
+		frameSize: frameSize,
+		preSkip:   uint64(lookahead) * opusGranuleRate / uint64(opts.SampleRate),
+		pending:   make([]int16, 0, frameSize*int(opts.Channels)),
+		packet:    make([]byte, opusMaxPacket),
+	}
+	if err := enc.writeHeaders(); err != nil {
+		C.opus_encoder_destroy(encoder)
+		return nil, err
+	}
+	return enc, nil
+}
+
+func (enc *opusEncoder) Codec() string {
+	return "opus"
+}
+
+func (enc *opusEncoder) Extension() string {
+	return "ogg"
+}
+
+// writeHeaders writes identification and comment header, each on a page of its own
+func (enc *opusEncoder) writeHeaders() error {
+	head := make([]byte, 19)
+	copy(head, "OpusHead")
+	head[8] = 1
+	head[9] = byte(enc.opts.Channels)
+	binary.LittleEndian.PutUint16(head[10:], uint16(enc.preSkip))
+	binary.LittleEndian.PutUint32(head[12:], enc.opts.SampleRate)
+	// output gain 0 and channel mapping family 0
+	if err := enc.ogg.WritePacket(head, 0); err != nil {
+		return err
+	}
+	if err := enc.ogg.Flush(); err != nil {
+		return err
+	}
+
+	vendor := C.GoString(C.opus_get_version_string())
+	tags := make([]byte, 8+4+len(vendor)+4)
+	copy(tags, "OpusTags")
+	binary.LittleEndian.PutUint32(tags[8:], uint32(len(vendor)))
+	copy(tags[12:], vendor)
+	// no user comments
+	if err := enc.ogg.WritePacket(tags, 0); err != nil {
+		return err
+	}
+	return enc.ogg.Flush()
+}
+
+func (enc *opusEncoder) Encode(samples []int16) error {
+	enc.samples += uint64(len(samples) / int(enc.opts.Channels))
+	return enc.encode(samples)
+}
+
+func (enc *opusEncoder) encode(samples []int16) error {
+	frameSize := enc.frameSize * int(enc.opts.Channels)
+	for len(samples) > 0 {
+		n := min(frameSize-len(enc.pending), len(samples))
+		enc.pending = append(enc.pending, samples[:n]...)
+		samples = samples[n:]
+		if len(enc.pending) == frameSize {
+			if err := enc.writeFrame(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (enc *opusEncoder) writeFrame() error {
+	n := C.opus_encode(enc.encoder,
+		(*C.opus_int16)(unsafe.Pointer(&enc.pending[0])), C.int(enc.frameSize),
+		(*C.uchar)(unsafe.Pointer(&enc.packet[0])), C.opus_int32(len(enc.packet)))
+	if n < 0 {
+		return fmt.Errorf("opus: failed to encode frame %s", C.GoString(C.opus_strerror(C.int(n))))
+	}
+	enc.pending = enc.pending[:0]
+	enc.encoded += uint64(enc.frameSize)
+	enc.packets++
+
+	packet := make([]byte, int(n))
+	copy(packet, enc.packet)
+	if err := enc.ogg.WritePacket(packet, enc.encoded*opusGranuleRate/uint64(enc.opts.SampleRate)); err != nil {
+		return err
+	}
+	if enc.packets%opusPacketsPerPage == 0 {
+		return enc.ogg.Flush()
+	}
+	return nil
+}
+
+func (enc *opusEncoder) Close() error {
+	if enc.encoder == nil {
+		return nil
+	}
+	defer func() {
+		C.opus_encoder_destroy(enc.encoder)
+		enc.encoder = nil
+	}()
+
+	// the encoder delays audio by its lookahead, silence pushes the last samples out of it
+	// and the granule of the last page trims the stream to the samples given
+	channels := int(enc.opts.Channels)
+	lookahead := int(enc.preSkip * uint64(enc.opts.SampleRate) / opusGranuleRate)
+	padding := lookahead * channels
+	if rest := (len(enc.pending) + padding) % (enc.frameSize * channels); rest != 0 {
+		padding += enc.frameSize*channels - rest
+	}
+	if err := enc.encode(make([]int16, padding)); err != nil {
+		return err
+	}
+	enc.ogg.granule = enc.preSkip + enc.samples*opusGranuleRate/uint64(enc.opts.SampleRate)
+	return enc.ogg.Close()
+}
@@ -0,0 +1,86 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_encoder_wav
+
+import (
+	"encoding/binary"
+	"io"
+	"math"
+
+	internal_encoder "github.com/rapidaai/api/assistant-api/internal/encoder"
+	"github.com/rapidaai/pkg/commons"
+)
+
+const wavHeaderSize = 44
+
+type wavEncoder struct {
+	logger commons.Logger
+	w      io.Writer
+	opts   *internal_encoder.EncoderOptions
+	size   int64
+}
+
+// NewWavEncoder writes 16 bit pcm wav, sizes in header are written when the writer can seek
+// back to it on close and left at maximum for streaming readers otherwise
+func NewWavEncoder(logger commons.Logger, w io.Writer, opts *internal_encoder.EncoderOptions) (internal_encoder.Encoder, error) {
+	enc := &wavEncoder{logger: logger, w: w, opts: opts}
+	if _, err := w.Write(enc.header(math.MaxUint32 - wavHeaderSize)); err != nil {
+		return nil, err
+	}
+	return enc, nil
+}
+
+func (enc *wavEncoder) Codec() string {
+	return "wav"
+}
+
+func (enc *wavEncoder) Extension() string {
+	return "wav"
+}
+
+func (enc *wavEncoder) header(dataSize uint32) []byte {
+	header := make([]byte, wavHeaderSize)
+	channels := uint16(enc.opts.Channels)
+	copy(header[0:], "RIFF")
+	binary.LittleEndian.PutUint32(header[4:], dataSize+wavHeaderSize-8)
+	copy(header[8:], "WAVE")
+	copy(header[12:], "fmt ")
+	binary.LittleEndian.PutUint32(header[16:], 16)                                     // fmt chunk size
+	binary.LittleEndian.PutUint16(header[20:], 1)                                      // pcm
+	binary.LittleEndian.PutUint16(header[22:], channels)                               // channels
+	binary.LittleEndian.PutUint32(header[24:], enc.opts.SampleRate)                    // sample rate
+	binary.LittleEndian.PutUint32(header[28:], enc.opts.SampleRate*uint32(channels)*2) // byte rate
+	binary.LittleEndian.PutUint16(header[32:], channels*2)                             // block align
+	binary.LittleEndian.PutUint16(header[34:], 16)                                     // bits per sample
+	copy(header[36:], "data")
+	binary.LittleEndian.PutUint32(header[40:], dataSize)
+	return header
+}
+
+func (enc *wavEncoder) Encode(samples []int16) error {
+	data := make([]byte, len(samples)*2)
+	for i, sample := range samples {
+		binary.LittleEndian.PutUint16(data[i*2:], uint16(sample))
+	}
+	n, err := enc.w.Write(data)
+	enc.size += int64(n)
+	return err
+}
+
+func (enc *wavEncoder) Close() error {
+	ws, ok := enc.w.(io.WriteSeeker)
+	if !ok || enc.size > math.MaxUint32-wavHeaderSize {
+		return nil
+	}
+	if _, err := ws.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	if _, err := ws.Write(enc.header(uint32(enc.size))); err != nil {
+		return err
+	}
+	_, err := ws.Seek(0, io.SeekEnd)
+	return err
+}
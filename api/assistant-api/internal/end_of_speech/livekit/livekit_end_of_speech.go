@@ -1,15 +1,211 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
 package internal_livekit_end_of_speech
 
 import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
 	internal_end_of_speech "github.com/rapidaai/api/assistant-api/internal/end_of_speech"
 	"github.com/rapidaai/pkg/commons"
 	"github.com/rapidaai/pkg/utils"
 )
 
+// livekitEndOfSpeech decides end of turn semantically, the transcript of the
+// current turn is scored by the turn detector model and the silence timeout
+// is shortened when the user sounds done and stretched when they do not.
+type livekitEndOfSpeech struct {
+	mu         sync.Mutex
+	logger     commons.Logger
+	onCallback internal_end_of_speech.EndOfSpeechCallback
+	detector   turnPredictor
+
+	threshold  float64
+	minTimeout time.Duration
+	maxTimeout time.Duration
+
+	// transcript of the current turn, lastFinal is the last final segment of stt
+	// which is redelivered by some providers
+	committed string
+	lastFinal string
+	interim   string
+	timeout   time.Duration
+	revision  uint64
+
+	generation    uint64
+	currentCancel context.CancelFunc
+}
+
 func NewLivekitEndOfSpeech(
 	logger commons.Logger,
 	onCallback internal_end_of_speech.EndOfSpeechCallback,
 	opts utils.Option,
 ) (internal_end_of_speech.EndOfSpeech, error) {
-	return nil, nil
+	minTimeout := time.Duration(500) * time.Millisecond
+	if timeOut, err := opts.GetFloat64("microphone.eos.timeout"); err == nil {
+		logger.Debugf("overriding default minimum timeout for livekit eos.")
+		minTimeout = time.Duration(timeOut) * time.Millisecond
+	}
+	maxTimeout := time.Duration(3000) * time.Millisecond
+	if timeOut, err := opts.GetFloat64("microphone.eos.max_timeout"); err == nil {
+		logger.Debugf("overriding default maximum timeout for livekit eos.")
+		maxTimeout = time.Duration(timeOut) * time.Millisecond
+	}
+	if maxTimeout < minTimeout {
+		maxTimeout = minTimeout
+	}
+	threshold := 0.15
+	if thr, err := opts.GetFloat64("microphone.eos.threshold"); err == nil {
+		threshold = thr
+	}
+
+	detector, err := getTurnDetector()
+	if err != nil {
+		logger.Errorf("unable to load livekit turn detector %+v", err)
+		return nil, err
+	}
+	return &livekitEndOfSpeech{
+		logger:     logger,
+		onCallback: onCallback,
+		detector:   detector,
+		threshold:  threshold,
+		minTimeout: minTimeout,
+		maxTimeout: maxTimeout,
+		timeout:    maxTimeout,
+	}, nil
+}
+
+func (eos *livekitEndOfSpeech) Name() string {
+	return "livekitEndOfSpeech"
+}
+
+func (eos *livekitEndOfSpeech) Analyze(ctx context.Context, msg internal_end_of_speech.EndOfSpeechInput) error {
+	switch input := msg.(type) {
+	case *internal_end_of_speech.UserEndOfSpeechInput:
+		// typed text is always a complete turn
+		return eos.triggerExtension(ctx, input.GetMessage(), eos.minTimeout)
+
+	case *internal_end_of_speech.SystemEndOfSpeechInput:
+		// user is still making noise, keep waiting with last predicted timeout
+		eos.mu.Lock()
+		speech, timeout := eos.transcriptLocked(), eos.timeout
+		eos.mu.Unlock()
+		return eos.triggerExtension(ctx, speech, timeout)
+
+	case *internal_end_of_speech.STTEndOfSpeechInput:
+		return eos.handleSTTInput(ctx, input)
+	}
+	return nil
+}
+
+func (eos *livekitEndOfSpeech) handleSTTInput(ctx context.Context, input *internal_end_of_speech.STTEndOfSpeechInput) error {
+	eos.mu.Lock()
+	if input.IsComplete {
+		// a duplicate final of the same segment is scored again but not appended twice
+		if final := normalizeUtterance(input.GetMessage()); final != eos.lastFinal {
+			eos.committed = strings.TrimSpace(eos.committed + " " + input.GetMessage())
+			eos.lastFinal = final
+		}
+		eos.interim = ""
+	} else {
+		eos.interim = input.GetMessage()
+	}
+	eos.revision++
+	revision := eos.revision
+	speech := eos.transcriptLocked()
+	eos.mu.Unlock()
+
+	timeout := eos.maxTimeout
+	probability, err := eos.detector.Predict(speech)
+	if err != nil {
+		eos.logger.Warnf("turn detector failed, falling back to maximum timeout %+v", err)
+	} else {
+		timeout = eos.timeoutFor(probability)
+		eos.logger.Debugf("turn detector probability %.4f for '%s', waiting %v", probability, speech, timeout)
+	}
+
+	eos.mu.Lock()
+	if revision != eos.revision {
+		// newer transcript arrived while scoring, it will schedule its own timer
+		eos.mu.Unlock()
+		return nil
+	}
+	eos.timeout = timeout
+	eos.mu.Unlock()
+	return eos.triggerExtension(ctx, speech, timeout)
+}
+
+// timeoutFor maps end of turn probability to silence timeout, anything above
+// threshold gets the minimum timeout and lower probabilities scale towards maximum.
+func (eos *livekitEndOfSpeech) timeoutFor(probability float64) time.Duration {
+	if probability >= eos.threshold || eos.threshold <= 0 {
+		return eos.minTimeout
+	}
+	ratio := probability / eos.threshold
+	if ratio < 0 {
+		ratio = 0
+	}
+	return eos.maxTimeout - time.Duration(float64(eos.maxTimeout-eos.minTimeout)*ratio)
+}
+
+func (eos *livekitEndOfSpeech) transcriptLocked() string {
+	return strings.TrimSpace(eos.committed + " " + eos.interim)
+}
+
+func (eos *livekitEndOfSpeech) triggerExtension(ctx context.Context, speech string, duration time.Duration) error {
+	eos.mu.Lock()
+	if eos.currentCancel != nil {
+		eos.currentCancel()
+	}
+	eos.generation++
+	generation := eos.generation
+	timerCtx, cancel := context.WithCancel(ctx)
+	eos.currentCancel = cancel
+	eos.mu.Unlock()
+
+	start := time.Now()
+	timer := time.NewTimer(duration)
+	select {
+	case <-timerCtx.Done():
+		timer.Stop()
+		return timerCtx.Err()
+	case <-timer.C:
+	}
+
+	eos.mu.Lock()
+	if generation != eos.generation {
+		eos.mu.Unlock()
+		return nil
+	}
+	eos.committed, eos.lastFinal, eos.interim = "", "", ""
+	eos.timeout = eos.maxTimeout
+	eos.mu.Unlock()
+
+	if speech == "" {
+		return nil
+	}
+	seg := &internal_end_of_speech.EndOfSpeechResult{
+		StartAt: float64(start.UnixNano()) / 1e9,
+		EndAt:   float64(time.Now().UnixNano()) / 1e9,
+		Speech:  speech,
+	}
+	eos.logger.Debugf("end of turn detected. Speech segment: '%s', Silence duration: %.2f ms", speech, seg.GetDuration()*1000)
+	if err := eos.onCallback(timerCtx, seg); err != nil {
+		eos.logger.Errorf("interrupt: Error in onAnalyze: %v", err)
+	}
+	return nil
+}
+
+func (eos *livekitEndOfSpeech) Close() error {
+	eos.mu.Lock()
+	defer eos.mu.Unlock()
+	if eos.currentCancel != nil {
+		eos.currentCancel()
+	}
+	return nil
 }
@@ -0,0 +1,152 @@
+package internal_livekit_end_of_speech
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	internal_end_of_speech "github.com/rapidaai/api/assistant-api/internal/end_of_speech"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubPredictor stands in for the onnx model, it scores utterances from a fixed table
+type stubPredictor struct {
+	mu         sync.Mutex
+	scores     map[string]float64
+	err        error
+	utterances []string
+}
+
+func (s *stubPredictor) Predict(utterance string) (float64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.utterances = append(s.utterances, utterance)
+	if s.err != nil {
+		return 0, s.err
+	}
+	return s.scores[utterance], nil
+}
+
+func newTestEndOfSpeech(t *testing.T, predictor turnPredictor, results chan *internal_end_of_speech.EndOfSpeechResult) *livekitEndOfSpeech {
+	logger := commons.NewApplicationLoggerWithOptions(commons.EnableFile(false), commons.Level("error"))
+	logger.InitLogger()
+	return &livekitEndOfSpeech{
+		logger: logger,
+		onCallback: func(_ context.Context, res *internal_end_of_speech.EndOfSpeechResult) error {
+			results <- res
+			return nil
+		},
+		detector:   predictor,
+		threshold:  0.5,
+		minTimeout: 20 * time.Millisecond,
+		maxTimeout: 400 * time.Millisecond,
+		timeout:    400 * time.Millisecond,
+	}
+}
+
+func TestTimeoutFor(t *testing.T) {
+	eos := &livekitEndOfSpeech{threshold: 0.5, minTimeout: 100 * time.Millisecond, maxTimeout: 500 * time.Millisecond}
+	assert.Equal(t, 100*time.Millisecond, eos.timeoutFor(0.9))
+	assert.Equal(t, 100*time.Millisecond, eos.timeoutFor(0.5))
+	assert.Equal(t, 300*time.Millisecond, eos.timeoutFor(0.25))
+	assert.Equal(t, 500*time.Millisecond, eos.timeoutFor(0))
+}
+
+func TestNormalizeUtterance(t *testing.T) {
+	assert.Equal(t, "i'm done thanks", normalizeUtterance("  I'm done, thanks! "))
+	assert.Equal(t, "well-known fact", normalizeUtterance("Well-known fact..."))
+}
+
+func TestAnalyzeCompleteTurn(t *testing.T) {
+	predictor := &stubPredictor{scores: map[string]float64{"book a table for two": 0.9}}
+	results := make(chan *internal_end_of_speech.EndOfSpeechResult, 1)
+	eos := newTestEndOfSpeech(t, predictor, results)
+
+	start := time.Now()
+	require.NoError(t, eos.Analyze(context.Background(), &internal_end_of_speech.STTEndOfSpeechInput{
+		Message: "book a table for two", IsComplete: true, Time: time.Now(),
+	}))
+	res := <-results
+	assert.Equal(t, "book a table for two", res.Speech)
+	// a complete sounding turn only waits for the minimum timeout
+	assert.Less(t, time.Since(start), eos.maxTimeout)
+}
+
+func TestAnalyzeIncompleteTurnWaitsLonger(t *testing.T) {
+	predictor := &stubPredictor{scores: map[string]float64{"i would like to": 0}}
+	results := make(chan *internal_end_of_speech.EndOfSpeechResult, 1)
+	eos := newTestEndOfSpeech(t, predictor, results)
+
+	start := time.Now()
+	require.NoError(t, eos.Analyze(context.Background(), &internal_end_of_speech.STTEndOfSpeechInput{
+		Message: "i would like to", IsComplete: true, Time: time.Now(),
+	}))
+	<-results
+	assert.GreaterOrEqual(t, time.Since(start), eos.maxTimeout)
+}
+
+func TestAnalyzeAccumulatesTranscript(t *testing.T) {
+	predictor := &stubPredictor{scores: map[string]float64{"hello there how are you": 0.9}}
+	results := make(chan *internal_end_of_speech.EndOfSpeechResult, 1)
+	eos := newTestEndOfSpeech(t, predictor, results)
+
+	ctx := context.Background()
+	go eos.Analyze(ctx, &internal_end_of_speech.STTEndOfSpeechInput{Message: "hello there", IsComplete: true})
+	time.Sleep(5 * time.Millisecond)
+	require.NoError(t, eos.Analyze(ctx, &internal_end_of_speech.STTEndOfSpeechInput{Message: "how are you", IsComplete: false}))
+
+	res := <-results
+	assert.Equal(t, "hello there how are you", res.Speech)
+	select {
+	case extra := <-results:
+		t.Fatalf("unexpected second end of turn %q", extra.Speech)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestAnalyzeDedupesFinalTranscripts(t *testing.T) {
+	predictor := &stubPredictor{scores: map[string]float64{"Hello there.": 0.9}}
+	results := make(chan *internal_end_of_speech.EndOfSpeechResult, 1)
+	eos := newTestEndOfSpeech(t, predictor, results)
+
+	ctx := context.Background()
+	go eos.Analyze(ctx, &internal_end_of_speech.STTEndOfSpeechInput{Message: "Hello there.", IsComplete: true})
+	time.Sleep(5 * time.Millisecond)
+	// the same final segment redelivered by the provider
+	require.NoError(t, eos.Analyze(ctx, &internal_end_of_speech.STTEndOfSpeechInput{Message: "hello there", IsComplete: true}))
+
+	res := <-results
+	assert.Equal(t, "Hello there.", res.Speech)
+	predictor.mu.Lock()
+	defer predictor.mu.Unlock()
+	assert.Equal(t, []string{"Hello there.", "Hello there."}, predictor.utterances)
+}
+
+func TestAnalyzeFallsBackToMaximumTimeout(t *testing.T) {
+	predictor := &stubPredictor{err: errors.New("model not loaded")}
+	results := make(chan *internal_end_of_speech.EndOfSpeechResult, 1)
+	eos := newTestEndOfSpeech(t, predictor, results)
+
+	start := time.Now()
+	require.NoError(t, eos.Analyze(context.Background(), &internal_end_of_speech.STTEndOfSpeechInput{
+		Message: "okay", IsComplete: true,
+	}))
+	res := <-results
+	assert.Equal(t, "okay", res.Speech)
+	assert.GreaterOrEqual(t, time.Since(start), eos.maxTimeout)
+}
+
+func TestAnalyzeTypedTextIsCompleteTurn(t *testing.T) {
+	predictor := &stubPredictor{}
+	results := make(chan *internal_end_of_speech.EndOfSpeechResult, 1)
+	eos := newTestEndOfSpeech(t, predictor, results)
+
+	require.NoError(t, eos.Analyze(context.Background(), &internal_end_of_speech.UserEndOfSpeechInput{Message: "hi"}))
+	assert.Equal(t, "hi", (<-results).Speech)
+	// typed text is not scored by the model
+	assert.Empty(t, predictor.utterances)
+}
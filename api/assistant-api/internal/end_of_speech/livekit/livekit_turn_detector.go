@@ -0,0 +1,163 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_livekit_end_of_speech
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+	"unicode"
+
+	"github.com/sugarme/tokenizer"
+	"github.com/sugarme/tokenizer/pretrained"
+	ort "github.com/yalue/onnxruntime_go"
+)
+
+const (
+	// maximum number of tokens of context passed to the model, older tokens are dropped
+	maxTurnDetectorTokens = 128
+	// the model is trained on chat template, the end of turn probability is the
+	// probability of <|im_end|> following the user utterance
+	turnDetectorUserPrefix = "<|im_start|>user\n"
+)
+
+var (
+	sharedDetector     *turnDetector
+	sharedDetectorErr  error
+	sharedDetectorOnce sync.Once
+)
+
+// turnPredictor scores how likely a user utterance is a complete turn
+type turnPredictor interface {
+	Predict(utterance string) (float64, error)
+}
+
+// turnDetector scores how likely a user utterance is a complete turn
+// using livekit's turn detector onnx model.
+type turnDetector struct {
+	mu         sync.Mutex
+	session    *ort.DynamicAdvancedSession
+	tokenizer  *tokenizer.Tokenizer
+	inputName  string
+	outputName string
+}
+
+// getTurnDetector returns process wide detector, the model is large enough
+// that loading it for every conversation is not acceptable.
+func getTurnDetector() (*turnDetector, error) {
+	sharedDetectorOnce.Do(func() {
+		sharedDetector, sharedDetectorErr = newTurnDetector()
+	})
+	return sharedDetector, sharedDetectorErr
+}
+
+func newTurnDetector() (*turnDetector, error) {
+	_, path, _, _ := runtime.Caller(0)
+	modelPath := os.Getenv("LIVEKIT_TURN_DETECTOR_MODEL_PATH")
+	if modelPath == "" {
+		modelPath = filepath.Join(filepath.Dir(path), "models/livekit_turn_detector_q8.onnx")
+	}
+	tokenizerPath := os.Getenv("LIVEKIT_TURN_DETECTOR_TOKENIZER_PATH")
+	if tokenizerPath == "" {
+		tokenizerPath = filepath.Join(filepath.Dir(modelPath), "tokenizer.json")
+	}
+
+	if !ort.IsInitialized() {
+		libraryPath := os.Getenv("ONNXRUNTIME_LIB_PATH")
+		if libraryPath == "" {
+			libraryPath = "/opt/onnxruntime/lib/libonnxruntime.so"
+		}
+		ort.SetSharedLibraryPath(libraryPath)
+		if err := ort.InitializeEnvironment(); err != nil {
+			return nil, fmt.Errorf("unable to initialize onnxruntime: %w", err)
+		}
+	}
+
+	tk, err := pretrained.FromFile(tokenizerPath)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load turn detector tokenizer: %w", err)
+	}
+
+	inputs, outputs, err := ort.GetInputOutputInfo(modelPath)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read turn detector model: %w", err)
+	}
+	if len(inputs) == 0 || len(outputs) == 0 {
+		return nil, fmt.Errorf("turn detector model has no input or output")
+	}
+
+	session, err := ort.NewDynamicAdvancedSession(modelPath,
+		[]string{inputs[0].Name},
+		[]string{outputs[0].Name}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create turn detector session: %w", err)
+	}
+	return &turnDetector{
+		session:    session,
+		tokenizer:  tk,
+		inputName:  inputs[0].Name,
+		outputName: outputs[0].Name,
+	}, nil
+}
+
+// Predict returns the probability of the given user utterance being end of turn.
+func (d *turnDetector) Predict(utterance string) (float64, error) {
+	encoding, err := d.tokenizer.EncodeSingle(turnDetectorUserPrefix+normalizeUtterance(utterance), false)
+	if err != nil {
+		return 0, fmt.Errorf("unable to tokenize utterance: %w", err)
+	}
+	ids := encoding.GetIds()
+	if len(ids) > maxTurnDetectorTokens {
+		ids = ids[len(ids)-maxTurnDetectorTokens:]
+	}
+	inputIds := make([]int64, len(ids))
+	for i, id := range ids {
+		inputIds[i] = int64(id)
+	}
+
+	input, err := ort.NewTensor(ort.NewShape(1, int64(len(inputIds))), inputIds)
+	if err != nil {
+		return 0, err
+	}
+	defer input.Destroy()
+
+	d.mu.Lock()
+	outputs := []ort.ArbitraryTensor{nil}
+	err = d.session.Run([]ort.ArbitraryTensor{input}, outputs)
+	d.mu.Unlock()
+	if err != nil {
+		return 0, fmt.Errorf("unable to run turn detector: %w", err)
+	}
+	defer outputs[0].Destroy()
+
+	probabilities, ok := outputs[0].(*ort.Tensor[float32])
+	if !ok {
+		return 0, fmt.Errorf("unexpected turn detector output type %T", outputs[0])
+	}
+	data := probabilities.GetData()
+	if len(data) == 0 {
+		return 0, fmt.Errorf("empty turn detector output")
+	}
+	return float64(data[len(data)-1]), nil
+}
+
+// normalizeUtterance matches the normalization the model was trained on,
+// lower case without punctuation except apostrophe and hyphen.
+func normalizeUtterance(utterance string) string {
+	normalized := strings.Map(func(r rune) rune {
+		if r == '\'' || r == '-' {
+			return r
+		}
+		if unicode.IsPunct(r) || unicode.IsSymbol(r) {
+			return -1
+		}
+		return unicode.ToLower(r)
+	}, utterance)
+	return strings.Join(strings.Fields(normalized), " ")
+}
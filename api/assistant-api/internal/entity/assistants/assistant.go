@@ -46,6 +46,9 @@ type Assistant struct {
 	AssistantTools               []*AssistantTool                                    `json:"assistantTools"  gorm:"foreignKey:AssistantId"`
 	AssistantAnalyses            []*AssistantAnalysis                                `json:"assistantAnalyses"  gorm:"foreignKey:AssistantId"`
 	AssistantWebhooks            []*AssistantWebhook                                 `json:"assistantWebhooks"  gorm:"foreignKey:AssistantId"`
+	AssistantModerators          []*AssistantModerator                               `json:"assistantModerators"  gorm:"foreignKey:AssistantId"`
+	AssistantLexicons            []*AssistantLexicon                                 `json:"assistantLexicons"  gorm:"foreignKey:AssistantId"`
+	AssistantEvaluators          []*AssistantEvaluator                               `json:"assistantEvaluators"  gorm:"foreignKey:AssistantId"`
 }
 
 func (a *Assistant) IsPhoneDeploymentEnable() bool {
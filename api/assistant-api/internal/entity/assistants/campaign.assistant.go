@@ -0,0 +1,178 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_assistant_entity
+
+import (
+	"fmt"
+	"time"
+
+	gorm_model "github.com/rapidaai/pkg/models/gorm"
+	gorm_types "github.com/rapidaai/pkg/models/gorm/types"
+)
+
+// state of the campaign, status of the record is only used to archive it
+type CampaignState string
+
+const (
+	CAMPAIGN_RUNNING   CampaignState = "RUNNING"
+	CAMPAIGN_PAUSED    CampaignState = "PAUSED"
+	CAMPAIGN_COMPLETED CampaignState = "COMPLETED"
+	CAMPAIGN_CANCELLED CampaignState = "CANCELLED"
+)
+
+// state of a contact of the campaign
+type CampaignContactState string
+
+const (
+	// waiting for the first attempt or the next retry
+	CONTACT_PENDING CampaignContactState = "PENDING"
+	// call is placed and the outcome is not known yet
+	CONTACT_DIALING   CampaignContactState = "DIALING"
+	CONTACT_COMPLETED CampaignContactState = "COMPLETED"
+	// outcome is not retryable or the attempts are exhausted
+	CONTACT_FAILED CampaignContactState = "FAILED"
+	// campaign was cancelled before the contact was called
+	CONTACT_CANCELLED CampaignContactState = "CANCELLED"
+)
+
+type AssistantCampaign struct {
+	gorm_model.Audited
+	gorm_model.Mutable
+	gorm_model.Organizational
+
+	Name             string `json:"name" gorm:"type:string;size:200;not null"`
+	AssistantId      uint64 `json:"assistantId" gorm:"type:bigint;not null"`
+	AssistantVersion string `json:"assistantVersion" gorm:"type:string;size:50"`
+	// empty uses the number of the phone deployment
+	FromNumber string        `json:"fromNumber" gorm:"type:string;size:50"`
+	State      CampaignState `json:"state" gorm:"type:string;size:50;not null"`
+
+	// pacing
+	Concurrency    uint32  `json:"concurrency" gorm:"type:int;not null"`
+	CallsPerSecond float64 `json:"callsPerSecond" gorm:"type:double precision;not null"`
+
+	// calling hours as HH:MM in the timezone of the contact or the campaign, empty is any time
+	Timezone         string                 `json:"timezone" gorm:"type:string;size:100"`
+	CallingHourStart string                 `json:"callingHourStart" gorm:"type:string;size:5"`
+	CallingHourEnd   string                 `json:"callingHourEnd" gorm:"type:string;size:5"`
+	StartDate        gorm_model.TimeWrapper `json:"startDate" gorm:"type:timestamp;default:null"`
+
+	// retries
+	MaxAttempts      uint32                 `json:"maxAttempts" gorm:"type:int;not null"`
+	RetryDelaySecond uint32                 `json:"retryDelaySecond" gorm:"type:int;not null"`
+	RetryOutcomes    gorm_types.StringArray `json:"retryOutcomes" gorm:"type:string"`
+
+	// project credential of the creator sealed with the service secret, telephony providers call back with it
+	CredentialToken string `json:"-" gorm:"type:text;not null"`
+	// set while dialing to pace the calls across workers
+	LastDialDate gorm_model.TimeWrapper `json:"lastDialDate" gorm:"type:timestamp;default:null"`
+
+	Progress *AssistantCampaignProgress `json:"progress" gorm:"-"`
+}
+
+// number of contacts of the campaign per state
+type AssistantCampaignProgress struct {
+	Total     uint64 `json:"total"`
+	Pending   uint64 `json:"pending"`
+	Dialing   uint64 `json:"dialing"`
+	Completed uint64 `json:"completed"`
+	Failed    uint64 `json:"failed"`
+	Cancelled uint64 `json:"cancelled"`
+}
+
+func (ac *AssistantCampaign) GetTimezone() string {
+	if ac.Timezone == "" {
+		return "UTC"
+	}
+	return ac.Timezone
+}
+
+func (ac *AssistantCampaign) GetRetryOutcomes() []string {
+	return ac.RetryOutcomes
+}
+
+// NextCallingTime returns now when it is within the calling hours in the given timezone,
+// otherwise the time the calling hours open next. Windows past midnight like 20:00 to 02:00 are allowed.
+func (ac *AssistantCampaign) NextCallingTime(now time.Time, timezone string) (time.Time, error) {
+	if ac.CallingHourStart == "" || ac.CallingHourEnd == "" {
+		return now, nil
+	}
+	if timezone == "" {
+		timezone = ac.GetTimezone()
+	}
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return now, fmt.Errorf("invalid timezone %s", timezone)
+	}
+	openAt, err := minuteOfDay(ac.CallingHourStart)
+	if err != nil {
+		return now, err
+	}
+	closeAt, err := minuteOfDay(ac.CallingHourEnd)
+	if err != nil {
+		return now, err
+	}
+
+	local := now.In(loc)
+	current := local.Hour()*60 + local.Minute()
+	if openAt == closeAt {
+		return now, nil
+	}
+	if openAt < closeAt && current >= openAt && current < closeAt {
+		return now, nil
+	}
+	if openAt > closeAt && (current >= openAt || current < closeAt) {
+		return now, nil
+	}
+	next := time.Date(local.Year(), local.Month(), local.Day(), openAt/60, openAt%60, 0, 0, loc)
+	if current >= openAt {
+		next = next.AddDate(0, 0, 1)
+	}
+	return next, nil
+}
+
+// ValidateCallingHours checks the calling hours and the timezone of the campaign
+func (ac *AssistantCampaign) ValidateCallingHours() error {
+	if _, err := time.LoadLocation(ac.GetTimezone()); err != nil {
+		return fmt.Errorf("invalid timezone %s", ac.Timezone)
+	}
+	if ac.CallingHourStart == "" && ac.CallingHourEnd == "" {
+		return nil
+	}
+	if _, err := minuteOfDay(ac.CallingHourStart); err != nil {
+		return err
+	}
+	_, err := minuteOfDay(ac.CallingHourEnd)
+	return err
+}
+
+func minuteOfDay(hm string) (int, error) {
+	t, err := time.Parse("15:04", hm)
+	if err != nil {
+		return 0, fmt.Errorf("invalid calling hour %s, expected HH:MM", hm)
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+type AssistantCampaignContact struct {
+	gorm_model.Audited
+	gorm_model.Mutable
+	AssistantCampaignId uint64                  `json:"assistantCampaignId" gorm:"type:bigint;not null"`
+	ToNumber            string                  `json:"toNumber" gorm:"type:string;size:50;not null"`
+	Arguments           gorm_types.InterfaceMap `json:"arguments" gorm:"type:jsonb"`
+	Metadata            gorm_types.InterfaceMap `json:"metadata" gorm:"type:jsonb"`
+	Options             gorm_types.InterfaceMap `json:"options" gorm:"type:jsonb"`
+	// overrides the timezone of the campaign for the calling hours
+	Timezone string `json:"timezone" gorm:"type:string;size:100"`
+
+	State                   CampaignContactState   `json:"state" gorm:"type:string;size:50;not null"`
+	Attempts                uint32                 `json:"attempts" gorm:"type:int;not null"`
+	NextAttemptDate         gorm_model.TimeWrapper `json:"nextAttemptDate" gorm:"type:timestamp;default:null"`
+	AssistantConversationId uint64                 `json:"assistantConversationId" gorm:"type:bigint"`
+	// busy, no-answer, failed or completed
+	LastOutcome string `json:"lastOutcome" gorm:"type:string;size:50"`
+	LastError   string `json:"lastError" gorm:"type:text"`
+}
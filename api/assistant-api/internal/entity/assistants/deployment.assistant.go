@@ -39,6 +39,14 @@ type AssistantDeploymentWhatsapp struct {
 	WhatsappOptions  []*AssistantDeploymentWhatsappOption `json:"whatsappOptions"  gorm:"foreignKey:AssistantDeploymentWhatsappId"`
 }
 
+func (a *AssistantDeploymentWhatsapp) GetOptions() utils.Option {
+	opts := make(map[string]interface{})
+	for _, v := range a.WhatsappOptions {
+		opts[v.Key] = v.Value
+	}
+	return opts
+}
+
 type AssistantDeploymentWhatsappOption struct {
 	gorm_model.Audited
 	gorm_model.Mutable
@@ -89,6 +97,7 @@ type AssistantDeploymentBehavior struct {
 	Mistake             *string `json:"mistake" gorm:"type:string;size:50;not null;"`
 	IdealTimeout        *uint64 `json:"idealTimeout"`
 	IdealTimeoutMessage *string `json:"idealTimeoutMessage" gorm:"type:string;size:50;not null;"`
+	IdealTimeoutRetry   *uint64 `json:"idealTimeoutRetry"`
 	MaxSessionDuration  *uint64 `json:"maxSessionDuration"`
 }
 
@@ -0,0 +1,48 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_assistant_entity
+
+import gorm_model "github.com/rapidaai/pkg/models/gorm"
+
+// AssistantLexicon tells the speaker how a word or a pattern is pronounced,
+// either by an alias spoken instead of it or by a phoneme in ipa or x-sampa.
+type AssistantLexicon struct {
+	gorm_model.Audited
+	gorm_model.Mutable
+
+	AssistantId   uint64 `json:"assistantId" gorm:"type:bigint;not null"`
+	Phrase        string `json:"phrase" gorm:"type:string;size:400;not null"`
+	MatchType     string `json:"matchType" gorm:"type:string;size:20;default:word"`
+	CaseSensitive bool   `json:"caseSensitive" gorm:"type:bool;default:false"`
+	Alias         string `json:"alias" gorm:"type:text"`
+	Phoneme       string `json:"phoneme" gorm:"type:text"`
+	Alphabet      string `json:"alphabet" gorm:"type:string;size:20"`
+	Description   string `json:"description" gorm:"type:text"`
+}
+
+func (al *AssistantLexicon) GetPhrase() string {
+	return al.Phrase
+}
+
+func (al *AssistantLexicon) GetMatchType() string {
+	return al.MatchType
+}
+
+func (al *AssistantLexicon) IsCaseSensitive() bool {
+	return al.CaseSensitive
+}
+
+func (al *AssistantLexicon) GetAlias() string {
+	return al.Alias
+}
+
+func (al *AssistantLexicon) GetPhoneme() string {
+	return al.Phoneme
+}
+
+func (al *AssistantLexicon) GetAlphabet() string {
+	return al.Alphabet
+}
@@ -28,6 +28,9 @@ type AssistantWebhook struct {
 	MaxRetryCount     uint32                 `json:"maxRetryCount" gorm:"type:int"`
 	TimeoutSeconds    uint32                 `json:"timeoutSecond" gorm:"type:int"`
 	ExecutionPriority uint32                 `json:"executionPriority" gorm:"type:int"`
+
+	// vault credential holding the secret used to sign the payload
+	SecretCredentialId uint64 `json:"secretCredentialId" gorm:"type:bigint"`
 }
 
 func (aa *AssistantWebhook) GetExecutionPriority() uint32 {
@@ -62,6 +65,10 @@ func (aa *AssistantWebhook) GetTimeoutSecond() uint32 {
 	return aa.TimeoutSeconds
 }
 
+func (aa *AssistantWebhook) GetSecretCredentialId() uint64 {
+	return aa.SecretCredentialId
+}
+
 type AssistantWebhookLog struct {
 	gorm_model.Audited
 	gorm_model.Mutable
@@ -76,4 +83,9 @@ type AssistantWebhookLog struct {
 	ResponseStatus          int64  `json:"responseStatus" gorm:"type:bigint;size:10"`
 	TimeTaken               int64  `json:"timeTaken" gorm:"type:bigint;size:20"`
 	RetryCount              uint32 `json:"retryCount" gorm:"type:bigint;size:20"`
+
+	// delivery queue
+	IdempotencyKey  string                 `json:"idempotencyKey" gorm:"type:string;size:200"`
+	NextAttemptDate gorm_model.TimeWrapper `json:"nextAttemptDate" gorm:"type:timestamp;default:null"`
+	LastError       string                 `json:"lastError" gorm:"type:text"`
 }
@@ -7,6 +7,7 @@ package internal_conversation_gorm
 
 import (
 	gorm_model "github.com/rapidaai/pkg/models/gorm"
+	gorm_types "github.com/rapidaai/pkg/models/gorm/types"
 	type_enums "github.com/rapidaai/pkg/types/enums"
 	"github.com/rapidaai/pkg/utils"
 )
@@ -68,4 +69,13 @@ type AssistantConversationRecording struct {
 	AssistantId             uint64 `json:"assistantId" gorm:"type:bigint;not null"`
 	AssistantConversationId uint64 `json:"assistantConversationId" gorm:"type:bigint;not null"`
 	RecordingUrl            string `json:"recordingUrl" gorm:"type:string;not null"`
+	Codec                   string `json:"codec" gorm:"type:string;size:20"`
+	Channels                uint32 `json:"channels" gorm:"type:int"`
+	SampleRate              uint32 `json:"sampleRate" gorm:"type:int"`
+	// duration in milliseconds
+	Duration uint64 `json:"duration" gorm:"type:bigint"`
+	// size in bytes
+	Size uint64 `json:"size" gorm:"type:bigint"`
+	// offsets in milliseconds where the user interrupted the assistant
+	Interruptions gorm_types.IntArray `json:"interruptions" gorm:"type:jsonb"`
 }
@@ -0,0 +1,42 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_conversation_gorm
+
+import (
+	gorm_model "github.com/rapidaai/pkg/models/gorm"
+)
+
+// AssistantConversationEvaluation is the outcome of an evaluator for a completed conversation
+type AssistantConversationEvaluation struct {
+	gorm_model.Audited
+	gorm_model.Mutable
+	gorm_model.Organizational
+	AssistantId              uint64 `json:"assistantId" gorm:"type:bigint;not null"`
+	AssistantProviderModelId uint64 `json:"assistantProviderModelId" gorm:"type:bigint;not null"`
+	AssistantConversationId  uint64 `json:"assistantConversationId" gorm:"type:bigint;not null"`
+	AssistantEvaluatorId     uint64 `json:"assistantEvaluatorId" gorm:"type:bigint;not null"`
+
+	Name   string  `json:"name" gorm:"type:string;size:200"`
+	Type   string  `json:"type" gorm:"type:string;size:50"`
+	Score  float64 `json:"score" gorm:"type:double precision"`
+	Passed bool    `json:"passed" gorm:"type:bool"`
+	Reason string  `json:"reason" gorm:"type:text"`
+}
+
+// AssistantConversationEvaluationSummary is rollup of evaluations of an assistant version,
+// it is not backed by a table.
+type AssistantConversationEvaluationSummary struct {
+	AssistantProviderModelId uint64  `json:"assistantProviderModelId"`
+	AssistantEvaluatorId     uint64  `json:"assistantEvaluatorId"`
+	Name                     string  `json:"name"`
+	Type                     string  `json:"type"`
+	Count                    uint64  `json:"count"`
+	Passed                   uint64  `json:"passed"`
+	PassRate                 float64 `json:"passRate"`
+	AverageScore             float64 `json:"averageScore"`
+	MinScore                 float64 `json:"minScore"`
+	MaxScore                 float64 `json:"maxScore"`
+}
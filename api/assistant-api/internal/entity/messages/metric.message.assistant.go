@@ -16,3 +16,13 @@ type AssistantConversationMessageMetric struct {
 	AssistantConversationId        uint64 `json:"assistantConversationId" gorm:"type:bigint;not null"`
 	AssistantConversationMessageId string `json:"assistantConversationMessageId" gorm:"type:string;not null"`
 }
+
+// AssistantConversationMessageMetricPercentile is rollup of a message metric
+// across conversations of an assistant, it is not backed by a table.
+type AssistantConversationMessageMetricPercentile struct {
+	Name      string  `json:"name"`
+	Providers string  `json:"providers"`
+	P50       float64 `json:"p50"`
+	P95       float64 `json:"p95"`
+	Count     uint64  `json:"count"`
+}
@@ -0,0 +1,77 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_evaluator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/rapidaai/pkg/types"
+)
+
+// who ended the conversation
+const (
+	// assistant ended the conversation with end of conversation tool
+	EndedByAgent = "agent"
+	// user hung up or closed the session
+	EndedByUser = "user"
+	// session was ended by idle timeout or maximum session duration
+	EndedBySystem = "system"
+)
+
+// ToolCall made by the assistant during the conversation
+type ToolCall struct {
+	Name            string
+	ExecutionMethod string
+	Success         bool
+}
+
+// Conversation is what evaluators get to see of a completed conversation
+type Conversation struct {
+	Messages  []*types.Message
+	ToolCalls []*ToolCall
+	// latency metrics of the turns, values in nanoseconds
+	Metrics  []*types.Metric
+	EndedBy  string
+	Duration time.Duration
+}
+
+// Transcript of the conversation with one message a line
+func (c *Conversation) Transcript() string {
+	var sb strings.Builder
+	for _, msg := range c.Messages {
+		content := strings.TrimSpace(types.OnlyStringContent(msg.GetContents()))
+		if content == "" {
+			continue
+		}
+		fmt.Fprintf(&sb, "%s: %s\n", msg.GetRole(), content)
+	}
+	return sb.String()
+}
+
+// Result of an evaluator for the conversation
+type Result struct {
+	Score  float64
+	Passed bool
+	Reason string
+}
+
+type Evaluator interface {
+	Name() string
+	Type() string
+	Evaluate(ctx context.Context, conversation *Conversation) (*Result, error)
+}
+
+// Pass gives a boolean result scored 1 when passed and 0 otherwise
+func Pass(passed bool, reason string) *Result {
+	result := &Result{Passed: passed, Reason: reason}
+	if passed {
+		result.Score = 1
+	}
+	return result
+}
@@ -0,0 +1,162 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_evaluator_judge
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	internal_evaluator "github.com/rapidaai/api/assistant-api/internal/evaluator"
+	endpoint_client "github.com/rapidaai/pkg/clients/endpoint"
+	endpoint_client_builders "github.com/rapidaai/pkg/clients/endpoint/builders"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/types"
+	"github.com/rapidaai/pkg/utils"
+	"github.com/rapidaai/protos"
+)
+
+// options of llm judge, the endpoint is invoked with rubric, transcript, messages and ended_by
+// as arguments and answers with json {"score": 0.8, "passed": true, "reason": "..."}
+//
+//	evaluator.endpoint_id      = 2123870081222311936
+//	evaluator.endpoint_version = latest
+//	evaluator.rubric           = Did the assistant confirm the appointment time?
+//	evaluator.pass_score       = 0.5
+const (
+	EndpointIdOption      = "evaluator.endpoint_id"
+	EndpointVersionOption = "evaluator.endpoint_version"
+	RubricOption          = "evaluator.rubric"
+	PassScoreOption       = "evaluator.pass_score"
+
+	defaultPassScore = 0.5
+)
+
+type judgeEvaluator struct {
+	logger          commons.Logger
+	name            string
+	auth            types.SimplePrinciple
+	client          endpoint_client.DeploymentServiceClient
+	endpointId      uint64
+	endpointVersion string
+	rubric          string
+	passScore       float64
+}
+
+func NewJudgeEvaluator(
+	logger commons.Logger,
+	name string,
+	auth types.SimplePrinciple,
+	client endpoint_client.DeploymentServiceClient,
+	options utils.Option,
+) (internal_evaluator.Evaluator, error) {
+	endpointId, err := options.GetUint64(EndpointIdOption)
+	if err != nil || endpointId == 0 {
+		return nil, fmt.Errorf("%s is required for llm judge", EndpointIdOption)
+	}
+	rubric, err := options.GetString(RubricOption)
+	if err != nil || strings.TrimSpace(rubric) == "" {
+		return nil, fmt.Errorf("%s is required for llm judge", RubricOption)
+	}
+	version, err := options.GetString(EndpointVersionOption)
+	if err != nil || version == "" {
+		version = "latest"
+	}
+	passScore, err := options.GetFloat64(PassScoreOption)
+	if err != nil {
+		passScore = defaultPassScore
+	}
+	return &judgeEvaluator{
+		logger:          logger,
+		name:            name,
+		auth:            auth,
+		client:          client,
+		endpointId:      endpointId,
+		endpointVersion: version,
+		rubric:          rubric,
+		passScore:       passScore,
+	}, nil
+}
+
+func (e *judgeEvaluator) Name() string {
+	return e.name
+}
+
+func (e *judgeEvaluator) Type() string {
+	return "llm_judge"
+}
+
+func (e *judgeEvaluator) Evaluate(ctx context.Context, conversation *internal_evaluator.Conversation) (*internal_evaluator.Result, error) {
+	inputBuilder := endpoint_client_builders.NewInputInvokeBuilder(e.logger)
+	ivk, err := e.client.Invoke(ctx, e.auth, inputBuilder.Invoke(
+		&protos.EndpointDefinition{
+			EndpointId: e.endpointId,
+			Version:    e.endpointVersion,
+		},
+		inputBuilder.Arguments(map[string]interface{}{
+			"rubric":     e.rubric,
+			"transcript": conversation.Transcript(),
+			"messages":   types.ToSimpleMessage(conversation.Messages),
+			"ended_by":   conversation.EndedBy,
+		}, nil),
+		nil, nil,
+	))
+	if err != nil {
+		return nil, err
+	}
+	if !ivk.GetSuccess() || len(ivk.GetData()) == 0 {
+		return nil, fmt.Errorf("empty response from endpoint")
+	}
+	return ParseVerdict(ivk.GetData()[0].GetContent(), e.passScore)
+}
+
+// ParseVerdict reads the verdict of the judge, the json can be wrapped in a markdown code block.
+// Passed is decided by the score when the judge does not give it.
+func ParseVerdict(content []byte, passScore float64) (*internal_evaluator.Result, error) {
+	text := strings.TrimSpace(string(content))
+	if start, end := strings.Index(text, "{"), strings.LastIndex(text, "}"); start >= 0 && end > start {
+		text = text[start : end+1]
+	}
+	var verdict map[string]interface{}
+	if err := json.Unmarshal([]byte(text), &verdict); err != nil {
+		return nil, fmt.Errorf("judge did not answer with json: %w", err)
+	}
+
+	result := &internal_evaluator.Result{}
+	score, hasScore := verdict["score"]
+	switch v := score.(type) {
+	case float64:
+		result.Score = v
+	case string:
+		parsed, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+		if err != nil {
+			return nil, fmt.Errorf("judge answered with invalid score %q", v)
+		}
+		result.Score = parsed
+	case nil:
+	default:
+		return nil, fmt.Errorf("judge answered with invalid score %v", v)
+	}
+	if reason, ok := verdict["reason"].(string); ok {
+		result.Reason = reason
+	}
+
+	switch passed := verdict["passed"].(type) {
+	case bool:
+		result.Passed = passed
+		if !hasScore && passed {
+			result.Score = 1
+		}
+	default:
+		if !hasScore {
+			return nil, fmt.Errorf("judge answered without score and passed")
+		}
+		result.Passed = result.Score >= passScore
+	}
+	return result, nil
+}
@@ -0,0 +1,38 @@
+package internal_evaluator_judge
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseVerdict(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		score   float64
+		passed  bool
+		reason  string
+	}{
+		{"score and passed", `{"score": 0.3, "passed": true, "reason": "confirmed"}`, 0.3, true, "confirmed"},
+		{"score decides passed", `{"score": 0.7}`, 0.7, true, ""},
+		{"score below pass score", `{"score": "0.2", "reason": "no time given"}`, 0.2, false, "no time given"},
+		{"passed without score", `{"passed": true}`, 1, true, ""},
+		{"code block", "```json\n{\"score\": 1, \"passed\": false}\n```", 1, false, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := ParseVerdict([]byte(tt.content), 0.5)
+			require.NoError(t, err)
+			assert.Equal(t, tt.score, result.Score)
+			assert.Equal(t, tt.passed, result.Passed)
+			assert.Equal(t, tt.reason, result.Reason)
+		})
+	}
+
+	for _, content := range []string{"looks good", `{"reason": "no verdict"}`, `{"score": "high"}`} {
+		_, err := ParseVerdict([]byte(content), 0.5)
+		assert.Error(t, err, content)
+	}
+}
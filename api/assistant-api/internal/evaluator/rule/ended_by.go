@@ -0,0 +1,50 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_evaluator_rule
+
+import (
+	"context"
+	"fmt"
+
+	internal_evaluator "github.com/rapidaai/api/assistant-api/internal/evaluator"
+	"github.com/rapidaai/pkg/utils"
+)
+
+// options of ended by evaluator, agent, user or system
+//
+//	evaluator.ended_by = agent
+const EndedByOption = "evaluator.ended_by"
+
+type endedByEvaluator struct {
+	name    string
+	endedBy string
+}
+
+func NewEndedByEvaluator(name string, options utils.Option) (internal_evaluator.Evaluator, error) {
+	endedBy, err := options.GetString(EndedByOption)
+	if err != nil || endedBy == "" {
+		endedBy = internal_evaluator.EndedByAgent
+	}
+	switch endedBy {
+	case internal_evaluator.EndedByAgent, internal_evaluator.EndedByUser, internal_evaluator.EndedBySystem:
+	default:
+		return nil, fmt.Errorf("unsupported %s %q for ended by evaluator", EndedByOption, endedBy)
+	}
+	return &endedByEvaluator{name: name, endedBy: endedBy}, nil
+}
+
+func (e *endedByEvaluator) Name() string {
+	return e.name
+}
+
+func (e *endedByEvaluator) Type() string {
+	return "ended_by"
+}
+
+func (e *endedByEvaluator) Evaluate(ctx context.Context, conversation *internal_evaluator.Conversation) (*internal_evaluator.Result, error) {
+	return internal_evaluator.Pass(conversation.EndedBy == e.endedBy,
+		fmt.Sprintf("conversation was ended by %s, expected %s", conversation.EndedBy, e.endedBy)), nil
+}
@@ -0,0 +1,116 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_evaluator_rule
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	internal_evaluator "github.com/rapidaai/api/assistant-api/internal/evaluator"
+	type_enums "github.com/rapidaai/pkg/types/enums"
+	"github.com/rapidaai/pkg/utils"
+)
+
+// options of latency evaluator, the aggregate of the turn latency has to stay within the threshold
+//
+//	evaluator.metric       = MOUTH_TO_EAR_LATENCY
+//	evaluator.aggregate    = p50 | p95 | avg | max
+//	evaluator.threshold_ms = 1200
+const (
+	MetricOption      = "evaluator.metric"
+	AggregateOption   = "evaluator.aggregate"
+	ThresholdMsOption = "evaluator.threshold_ms"
+)
+
+type latencyEvaluator struct {
+	name      string
+	metric    string
+	aggregate string
+	threshold float64
+}
+
+func NewLatencyEvaluator(name string, options utils.Option) (internal_evaluator.Evaluator, error) {
+	threshold, err := options.GetFloat64(ThresholdMsOption)
+	if err != nil || threshold <= 0 {
+		return nil, fmt.Errorf("%s is required for latency evaluator", ThresholdMsOption)
+	}
+	metric, err := options.GetString(MetricOption)
+	if err != nil || metric == "" {
+		metric = type_enums.MOUTH_TO_EAR_LATENCY.String()
+	}
+	aggregate, err := options.GetString(AggregateOption)
+	if err != nil || aggregate == "" {
+		aggregate = "p95"
+	}
+	switch aggregate {
+	case "p50", "p95", "avg", "max":
+	default:
+		return nil, fmt.Errorf("unsupported %s %q for latency evaluator", AggregateOption, aggregate)
+	}
+	return &latencyEvaluator{name: name, metric: strings.ToUpper(metric), aggregate: aggregate, threshold: threshold}, nil
+}
+
+func (e *latencyEvaluator) Name() string {
+	return e.name
+}
+
+func (e *latencyEvaluator) Type() string {
+	return "latency"
+}
+
+// Evaluate scores the conversation with the aggregated latency in milliseconds
+func (e *latencyEvaluator) Evaluate(ctx context.Context, conversation *internal_evaluator.Conversation) (*internal_evaluator.Result, error) {
+	var samples []float64
+	for _, metric := range conversation.Metrics {
+		if metric.GetName() != e.metric {
+			continue
+		}
+		value, err := strconv.ParseFloat(metric.GetValue(), 64)
+		if err != nil {
+			continue
+		}
+		samples = append(samples, value/1e6)
+	}
+	if len(samples) == 0 {
+		return nil, fmt.Errorf("no %s recorded in the conversation", e.metric)
+	}
+	sort.Float64s(samples)
+
+	var value float64
+	switch e.aggregate {
+	case "p50":
+		value = percentile(samples, 0.5)
+	case "p95":
+		value = percentile(samples, 0.95)
+	case "max":
+		value = samples[len(samples)-1]
+	default:
+		for _, sample := range samples {
+			value += sample
+		}
+		value /= float64(len(samples))
+	}
+	value = math.Round(value*100) / 100
+	return &internal_evaluator.Result{
+		Score:  value,
+		Passed: value <= e.threshold,
+		Reason: fmt.Sprintf("%s of %s is %.2fms over %d turns, threshold %.2fms", e.aggregate, e.metric, value, len(samples), e.threshold),
+	}, nil
+}
+
+// percentile of sorted samples with linear interpolation, the same as percentile_cont
+func percentile(samples []float64, p float64) float64 {
+	position := p * float64(len(samples)-1)
+	lower := int(position)
+	if lower+1 >= len(samples) {
+		return samples[lower]
+	}
+	return samples[lower] + (samples[lower+1]-samples[lower])*(position-float64(lower))
+}
@@ -0,0 +1,115 @@
+package internal_evaluator_rule
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	internal_evaluator "github.com/rapidaai/api/assistant-api/internal/evaluator"
+	"github.com/rapidaai/pkg/types"
+	type_enums "github.com/rapidaai/pkg/types/enums"
+	"github.com/rapidaai/pkg/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func conversation() *internal_evaluator.Conversation {
+	latency := func(name type_enums.MetricName, ms int) *types.Metric {
+		return types.NewMetric(name.String(), fmt.Sprintf("%d", time.Duration(ms)*time.Millisecond), nil)
+	}
+	return &internal_evaluator.Conversation{
+		ToolCalls: []*internal_evaluator.ToolCall{
+			{Name: "lookup_order", ExecutionMethod: "endpoint", Success: true},
+			{Name: "transfer", ExecutionMethod: "transfer_call", Success: false},
+			{Name: "goodbye", ExecutionMethod: "end_of_conversation", Success: true},
+		},
+		Metrics: []*types.Metric{
+			latency(type_enums.MOUTH_TO_EAR_LATENCY, 800),
+			latency(type_enums.MOUTH_TO_EAR_LATENCY, 1000),
+			latency(type_enums.MOUTH_TO_EAR_LATENCY, 1200),
+			latency(type_enums.MOUTH_TO_EAR_LATENCY, 3000),
+			latency(type_enums.LLM_TIME_TO_FIRST_TOKEN, 400),
+		},
+		EndedBy: internal_evaluator.EndedByAgent,
+	}
+}
+
+func TestToolCalledEvaluator(t *testing.T) {
+	tests := []struct {
+		name    string
+		options utils.Option
+		passed  bool
+	}{
+		{"called by name", utils.Option{ToolNameOption: "lookup_order"}, true},
+		{"called by execution method", utils.Option{ToolNameOption: "end_of_conversation"}, true},
+		{"failed call is not a call", utils.Option{ToolNameOption: "transfer"}, false},
+		{"never called", utils.Option{ToolNameOption: "refund"}, false},
+		{"expected not to be called", utils.Option{ToolNameOption: "refund", ExpectedOption: "false"}, true},
+		{"called when it should not", utils.Option{ToolNameOption: "lookup_order", ExpectedOption: "false"}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			evaluator, err := NewToolCalledEvaluator("tool", tt.options)
+			require.NoError(t, err)
+			result, err := evaluator.Evaluate(context.Background(), conversation())
+			require.NoError(t, err)
+			assert.Equal(t, tt.passed, result.Passed)
+			assert.Equal(t, map[bool]float64{true: 1, false: 0}[tt.passed], result.Score)
+		})
+	}
+	_, err := NewToolCalledEvaluator("tool", utils.Option{})
+	assert.Error(t, err)
+}
+
+func TestEndedByEvaluator(t *testing.T) {
+	evaluator, err := NewEndedByEvaluator("ended", utils.Option{})
+	require.NoError(t, err)
+	result, err := evaluator.Evaluate(context.Background(), conversation())
+	require.NoError(t, err)
+	assert.True(t, result.Passed)
+
+	evaluator, err = NewEndedByEvaluator("ended", utils.Option{EndedByOption: internal_evaluator.EndedByUser})
+	require.NoError(t, err)
+	result, err = evaluator.Evaluate(context.Background(), conversation())
+	require.NoError(t, err)
+	assert.False(t, result.Passed)
+
+	_, err = NewEndedByEvaluator("ended", utils.Option{EndedByOption: "assistant"})
+	assert.Error(t, err)
+}
+
+func TestLatencyEvaluator(t *testing.T) {
+	tests := []struct {
+		name    string
+		options utils.Option
+		score   float64
+		passed  bool
+	}{
+		{"p95 over threshold", utils.Option{ThresholdMsOption: "1500"}, 2730, false},
+		{"p50 within threshold", utils.Option{ThresholdMsOption: "1500", AggregateOption: "p50"}, 1100, true},
+		{"avg", utils.Option{ThresholdMsOption: "1500", AggregateOption: "avg"}, 1500, true},
+		{"max", utils.Option{ThresholdMsOption: "1500", AggregateOption: "max"}, 3000, false},
+		{"other metric", utils.Option{ThresholdMsOption: "500", MetricOption: "llm_time_to_first_token"}, 400, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			evaluator, err := NewLatencyEvaluator("latency", tt.options)
+			require.NoError(t, err)
+			result, err := evaluator.Evaluate(context.Background(), conversation())
+			require.NoError(t, err)
+			assert.InDelta(t, tt.score, result.Score, 0.01)
+			assert.Equal(t, tt.passed, result.Passed)
+		})
+	}
+
+	evaluator, err := NewLatencyEvaluator("latency", utils.Option{ThresholdMsOption: "500", MetricOption: type_enums.TTS_TIME_TO_FIRST_BYTE.String()})
+	require.NoError(t, err)
+	_, err = evaluator.Evaluate(context.Background(), conversation())
+	assert.Error(t, err)
+
+	_, err = NewLatencyEvaluator("latency", utils.Option{})
+	assert.Error(t, err)
+	_, err = NewLatencyEvaluator("latency", utils.Option{ThresholdMsOption: "500", AggregateOption: "p99"})
+	assert.Error(t, err)
+}
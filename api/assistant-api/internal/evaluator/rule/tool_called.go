@@ -0,0 +1,63 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_evaluator_rule
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	internal_evaluator "github.com/rapidaai/api/assistant-api/internal/evaluator"
+	"github.com/rapidaai/pkg/utils"
+)
+
+// options of tool called evaluator, tool is matched by name or execution method
+//
+//	evaluator.tool_name = book_appointment
+//	evaluator.expected  = true
+const (
+	ToolNameOption = "evaluator.tool_name"
+	ExpectedOption = "evaluator.expected"
+)
+
+type toolCalledEvaluator struct {
+	name     string
+	tool     string
+	expected bool
+}
+
+func NewToolCalledEvaluator(name string, options utils.Option) (internal_evaluator.Evaluator, error) {
+	tool, err := options.GetString(ToolNameOption)
+	if err != nil || strings.TrimSpace(tool) == "" {
+		return nil, fmt.Errorf("%s is required for tool called evaluator", ToolNameOption)
+	}
+	expected, err := options.GetBool(ExpectedOption)
+	if err != nil {
+		expected = true
+	}
+	return &toolCalledEvaluator{name: name, tool: strings.TrimSpace(tool), expected: expected}, nil
+}
+
+func (e *toolCalledEvaluator) Name() string {
+	return e.name
+}
+
+func (e *toolCalledEvaluator) Type() string {
+	return "tool_called"
+}
+
+func (e *toolCalledEvaluator) Evaluate(ctx context.Context, conversation *internal_evaluator.Conversation) (*internal_evaluator.Result, error) {
+	calls := 0
+	for _, call := range conversation.ToolCalls {
+		if call.Success && (call.Name == e.tool || call.ExecutionMethod == e.tool) {
+			calls++
+		}
+	}
+	if e.expected {
+		return internal_evaluator.Pass(calls > 0, fmt.Sprintf("%s was called %d times, expected to be called", e.tool, calls)), nil
+	}
+	return internal_evaluator.Pass(calls == 0, fmt.Sprintf("%s was called %d times, expected not to be called", e.tool, calls)), nil
+}
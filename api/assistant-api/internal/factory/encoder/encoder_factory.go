@@ -0,0 +1,49 @@
+package internal_encoder_factory
+
+import (
+	"io"
+
+	internal_encoder "github.com/rapidaai/api/assistant-api/internal/encoder"
+	internal_encoder_flac "github.com/rapidaai/api/assistant-api/internal/encoder/flac"
+	internal_encoder_mp3 "github.com/rapidaai/api/assistant-api/internal/encoder/mp3"
+	internal_encoder_opus "github.com/rapidaai/api/assistant-api/internal/encoder/opus"
+	internal_encoder_wav "github.com/rapidaai/api/assistant-api/internal/encoder/wav"
+	"github.com/rapidaai/pkg/commons"
+)
+
+type EncoderIdentifier string
+
+const (
+	WAV  EncoderIdentifier = "wav"
+	FLAC EncoderIdentifier = "flac"
+	OPUS EncoderIdentifier = "opus"
+	MP3  EncoderIdentifier = "mp3"
+)
+
+// logger, writer, opts
+func GetEncoder(aa EncoderIdentifier, logger commons.Logger, w io.Writer, opts *internal_encoder.EncoderOptions) (internal_encoder.Encoder, error) {
+	switch aa {
+	case FLAC:
+		return internal_encoder_flac.NewFlacEncoder(logger, w, opts)
+	case OPUS:
+		return internal_encoder_opus.NewOpusEncoder(logger, w, opts)
+	case MP3:
+		return internal_encoder_mp3.NewMp3Encoder(logger, w, opts)
+	default:
+		return internal_encoder_wav.NewWavEncoder(logger, w, opts)
+	}
+}
+
+// GetExtension of the file written by the encoder
+func GetExtension(aa EncoderIdentifier) string {
+	switch aa {
+	case FLAC:
+		return "flac"
+	case OPUS:
+		return "ogg"
+	case MP3:
+		return "mp3"
+	default:
+		return "wav"
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 
 	internal_end_of_speech "github.com/rapidaai/api/assistant-api/internal/end_of_speech"
+	internal_livekit_end_of_speech "github.com/rapidaai/api/assistant-api/internal/end_of_speech/livekit"
 	internal_silence_based_end_of_speech "github.com/rapidaai/api/assistant-api/internal/end_of_speech/silence_based"
 	"github.com/rapidaai/pkg/commons"
 	"github.com/rapidaai/pkg/utils"
@@ -20,6 +21,8 @@ func GetEndOfSpeech(aa EndOfSpeechIdentifier, logger commons.Logger, onCallback
 	switch aa {
 	case SilenceBasedEndOfSpeech:
 		return internal_silence_based_end_of_speech.NewSilenceBasedEndOfSpeech(logger, onCallback, opts)
+	case LiveKitEndOfSpeech:
+		return internal_livekit_end_of_speech.NewLivekitEndOfSpeech(logger, onCallback, opts)
 	default:
 		return nil, errors.New("illegal end of speeh")
 	}
@@ -0,0 +1,67 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_evaluator_factory
+
+import (
+	"fmt"
+
+	internal_assistant_entity "github.com/rapidaai/api/assistant-api/internal/entity/assistants"
+	internal_evaluator "github.com/rapidaai/api/assistant-api/internal/evaluator"
+	internal_evaluator_judge "github.com/rapidaai/api/assistant-api/internal/evaluator/judge"
+	internal_evaluator_rule "github.com/rapidaai/api/assistant-api/internal/evaluator/rule"
+	endpoint_client "github.com/rapidaai/pkg/clients/endpoint"
+	"github.com/rapidaai/pkg/commons"
+	gorm_model "github.com/rapidaai/pkg/models/gorm"
+	"github.com/rapidaai/pkg/types"
+	"github.com/rapidaai/pkg/utils"
+	"github.com/rapidaai/protos"
+)
+
+type EvaluatorIdentifier string
+
+const (
+	LLM_JUDGE   EvaluatorIdentifier = "llm_judge"
+	TOOL_CALLED EvaluatorIdentifier = "tool_called"
+	ENDED_BY    EvaluatorIdentifier = "ended_by"
+	LATENCY     EvaluatorIdentifier = "latency"
+)
+
+func GetEvaluator(
+	logger commons.Logger,
+	auth types.SimplePrinciple,
+	deploymentClient endpoint_client.DeploymentServiceClient,
+	evaluator *internal_assistant_entity.AssistantEvaluator,
+) (internal_evaluator.Evaluator, error) {
+	options := utils.Option(evaluator.GetOptions())
+	name := evaluator.GetName()
+	if name == "" {
+		name = evaluator.GetType()
+	}
+	switch EvaluatorIdentifier(evaluator.GetType()) {
+	case LLM_JUDGE:
+		return internal_evaluator_judge.NewJudgeEvaluator(logger, name, auth, deploymentClient, options)
+	case TOOL_CALLED:
+		return internal_evaluator_rule.NewToolCalledEvaluator(name, options)
+	case ENDED_BY:
+		return internal_evaluator_rule.NewEndedByEvaluator(name, options)
+	case LATENCY:
+		return internal_evaluator_rule.NewLatencyEvaluator(name, options)
+	default:
+		return nil, fmt.Errorf("unsupported evaluator type %s", evaluator.GetType())
+	}
+}
+
+// ValidateEvaluator checks the type and options of an evaluator before it is saved
+func ValidateEvaluator(evaluatorType string, options []*protos.Metadata) error {
+	evaluator := &internal_assistant_entity.AssistantEvaluator{Type: evaluatorType}
+	for _, opt := range options {
+		evaluator.Options = append(evaluator.Options, &internal_assistant_entity.AssistantEvaluatorOption{
+			Metadata: gorm_model.Metadata{Key: opt.GetKey(), Value: opt.GetValue()},
+		})
+	}
+	_, err := GetEvaluator(nil, nil, nil, evaluator)
+	return err
+}
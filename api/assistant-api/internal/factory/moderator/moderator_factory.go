@@ -0,0 +1,86 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_moderator_factory
+
+import (
+	"context"
+	"fmt"
+
+	internal_assistant_entity "github.com/rapidaai/api/assistant-api/internal/entity/assistants"
+	internal_moderator "github.com/rapidaai/api/assistant-api/internal/moderator"
+	internal_moderator_local "github.com/rapidaai/api/assistant-api/internal/moderator/local"
+	internal_moderator_provider "github.com/rapidaai/api/assistant-api/internal/moderator/provider"
+	integration_client "github.com/rapidaai/pkg/clients/integration"
+	web_client "github.com/rapidaai/pkg/clients/web"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/types"
+	"github.com/rapidaai/pkg/utils"
+)
+
+type ModeratorIdentifier string
+
+const (
+	REGEX     ModeratorIdentifier = "regex"
+	PII       ModeratorIdentifier = "pii"
+	BLOCKLIST ModeratorIdentifier = "blocklist"
+	OPENAI    ModeratorIdentifier = "openai"
+	AZURE     ModeratorIdentifier = "azure-foundry"
+)
+
+func GetModerator(
+	ctx context.Context,
+	logger commons.Logger,
+	auth types.SimplePrinciple,
+	integrationClient integration_client.IntegrationServiceClient,
+	vaultClient web_client.VaultClient,
+	moderator *internal_assistant_entity.AssistantModerator,
+) (internal_moderator.Moderator, error) {
+	options := utils.Option(moderator.GetOptions())
+	name := moderator.GetName()
+	if name == "" {
+		name = moderator.GetType()
+	}
+	switch typ := ModeratorIdentifier(moderator.GetType()); typ {
+	case REGEX:
+		return internal_moderator_local.NewRegexModerator(name, options)
+	case PII:
+		return internal_moderator_local.NewPIIModerator(name, options)
+	case BLOCKLIST:
+		return internal_moderator_local.NewBlocklistModerator(name, options)
+	case OPENAI, AZURE:
+		return internal_moderator_provider.NewProviderModerator(ctx, logger, name, string(typ), auth, integrationClient, vaultClient, options)
+	default:
+		return nil, fmt.Errorf("unsupported moderator type %s", moderator.GetType())
+	}
+}
+
+// GetGuardrails gives the guardrail of input and output stage, moderator which can not be
+// initialized is skipped so misconfiguration does not stop the conversation.
+func GetGuardrails(
+	ctx context.Context,
+	logger commons.Logger,
+	auth types.SimplePrinciple,
+	integrationClient integration_client.IntegrationServiceClient,
+	vaultClient web_client.VaultClient,
+	moderators []*internal_assistant_entity.AssistantModerator,
+) (input *internal_moderator.Guardrail, output *internal_moderator.Guardrail) {
+	rules := map[internal_moderator.Stage][]*internal_moderator.Rule{}
+	for _, m := range moderators {
+		stage := internal_moderator.Stage(m.GetStage())
+		if stage != internal_moderator.InputStage && stage != internal_moderator.OutputStage {
+			logger.Warnf("ignoring moderator %s with unsupported stage %s", m.GetName(), m.GetStage())
+			continue
+		}
+		moderator, err := GetModerator(ctx, logger, auth, integrationClient, vaultClient, m)
+		if err != nil {
+			logger.Errorf("unable to initialize moderator %s with error %v", m.GetName(), err)
+			continue
+		}
+		rules[stage] = append(rules[stage], internal_moderator.NewRule(moderator, m.GetOptions()))
+	}
+	return internal_moderator.NewGuardrail(logger, internal_moderator.InputStage, rules[internal_moderator.InputStage]...),
+		internal_moderator.NewGuardrail(logger, internal_moderator.OutputStage, rules[internal_moderator.OutputStage]...)
+}
@@ -12,6 +12,7 @@ import (
 	internal_adapter_request_talking_phone "github.com/rapidaai/api/assistant-api/internal/adapters/phone/talking"
 	internal_adapter_request_talking_sdk "github.com/rapidaai/api/assistant-api/internal/adapters/sdk/talking"
 	internal_adapter_request_talking_web_plugin "github.com/rapidaai/api/assistant-api/internal/adapters/web-plugin/talking"
+	internal_adapter_request_talking_whatsapp "github.com/rapidaai/api/assistant-api/internal/adapters/whatsapp/talking"
 	internal_streamers "github.com/rapidaai/api/assistant-api/internal/streamers"
 	"github.com/rapidaai/pkg/commons"
 	"github.com/rapidaai/pkg/connectors"
@@ -88,6 +89,20 @@ func GetTalker(source utils.RapidaSource,
 			logger.Errorf("assistant call talker failed with err %+v", err)
 		}
 		return talker, nil
+	case utils.Whatsapp:
+		talker, err := internal_adapter_request_talking_whatsapp.NewTalking(
+			ctx,
+			cfg,
+			logger,
+			postgres,
+			opensearch,
+			redis,
+			storage, streamer)
+		if err != nil {
+			logger.Errorf("assistant call talker failed with err %+v", err)
+			return nil, err
+		}
+		return talker, nil
 	default:
 		talker, err := internal_adapter_request_talking_debugger.NewTalking(
 			ctx,
@@ -1,10 +1,12 @@
 package internal_tool_factory
 
 import (
+	"context"
 	"errors"
 
 	internal_adapter_requests "github.com/rapidaai/api/assistant-api/internal/adapters"
 	internal_agent_tools "github.com/rapidaai/api/assistant-api/internal/agent/tool/local"
+	internal_agent_mcp_tool "github.com/rapidaai/api/assistant-api/internal/agent/tool/mcp"
 	internal_assistant_entity "github.com/rapidaai/api/assistant-api/internal/entity/assistants"
 	"github.com/rapidaai/pkg/commons"
 )
@@ -25,7 +27,23 @@ func GetToolAction(
 		return internal_agent_tools.NewPutOnHoldToolCaller(logger, toolOpts, communcation)
 	case "end_of_conversation":
 		return internal_agent_tools.NewEndOfConversationCaller(logger, toolOpts, communcation)
+	case "transfer_call":
+		return internal_agent_tools.NewTransferCallToolCaller(logger, toolOpts, communcation)
 	default:
 		return nil, errors.New("illegal tool action provided")
 	}
 }
+
+// GetMCPCaller connects the mcp server of tool, a single tool with mcp execution method
+// exposes all the tools of the server.
+func GetMCPCaller(
+	ctx context.Context,
+	logger commons.Logger,
+	toolOpts *internal_assistant_entity.AssistantTool,
+	communcation internal_adapter_requests.Communication,
+) (internal_agent_mcp_tool.MCPCaller, error) {
+	if toolOpts.ExecutionMethod != "mcp" {
+		return nil, errors.New("illegal tool action provided")
+	}
+	return internal_agent_mcp_tool.NewMCPCaller(ctx, logger, toolOpts, communcation.MCPStdioCommands())
+}
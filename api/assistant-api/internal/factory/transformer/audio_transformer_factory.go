@@ -6,13 +6,17 @@ import (
 
 	internal_transformer "github.com/rapidaai/api/assistant-api/internal/transformer"
 	internal_transformer_assemblyai "github.com/rapidaai/api/assistant-api/internal/transformer/assembly-ai"
+	internal_transformer_aws "github.com/rapidaai/api/assistant-api/internal/transformer/aws"
 	internal_transformer_azure "github.com/rapidaai/api/assistant-api/internal/transformer/azure"
 	internal_transformer_cartesia "github.com/rapidaai/api/assistant-api/internal/transformer/cartesia"
 	internal_transformer_deepgram "github.com/rapidaai/api/assistant-api/internal/transformer/deepgram"
 	internal_transformer_elevenlabs "github.com/rapidaai/api/assistant-api/internal/transformer/elevenlabs"
 	internal_transformer_google "github.com/rapidaai/api/assistant-api/internal/transformer/google"
+	internal_transformer_openai "github.com/rapidaai/api/assistant-api/internal/transformer/openai"
+	internal_transformer_resemble "github.com/rapidaai/api/assistant-api/internal/transformer/resemble"
 	internal_transformer_revai "github.com/rapidaai/api/assistant-api/internal/transformer/revai"
 	internal_transformer_sarvam "github.com/rapidaai/api/assistant-api/internal/transformer/sarvam"
+	internal_transformer_speechmatics "github.com/rapidaai/api/assistant-api/internal/transformer/speechmatics"
 	"github.com/rapidaai/pkg/commons"
 	"github.com/rapidaai/protos"
 )
@@ -28,6 +32,10 @@ const (
 	SARVAM                AudioTransformer = "sarvamai"
 	ELEVENLABS            AudioTransformer = "elevenlabs"
 	ASSEMBLYAI            AudioTransformer = "assemblyai"
+	OPENAI                AudioTransformer = "openai"
+	AWS                   AudioTransformer = "aws"
+	SPEECHMATICS          AudioTransformer = "speechmatics"
+	RESEMBLE              AudioTransformer = "resembleai"
 )
 
 func (at AudioTransformer) String() string {
@@ -55,6 +63,12 @@ func GetTextToSpeechTransformer(
 		return internal_transformer_sarvam.NewSarvamTextToSpeech(ctx, logger, credential, opts)
 	case ELEVENLABS:
 		return internal_transformer_elevenlabs.NewElevenlabsTextToSpeech(ctx, logger, credential, opts)
+	case OPENAI:
+		return internal_transformer_openai.NewOpenaiTextToSpeech(ctx, logger, credential, opts)
+	case AWS:
+		return internal_transformer_aws.NewAWSTextToSpeech(ctx, logger, credential, opts)
+	case RESEMBLE:
+		return internal_transformer_resemble.NewResembleTextToSpeech(ctx, logger, credential, opts)
 	default:
 		return nil, fmt.Errorf("illegal text to speech idenitfier")
 	}
@@ -81,6 +95,12 @@ func GetSpeechToTextTransformer(at AudioTransformer,
 		return internal_transformer_sarvam.NewSarvamSpeechToText(ctx, logger, credential, opts)
 	case CARTESIA:
 		return internal_transformer_cartesia.NewCartesiaSpeechToText(ctx, logger, credential, opts)
+	case OPENAI:
+		return internal_transformer_openai.NewOpenaiSpeechToText(ctx, logger, credential, opts)
+	case AWS:
+		return internal_transformer_aws.NewAWSSpeechToText(ctx, logger, credential, opts)
+	case SPEECHMATICS:
+		return internal_transformer_speechmatics.NewSpeechmaticsSpeechToText(ctx, logger, credential, opts)
 	default:
 		return nil, fmt.Errorf("illegal speech to text idenitfier")
 	}
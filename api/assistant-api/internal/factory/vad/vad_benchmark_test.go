@@ -0,0 +1,159 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_vad_factory
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	internal_audio "github.com/rapidaai/api/assistant-api/internal/audio"
+	internal_vad "github.com/rapidaai/api/assistant-api/internal/vad"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/utils"
+)
+
+// vadFixture describes a recorded 16khz linear16 mono clip in testdata,
+// speech_start is where the speaker starts talking, negative for clips
+// without any speech (background noise, hold music, breathing).
+type vadFixture struct {
+	File        string  `json:"file"`
+	SpeechStart float64 `json:"speech_start"`
+}
+
+type vadFixtureResult struct {
+	detected       bool
+	detectionDelay time.Duration
+	falseTriggers  int
+}
+
+// 20ms chunks, the size telephony and web clients stream in
+const vadBenchmarkChunkBytes = 640
+
+// loadVadFixtures returns the recorded fixtures of testdata/fixtures.json, the
+// benchmark is skipped when they are absent.
+func loadVadFixtures(b *testing.B) ([]vadFixture, [][]byte) {
+	manifest, err := os.ReadFile(filepath.Join("testdata", "fixtures.json"))
+	if os.IsNotExist(err) {
+		b.Skip("vad fixtures are not available in testdata")
+	}
+	if err != nil {
+		b.Fatalf("unable to read vad fixture manifest: %v", err)
+	}
+	var fixtures []vadFixture
+	if err := json.Unmarshal(manifest, &fixtures); err != nil {
+		b.Fatalf("invalid vad fixture manifest: %v", err)
+	}
+	clips := make([][]byte, len(fixtures))
+	for i, fixture := range fixtures {
+		audio, err := os.ReadFile(filepath.Join("testdata", fixture.File))
+		if os.IsNotExist(err) {
+			b.Skipf("vad fixture %s is not available in testdata", fixture.File)
+		}
+		if err != nil {
+			b.Fatalf("unable to read fixture %s: %v", fixture.File, err)
+		}
+		clips[i] = audio
+	}
+	return fixtures, clips
+}
+
+func runVadFixture(tb testing.TB, logger commons.Logger, provider VADIdentifier, audio []byte, fixture vadFixture) vadFixtureResult {
+	var (
+		mu       sync.Mutex
+		position float64
+		result   vadFixtureResult
+	)
+	vad, err := GetVAD(provider, logger, internal_audio.NewLinear16khzMonoAudioConfig(),
+		func(vr *internal_vad.VadResult) error {
+			// detection is measured on the start of speech
+			if vr.IsEnded() {
+				return nil
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			// anything starting clearly before the speaker is a false trigger
+			if fixture.SpeechStart < 0 || vr.GetSpeechStartAt() < fixture.SpeechStart-0.1 {
+				result.falseTriggers++
+				return nil
+			}
+			if !result.detected {
+				result.detected = true
+				result.detectionDelay = time.Duration((position - fixture.SpeechStart) * float64(time.Second))
+			}
+			return nil
+		},
+		utils.Option{"microphone.vad.threshold": 0.5},
+	)
+	if err != nil {
+		tb.Fatalf("unable to create %s: %v", provider, err)
+	}
+	defer vad.Close()
+
+	for offset := 0; offset < len(audio); offset += vadBenchmarkChunkBytes {
+		end := min(offset+vadBenchmarkChunkBytes, len(audio))
+		mu.Lock()
+		position = float64(end/2) / 16000
+		mu.Unlock()
+		if err := vad.Process(audio[offset:end]); err != nil {
+			tb.Fatalf("%s failed to process %s: %v", provider, fixture.File, err)
+		}
+	}
+	return result
+}
+
+// BenchmarkVAD compares processing cost, detection latency and false trigger
+// rate of the vad providers on recorded fixtures.
+//
+//	go test -run ^$ -bench BenchmarkVAD ./internal/factory/vad/
+func BenchmarkVAD(b *testing.B) {
+	fixtures, clips := loadVadFixtures(b)
+	logger := commons.NewApplicationLoggerWithOptions(commons.EnableFile(false), commons.Level("error"))
+	if err := logger.InitLogger(); err != nil {
+		b.Fatal(err)
+	}
+	var audioSeconds float64
+	for _, audio := range clips {
+		audioSeconds += float64(len(audio)/2) / 16000
+	}
+
+	for _, provider := range []VADIdentifier{SILERO_VAD, TEN_VAD} {
+		b.Run(string(provider), func(b *testing.B) {
+			var (
+				delay         time.Duration
+				detected      int
+				missed        int
+				falseTriggers int
+			)
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				for j, fixture := range fixtures {
+					result := runVadFixture(b, logger, provider, clips[j], fixture)
+					falseTriggers += result.falseTriggers
+					if fixture.SpeechStart < 0 {
+						continue
+					}
+					if result.detected {
+						detected++
+						delay += result.detectionDelay
+					} else {
+						missed++
+					}
+				}
+			}
+			b.StopTimer()
+			if detected > 0 {
+				b.ReportMetric(float64(delay.Milliseconds())/float64(detected), "detect-ms")
+			}
+			b.ReportMetric(float64(missed)/float64(b.N), "missed/op")
+			b.ReportMetric(float64(falseTriggers)/float64(b.N), "false-triggers/op")
+			b.ReportMetric(float64(b.Elapsed().Nanoseconds())/float64(b.N)/audioSeconds, "ns/audio-sec")
+		})
+	}
+}
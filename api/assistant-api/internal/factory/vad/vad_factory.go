@@ -3,6 +3,7 @@ package internal_vad_factory
 import (
 	internal_vad "github.com/rapidaai/api/assistant-api/internal/vad"
 	internal_vad_silero "github.com/rapidaai/api/assistant-api/internal/vad/silero_vad"
+	internal_ten_vad "github.com/rapidaai/api/assistant-api/internal/vad/ten_vad"
 	"github.com/rapidaai/pkg/commons"
 	"github.com/rapidaai/pkg/utils"
 	"github.com/rapidaai/protos"
@@ -23,7 +24,7 @@ func GetVAD(aa VADIdentifier, logger commons.Logger, intputAudio *protos.AudioCo
 	case SILERO_VAD:
 		return internal_vad_silero.NewSileroVAD(logger, intputAudio, callback, options)
 	case TEN_VAD:
-		return internal_vad_silero.NewSileroVAD(logger, intputAudio, callback, options)
+		return internal_ten_vad.NewTenVad(logger, intputAudio, callback, options)
 	default:
 		return internal_vad_silero.NewSileroVAD(logger, intputAudio, callback, options)
 	}
@@ -0,0 +1,36 @@
+package internal_whatsapp_factory
+
+import (
+	"errors"
+
+	"github.com/rapidaai/api/assistant-api/config"
+	internal_whatsapp "github.com/rapidaai/api/assistant-api/internal/whatsapp"
+	internal_meta_whatsapp "github.com/rapidaai/api/assistant-api/internal/whatsapp/meta"
+	internal_twilio_whatsapp "github.com/rapidaai/api/assistant-api/internal/whatsapp/twilio"
+	"github.com/rapidaai/pkg/commons"
+)
+
+type Whatsapp string
+
+const (
+	Twilio Whatsapp = "twilio"
+	Meta   Whatsapp = "meta"
+)
+
+func (at Whatsapp) String() string {
+	return string(at)
+}
+
+func GetWhatsapp(
+	at Whatsapp,
+	cfg *config.AssistantConfig,
+	logger commons.Logger) (internal_whatsapp.Whatsapp, error) {
+	switch at {
+	case Twilio:
+		return internal_twilio_whatsapp.NewTwilioWhatsapp(cfg, logger)
+	case Meta:
+		return internal_meta_whatsapp.NewMetaWhatsapp(cfg, logger)
+	default:
+		return nil, errors.New("illegal whatsapp provider")
+	}
+}
@@ -0,0 +1,45 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_moderator_local
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	internal_moderator "github.com/rapidaai/api/assistant-api/internal/moderator"
+	"github.com/rapidaai/pkg/utils"
+)
+
+// options of blocklist moderator, words or phrases are comma separated and matched case insensitive
+//
+//	moderator.words = competitor, refund policy
+const WordsOption = "moderator.words"
+
+func NewBlocklistModerator(name string, options utils.Option) (internal_moderator.Moderator, error) {
+	words, err := options.GetString(WordsOption)
+	if err != nil || strings.TrimSpace(words) == "" {
+		return nil, fmt.Errorf("%s is required for blocklist moderator", WordsOption)
+	}
+	quoted := make([]string, 0)
+	for _, word := range strings.Split(words, ",") {
+		if word = strings.TrimSpace(word); word != "" {
+			quoted = append(quoted, regexp.QuoteMeta(word))
+		}
+	}
+	if len(quoted) == 0 {
+		return nil, fmt.Errorf("%s is required for blocklist moderator", WordsOption)
+	}
+	re := regexp.MustCompile(`(?i)\b(?:` + strings.Join(quoted, "|") + `)\b`)
+	return &patternModerator{
+		name: name,
+		patterns: []*pattern{{
+			category: "blocklist",
+			re:       re,
+			mask:     func(match string) string { return strings.Repeat("*", len([]rune(match))) },
+		}},
+	}, nil
+}
@@ -0,0 +1,122 @@
+package internal_moderator_local
+
+import (
+	"context"
+	"testing"
+
+	internal_moderator "github.com/rapidaai/api/assistant-api/internal/moderator"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPIIModerator(t *testing.T) {
+	moderator, err := NewPIIModerator("pii", utils.Option{})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		content    string
+		flagged    bool
+		redacted   string
+		categories []string
+	}{
+		{"clean", "I want to book a table for two", false, "I want to book a table for two", nil},
+		{"email", "mail me at jane.doe@example.com please", true, "mail me at [EMAIL] please", []string{"email"}},
+		{"card", "my card is 4111 1111 1111 1111", true, "my card is [CREDIT_CARD]", []string{"credit_card"}},
+		{"invalid card", "order 4111 1111 1111 1112 shipped", true, "order [PHONE] 1112 shipped", []string{"phone"}},
+		{"ssn", "ssn 123-45-6789", true, "ssn [SSN]", []string{"ssn"}},
+		{"phone", "call me on +1 415 555 0132", true, "call me on [PHONE]", []string{"phone"}},
+		{"ip", "server 10.0.0.12 is down", true, "server [IP_ADDRESS] is down", []string{"ip_address"}},
+		{"not ip", "version 999.1.1.1", false, "version 999.1.1.1", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			verdict, err := moderator.Moderate(context.Background(), tt.content)
+			require.NoError(t, err)
+			assert.Equal(t, tt.flagged, verdict.Flagged)
+			assert.Equal(t, tt.redacted, verdict.Redacted)
+			assert.Equal(t, tt.categories, verdict.Categories)
+		})
+	}
+
+	_, err = NewPIIModerator("pii", utils.Option{PIITypesOption: "email, passport"})
+	assert.Error(t, err)
+}
+
+func TestBlocklistModerator(t *testing.T) {
+	moderator, err := NewBlocklistModerator("blocklist", utils.Option{WordsOption: "darn, refund policy"})
+	require.NoError(t, err)
+
+	verdict, err := moderator.Moderate(context.Background(), "Darn, what is the Refund Policy?")
+	require.NoError(t, err)
+	assert.True(t, verdict.Flagged)
+	assert.Equal(t, "****, what is the *************?", verdict.Redacted)
+
+	verdict, err = moderator.Moderate(context.Background(), "darnit, no refunds")
+	require.NoError(t, err)
+	assert.False(t, verdict.Flagged)
+
+	_, err = NewBlocklistModerator("blocklist", utils.Option{})
+	assert.Error(t, err)
+}
+
+func TestRegexModerator(t *testing.T) {
+	moderator, err := NewRegexModerator("regex", utils.Option{PatternsOption: "(?i)order\\s+#\\d+\n\n(?i)internal"})
+	require.NoError(t, err)
+
+	verdict, err := moderator.Moderate(context.Background(), "Your order #1234 is with Internal team")
+	require.NoError(t, err)
+	assert.True(t, verdict.Flagged)
+	assert.Equal(t, "Your [REDACTED] is with [REDACTED] team", verdict.Redacted)
+
+	_, err = NewRegexModerator("regex", utils.Option{PatternsOption: "("})
+	assert.Error(t, err)
+}
+
+func TestGuardrail(t *testing.T) {
+	logger := commons.NewApplicationLoggerWithOptions(commons.EnableFile(false), commons.Level("error"))
+	logger.InitLogger()
+	pii, _ := NewPIIModerator("pii", utils.Option{})
+	blocklist, _ := NewBlocklistModerator("blocklist", utils.Option{WordsOption: "secret"})
+
+	tests := []struct {
+		name     string
+		action   string
+		content  string
+		expected string
+		blocked  bool
+		replaced bool
+		actions  []internal_moderator.Action
+	}{
+		{"allow", "block", "hello there", "hello there", false, false, []internal_moderator.Action{internal_moderator.ALLOW, internal_moderator.ALLOW}},
+		{"redact", "redact", "the secret is at a@b.io", "the ****** is at [EMAIL]", false, false, []internal_moderator.Action{internal_moderator.REDACT, internal_moderator.REDACT}},
+		{"block", "block", "the secret is at a@b.io", "", true, false, []internal_moderator.Action{internal_moderator.BLOCK}},
+		{"replace", "replace", "the secret is at a@b.io", "Let us talk about something else.", false, true, []internal_moderator.Action{internal_moderator.REPLACE}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			options := map[string]interface{}{
+				internal_moderator.ActionOption:       tt.action,
+				internal_moderator.SafeResponseOption: "Let us talk about something else.",
+			}
+			guardrail := internal_moderator.NewGuardrail(logger, internal_moderator.OutputStage,
+				internal_moderator.NewRule(pii, options),
+				internal_moderator.NewRule(blocklist, options),
+			)
+			result := guardrail.Apply(context.Background(), tt.content)
+			assert.Equal(t, tt.expected, result.Content)
+			assert.Equal(t, tt.blocked, result.Blocked)
+			assert.Equal(t, tt.replaced, result.Replaced)
+			actions := make([]internal_moderator.Action, 0)
+			for _, d := range result.Decisions {
+				actions = append(actions, d.Action)
+			}
+			assert.Equal(t, tt.actions, actions)
+			for _, m := range result.Metrics(internal_moderator.OutputStage) {
+				assert.Contains(t, m.GetName(), "moderation.output.")
+			}
+		})
+	}
+}
@@ -0,0 +1,55 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_moderator_local
+
+import (
+	"context"
+	"regexp"
+
+	internal_moderator "github.com/rapidaai/api/assistant-api/internal/moderator"
+)
+
+// pattern flags the content matching the expression, match is replaced by mask when redacting
+type pattern struct {
+	category string
+	re       *regexp.Regexp
+	mask     func(match string) string
+	// valid filters the false positive matches
+	valid func(match string) bool
+}
+
+// patternModerator is the base of local moderators, no content leaves the service
+type patternModerator struct {
+	name     string
+	patterns []*pattern
+}
+
+func (pm *patternModerator) Name() string {
+	return pm.name
+}
+
+func (pm *patternModerator) Moderate(ctx context.Context, content string) (*internal_moderator.Verdict, error) {
+	verdict := &internal_moderator.Verdict{Redacted: content}
+	for _, p := range pm.patterns {
+		flagged := false
+		verdict.Redacted = p.re.ReplaceAllStringFunc(verdict.Redacted, func(match string) string {
+			if p.valid != nil && !p.valid(match) {
+				return match
+			}
+			flagged = true
+			return p.mask(match)
+		})
+		if flagged {
+			verdict.Flagged = true
+			verdict.Categories = append(verdict.Categories, p.category)
+		}
+	}
+	return verdict, nil
+}
+
+func fixedMask(mask string) func(string) string {
+	return func(string) string { return mask }
+}
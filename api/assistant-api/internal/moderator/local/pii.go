@@ -0,0 +1,109 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_moderator_local
+
+import (
+	"fmt"
+	"net"
+	"regexp"
+	"strings"
+
+	internal_moderator "github.com/rapidaai/api/assistant-api/internal/moderator"
+	"github.com/rapidaai/pkg/utils"
+)
+
+// options of pii moderator, comma separated types to detect, all types are detected when not given
+//
+//	moderator.pii_types = email, phone, credit_card, ssn, ip_address
+const PIITypesOption = "moderator.pii_types"
+
+var piiPatterns = map[string]*pattern{
+	"email": {
+		category: "email",
+		re:       regexp.MustCompile(`[A-Za-z0-9._%+\-]+@[A-Za-z0-9.\-]+\.[A-Za-z]{2,}`),
+		mask:     fixedMask("[EMAIL]"),
+	},
+	"credit_card": {
+		category: "credit_card",
+		re:       regexp.MustCompile(`\b(?:\d[ \-]?){12,18}\d\b`),
+		mask:     fixedMask("[CREDIT_CARD]"),
+		valid:    luhn,
+	},
+	"ssn": {
+		category: "ssn",
+		re:       regexp.MustCompile(`\b\d{3}-\d{2}-\d{4}\b`),
+		mask:     fixedMask("[SSN]"),
+	},
+	"phone": {
+		category: "phone",
+		re:       regexp.MustCompile(`(?:\+\d{1,3}[ \-.]?)?(?:\(\d{2,4}\)[ \-.]?)?\d{3,4}[ \-.]?\d{3,4}(?:[ \-.]?\d{2,4})?\b`),
+		mask:     fixedMask("[PHONE]"),
+		valid: func(match string) bool {
+			return len(digits(match)) >= 7
+		},
+	},
+	"ip_address": {
+		category: "ip_address",
+		re:       regexp.MustCompile(`\b(?:\d{1,3}\.){3}\d{1,3}\b`),
+		mask:     fixedMask("[IP_ADDRESS]"),
+		valid: func(match string) bool {
+			return net.ParseIP(match) != nil
+		},
+	},
+}
+
+// detection order matters, card and ssn numbers would be taken as phone numbers otherwise
+var piiOrder = []string{"email", "credit_card", "ssn", "ip_address", "phone"}
+
+func NewPIIModerator(name string, options utils.Option) (internal_moderator.Moderator, error) {
+	enabled := map[string]bool{}
+	if v, err := options.GetString(PIITypesOption); err == nil && strings.TrimSpace(v) != "" {
+		for _, t := range strings.Split(v, ",") {
+			t = strings.ToLower(strings.TrimSpace(t))
+			if _, ok := piiPatterns[t]; !ok {
+				return nil, fmt.Errorf("unsupported pii type %s", t)
+			}
+			enabled[t] = true
+		}
+	}
+	moderator := &patternModerator{name: name}
+	for _, t := range piiOrder {
+		if len(enabled) == 0 || enabled[t] {
+			moderator.patterns = append(moderator.patterns, piiPatterns[t])
+		}
+	}
+	return moderator, nil
+}
+
+func digits(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		if r >= '0' && r <= '9' {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// luhn validates the checksum of card number
+func luhn(s string) bool {
+	number := digits(s)
+	if len(number) < 13 || len(number) > 19 {
+		return false
+	}
+	sum := 0
+	for i := 0; i < len(number); i++ {
+		d := int(number[len(number)-1-i] - '0')
+		if i%2 == 1 {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+	}
+	return sum%10 == 0
+}
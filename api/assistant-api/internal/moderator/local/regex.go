@@ -0,0 +1,39 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_moderator_local
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	internal_moderator "github.com/rapidaai/api/assistant-api/internal/moderator"
+	"github.com/rapidaai/pkg/utils"
+)
+
+// options of regex moderator, one expression per line
+//
+//	moderator.patterns = (?i)internal\s+code\s+\d+
+const PatternsOption = "moderator.patterns"
+
+func NewRegexModerator(name string, options utils.Option) (internal_moderator.Moderator, error) {
+	expressions, err := options.GetString(PatternsOption)
+	if err != nil || strings.TrimSpace(expressions) == "" {
+		return nil, fmt.Errorf("%s is required for regex moderator", PatternsOption)
+	}
+	moderator := &patternModerator{name: name}
+	for _, expr := range strings.Split(expressions, "\n") {
+		if expr = strings.TrimSpace(expr); expr == "" {
+			continue
+		}
+		re, err := regexp.Compile(expr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid pattern %q for regex moderator: %w", expr, err)
+		}
+		moderator.patterns = append(moderator.patterns, &pattern{category: "regex", re: re, mask: fixedMask("[REDACTED]")})
+	}
+	return moderator, nil
+}
@@ -0,0 +1,176 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_moderator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/types"
+)
+
+// Stage of conversation where moderator is executed
+type Stage string
+
+const (
+	// user transcript before it is given to the assistant
+	InputStage Stage = "input"
+	// generated text of assistant before it is spoken
+	OutputStage Stage = "output"
+)
+
+// Action taken by guardrail when moderator flags the content
+type Action string
+
+const (
+	ALLOW   Action = "allow"
+	BLOCK   Action = "block"
+	REDACT  Action = "redact"
+	REPLACE Action = "replace"
+)
+
+// options shared by all moderators
+//
+//	moderator.action        = block | redact | replace
+//	moderator.safe_response = I'm sorry, I can't help with that.
+const (
+	ActionOption       = "moderator.action"
+	SafeResponseOption = "moderator.safe_response"
+
+	DefaultSafeResponse = "I'm sorry, I can't help with that."
+)
+
+// Verdict of a moderator for the content
+type Verdict struct {
+	Flagged    bool
+	Categories []string
+	// content with the flagged parts masked, empty when moderator is not able to redact
+	Redacted string
+}
+
+type Moderator interface {
+	Name() string
+	Moderate(ctx context.Context, content string) (*Verdict, error)
+}
+
+// Rule is a moderator configured with the action to take on violation
+type Rule struct {
+	Moderator    Moderator
+	Action       Action
+	SafeResponse string
+}
+
+// Decision of a rule, every decision is logged as metric of message
+type Decision struct {
+	Moderator  string
+	Action     Action
+	Categories []string
+}
+
+func (d *Decision) ToMetric(stage Stage) *types.Metric {
+	return &types.Metric{
+		Name:        fmt.Sprintf("moderation.%s.%s", stage, d.Moderator),
+		Value:       string(d.Action),
+		Description: strings.Join(d.Categories, ","),
+	}
+}
+
+// Result of guardrail, content is what should continue in the conversation
+type Result struct {
+	Content string
+	Blocked bool
+	// set when content is replaced with the safe response
+	Replaced  bool
+	Decisions []*Decision
+}
+
+func (r *Result) Metrics(stage Stage) []*types.Metric {
+	metrics := make([]*types.Metric, 0, len(r.Decisions))
+	for _, d := range r.Decisions {
+		metrics = append(metrics, d.ToMetric(stage))
+	}
+	return metrics
+}
+
+// Guardrail runs the rules of a stage in order, redacted content of a rule is moderated by next rule,
+// block and replace stop the evaluation.
+type Guardrail struct {
+	logger commons.Logger
+	stage  Stage
+	rules  []*Rule
+}
+
+func NewGuardrail(logger commons.Logger, stage Stage, rules ...*Rule) *Guardrail {
+	return &Guardrail{logger: logger, stage: stage, rules: rules}
+}
+
+func (g *Guardrail) Stage() Stage {
+	return g.stage
+}
+
+func (g *Guardrail) Enabled() bool {
+	return g != nil && len(g.rules) > 0
+}
+
+func (g *Guardrail) Apply(ctx context.Context, content string) *Result {
+	result := &Result{Content: content, Decisions: make([]*Decision, 0)}
+	if !g.Enabled() || strings.TrimSpace(content) == "" {
+		return result
+	}
+	for _, rule := range g.rules {
+		verdict, err := rule.Moderator.Moderate(ctx, result.Content)
+		if err != nil {
+			// moderator failing should not break the conversation
+			g.logger.Errorf("moderator %s failed at %s stage with error %v", rule.Moderator.Name(), g.stage, err)
+			continue
+		}
+		if !verdict.Flagged {
+			result.Decisions = append(result.Decisions, &Decision{Moderator: rule.Moderator.Name(), Action: ALLOW})
+			continue
+		}
+		decision := &Decision{Moderator: rule.Moderator.Name(), Action: rule.Action, Categories: verdict.Categories}
+		result.Decisions = append(result.Decisions, decision)
+		switch rule.Action {
+		case REDACT:
+			if verdict.Redacted != "" {
+				result.Content = verdict.Redacted
+				continue
+			}
+			// moderator can not point the flagged part, nothing of the content can be kept
+			decision.Action = REPLACE
+			result.Content = rule.SafeResponse
+			result.Replaced = true
+			return result
+		case REPLACE:
+			result.Content = rule.SafeResponse
+			result.Replaced = true
+			return result
+		default:
+			decision.Action = BLOCK
+			result.Content = ""
+			result.Blocked = true
+			return result
+		}
+	}
+	return result
+}
+
+// NewRule reads the action and safe response from options of moderator
+func NewRule(moderator Moderator, options map[string]interface{}) *Rule {
+	rule := &Rule{Moderator: moderator, Action: BLOCK, SafeResponse: DefaultSafeResponse}
+	if v, ok := options[ActionOption].(string); ok {
+		switch action := Action(strings.ToLower(strings.TrimSpace(v))); action {
+		case BLOCK, REDACT, REPLACE:
+			rule.Action = action
+		}
+	}
+	if v, ok := options[SafeResponseOption].(string); ok && strings.TrimSpace(v) != "" {
+		rule.SafeResponse = v
+	}
+	return rule
+}
@@ -0,0 +1,113 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_moderator_provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	internal_moderator "github.com/rapidaai/api/assistant-api/internal/moderator"
+	integration_client "github.com/rapidaai/pkg/clients/integration"
+	web_client "github.com/rapidaai/pkg/clients/web"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/types"
+	"github.com/rapidaai/pkg/utils"
+	"github.com/rapidaai/protos"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// options of provider moderator, model.* options are sent as model parameters
+//
+//	rapida.credential_id = 2123
+//	model.name           = omni-moderation-latest
+const CredentialOption = "rapida.credential_id"
+
+// providerModerator uses moderation model of provider through integration service
+type providerModerator struct {
+	logger            commons.Logger
+	name              string
+	provider          string
+	auth              types.SimplePrinciple
+	integrationClient integration_client.IntegrationServiceClient
+	credential        *protos.Credential
+	parameters        map[string]*anypb.Any
+}
+
+func NewProviderModerator(
+	ctx context.Context,
+	logger commons.Logger,
+	name, provider string,
+	auth types.SimplePrinciple,
+	integrationClient integration_client.IntegrationServiceClient,
+	vaultClient web_client.VaultClient,
+	options utils.Option,
+) (internal_moderator.Moderator, error) {
+	credentialId, err := options.GetUint64(CredentialOption)
+	if err != nil {
+		return nil, fmt.Errorf("%s is required for %s moderator", CredentialOption, provider)
+	}
+	credential, err := vaultClient.GetCredential(ctx, auth, credentialId)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get credential for %s moderator: %w", provider, err)
+	}
+	parameters := make(map[string]*anypb.Any)
+	for k, v := range options {
+		if !strings.HasPrefix(k, "model.") {
+			continue
+		}
+		value, err := structpb.NewValue(v)
+		if err != nil {
+			continue
+		}
+		if anyValue, err := anypb.New(value); err == nil {
+			parameters[k] = anyValue
+		}
+	}
+	return &providerModerator{
+		logger:            logger,
+		name:              name,
+		provider:          provider,
+		auth:              auth,
+		integrationClient: integrationClient,
+		credential:        &protos.Credential{Id: credential.GetId(), Value: credential.GetValue()},
+		parameters:        parameters,
+	}, nil
+}
+
+func (pm *providerModerator) Name() string {
+	return pm.name
+}
+
+// Moderate flags the content when any category is flagged by provider, provider does not point the
+// flagged part so content can not be redacted.
+func (pm *providerModerator) Moderate(ctx context.Context, content string) (*internal_moderator.Verdict, error) {
+	res, err := pm.integrationClient.GetModeration(ctx, pm.auth, pm.provider, &protos.GetModerationRequest{
+		Credential: pm.credential,
+		Content: &protos.Content{
+			ContentType:   commons.TEXT_CONTENT.String(),
+			ContentFormat: commons.TEXT_CONTENT_FORMAT_RAW.String(),
+			Content:       []byte(content),
+		},
+		ModelParameters: pm.parameters,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !res.GetSuccess() {
+		return nil, errors.New(res.GetError().GetErrorMessage())
+	}
+	verdict := &internal_moderator.Verdict{}
+	for _, m := range res.GetData() {
+		if m.GetFlagged() {
+			verdict.Flagged = true
+			verdict.Categories = append(verdict.Categories, m.GetName())
+		}
+	}
+	return verdict, nil
+}
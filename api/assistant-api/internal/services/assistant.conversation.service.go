@@ -7,9 +7,11 @@ package internal_services
 
 import (
 	"context"
+	"time"
 
 	internal_conversation_gorm "github.com/rapidaai/api/assistant-api/internal/entity/conversations"
 	internal_message_gorm "github.com/rapidaai/api/assistant-api/internal/entity/messages"
+	"github.com/rapidaai/pkg/storages"
 	"github.com/rapidaai/pkg/types"
 	type_enums "github.com/rapidaai/pkg/types/enums"
 	"github.com/rapidaai/pkg/utils"
@@ -164,6 +166,14 @@ type AssistantConversationService interface {
 		assistantConversationId uint64,
 		opts *GetConversationOption) (*internal_conversation_gorm.AssistantConversation, error)
 
+	// GetActiveConversation returns the latest conversation of the identifier which has
+	// been active since the given time, used by channels which resume conversations
+	GetActiveConversation(ctx context.Context,
+		auth types.SimplePrinciple,
+		identifier string,
+		assistantId uint64,
+		since time.Time) (*internal_conversation_gorm.AssistantConversation, error)
+
 	//
 	GetAllConversationMessage(context.Context,
 		types.SimplePrinciple,
@@ -187,6 +197,14 @@ type AssistantConversationService interface {
 		paginate *workflow_api.Paginate,
 		ordering *workflow_api.Ordering, opts *GetMessageOption) (int64, []*internal_message_gorm.AssistantConversationMessage, error)
 
+	// GetTurnLatency returns p50/p95 of turn latency metrics of the assistant per provider combination
+	GetTurnLatency(
+		ctx context.Context,
+		auth types.SimplePrinciple,
+		assistantId uint64,
+		criterias []*workflow_api.Criteria,
+	) ([]*internal_message_gorm.AssistantConversationMessageMetricPercentile, error)
+
 	GetAllMessage(
 		ctx context.Context,
 		auth types.SimplePrinciple,
@@ -301,14 +319,46 @@ type AssistantConversationService interface {
 		metrics []*types.Metric,
 	) ([]*internal_conversation_gorm.AssistantConversationMetric, error)
 
-	CreateConversationRecording(
+	BeginConversationRecording(
 		ctx context.Context,
 		auth types.SimplePrinciple,
 		assistantId uint64,
 		assistantConversationId uint64,
-		body []byte,
+		extension string,
+	) (*internal_conversation_gorm.AssistantConversationRecording, *storages.Spool, error)
+
+	CreateConversationRecording(
+		ctx context.Context,
+		auth types.SimplePrinciple,
+		recording *internal_conversation_gorm.AssistantConversationRecording,
+		spool *storages.Spool,
 	) (*internal_conversation_gorm.AssistantConversationRecording, error)
 
+	CreateConversationEvaluations(
+		ctx context.Context,
+		auth types.SimplePrinciple,
+		assistantId uint64,
+		assistantProviderModelId uint64,
+		assistantConversationId uint64,
+		evaluations []*internal_conversation_gorm.AssistantConversationEvaluation,
+	) ([]*internal_conversation_gorm.AssistantConversationEvaluation, error)
+
+	GetAllConversationEvaluation(
+		ctx context.Context,
+		auth types.SimplePrinciple,
+		assistantId uint64,
+		criterias []*workflow_api.Criteria,
+		paginate *workflow_api.Paginate,
+	) (int64, []*internal_conversation_gorm.AssistantConversationEvaluation, error)
+
+	// GetEvaluationSummary returns pass rate and scores of evaluators per assistant version
+	GetEvaluationSummary(
+		ctx context.Context,
+		auth types.SimplePrinciple,
+		assistantId uint64,
+		criterias []*workflow_api.Criteria,
+	) ([]*internal_conversation_gorm.AssistantConversationEvaluationSummary, error)
+
 	ApplyConversationTelephonyEvent(
 		ctx context.Context,
 		auth types.SimplePrinciple,
@@ -44,7 +44,7 @@ func (eService assistantDeploymentService) CreateWebPluginDeployment(
 	assistantId uint64,
 	name string,
 	greeting, mistake *string,
-	idealTimeout *uint64, idealTimeoutMessage *string, maxSessionDuration *uint64,
+	idealTimeout *uint64, idealTimeoutMessage *string, idealTimeoutRetry *uint64, maxSessionDuration *uint64,
 	suggestion []string,
 	helpCenterEnabled, productCatalogEnabled, articleCatalogEnabled bool,
 	inputAudio, outputAudio *protos.DeploymentAudioProvider,
@@ -63,6 +63,7 @@ func (eService assistantDeploymentService) CreateWebPluginDeployment(
 			Mistake:             mistake,
 			IdealTimeout:        idealTimeout,
 			IdealTimeoutMessage: idealTimeoutMessage,
+			IdealTimeoutRetry:   idealTimeoutRetry,
 			MaxSessionDuration:  maxSessionDuration,
 		},
 		Suggestion:            suggestion,
@@ -146,7 +147,7 @@ func (eService assistantDeploymentService) CreateDebuggerDeployment(
 	auth types.SimplePrinciple,
 	assistantId uint64,
 	greeting, mistake *string,
-	idealTimeout *uint64, idealTimeoutMessage *string, maxSessionDuration *uint64,
+	idealTimeout *uint64, idealTimeoutMessage *string, idealTimeoutRetry *uint64, maxSessionDuration *uint64,
 	inputAudio, outputAudio *protos.DeploymentAudioProvider,
 ) (*internal_assistant_entity.AssistantDebuggerDeployment, error) {
 	db := eService.postgres.DB(ctx)
@@ -163,6 +164,7 @@ func (eService assistantDeploymentService) CreateDebuggerDeployment(
 			Mistake:             mistake,
 			IdealTimeout:        idealTimeout,
 			IdealTimeoutMessage: idealTimeoutMessage,
+			IdealTimeoutRetry:   idealTimeoutRetry,
 			MaxSessionDuration:  maxSessionDuration,
 		},
 	}
@@ -187,7 +189,7 @@ func (eService assistantDeploymentService) CreateApiDeployment(
 	auth types.SimplePrinciple,
 	assistantId uint64,
 	greeting, mistake *string,
-	idealTimeout *uint64, idealTimeoutMessage *string, maxSessionDuration *uint64,
+	idealTimeout *uint64, idealTimeoutMessage *string, idealTimeoutRetry *uint64, maxSessionDuration *uint64,
 	inputAudio, outputAudio *protos.DeploymentAudioProvider,
 ) (*internal_assistant_entity.AssistantApiDeployment, error) {
 	db := eService.postgres.DB(ctx)
@@ -204,6 +206,7 @@ func (eService assistantDeploymentService) CreateApiDeployment(
 			Mistake:             mistake,
 			IdealTimeout:        idealTimeout,
 			IdealTimeoutMessage: idealTimeoutMessage,
+			IdealTimeoutRetry:   idealTimeoutRetry,
 			MaxSessionDuration:  maxSessionDuration,
 		},
 	}
@@ -228,7 +231,7 @@ func (eService assistantDeploymentService) CreateWhatsappDeployment(
 	auth types.SimplePrinciple,
 	assistantId uint64,
 	greeting, mistake *string,
-	idealTimeout *uint64, idealTimeoutMessage *string, maxSessionDuration *uint64,
+	idealTimeout *uint64, idealTimeoutMessage *string, idealTimeoutRetry *uint64, maxSessionDuration *uint64,
 	whatsappProvider string,
 	whatsappOptions []*protos.Metadata,
 ) (*internal_assistant_entity.AssistantWhatsappDeployment, error) {
@@ -246,6 +249,7 @@ func (eService assistantDeploymentService) CreateWhatsappDeployment(
 			Mistake:             mistake,
 			IdealTimeout:        idealTimeout,
 			IdealTimeoutMessage: idealTimeoutMessage,
+			IdealTimeoutRetry:   idealTimeoutRetry,
 			MaxSessionDuration:  maxSessionDuration,
 		},
 		AssistantDeploymentWhatsapp: internal_assistant_entity.AssistantDeploymentWhatsapp{
@@ -297,7 +301,7 @@ func (eService assistantDeploymentService) CreatePhoneDeployment(
 	auth types.SimplePrinciple,
 	assistantId uint64,
 	greeting, mistake *string,
-	idealTimeout *uint64, idealTimeoutMessage *string, maxSessionDuration *uint64,
+	idealTimeout *uint64, idealTimeoutMessage *string, idealTimeoutRetry *uint64, maxSessionDuration *uint64,
 	phoneProvider string,
 	inputAudio, outputAudio *protos.DeploymentAudioProvider,
 	opts []*protos.Metadata,
@@ -316,6 +320,7 @@ func (eService assistantDeploymentService) CreatePhoneDeployment(
 			Mistake:             mistake,
 			IdealTimeout:        idealTimeout,
 			IdealTimeoutMessage: idealTimeoutMessage,
+			IdealTimeoutRetry:   idealTimeoutRetry,
 			MaxSessionDuration:  maxSessionDuration,
 		},
 		AssistantDeploymentTelephony: internal_assistant_entity.AssistantDeploymentTelephony{
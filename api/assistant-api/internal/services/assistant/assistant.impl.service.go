@@ -313,6 +313,11 @@ func (eService *assistantService) Get(ctx context.Context,
 				defer wg.Done()
 				var deployment *internal_assistant_entity.AssistantWhatsappDeployment
 				tx := db.
+					Preload("WhatsappOptions").
+					Order(clause.OrderByColumn{
+						Column: clause.Column{Name: "created_date"},
+						Desc:   true,
+					}).
 					Where("assistant_id = ?", assistantId).First(&deployment)
 				if tx.Error != nil {
 					return
@@ -432,6 +437,62 @@ func (eService *assistantService) Get(ctx context.Context,
 				assistant.AssistantAnalyses = analysis
 			})
 	}
+
+	if opts.InjectModerator {
+		wg.Add(1)
+		utils.Go(ctx,
+			func() {
+				defer wg.Done()
+				var moderators []*internal_assistant_entity.AssistantModerator
+				tx := db.
+					Preload("Options", "status = ?", type_enums.RECORD_ACTIVE).
+					Where("assistant_id = ? AND status = ?", assistantId, type_enums.RECORD_ACTIVE.String()).
+					Order("created_date ASC").
+					Find(&moderators)
+				if tx.Error != nil {
+					eService.logger.Warnf("unable to find assistant moderators with error %+v", tx.Error)
+					return
+				}
+				assistant.AssistantModerators = moderators
+			})
+	}
+
+	if opts.InjectEvaluator {
+		wg.Add(1)
+		utils.Go(ctx,
+			func() {
+				defer wg.Done()
+				var evaluators []*internal_assistant_entity.AssistantEvaluator
+				tx := db.
+					Preload("Options", "status = ?", type_enums.RECORD_ACTIVE).
+					Where("assistant_id = ? AND status = ?", assistantId, type_enums.RECORD_ACTIVE.String()).
+					Order("created_date ASC").
+					Find(&evaluators)
+				if tx.Error != nil {
+					eService.logger.Warnf("unable to find assistant evaluators with error %+v", tx.Error)
+					return
+				}
+				assistant.AssistantEvaluators = evaluators
+			})
+	}
+
+	if opts.InjectLexicon {
+		wg.Add(1)
+		utils.Go(ctx,
+			func() {
+				defer wg.Done()
+				var lexicons []*internal_assistant_entity.AssistantLexicon
+				tx := db.
+					Where("assistant_id = ? AND status = ?", assistantId, type_enums.RECORD_ACTIVE.String()).
+					Order("created_date ASC").
+					Find(&lexicons)
+				if tx.Error != nil {
+					eService.logger.Warnf("unable to find assistant lexicons with error %+v", tx.Error)
+					return
+				}
+				assistant.AssistantLexicons = lexicons
+			})
+	}
 	wg.Wait()
 	eService.logger.Benchmark("assistantService.Get", time.Since(start))
 	return assistant, nil
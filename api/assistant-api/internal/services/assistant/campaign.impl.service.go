@@ -0,0 +1,574 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_assistant_service
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	internal_assistant_entity "github.com/rapidaai/api/assistant-api/internal/entity/assistants"
+	internal_conversation_gorm "github.com/rapidaai/api/assistant-api/internal/entity/conversations"
+	internal_services "github.com/rapidaai/api/assistant-api/internal/services"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/connectors"
+	gorm_models "github.com/rapidaai/pkg/models/gorm"
+	"github.com/rapidaai/pkg/types"
+	type_enums "github.com/rapidaai/pkg/types/enums"
+	"github.com/rapidaai/protos"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const campaignContactBatch = 500
+
+type assistantCampaignService struct {
+	logger   commons.Logger
+	postgres connectors.PostgresConnector
+}
+
+func NewAssistantCampaignService(logger commons.Logger, postgres connectors.PostgresConnector) internal_services.AssistantCampaignService {
+	return &assistantCampaignService{
+		logger:   logger,
+		postgres: postgres,
+	}
+}
+
+func (eService *assistantCampaignService) Create(ctx context.Context,
+	auth types.SimplePrinciple,
+	campaign *internal_assistant_entity.AssistantCampaign,
+	contacts []*internal_assistant_entity.AssistantCampaignContact,
+) (*internal_assistant_entity.AssistantCampaign, error) {
+	start := time.Now()
+	db := eService.postgres.DB(ctx)
+	campaign.ProjectId = *auth.GetCurrentProjectId()
+	campaign.OrganizationId = *auth.GetCurrentOrganizationId()
+	campaign.Status = type_enums.RECORD_ACTIVE
+	campaign.State = internal_assistant_entity.CAMPAIGN_RUNNING
+	if auth.GetUserId() != nil {
+		campaign.CreatedBy = *auth.GetUserId()
+	}
+	if time.Time(campaign.StartDate).IsZero() {
+		campaign.StartDate = gorm_models.TimeWrapper(start)
+	}
+	err := db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(campaign).Error; err != nil {
+			return err
+		}
+		for _, contact := range contacts {
+			contact.AssistantCampaignId = campaign.Id
+			contact.State = internal_assistant_entity.CONTACT_PENDING
+			contact.NextAttemptDate = campaign.StartDate
+			contact.Status = type_enums.RECORD_ACTIVE
+			contact.CreatedBy = campaign.CreatedBy
+		}
+		return tx.CreateInBatches(contacts, campaignContactBatch).Error
+	})
+	eService.logger.Benchmark("assistantCampaignService.Create", time.Since(start))
+	if err != nil {
+		eService.logger.Errorf("error while creating campaign %v", err)
+		return nil, err
+	}
+	campaign.Progress = &internal_assistant_entity.AssistantCampaignProgress{
+		Total:   uint64(len(contacts)),
+		Pending: uint64(len(contacts)),
+	}
+	return campaign, nil
+}
+
+func (eService *assistantCampaignService) Get(ctx context.Context, auth types.SimplePrinciple, campaignId, assistantId uint64) (*internal_assistant_entity.AssistantCampaign, error) {
+	start := time.Now()
+	db := eService.postgres.DB(ctx)
+	var campaign *internal_assistant_entity.AssistantCampaign
+	tx := db.
+		Where("id = ? AND assistant_id = ? AND project_id = ? AND status = ?",
+			campaignId, assistantId, *auth.GetCurrentProjectId(), type_enums.RECORD_ACTIVE).
+		First(&campaign)
+	if tx.Error != nil {
+		eService.logger.Benchmark("assistantCampaignService.Get", time.Since(start))
+		eService.logger.Errorf("not able to find any campaign %v", tx.Error)
+		return nil, tx.Error
+	}
+	if err := eService.progress(ctx, campaign); err != nil {
+		eService.logger.Benchmark("assistantCampaignService.Get", time.Since(start))
+		return nil, err
+	}
+	eService.logger.Benchmark("assistantCampaignService.Get", time.Since(start))
+	return campaign, nil
+}
+
+func (eService *assistantCampaignService) GetAll(ctx context.Context,
+	auth types.SimplePrinciple,
+	assistantId uint64,
+	criterias []*protos.Criteria,
+	paginate *protos.Paginate) (int64, []*internal_assistant_entity.AssistantCampaign, error) {
+	start := time.Now()
+	db := eService.postgres.DB(ctx)
+	var (
+		campaigns []*internal_assistant_entity.AssistantCampaign
+		cnt       int64
+	)
+	qry := db.Model(internal_assistant_entity.AssistantCampaign{})
+	qry.
+		Where("assistant_id = ? AND project_id = ? AND status = ?",
+			assistantId, *auth.GetCurrentProjectId(), type_enums.RECORD_ACTIVE)
+	for _, ct := range criterias {
+		qry.Where(fmt.Sprintf("%s %s ?", ct.GetKey(), ct.GetLogic()), ct.GetValue())
+	}
+	tx := qry.
+		Scopes(gorm_models.
+			Paginate(gorm_models.
+				NewPaginated(
+					int(paginate.GetPage()),
+					int(paginate.GetPageSize()),
+					&cnt,
+					qry))).
+		Order(clause.OrderByColumn{
+			Column: clause.Column{Name: "created_date"},
+			Desc:   true,
+		}).Find(&campaigns)
+
+	if tx.Error != nil {
+		eService.logger.Errorf("not able to find any campaigns %v", tx.Error)
+		return cnt, nil, tx.Error
+	}
+	for _, campaign := range campaigns {
+		if err := eService.progress(ctx, campaign); err != nil {
+			return cnt, nil, err
+		}
+	}
+	eService.logger.Benchmark("assistantCampaignService.GetAll", time.Since(start))
+	return cnt, campaigns, nil
+}
+
+// progress counts the contacts of the campaign per state
+func (eService *assistantCampaignService) progress(ctx context.Context, campaign *internal_assistant_entity.AssistantCampaign) error {
+	db := eService.postgres.DB(ctx)
+	var counts []struct {
+		State internal_assistant_entity.CampaignContactState
+		Count uint64
+	}
+	tx := db.Model(&internal_assistant_entity.AssistantCampaignContact{}).
+		Select("state, count(*) as count").
+		Where("assistant_campaign_id = ?", campaign.Id).
+		Group("state").
+		Scan(&counts)
+	if tx.Error != nil {
+		eService.logger.Errorf("error while counting campaign contacts %v", tx.Error)
+		return tx.Error
+	}
+	progress := &internal_assistant_entity.AssistantCampaignProgress{}
+	for _, c := range counts {
+		progress.Total += c.Count
+		switch c.State {
+		case internal_assistant_entity.CONTACT_PENDING:
+			progress.Pending = c.Count
+		case internal_assistant_entity.CONTACT_DIALING:
+			progress.Dialing = c.Count
+		case internal_assistant_entity.CONTACT_COMPLETED:
+			progress.Completed = c.Count
+		case internal_assistant_entity.CONTACT_FAILED:
+			progress.Failed = c.Count
+		case internal_assistant_entity.CONTACT_CANCELLED:
+			progress.Cancelled = c.Count
+		}
+	}
+	campaign.Progress = progress
+	return nil
+}
+
+func (eService *assistantCampaignService) UpdateState(ctx context.Context,
+	auth types.SimplePrinciple,
+	campaignId uint64,
+	assistantId uint64,
+	state internal_assistant_entity.CampaignState,
+) (*internal_assistant_entity.AssistantCampaign, error) {
+	start := time.Now()
+	db := eService.postgres.DB(ctx)
+	// only a campaign which is not finished can change its state
+	from := []internal_assistant_entity.CampaignState{internal_assistant_entity.CAMPAIGN_RUNNING, internal_assistant_entity.CAMPAIGN_PAUSED}
+	updates := map[string]interface{}{
+		"state":        state,
+		"updated_date": time.Now(),
+	}
+	if auth.GetUserId() != nil {
+		updates["updated_by"] = *auth.GetUserId()
+	}
+	err := db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&internal_assistant_entity.AssistantCampaign{}).
+			Where("id = ? AND assistant_id = ? AND project_id = ? AND state IN ?",
+				campaignId, assistantId, *auth.GetCurrentProjectId(), from).
+			Updates(updates)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return fmt.Errorf("campaign is not running or paused")
+		}
+		if state != internal_assistant_entity.CAMPAIGN_CANCELLED {
+			return nil
+		}
+		return tx.Model(&internal_assistant_entity.AssistantCampaignContact{}).
+			Where("assistant_campaign_id = ? AND state = ?", campaignId, internal_assistant_entity.CONTACT_PENDING).
+			Updates(map[string]interface{}{
+				"state":        internal_assistant_entity.CONTACT_CANCELLED,
+				"updated_date": time.Now(),
+			}).Error
+	})
+	eService.logger.Benchmark("assistantCampaignService.UpdateState", time.Since(start))
+	if err != nil {
+		eService.logger.Errorf("error while updating campaign state %v", err)
+		return nil, err
+	}
+	return eService.Get(ctx, auth, campaignId, assistantId)
+}
+
+func (eService *assistantCampaignService) Delete(ctx context.Context, auth types.SimplePrinciple, campaignId, assistantId uint64) (*internal_assistant_entity.AssistantCampaign, error) {
+	start := time.Now()
+	db := eService.postgres.DB(ctx)
+	campaign := &internal_assistant_entity.AssistantCampaign{
+		Mutable: gorm_models.Mutable{
+			Status: type_enums.RECORD_ARCHIEVE,
+		},
+	}
+	if auth.GetUserId() != nil {
+		campaign.UpdatedBy = *auth.GetUserId()
+	}
+	err := db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("id = ? AND assistant_id = ? AND project_id = ?",
+			campaignId, assistantId, *auth.GetCurrentProjectId()).
+			Updates(campaign).Error; err != nil {
+			return err
+		}
+		return tx.Model(&internal_assistant_entity.AssistantCampaignContact{}).
+			Where("assistant_campaign_id = ? AND state = ?", campaignId, internal_assistant_entity.CONTACT_PENDING).
+			Updates(map[string]interface{}{
+				"state":        internal_assistant_entity.CONTACT_CANCELLED,
+				"updated_date": time.Now(),
+			}).Error
+	})
+	eService.logger.Benchmark("assistantCampaignService.Delete", time.Since(start))
+	if err != nil {
+		eService.logger.Errorf("error while deleting campaign %v", err)
+		return nil, err
+	}
+	return campaign, nil
+}
+
+func (eService *assistantCampaignService) GetAllContact(ctx context.Context,
+	auth types.SimplePrinciple,
+	campaignId uint64,
+	criterias []*protos.Criteria,
+	paginate *protos.Paginate) (int64, []*internal_assistant_entity.AssistantCampaignContact, error) {
+	start := time.Now()
+	db := eService.postgres.DB(ctx)
+	var (
+		contacts []*internal_assistant_entity.AssistantCampaignContact
+		cnt      int64
+	)
+	qry := db.Model(internal_assistant_entity.AssistantCampaignContact{})
+	qry.
+		Where("assistant_campaign_id = ? AND assistant_campaign_id IN (?)", campaignId,
+			db.Model(&internal_assistant_entity.AssistantCampaign{}).
+				Select("id").
+				Where("project_id = ?", *auth.GetCurrentProjectId()))
+	for _, ct := range criterias {
+		qry.Where(fmt.Sprintf("%s %s ?", ct.GetKey(), ct.GetLogic()), ct.GetValue())
+	}
+	tx := qry.
+		Scopes(gorm_models.
+			Paginate(gorm_models.
+				NewPaginated(
+					int(paginate.GetPage()),
+					int(paginate.GetPageSize()),
+					&cnt,
+					qry))).
+		Order(clause.OrderByColumn{
+			Column: clause.Column{Name: "id"},
+		}).Find(&contacts)
+
+	if tx.Error != nil {
+		eService.logger.Errorf("not able to find any campaign contacts %v", tx.Error)
+		return cnt, nil, tx.Error
+	}
+	eService.logger.Benchmark("assistantCampaignService.GetAllContact", time.Since(start))
+	return cnt, contacts, nil
+}
+
+func (eService *assistantCampaignService) GetById(ctx context.Context, campaignId uint64) (*internal_assistant_entity.AssistantCampaign, error) {
+	start := time.Now()
+	db := eService.postgres.DB(ctx)
+	var campaign *internal_assistant_entity.AssistantCampaign
+	tx := db.Where("id = ?", campaignId).First(&campaign)
+	eService.logger.Benchmark("assistantCampaignService.GetById", time.Since(start))
+	if tx.Error != nil {
+		eService.logger.Errorf("not able to find campaign %d %v", campaignId, tx.Error)
+		return nil, tx.Error
+	}
+	return campaign, nil
+}
+
+func (eService *assistantCampaignService) GetAllRunning(ctx context.Context, now time.Time) ([]*internal_assistant_entity.AssistantCampaign, error) {
+	start := time.Now()
+	db := eService.postgres.DB(ctx)
+	var campaigns []*internal_assistant_entity.AssistantCampaign
+	tx := db.
+		Where("state = ? AND status = ? AND start_date <= ?",
+			internal_assistant_entity.CAMPAIGN_RUNNING, type_enums.RECORD_ACTIVE, now).
+		Find(&campaigns)
+	eService.logger.Benchmark("assistantCampaignService.GetAllRunning", time.Since(start))
+	if tx.Error != nil {
+		eService.logger.Errorf("error while getting running campaigns %v", tx.Error)
+		return nil, tx.Error
+	}
+	return campaigns, nil
+}
+
+func (eService *assistantCampaignService) ClaimContacts(ctx context.Context, campaignId uint64, now time.Time) (*internal_assistant_entity.AssistantCampaign, []*internal_assistant_entity.AssistantCampaignContact, error) {
+	start := time.Now()
+	db := eService.postgres.DB(ctx)
+	var (
+		campaign *internal_assistant_entity.AssistantCampaign
+		claimed  []*internal_assistant_entity.AssistantCampaignContact
+	)
+	err := db.Transaction(func(tx *gorm.DB) error {
+		// the campaign row is locked so pacing holds across workers, a busy campaign is skipped for this poll
+		var campaigns []*internal_assistant_entity.AssistantCampaign
+		if err := tx.
+			Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("id = ? AND state = ? AND status = ?",
+				campaignId, internal_assistant_entity.CAMPAIGN_RUNNING, type_enums.RECORD_ACTIVE).
+			Limit(1).
+			Find(&campaigns).Error; err != nil {
+			return err
+		}
+		if len(campaigns) == 0 {
+			return nil
+		}
+		campaign = campaigns[0]
+
+		var dialing int64
+		if err := tx.Model(&internal_assistant_entity.AssistantCampaignContact{}).
+			Where("assistant_campaign_id = ? AND state = ?", campaignId, internal_assistant_entity.CONTACT_DIALING).
+			Count(&dialing).Error; err != nil {
+			return err
+		}
+		limit := min(int(campaign.Concurrency)-int(dialing), dialAllowance(campaign, now))
+		if limit <= 0 {
+			return nil
+		}
+
+		var contacts []*internal_assistant_entity.AssistantCampaignContact
+		if err := tx.
+			Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("assistant_campaign_id = ? AND state = ? AND next_attempt_date <= ?",
+				campaignId, internal_assistant_entity.CONTACT_PENDING, now).
+			Order("next_attempt_date").
+			Limit(limit).
+			Find(&contacts).Error; err != nil {
+			return err
+		}
+		due, rescheduled, failed := claim(campaign, contacts, now)
+		for _, contact := range failed {
+			if err := tx.Model(contact).Select("state", "last_error", "updated_date").Updates(contact).Error; err != nil {
+				return err
+			}
+		}
+		for _, contact := range rescheduled {
+			if err := tx.Model(contact).Select("next_attempt_date", "updated_date").Updates(contact).Error; err != nil {
+				return err
+			}
+		}
+		ids := make([]uint64, 0, len(due))
+		for _, contact := range due {
+			ids = append(ids, contact.Id)
+		}
+		claimed = due
+		if len(ids) == 0 {
+			return nil
+		}
+		if err := tx.Model(&internal_assistant_entity.AssistantCampaignContact{}).
+			Where("id IN ?", ids).
+			Updates(map[string]interface{}{
+				"state":                     internal_assistant_entity.CONTACT_DIALING,
+				"attempts":                  gorm.Expr("attempts + 1"),
+				"assistant_conversation_id": 0,
+				"updated_date":              now,
+			}).Error; err != nil {
+			return err
+		}
+		return tx.Model(&internal_assistant_entity.AssistantCampaign{}).
+			Where("id = ?", campaignId).
+			Update("last_dial_date", now).Error
+	})
+	eService.logger.Benchmark("assistantCampaignService.ClaimContacts", time.Since(start))
+	if err != nil {
+		eService.logger.Errorf("error while claiming campaign contacts %v", err)
+		return nil, nil, err
+	}
+	return campaign, claimed, nil
+}
+
+// claim splits the due contacts into the ones dialed now, the ones rescheduled to the calling hours
+// of their timezone and the ones failed for an invalid timezone
+func claim(
+	campaign *internal_assistant_entity.AssistantCampaign,
+	contacts []*internal_assistant_entity.AssistantCampaignContact,
+	now time.Time,
+) (due, rescheduled, failed []*internal_assistant_entity.AssistantCampaignContact) {
+	for _, contact := range contacts {
+		next, err := campaign.NextCallingTime(now, contact.Timezone)
+		switch {
+		case err != nil:
+			contact.State = internal_assistant_entity.CONTACT_FAILED
+			contact.LastError = err.Error()
+			failed = append(failed, contact)
+		case next.After(now):
+			contact.NextAttemptDate = gorm_models.TimeWrapper(next)
+			rescheduled = append(rescheduled, contact)
+		default:
+			contact.State = internal_assistant_entity.CONTACT_DIALING
+			contact.Attempts++
+			due = append(due, contact)
+		}
+	}
+	return due, rescheduled, failed
+}
+
+// dialAllowance is the number of calls the campaign can place since its last dial,
+// bursts are capped to the calls of a second
+func dialAllowance(campaign *internal_assistant_entity.AssistantCampaign, now time.Time) int {
+	if campaign.CallsPerSecond <= 0 {
+		return 0
+	}
+	burst := int(math.Ceil(campaign.CallsPerSecond))
+	last := time.Time(campaign.LastDialDate)
+	if last.IsZero() {
+		return burst
+	}
+	return min(int(now.Sub(last).Seconds()*campaign.CallsPerSecond), burst)
+}
+
+func (eService *assistantCampaignService) GetAllDialingCampaign(ctx context.Context) ([]uint64, error) {
+	start := time.Now()
+	db := eService.postgres.DB(ctx)
+	var campaignIds []uint64
+	tx := db.Model(&internal_assistant_entity.AssistantCampaignContact{}).
+		Distinct("assistant_campaign_id").
+		Where("state = ?", internal_assistant_entity.CONTACT_DIALING).
+		Pluck("assistant_campaign_id", &campaignIds)
+	eService.logger.Benchmark("assistantCampaignService.GetAllDialingCampaign", time.Since(start))
+	if tx.Error != nil {
+		eService.logger.Errorf("error while getting dialing campaigns %v", tx.Error)
+		return nil, tx.Error
+	}
+	return campaignIds, nil
+}
+
+func (eService *assistantCampaignService) GetAllEndedContact(ctx context.Context,
+	campaignId uint64,
+	afterId uint64,
+	option *internal_services.EndedContactOption,
+	limit int,
+) ([]*internal_assistant_entity.AssistantCampaignContact, error) {
+	start := time.Now()
+	db := eService.postgres.DB(ctx)
+	var contacts []*internal_assistant_entity.AssistantCampaignContact
+	// calls in progress are left out so they never hold back the ones which ended
+	ended := db.Model(&internal_conversation_gorm.AssistantConversationTelephonyEvent{}).
+		Select("1").
+		Where("assistant_conversation_telephony_events.assistant_conversation_id = assistant_campaign_contacts.assistant_conversation_id").
+		Where("LOWER(event_type) IN ?", option.FinalEvents)
+	tx := db.
+		Where("assistant_campaign_id = ? AND state = ? AND id > ?", campaignId, internal_assistant_entity.CONTACT_DIALING, afterId).
+		Where(db.
+			Where("COALESCE(assistant_conversation_id, 0) = 0 AND updated_date < ?", option.ClaimedBefore).
+			Or("COALESCE(assistant_conversation_id, 0) > 0 AND updated_date < ?", option.DialedBefore).
+			Or("COALESCE(assistant_conversation_id, 0) > 0 AND EXISTS (?)", ended)).
+		Order("id").
+		Limit(limit).
+		Find(&contacts)
+	eService.logger.Benchmark("assistantCampaignService.GetAllEndedContact", time.Since(start))
+	if tx.Error != nil {
+		eService.logger.Errorf("error while getting ended contacts of campaign %d %v", campaignId, tx.Error)
+		return nil, tx.Error
+	}
+	return contacts, nil
+}
+
+func (eService *assistantCampaignService) GetTelephonyEvents(ctx context.Context, conversationIds []uint64) (map[uint64][]string, error) {
+	start := time.Now()
+	out := make(map[uint64][]string, len(conversationIds))
+	if len(conversationIds) == 0 {
+		return out, nil
+	}
+	db := eService.postgres.DB(ctx)
+	var events []*internal_conversation_gorm.AssistantConversationTelephonyEvent
+	tx := db.
+		Select("assistant_conversation_id", "event_type").
+		Where("assistant_conversation_id IN ?", conversationIds).
+		Order("created_date").
+		Find(&events)
+	eService.logger.Benchmark("assistantCampaignService.GetTelephonyEvents", time.Since(start))
+	if tx.Error != nil {
+		eService.logger.Errorf("error while getting telephony events %v", tx.Error)
+		return nil, tx.Error
+	}
+	for _, evt := range events {
+		out[evt.AssistantConversationId] = append(out[evt.AssistantConversationId], evt.EventType)
+	}
+	return out, nil
+}
+
+func (eService *assistantCampaignService) UpdateContact(ctx context.Context,
+	contact *internal_assistant_entity.AssistantCampaignContact,
+	from internal_assistant_entity.CampaignContactState,
+) (bool, error) {
+	start := time.Now()
+	db := eService.postgres.DB(ctx)
+	tx := db.Model(&internal_assistant_entity.AssistantCampaignContact{}).
+		Where("id = ? AND state = ?", contact.Id, from).
+		Updates(map[string]interface{}{
+			"state":                     contact.State,
+			"attempts":                  contact.Attempts,
+			"next_attempt_date":         time.Time(contact.NextAttemptDate),
+			"assistant_conversation_id": contact.AssistantConversationId,
+			"last_outcome":              contact.LastOutcome,
+			"last_error":                contact.LastError,
+			"updated_date":              time.Now(),
+		})
+	eService.logger.Benchmark("assistantCampaignService.UpdateContact", time.Since(start))
+	if tx.Error != nil {
+		eService.logger.Errorf("error while updating campaign contact %v", tx.Error)
+		return false, tx.Error
+	}
+	return tx.RowsAffected > 0, nil
+}
+
+func (eService *assistantCampaignService) Complete(ctx context.Context, campaignId uint64) (bool, error) {
+	start := time.Now()
+	db := eService.postgres.DB(ctx)
+	tx := db.Model(&internal_assistant_entity.AssistantCampaign{}).
+		Where("id = ? AND state = ?", campaignId, internal_assistant_entity.CAMPAIGN_RUNNING).
+		Where("NOT EXISTS (?)", db.Model(&internal_assistant_entity.AssistantCampaignContact{}).
+			Select("1").
+			Where("assistant_campaign_id = ? AND state IN ?", campaignId,
+				[]internal_assistant_entity.CampaignContactState{
+					internal_assistant_entity.CONTACT_PENDING,
+					internal_assistant_entity.CONTACT_DIALING,
+				})).
+		Updates(map[string]interface{}{
+			"state":        internal_assistant_entity.CAMPAIGN_COMPLETED,
+			"updated_date": time.Now(),
+		})
+	eService.logger.Benchmark("assistantCampaignService.Complete", time.Since(start))
+	if tx.Error != nil {
+		eService.logger.Errorf("error while completing campaign %v", tx.Error)
+		return false, tx.Error
+	}
+	return tx.RowsAffected > 0, nil
+}
@@ -0,0 +1,187 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_assistant_service
+
+import (
+	"context"
+	"os"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	internal_assistant_entity "github.com/rapidaai/api/assistant-api/internal/entity/assistants"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/configs"
+	"github.com/rapidaai/pkg/connectors"
+	gorm_models "github.com/rapidaai/pkg/models/gorm"
+	"github.com/rapidaai/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDialAllowance(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name           string
+		callsPerSecond float64
+		lastDial       time.Time
+		want           int
+	}{
+		{"no pace", 0, time.Time{}, 0},
+		{"first dial bursts a second", 3, time.Time{}, 3},
+		{"fractional rate bursts one", 0.5, time.Time{}, 1},
+		{"nothing since last dial", 2, now, 0},
+		{"half a second", 4, now.Add(-500 * time.Millisecond), 2},
+		{"slow rate waits", 0.5, now.Add(-time.Second), 0},
+		{"slow rate after interval", 0.5, now.Add(-2 * time.Second), 1},
+		{"idle campaign capped to burst", 2, now.Add(-time.Hour), 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			campaign := &internal_assistant_entity.AssistantCampaign{
+				CallsPerSecond: tt.callsPerSecond,
+				LastDialDate:   gorm_models.TimeWrapper(tt.lastDial),
+			}
+			assert.Equal(t, tt.want, dialAllowance(campaign, now))
+		})
+	}
+}
+
+func TestClaim(t *testing.T) {
+	// 12:00 utc is within 09:00-17:00 of london and outside of it in tokyo
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	campaign := &internal_assistant_entity.AssistantCampaign{
+		Timezone:         "Europe/London",
+		CallingHourStart: "09:00",
+		CallingHourEnd:   "17:00",
+	}
+	open := &internal_assistant_entity.AssistantCampaignContact{ToNumber: "+441", Attempts: 1}
+	closed := &internal_assistant_entity.AssistantCampaignContact{ToNumber: "+811", Timezone: "Asia/Tokyo"}
+	invalid := &internal_assistant_entity.AssistantCampaignContact{ToNumber: "+000", Timezone: "Nowhere/Unknown"}
+
+	due, rescheduled, failed := claim(campaign,
+		[]*internal_assistant_entity.AssistantCampaignContact{open, closed, invalid}, now)
+
+	require.Equal(t, []*internal_assistant_entity.AssistantCampaignContact{open}, due)
+	assert.Equal(t, internal_assistant_entity.CONTACT_DIALING, open.State)
+	assert.Equal(t, uint32(2), open.Attempts)
+
+	require.Equal(t, []*internal_assistant_entity.AssistantCampaignContact{closed}, rescheduled)
+	next := time.Time(closed.NextAttemptDate)
+	assert.True(t, next.After(now))
+	assert.Equal(t, 9, next.In(mustLocation(t, "Asia/Tokyo")).Hour())
+	assert.Empty(t, closed.State)
+
+	require.Equal(t, []*internal_assistant_entity.AssistantCampaignContact{invalid}, failed)
+	assert.Equal(t, internal_assistant_entity.CONTACT_FAILED, invalid.State)
+	assert.Contains(t, invalid.LastError, "Nowhere/Unknown")
+}
+
+func mustLocation(t *testing.T, name string) *time.Location {
+	loc, err := time.LoadLocation(name)
+	require.NoError(t, err)
+	return loc
+}
+
+// TestClaimContactsConcurrently runs against a migrated database given by
+// TEST_POSTGRES_HOST, TEST_POSTGRES_PORT, TEST_POSTGRES_USER, TEST_POSTGRES_PASSWORD and TEST_POSTGRES_DB
+func TestClaimContactsConcurrently(t *testing.T) {
+	postgres := testPostgres(t)
+	logger := commons.NewApplicationLoggerWithOptions(commons.EnableFile(false), commons.Level("error"))
+	logger.InitLogger()
+	svc := NewAssistantCampaignService(logger, postgres)
+	ctx := context.Background()
+
+	projectId, organizationId := uint64(time.Now().UnixNano()), uint64(1)
+	auth := &types.ProjectScope{ProjectId: &projectId, OrganizationId: &organizationId}
+	contacts := make([]*internal_assistant_entity.AssistantCampaignContact, 20)
+	for i := range contacts {
+		contacts[i] = &internal_assistant_entity.AssistantCampaignContact{ToNumber: "+1555000" + strconv.Itoa(i)}
+	}
+	campaign, err := svc.Create(ctx, auth, &internal_assistant_entity.AssistantCampaign{
+		Name:            "claim-test",
+		AssistantId:     1,
+		FromNumber:      "+15550000000",
+		Concurrency:     5,
+		CallsPerSecond:  100,
+		MaxAttempts:     1,
+		CredentialToken: "sealed",
+		StartDate:       gorm_models.TimeWrapper(time.Now().Add(-time.Minute)),
+	}, contacts)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		db := postgres.DB(ctx)
+		db.Where("assistant_campaign_id = ?", campaign.Id).Delete(&internal_assistant_entity.AssistantCampaignContact{})
+		db.Delete(&internal_assistant_entity.AssistantCampaign{}, campaign.Id)
+	})
+
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		claimed = make(map[uint64]int)
+	)
+	now := time.Now()
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, contacts, err := svc.ClaimContacts(ctx, campaign.Id, now)
+			assert.NoError(t, err)
+			mu.Lock()
+			defer mu.Unlock()
+			for _, contact := range contacts {
+				claimed[contact.Id]++
+			}
+		}()
+	}
+	wg.Wait()
+
+	// the campaign is claimed by one worker at a time, up to its concurrency
+	assert.Len(t, claimed, int(campaign.Concurrency))
+	for id, n := range claimed {
+		assert.Equal(t, 1, n, "contact %d claimed more than once", id)
+	}
+
+	var dialing int64
+	require.NoError(t, postgres.DB(ctx).Model(&internal_assistant_entity.AssistantCampaignContact{}).
+		Where("assistant_campaign_id = ? AND state = ?", campaign.Id, internal_assistant_entity.CONTACT_DIALING).
+		Count(&dialing).Error)
+	assert.Equal(t, int64(len(claimed)), dialing)
+
+	// concurrency is full, nothing more is claimed
+	_, more, err := svc.ClaimContacts(ctx, campaign.Id, now.Add(time.Minute))
+	require.NoError(t, err)
+	assert.Empty(t, more)
+}
+
+func testPostgres(t *testing.T) connectors.PostgresConnector {
+	t.Helper()
+	host := os.Getenv("TEST_POSTGRES_HOST")
+	if host == "" {
+		t.Skip("TEST_POSTGRES_HOST is not set")
+	}
+	port, _ := strconv.Atoi(os.Getenv("TEST_POSTGRES_PORT"))
+	if port == 0 {
+		port = 5432
+	}
+	logger := commons.NewApplicationLoggerWithOptions(commons.EnableFile(false), commons.Level("error"))
+	logger.InitLogger()
+	cfg := &configs.PostgresConfig{
+		Host:               host,
+		Port:               port,
+		DBName:             os.Getenv("TEST_POSTGRES_DB"),
+		SslMode:            "disable",
+		MaxIdealConnection: 10,
+		MaxOpenConnection:  20,
+	}
+	cfg.Auth.User = os.Getenv("TEST_POSTGRES_USER")
+	cfg.Auth.Password = os.Getenv("TEST_POSTGRES_PASSWORD")
+	postgres := connectors.NewPostgresConnector(cfg, logger)
+	require.NoError(t, postgres.Connect(context.Background()))
+	t.Cleanup(func() { postgres.Disconnect(context.Background()) })
+	return postgres
+}
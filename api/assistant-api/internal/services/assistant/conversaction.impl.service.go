@@ -227,6 +227,35 @@ func (conversationService *assistantConversationService) GetConversation(
 	return assistantConversation, nil
 }
 
+func (conversationService *assistantConversationService) GetActiveConversation(
+	ctx context.Context,
+	auth types.SimplePrinciple,
+	identifier string,
+	assistantId uint64,
+	since time.Time) (*internal_conversation_gorm.AssistantConversation, error) {
+	start := time.Now()
+	db := conversationService.postgres.DB(ctx)
+	var assistantConversation *internal_conversation_gorm.AssistantConversation
+	tx := db.
+		Where("identifier = ? AND assistant_id = ? AND project_id = ? AND organization_id = ?",
+			identifier,
+			assistantId,
+			*auth.GetCurrentProjectId(),
+			*auth.GetCurrentOrganizationId()).
+		Where("created_date >= ? OR EXISTS (SELECT 1 FROM assistant_conversation_messages WHERE assistant_conversation_messages.assistant_conversation_id = assistant_conversations.id AND assistant_conversation_messages.created_date >= ?)", since, since).
+		Order(clause.OrderByColumn{
+			Column: clause.Column{Name: "id"},
+			Desc:   true,
+		}).
+		First(&assistantConversation)
+	if tx.Error != nil {
+		conversationService.logger.Benchmark("conversationService.GetActiveConversation", time.Since(start))
+		return nil, tx.Error
+	}
+	conversationService.logger.Benchmark("conversationService.GetActiveConversation", time.Since(start))
+	return assistantConversation, nil
+}
+
 func (conversationService *assistantConversationService) CreateConversation(
 	ctx context.Context,
 	auth types.SimplePrinciple,
@@ -526,21 +555,24 @@ func (conversationService *assistantConversationService) CreateCustomConversatio
 	return mtrx, nil
 }
 
-func (conversationService *assistantConversationService) CreateConversationRecording(
+// BeginConversationRecording reserves the recording of the conversation and the spool its
+// audio is written to while the call goes on
+func (conversationService *assistantConversationService) BeginConversationRecording(
 	ctx context.Context,
 	auth types.SimplePrinciple,
 	assistantId,
 	assistantConversationId uint64,
-	body []byte,
-) (*internal_conversation_gorm.AssistantConversationRecording, error) {
-	start := time.Now()
-	db := conversationService.postgres.DB(ctx)
-
+	extension string,
+) (*internal_conversation_gorm.AssistantConversationRecording, *storages.Spool, error) {
 	s3Prefix := conversationService.ObjectPrefix(*auth.GetCurrentOrganizationId(), *auth.GetCurrentProjectId())
 	recordingId := gorm_generator.ID()
 
-	key := conversationService.ObjectKey(s3Prefix, recordingId, fmt.Sprintf("recording-%d.wav", assistantConversationId))
-	conversationService.storage.Store(ctx, key, body)
+	key := conversationService.ObjectKey(s3Prefix, recordingId, fmt.Sprintf("recording-%d.%s", assistantConversationId, extension))
+	spool, err := storages.NewSpool(ctx, conversationService.storage, key, 0)
+	if err != nil {
+		conversationService.logger.Errorf("unable to create spool for conversation recording %v", err)
+		return nil, nil, err
+	}
 
 	conversationRecording := &internal_conversation_gorm.AssistantConversationRecording{
 		Audited: gorm_models.Audited{
@@ -557,6 +589,28 @@ func (conversationService *assistantConversationService) CreateConversationRecor
 	if auth.GetUserId() != nil {
 		conversationRecording.Mutable.CreatedBy = *auth.GetUserId()
 	}
+	return conversationRecording, spool, nil
+}
+
+// CreateConversationRecording completes the upload of the spooled recording and stores the recording
+func (conversationService *assistantConversationService) CreateConversationRecording(
+	ctx context.Context,
+	auth types.SimplePrinciple,
+	conversationRecording *internal_conversation_gorm.AssistantConversationRecording,
+	spool *storages.Spool,
+) (*internal_conversation_gorm.AssistantConversationRecording, error) {
+	start := time.Now()
+	db := conversationService.postgres.DB(ctx)
+
+	if size, err := spool.Size(); err == nil {
+		conversationRecording.Size = uint64(size)
+	}
+	if output := spool.Complete(ctx); output.Error != nil {
+		conversationService.logger.Benchmark("conversationService.CreateConversationRecording", time.Since(start))
+		conversationService.logger.Errorf("error while uploading conversation recording %v", output.Error)
+		return nil, output.Error
+	}
+
 	tx := db.Create(&conversationRecording)
 	if tx.Error != nil {
 		conversationService.logger.Benchmark("conversationService.CreateConversationRecording", time.Since(start))
@@ -0,0 +1,128 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_assistant_service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	internal_conversation_gorm "github.com/rapidaai/api/assistant-api/internal/entity/conversations"
+	gorm_models "github.com/rapidaai/pkg/models/gorm"
+	"github.com/rapidaai/pkg/types"
+	"github.com/rapidaai/protos"
+	"gorm.io/gorm/clause"
+)
+
+func (conversationService *assistantConversationService) CreateConversationEvaluations(
+	ctx context.Context,
+	auth types.SimplePrinciple,
+	assistantId uint64,
+	assistantProviderModelId uint64,
+	assistantConversationId uint64,
+	evaluations []*internal_conversation_gorm.AssistantConversationEvaluation,
+) ([]*internal_conversation_gorm.AssistantConversationEvaluation, error) {
+	start := time.Now()
+	if len(evaluations) == 0 {
+		return evaluations, nil
+	}
+	db := conversationService.postgres.DB(ctx)
+	for _, evaluation := range evaluations {
+		evaluation.AssistantId = assistantId
+		evaluation.AssistantProviderModelId = assistantProviderModelId
+		evaluation.AssistantConversationId = assistantConversationId
+		evaluation.OrganizationId = *auth.GetCurrentOrganizationId()
+		evaluation.ProjectId = *auth.GetCurrentProjectId()
+		if auth.GetUserId() != nil {
+			evaluation.CreatedBy = *auth.GetUserId()
+			evaluation.UpdatedBy = *auth.GetUserId()
+		}
+	}
+	tx := db.Create(&evaluations)
+	if tx.Error != nil {
+		conversationService.logger.Benchmark("conversationService.CreateConversationEvaluations", time.Since(start))
+		conversationService.logger.Errorf("unable to create conversation evaluations %v", tx.Error)
+		return nil, tx.Error
+	}
+	conversationService.logger.Benchmark("conversationService.CreateConversationEvaluations", time.Since(start))
+	return evaluations, nil
+}
+
+func (conversationService *assistantConversationService) GetAllConversationEvaluation(
+	ctx context.Context,
+	auth types.SimplePrinciple,
+	assistantId uint64,
+	criterias []*protos.Criteria,
+	paginate *protos.Paginate,
+) (int64, []*internal_conversation_gorm.AssistantConversationEvaluation, error) {
+	start := time.Now()
+	db := conversationService.postgres.DB(ctx)
+	var (
+		evaluations []*internal_conversation_gorm.AssistantConversationEvaluation
+		cnt         int64
+	)
+	qry := db.Model(internal_conversation_gorm.AssistantConversationEvaluation{}).
+		Where("assistant_id = ? AND organization_id = ? AND project_id = ?", assistantId, *auth.GetCurrentOrganizationId(), *auth.GetCurrentProjectId())
+	for _, ct := range criterias {
+		qry = qry.Where(fmt.Sprintf("%s %s ?", ct.GetKey(), ct.GetLogic()), ct.GetValue())
+	}
+	tx := qry.
+		Scopes(gorm_models.
+			Paginate(gorm_models.
+				NewPaginated(
+					int(paginate.GetPage()),
+					int(paginate.GetPageSize()),
+					&cnt,
+					qry))).
+		Order(clause.OrderByColumn{
+			Column: clause.Column{Name: "created_date"},
+			Desc:   true,
+		}).Find(&evaluations)
+	if tx.Error != nil {
+		conversationService.logger.Benchmark("conversationService.GetAllConversationEvaluation", time.Since(start))
+		conversationService.logger.Errorf("unable to get conversation evaluations for assistant %v", tx.Error)
+		return cnt, nil, tx.Error
+	}
+	conversationService.logger.Benchmark("conversationService.GetAllConversationEvaluation", time.Since(start))
+	return cnt, evaluations, nil
+}
+
+func (conversationService *assistantConversationService) GetEvaluationSummary(
+	ctx context.Context,
+	auth types.SimplePrinciple,
+	assistantId uint64,
+	criterias []*protos.Criteria,
+) ([]*internal_conversation_gorm.AssistantConversationEvaluationSummary, error) {
+	start := time.Now()
+	db := conversationService.postgres.DB(ctx)
+	var summaries []*internal_conversation_gorm.AssistantConversationEvaluationSummary
+	qry := db.Model(internal_conversation_gorm.AssistantConversationEvaluation{}).
+		Select(`assistant_provider_model_id,
+			assistant_evaluator_id,
+			name,
+			type,
+			COUNT(*) AS count,
+			SUM(CASE WHEN passed THEN 1 ELSE 0 END) AS passed,
+			AVG(CASE WHEN passed THEN 1.0 ELSE 0.0 END) AS pass_rate,
+			AVG(score) AS average_score,
+			MIN(score) AS min_score,
+			MAX(score) AS max_score`).
+		Where("assistant_id = ? AND organization_id = ? AND project_id = ?", assistantId, *auth.GetCurrentOrganizationId(), *auth.GetCurrentProjectId())
+	for _, ct := range criterias {
+		qry = qry.Where(fmt.Sprintf("%s %s ?", ct.GetKey(), ct.GetLogic()), ct.GetValue())
+	}
+	tx := qry.
+		Group("assistant_provider_model_id, assistant_evaluator_id, name, type").
+		Order("assistant_provider_model_id DESC, name").
+		Scan(&summaries)
+	if tx.Error != nil {
+		conversationService.logger.Benchmark("conversationService.GetEvaluationSummary", time.Since(start))
+		conversationService.logger.Errorf("unable to get evaluation summary for assistant %v", tx.Error)
+		return nil, tx.Error
+	}
+	conversationService.logger.Benchmark("conversationService.GetEvaluationSummary", time.Since(start))
+	return summaries, nil
+}
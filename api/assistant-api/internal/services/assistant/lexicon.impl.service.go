@@ -0,0 +1,191 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_assistant_service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	internal_assistant_entity "github.com/rapidaai/api/assistant-api/internal/entity/assistants"
+	internal_services "github.com/rapidaai/api/assistant-api/internal/services"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/connectors"
+	gorm_models "github.com/rapidaai/pkg/models/gorm"
+	"github.com/rapidaai/pkg/types"
+	type_enums "github.com/rapidaai/pkg/types/enums"
+	"github.com/rapidaai/protos"
+	"gorm.io/gorm/clause"
+)
+
+type assistantLexiconService struct {
+	logger   commons.Logger
+	postgres connectors.PostgresConnector
+}
+
+func NewAssistantLexiconService(logger commons.Logger, postgres connectors.PostgresConnector) internal_services.AssistantLexiconService {
+	return &assistantLexiconService{
+		logger:   logger,
+		postgres: postgres,
+	}
+}
+
+// Get implements internal_services.AssistantLexiconService.
+func (eService *assistantLexiconService) Get(ctx context.Context, auth types.SimplePrinciple, lexiconId, assistantId uint64) (*internal_assistant_entity.AssistantLexicon, error) {
+	start := time.Now()
+	db := eService.postgres.DB(ctx)
+	var lexicon *internal_assistant_entity.AssistantLexicon
+	tx := db.Where("id = ? AND assistant_id = ?", lexiconId, assistantId).
+		First(&lexicon)
+	if tx.Error != nil {
+		eService.logger.Benchmark("assistantLexiconService.Get", time.Since(start))
+		eService.logger.Errorf("not able to find any lexicon %v", tx.Error)
+		return nil, tx.Error
+	}
+	eService.logger.Benchmark("assistantLexiconService.Get", time.Since(start))
+	return lexicon, nil
+}
+
+func (eService *assistantLexiconService) Create(ctx context.Context,
+	auth types.SimplePrinciple,
+	assistantId uint64,
+	phrase string,
+	matchType string,
+	caseSensitive bool,
+	alias string,
+	phoneme string,
+	alphabet string,
+	description *string,
+) (*internal_assistant_entity.AssistantLexicon, error) {
+	start := time.Now()
+	db := eService.postgres.DB(ctx)
+	lexicon := &internal_assistant_entity.AssistantLexicon{
+		AssistantId:   assistantId,
+		Phrase:        phrase,
+		MatchType:     matchType,
+		CaseSensitive: caseSensitive,
+		Alias:         alias,
+		Phoneme:       phoneme,
+		Alphabet:      alphabet,
+		Description:   *description,
+		Mutable: gorm_models.Mutable{
+			CreatedBy: *auth.GetUserId(),
+			Status:    type_enums.RECORD_ACTIVE,
+		},
+	}
+	tx := db.Create(&lexicon)
+	if tx.Error != nil {
+		eService.logger.Benchmark("assistantLexiconService.Create", time.Since(start))
+		eService.logger.Errorf("error while creating lexicon %v", tx.Error)
+		return nil, tx.Error
+	}
+	eService.logger.Benchmark("assistantLexiconService.Create", time.Since(start))
+	return lexicon, nil
+}
+
+func (eService *assistantLexiconService) Update(ctx context.Context,
+	auth types.SimplePrinciple,
+	assistantId uint64,
+	lexiconId uint64,
+	phrase string,
+	matchType string,
+	caseSensitive bool,
+	alias string,
+	phoneme string,
+	alphabet string,
+	description *string,
+) (*internal_assistant_entity.AssistantLexicon, error) {
+	start := time.Now()
+	db := eService.postgres.DB(ctx)
+	lexicon := &internal_assistant_entity.AssistantLexicon{
+		Phrase:        phrase,
+		MatchType:     matchType,
+		CaseSensitive: caseSensitive,
+		Alias:         alias,
+		Phoneme:       phoneme,
+		Alphabet:      alphabet,
+		Description:   *description,
+		Mutable: gorm_models.Mutable{
+			UpdatedBy: *auth.GetUserId(),
+		},
+	}
+	// alias, phoneme and case sensitivity can be cleared, so columns are updated even when empty
+	tx := db.Model(&internal_assistant_entity.AssistantLexicon{}).
+		Where("id = ? AND assistant_id = ? ", lexiconId, assistantId).
+		Select("phrase", "match_type", "case_sensitive", "alias", "phoneme", "alphabet", "description", "updated_by").
+		Updates(lexicon)
+	if tx.Error != nil {
+		eService.logger.Benchmark("assistantLexiconService.Update", time.Since(start))
+		eService.logger.Errorf("error while updating lexicon %v", tx.Error)
+		return nil, tx.Error
+	}
+	eService.logger.Benchmark("assistantLexiconService.Update", time.Since(start))
+	return eService.Get(ctx, auth, lexiconId, assistantId)
+}
+
+func (eService *assistantLexiconService) Delete(ctx context.Context,
+	auth types.SimplePrinciple,
+	lexiconId uint64,
+	assistantId uint64,
+) (*internal_assistant_entity.AssistantLexicon, error) {
+	start := time.Now()
+	db := eService.postgres.DB(ctx)
+	lexicon := &internal_assistant_entity.AssistantLexicon{
+		Mutable: gorm_models.Mutable{
+			Status:    type_enums.RECORD_ARCHIEVE,
+			UpdatedBy: *auth.GetUserId(),
+		},
+	}
+	tx := db.Where("id = ? AND assistant_id = ? ",
+		lexiconId,
+		assistantId).Updates(&lexicon)
+	if tx.Error != nil {
+		eService.logger.Benchmark("assistantLexiconService.Delete", time.Since(start))
+		eService.logger.Errorf("error while deleting lexicon %v", tx.Error)
+		return nil, tx.Error
+	}
+	eService.logger.Benchmark("assistantLexiconService.Delete", time.Since(start))
+	return lexicon, nil
+}
+
+// GetAll implements internal_services.AssistantLexiconService.
+func (eService *assistantLexiconService) GetAll(ctx context.Context,
+	auth types.SimplePrinciple,
+	assistantId uint64,
+	criterias []*protos.Criteria,
+	paginate *protos.Paginate) (int64, []*internal_assistant_entity.AssistantLexicon, error) {
+	start := time.Now()
+	db := eService.postgres.DB(ctx)
+	var (
+		lexicons []*internal_assistant_entity.AssistantLexicon
+		cnt      int64
+	)
+	qry := db.Model(internal_assistant_entity.AssistantLexicon{})
+	qry.
+		Where("assistant_id = ? AND status = ?", assistantId, type_enums.RECORD_ACTIVE)
+	for _, ct := range criterias {
+		qry.Where(fmt.Sprintf("%s %s ?", ct.GetKey(), ct.GetLogic()), ct.GetValue())
+	}
+	tx := qry.
+		Scopes(gorm_models.
+			Paginate(gorm_models.
+				NewPaginated(
+					int(paginate.GetPage()),
+					int(paginate.GetPageSize()),
+					&cnt,
+					qry))).
+		Order(clause.OrderByColumn{
+			Column: clause.Column{Name: "created_date"},
+			Desc:   true,
+		}).Find(&lexicons)
+
+	if tx.Error != nil {
+		eService.logger.Errorf("not able to find any lexicons %v", tx.Error)
+		return cnt, nil, tx.Error
+	}
+	eService.logger.Benchmark("assistantLexiconService.GetAll", time.Since(start))
+	return cnt, lexicons, nil
+}
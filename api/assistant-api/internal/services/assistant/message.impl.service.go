@@ -172,6 +172,47 @@ func (conversationService *assistantConversationService) GetAllAssistantMessage(
 	return cnt, conversationMessage, nil
 }
 
+func (conversationService *assistantConversationService) GetTurnLatency(
+	ctx context.Context,
+	auth types.SimplePrinciple,
+	assistantId uint64,
+	criterias []*protos.Criteria,
+) ([]*internal_message_gorm.AssistantConversationMessageMetricPercentile, error) {
+	start := time.Now()
+	db := conversationService.postgres.DB(ctx)
+	var percentiles []*internal_message_gorm.AssistantConversationMessageMetricPercentile
+
+	// latencies are stored in nanoseconds, rollups are in milliseconds
+	qry := db.Model(internal_message_gorm.AssistantConversationMessageMetric{}).
+		Select(`assistant_conversation_message_metrics.name AS name,
+			assistant_conversation_message_metrics.description AS providers,
+			percentile_cont(0.5) WITHIN GROUP (ORDER BY assistant_conversation_message_metrics.value::numeric) / 1e6 AS p50,
+			percentile_cont(0.95) WITHIN GROUP (ORDER BY assistant_conversation_message_metrics.value::numeric) / 1e6 AS p95,
+			COUNT(*) AS count`).
+		Joins("JOIN assistant_conversations ON assistant_conversations.id = assistant_conversation_message_metrics.assistant_conversation_id").
+		Where("assistant_conversations.assistant_id = ? AND assistant_conversations.organization_id = ? AND assistant_conversations.project_id = ?", assistantId, *auth.GetCurrentOrganizationId(), *auth.GetCurrentProjectId()).
+		Where("assistant_conversation_message_metrics.name IN ?", []string{
+			type_enums.END_OF_SPEECH_DELAY.String(),
+			type_enums.LLM_TIME_TO_FIRST_TOKEN.String(),
+			type_enums.TTS_TIME_TO_FIRST_BYTE.String(),
+			type_enums.MOUTH_TO_EAR_LATENCY.String(),
+		})
+	for _, ct := range criterias {
+		qry = qry.Where(fmt.Sprintf("%s %s ?", ct.GetKey(), ct.GetLogic()), ct.GetValue())
+	}
+	tx := qry.
+		Group("assistant_conversation_message_metrics.name, assistant_conversation_message_metrics.description").
+		Order("assistant_conversation_message_metrics.description, assistant_conversation_message_metrics.name").
+		Scan(&percentiles)
+	if tx.Error != nil {
+		conversationService.logger.Benchmark("conversationService.GetTurnLatency", time.Since(start))
+		conversationService.logger.Errorf("unable to get turn latency for assistant %v", tx.Error)
+		return nil, tx.Error
+	}
+	conversationService.logger.Benchmark("conversationService.GetTurnLatency", time.Since(start))
+	return percentiles, nil
+}
+
 func (conversationService *assistantConversationService) GetAllMessage(
 	ctx context.Context,
 	auth types.SimplePrinciple,
@@ -21,6 +21,7 @@ import (
 	type_enums "github.com/rapidaai/pkg/types/enums"
 	"github.com/rapidaai/pkg/utils"
 	"github.com/rapidaai/protos"
+	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 )
 
@@ -68,22 +69,24 @@ func (eService *assistantWebhookService) Create(ctx context.Context,
 	retryStatusCodes []string,
 	maxRetryCount uint32,
 	executionPriority uint32,
+	secretCredentialId uint64,
 	description *string,
 ) (*internal_assistant_entity.AssistantWebhook, error) {
 	start := time.Now()
 	db := eService.postgres.DB(ctx)
 	webhook := &internal_assistant_entity.AssistantWebhook{
-		AssistantId:       assistantId,
-		Description:       *description,
-		HttpMethod:        httpMethod,
-		HttpUrl:           httpUrl,
-		HttpBody:          httpBody,
-		HttpHeaders:       httpHeaders,
-		RetryStatusCodes:  retryStatusCodes,
-		AssistantEvents:   assistantEvents,
-		MaxRetryCount:     maxRetryCount,
-		TimeoutSeconds:    timeoutSecond,
-		ExecutionPriority: executionPriority,
+		AssistantId:        assistantId,
+		Description:        *description,
+		HttpMethod:         httpMethod,
+		HttpUrl:            httpUrl,
+		HttpBody:           httpBody,
+		HttpHeaders:        httpHeaders,
+		RetryStatusCodes:   retryStatusCodes,
+		AssistantEvents:    assistantEvents,
+		MaxRetryCount:      maxRetryCount,
+		TimeoutSeconds:     timeoutSecond,
+		ExecutionPriority:  executionPriority,
+		SecretCredentialId: secretCredentialId,
 		Mutable: gorm_models.Mutable{
 			CreatedBy: *auth.GetUserId(),
 			Status:    type_enums.RECORD_ACTIVE,
@@ -111,21 +114,23 @@ func (eService *assistantWebhookService) Update(ctx context.Context,
 	retryStatusCodes []string,
 	maxRetryCount uint32,
 	executionPriority uint32,
+	secretCredentialId uint64,
 	description *string,
 ) (*internal_assistant_entity.AssistantWebhook, error) {
 	start := time.Now()
 	db := eService.postgres.DB(ctx)
 	webhook := &internal_assistant_entity.AssistantWebhook{
-		Description:       *description,
-		HttpMethod:        httpMethod,
-		HttpUrl:           httpUrl,
-		HttpHeaders:       httpHeaders,
-		HttpBody:          httpBody,
-		RetryStatusCodes:  retryStatusCodes,
-		AssistantEvents:   assistantEvents,
-		MaxRetryCount:     maxRetryCount,
-		TimeoutSeconds:    timeoutSecond,
-		ExecutionPriority: executionPriority,
+		Description:        *description,
+		HttpMethod:         httpMethod,
+		HttpUrl:            httpUrl,
+		HttpHeaders:        httpHeaders,
+		HttpBody:           httpBody,
+		RetryStatusCodes:   retryStatusCodes,
+		AssistantEvents:    assistantEvents,
+		MaxRetryCount:      maxRetryCount,
+		TimeoutSeconds:     timeoutSecond,
+		ExecutionPriority:  executionPriority,
+		SecretCredentialId: secretCredentialId,
 		Mutable: gorm_models.Mutable{
 			UpdatedBy: *auth.GetUserId(),
 		},
@@ -394,3 +399,171 @@ func (eService *assistantWebhookService) GetLogObject(
 
 	return requestData, responseData, nil
 }
+
+func (eService *assistantWebhookService) EnqueueLog(
+	ctx context.Context,
+	auth types.SimplePrinciple,
+	webhook *internal_assistant_entity.AssistantWebhook,
+	conversationId uint64,
+	event string,
+	idempotencyKey string,
+	request []byte,
+) (*internal_assistant_entity.AssistantWebhookLog, error) {
+	start := time.Now()
+	db := eService.postgres.DB(ctx)
+	s3Prefix := eService.ObjectPrefix(*auth.GetCurrentOrganizationId(), *auth.GetCurrentProjectId())
+	_auditId := gorm_generator.ID()
+
+	// request must be available before the worker picks the delivery
+	if result := eService.storage.Store(ctx, eService.ObjectKey(s3Prefix, _auditId, "request.json"), request); result.Error != nil {
+		eService.logger.Errorf("error while storing webhook request %v", result.Error)
+		return nil, result.Error
+	}
+
+	webhookLog := &internal_assistant_entity.AssistantWebhookLog{
+		Audited: gorm_models.Audited{
+			Id: _auditId,
+		},
+		HttpMethod:              webhook.GetMethod(),
+		HttpUrl:                 webhook.GetUrl(),
+		AssistantId:             webhook.AssistantId,
+		WebhookId:               webhook.Id,
+		AssistantConversationId: conversationId,
+		AssetPrefix:             s3Prefix,
+		Event:                   event,
+		IdempotencyKey:          idempotencyKey,
+		NextAttemptDate:         gorm_models.TimeWrapper(time.Now()),
+		Organizational: gorm_models.Organizational{
+			ProjectId:      *auth.GetCurrentProjectId(),
+			OrganizationId: *auth.GetCurrentOrganizationId(),
+		},
+		Mutable: gorm_models.Mutable{
+			Status: type_enums.RECORD_QUEUED,
+		},
+	}
+	tx := db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "idempotency_key"}},
+		DoNothing: true,
+	}).Create(&webhookLog)
+	if tx.Error != nil {
+		eService.logger.Benchmark("WebhookService.EnqueueLog", time.Since(start))
+		eService.logger.Errorf("error while enqueuing webhook log %v", tx.Error)
+		return nil, tx.Error
+	}
+	if tx.RowsAffected == 0 {
+		eService.logger.Debugf("webhook delivery already enqueued for idempotency key %s", idempotencyKey)
+	}
+	eService.logger.Benchmark("WebhookService.EnqueueLog", time.Since(start))
+	return webhookLog, nil
+}
+
+func (eService *assistantWebhookService) ClaimLogs(ctx context.Context, limit int, lease time.Duration) ([]*internal_assistant_entity.AssistantWebhookLog, error) {
+	start := time.Now()
+	db := eService.postgres.DB(ctx)
+	var webhookLogs []*internal_assistant_entity.AssistantWebhookLog
+	err := db.Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+		if err := tx.
+			Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("(status = ? AND next_attempt_date <= ?) OR (status = ? AND updated_date <= ?)",
+				type_enums.RECORD_QUEUED, now,
+				type_enums.RECORD_IN_PROGRESS, now.Add(-lease)).
+			Order("next_attempt_date").
+			Limit(limit).
+			Find(&webhookLogs).Error; err != nil {
+			return err
+		}
+		if len(webhookLogs) == 0 {
+			return nil
+		}
+		ids := make([]uint64, 0, len(webhookLogs))
+		for _, wl := range webhookLogs {
+			ids = append(ids, wl.Id)
+			wl.Status = type_enums.RECORD_IN_PROGRESS
+		}
+		return tx.Model(&internal_assistant_entity.AssistantWebhookLog{}).
+			Where("id IN ?", ids).
+			Updates(map[string]interface{}{
+				"status":       type_enums.RECORD_IN_PROGRESS,
+				"updated_date": now,
+			}).Error
+	})
+	eService.logger.Benchmark("WebhookService.ClaimLogs", time.Since(start))
+	if err != nil {
+		eService.logger.Errorf("error while claiming webhook logs %v", err)
+		return nil, err
+	}
+	return webhookLogs, nil
+}
+
+func (eService *assistantWebhookService) UpdateLogDelivery(
+	ctx context.Context,
+	webhookLog *internal_assistant_entity.AssistantWebhookLog,
+	status type_enums.RecordState,
+	responseStatus int64,
+	timeTaken int64,
+	retryCount uint32,
+	nextAttemptDate time.Time,
+	lastError string,
+	response []byte,
+) error {
+	start := time.Now()
+	db := eService.postgres.DB(ctx)
+	if response != nil {
+		key := eService.ObjectKey(webhookLog.AssetPrefix, webhookLog.Id, "response.json")
+		if result := eService.storage.Store(ctx, key, response); result.Error != nil {
+			eService.logger.Errorf("error while storing webhook response %v", result.Error)
+		}
+	}
+	tx := db.Model(&internal_assistant_entity.AssistantWebhookLog{}).
+		Where("id = ?", webhookLog.Id).
+		Updates(map[string]interface{}{
+			"status":            status,
+			"response_status":   responseStatus,
+			"time_taken":        timeTaken,
+			"retry_count":       retryCount,
+			"next_attempt_date": nextAttemptDate,
+			"last_error":        lastError,
+			"updated_date":      time.Now(),
+		})
+	eService.logger.Benchmark("WebhookService.UpdateLogDelivery", time.Since(start))
+	if tx.Error != nil {
+		eService.logger.Errorf("error while updating webhook log delivery %v", tx.Error)
+		return tx.Error
+	}
+	return nil
+}
+
+func (eService *assistantWebhookService) RedeliverLog(ctx context.Context,
+	auth types.SimplePrinciple,
+	projectId uint64,
+	webhookLogId uint64) (*internal_assistant_entity.AssistantWebhookLog, error) {
+	start := time.Now()
+	db := eService.postgres.DB(ctx)
+	updates := map[string]interface{}{
+		"status":            type_enums.RECORD_QUEUED,
+		"retry_count":       0,
+		"next_attempt_date": time.Now(),
+		"last_error":        "",
+		"updated_date":      time.Now(),
+	}
+	if auth.HasUser() {
+		updates["updated_by"] = *auth.GetUserId()
+	}
+	webhookLog := &internal_assistant_entity.AssistantWebhookLog{}
+	tx := db.Model(webhookLog).
+		Clauses(clause.Returning{}).
+		Where("id = ? AND organization_id = ? AND project_id = ? AND status IN ?",
+			webhookLogId, *auth.GetCurrentOrganizationId(), projectId,
+			[]type_enums.RecordState{type_enums.RECORD_FAILED, type_enums.RECORD_DEAD_LETTER}).
+		Updates(updates)
+	eService.logger.Benchmark("WebhookService.RedeliverLog", time.Since(start))
+	if tx.Error != nil {
+		eService.logger.Errorf("error while redelivering webhook log %v", tx.Error)
+		return nil, tx.Error
+	}
+	if tx.RowsAffected == 0 {
+		return nil, fmt.Errorf("no failed webhook log found for id %d", webhookLogId)
+	}
+	return webhookLog, nil
+}
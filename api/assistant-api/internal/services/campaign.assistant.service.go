@@ -0,0 +1,78 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_services
+
+import (
+	"context"
+	"time"
+
+	internal_assistant_entity "github.com/rapidaai/api/assistant-api/internal/entity/assistants"
+	"github.com/rapidaai/pkg/types"
+	protos "github.com/rapidaai/protos"
+)
+
+// EndedContactOption tells which dialing contacts of a campaign can be settled by the worker
+type EndedContactOption struct {
+	// telephony events which end the call
+	FinalEvents []string
+	// contacts claimed before and never called are released
+	ClaimedBefore time.Time
+	// contacts called before without a final event are failed
+	DialedBefore time.Time
+}
+
+type AssistantCampaignService interface {
+	Create(ctx context.Context,
+		auth types.SimplePrinciple,
+		campaign *internal_assistant_entity.AssistantCampaign,
+		contacts []*internal_assistant_entity.AssistantCampaignContact,
+	) (*internal_assistant_entity.AssistantCampaign, error)
+	Get(ctx context.Context, auth types.SimplePrinciple, campaignId uint64, assistantId uint64) (*internal_assistant_entity.AssistantCampaign, error)
+	GetAll(ctx context.Context,
+		auth types.SimplePrinciple,
+		assistantId uint64,
+		criterias []*protos.Criteria,
+		paginate *protos.Paginate) (int64, []*internal_assistant_entity.AssistantCampaign, error)
+	// UpdateState pauses, resumes or cancels the campaign, pending contacts of a cancelled campaign are cancelled
+	UpdateState(ctx context.Context,
+		auth types.SimplePrinciple,
+		campaignId uint64,
+		assistantId uint64,
+		state internal_assistant_entity.CampaignState,
+	) (*internal_assistant_entity.AssistantCampaign, error)
+	Delete(ctx context.Context, auth types.SimplePrinciple, campaignId uint64, assistantId uint64) (*internal_assistant_entity.AssistantCampaign, error)
+	GetAllContact(ctx context.Context,
+		auth types.SimplePrinciple,
+		campaignId uint64,
+		criterias []*protos.Criteria,
+		paginate *protos.Paginate) (int64, []*internal_assistant_entity.AssistantCampaignContact, error)
+
+	// GetById returns the campaign for the worker, it is not scoped to a project
+	GetById(ctx context.Context, campaignId uint64) (*internal_assistant_entity.AssistantCampaign, error)
+	// GetAllRunning returns the running campaigns which are started
+	GetAllRunning(ctx context.Context, now time.Time) ([]*internal_assistant_entity.AssistantCampaign, error)
+	// ClaimContacts moves the due contacts of the campaign to dialing, limited by the free concurrency
+	// and the calls per second since the last dial. Contacts outside the calling hours are rescheduled.
+	ClaimContacts(ctx context.Context, campaignId uint64, now time.Time) (*internal_assistant_entity.AssistantCampaign, []*internal_assistant_entity.AssistantCampaignContact, error)
+	// GetAllDialingCampaign returns the campaigns which have contacts in dialing
+	GetAllDialingCampaign(ctx context.Context) ([]uint64, error)
+	// GetAllEndedContact returns the dialing contacts of the campaign which can be settled, ordered by id after the cursor
+	GetAllEndedContact(ctx context.Context,
+		campaignId uint64,
+		afterId uint64,
+		option *EndedContactOption,
+		limit int,
+	) ([]*internal_assistant_entity.AssistantCampaignContact, error)
+	// GetTelephonyEvents returns the telephony event types per conversation in the order they are received
+	GetTelephonyEvents(ctx context.Context, conversationIds []uint64) (map[uint64][]string, error)
+	// UpdateContact updates the outcome of the contact if it is still in the given state, false when another worker got there first
+	UpdateContact(ctx context.Context,
+		contact *internal_assistant_entity.AssistantCampaignContact,
+		from internal_assistant_entity.CampaignContactState,
+	) (bool, error)
+	// Complete marks the running campaign completed when no contact is pending or dialing
+	Complete(ctx context.Context, campaignId uint64) (bool, error)
+}
@@ -7,6 +7,7 @@ package internal_services
 
 import (
 	"context"
+	"time"
 
 	internal_assistant_entity "github.com/rapidaai/api/assistant-api/internal/entity/assistants"
 	"github.com/rapidaai/pkg/types"
@@ -27,6 +28,7 @@ type AssistantWebhookService interface {
 		httpHeaders, httpBody map[string]string,
 		retryStatusCodes []string,
 		retryCount, executionPriority uint32,
+		secretCredentialId uint64,
 		description *string,
 	) (*internal_assistant_entity.AssistantWebhook, error)
 	Update(ctx context.Context,
@@ -40,6 +42,7 @@ type AssistantWebhookService interface {
 		httpHeaders, httpBody map[string]string,
 		retryStatusCodes []string,
 		maxRetryCount, executionPriority uint32,
+		secretCredentialId uint64,
 		description *string,
 	) (*internal_assistant_entity.AssistantWebhook, error)
 
@@ -79,4 +82,39 @@ type AssistantWebhookService interface {
 		ctx context.Context,
 		organizationId,
 		projectId, webhookLogId uint64) (requestData []byte, responseData []byte, err error)
+
+	// EnqueueLog persists the delivery of the webhook to be picked by the delivery worker,
+	// a delivery with an existing idempotency key is not enqueued again.
+	EnqueueLog(
+		ctx context.Context,
+		auth types.SimplePrinciple,
+		webhook *internal_assistant_entity.AssistantWebhook,
+		conversationId uint64,
+		event string,
+		idempotencyKey string,
+		request []byte,
+	) (*internal_assistant_entity.AssistantWebhookLog, error)
+
+	// ClaimLogs locks the deliveries which are due and marks them in progress,
+	// deliveries stuck in progress longer than lease are claimed again.
+	ClaimLogs(ctx context.Context, limit int, lease time.Duration) ([]*internal_assistant_entity.AssistantWebhookLog, error)
+
+	// UpdateLogDelivery records the outcome of a delivery attempt.
+	UpdateLogDelivery(
+		ctx context.Context,
+		webhookLog *internal_assistant_entity.AssistantWebhookLog,
+		status type_enums.RecordState,
+		responseStatus int64,
+		timeTaken int64,
+		retryCount uint32,
+		nextAttemptDate time.Time,
+		lastError string,
+		response []byte,
+	) error
+
+	// RedeliverLog queues a failed or dead lettered delivery again.
+	RedeliverLog(ctx context.Context,
+		auth types.SimplePrinciple,
+		projectId uint64,
+		webhookLogId uint64) (*internal_assistant_entity.AssistantWebhookLog, error)
 }
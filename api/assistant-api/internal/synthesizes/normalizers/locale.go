@@ -0,0 +1,142 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_normalizers
+
+import (
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Currency holds the words used to read an amount of money, feminine major unit changes
+// the numbers before it in few languages (una libra, une livre)
+type Currency struct {
+	Major    string
+	Majors   string
+	Minor    string
+	Minors   string
+	Feminine bool
+}
+
+// Locale holds the words and the way of writing numbers, money, dates and times for a language.
+// Locale aware normalizers are built on top of it.
+type Locale struct {
+	// iso 639-1 code of language
+	Language string
+
+	// regular expression of integer part of a number as written in the locale,
+	// group separators are removed before the number is parsed
+	NumberPattern    string
+	GroupSeparators  string
+	DecimalSeparator string
+	DecimalWord      string
+
+	// currency symbols and iso codes
+	Currencies map[string]Currency
+
+	// ordinal as written in text, first group is the number, second the suffix
+	// and an optional third group is text consumed after the ordinal which is kept as it is
+	OrdinalPattern *regexp.Regexp
+
+	// time as written in text, first group is the hour and first non empty group after it is the minute
+	TimePattern *regexp.Regexp
+
+	Cardinal func(n int64) string
+
+	// form is the suffix written with the number (1st, 1º, 1er), german takes the preceding word
+	// instead as the ending of ordinal depends on it
+	Ordinal func(n int64, form string) string
+
+	Money func(major, minor int64, currency Currency) string
+
+	// preceding is the word before the date, few languages inflect the day by it
+	Date func(day, month, year int, preceding string) string
+
+	Time func(hour, minute int) string
+}
+
+var locales = map[string]*Locale{
+	"en": english,
+	"hi": hindi,
+	"es": spanish,
+	"fr": french,
+	"de": german,
+}
+
+// GetLocale resolves locale of the language code used by speakers such as hi-IN, es_ES or fr
+func GetLocale(language string) (*Locale, bool) {
+	code := strings.ToLower(strings.TrimSpace(language))
+	if idx := strings.IndexAny(code, "-_"); idx > 0 {
+		code = code[:idx]
+	}
+	locale, ok := locales[code]
+	return locale, ok
+}
+
+// parseNumber parses integer and fraction written in the locale
+func (l *Locale) parseNumber(integer, fraction string) (int64, string, bool) {
+	for _, sep := range l.GroupSeparators {
+		integer = strings.ReplaceAll(integer, string(sep), "")
+	}
+	// leading zero or very long numbers are codes, phone numbers or identifiers
+	if len(integer) > 12 || (len(integer) > 1 && integer[0] == '0') {
+		return 0, "", false
+	}
+	n, err := strconv.ParseInt(integer, 10, 64)
+	if err != nil {
+		return 0, "", false
+	}
+	return n, fraction, true
+}
+
+// numberRegex returns expression matching number of the locale, groups are integer and fraction
+func (l *Locale) numberRegex() string {
+	return `(` + l.NumberPattern + `)(?:` + regexp.QuoteMeta(l.DecimalSeparator) + `(\d+))?`
+}
+
+// currencyRegex returns alternation of currency symbols, longer first so Rs. wins over Rs
+func (l *Locale) currencyRegex() string {
+	symbols := make([]string, 0, len(l.Currencies))
+	for symbol := range l.Currencies {
+		symbols = append(symbols, symbol)
+	}
+	sort.Slice(symbols, func(i, j int) bool {
+		if len(symbols[i]) == len(symbols[j]) {
+			return symbols[i] < symbols[j]
+		}
+		return len(symbols[i]) > len(symbols[j])
+	})
+	for i, symbol := range symbols {
+		quoted := regexp.QuoteMeta(symbol)
+		if regexp.MustCompile(`^\w`).MatchString(symbol) {
+			quoted = `\b` + quoted
+		}
+		if regexp.MustCompile(`\w$`).MatchString(symbol) {
+			quoted = quoted + `\b`
+		}
+		symbols[i] = quoted
+	}
+	return `(` + strings.Join(symbols, "|") + `)`
+}
+
+// spellDigits reads every digit on its own, used for fraction part of decimal numbers
+func (l *Locale) spellDigits(digits string) string {
+	words := make([]string, 0, len(digits))
+	for _, d := range digits {
+		words = append(words, l.Cardinal(int64(d-'0')))
+	}
+	return strings.Join(words, " ")
+}
+
+// precedingWord returns the word just before the index in text
+func precedingWord(s string, idx int) string {
+	fields := strings.Fields(s[:idx])
+	if len(fields) == 0 {
+		return ""
+	}
+	return strings.ToLower(strings.Trim(fields[len(fields)-1], ".,;:!?\"'()"))
+}
@@ -0,0 +1,84 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_normalizers
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	ntw "moul.io/number-to-words"
+)
+
+// english keeps the existing english normalizers, only ordinals are read through locale
+var english = &Locale{
+	Language:         "en",
+	NumberPattern:    `\d{1,3}(?:,\d{3})+|\d+`,
+	GroupSeparators:  ",",
+	DecimalSeparator: ".",
+	DecimalWord:      "point",
+	Currencies: map[string]Currency{
+		"$":   {Major: "dollar", Majors: "dollars", Minor: "cent", Minors: "cents"},
+		"USD": {Major: "dollar", Majors: "dollars", Minor: "cent", Minors: "cents"},
+		"€":   {Major: "euro", Majors: "euros", Minor: "cent", Minors: "cents"},
+		"EUR": {Major: "euro", Majors: "euros", Minor: "cent", Minors: "cents"},
+		"£":   {Major: "pound", Majors: "pounds", Minor: "penny", Minors: "pence"},
+		"GBP": {Major: "pound", Majors: "pounds", Minor: "penny", Minors: "pence"},
+		"₹":   {Major: "rupee", Majors: "rupees", Minor: "paisa", Minors: "paise"},
+		"INR": {Major: "rupee", Majors: "rupees", Minor: "paisa", Minors: "paise"},
+	},
+	OrdinalPattern: regexp.MustCompile(`\b(\d+)(st|nd|rd|th)\b`),
+	TimePattern:    regexp.MustCompile(`\b([01]?\d|2[0-3]):([0-5]\d)\b`),
+	Cardinal:       englishCardinal,
+	Ordinal:        englishOrdinal,
+	Money: func(major, minor int64, currency Currency) string {
+		words := make([]string, 0, 2)
+		if major > 0 || minor == 0 {
+			words = append(words, englishCardinal(major)+" "+plural(major, currency.Major, currency.Majors))
+		}
+		if minor > 0 {
+			words = append(words, englishCardinal(minor)+" "+plural(minor, currency.Minor, currency.Minors))
+		}
+		return strings.Join(words, " and ")
+	},
+	Date: func(day, month, year int, preceding string) string {
+		return fmt.Sprintf("%s %s, %s", time.Month(month).String(), englishOrdinal(int64(day), ""), englishCardinal(int64(year)))
+	},
+	Time: func(hour, minute int) string {
+		return time.Date(0, 1, 1, hour, minute, 0, 0, time.UTC).Format("3:04 PM")
+	},
+}
+
+func englishCardinal(n int64) string {
+	return ntw.IntegerToEnUs(int(n))
+}
+
+func englishOrdinal(n int64, form string) string {
+	cardinal := englishCardinal(n)
+	irregular := map[string]string{
+		"one": "first", "two": "second", "three": "third", "five": "fifth",
+		"eight": "eighth", "nine": "ninth", "twelve": "twelfth",
+	}
+	// only the last word of cardinal changes, twenty-one becomes twenty-first
+	idx := strings.LastIndexAny(cardinal, " -") + 1
+	head, last := cardinal[:idx], cardinal[idx:]
+	if word, ok := irregular[last]; ok {
+		return head + word
+	}
+	if strings.HasSuffix(last, "y") {
+		return head + strings.TrimSuffix(last, "y") + "ieth"
+	}
+	return head + last + "th"
+}
+
+// plural picks singular word for one and plural otherwise
+func plural(n int64, one, many string) string {
+	if n == 1 {
+		return one
+	}
+	return many
+}
@@ -0,0 +1,210 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_normalizers
+
+import (
+	"regexp"
+	"strings"
+)
+
+var frenchUnits = [17]string{
+	"", "un", "deux", "trois", "quatre", "cinq", "six", "sept", "huit", "neuf",
+	"dix", "onze", "douze", "treize", "quatorze", "quinze", "seize",
+}
+
+var frenchTens = [7]string{"", "", "vingt", "trente", "quarante", "cinquante", "soixante"}
+
+var frenchMonths = [12]string{
+	"janvier", "février", "mars", "avril", "mai", "juin",
+	"juillet", "août", "septembre", "octobre", "novembre", "décembre",
+}
+
+var french = &Locale{
+	Language: "fr",
+	// thousands are grouped by space, no-break space, narrow no-break space or dot
+	NumberPattern:    `\d{1,3}(?:[ .\x{00A0}\x{202F}]\d{3})+|\d+`,
+	GroupSeparators:  " .\u00a0\u202f",
+	DecimalSeparator: ",",
+	DecimalWord:      "virgule",
+	Currencies: map[string]Currency{
+		"€":   {Major: "euro", Majors: "euros", Minor: "centime", Minors: "centimes"},
+		"EUR": {Major: "euro", Majors: "euros", Minor: "centime", Minors: "centimes"},
+		"$":   {Major: "dollar", Majors: "dollars", Minor: "cent", Minors: "cents"},
+		"USD": {Major: "dollar", Majors: "dollars", Minor: "cent", Minors: "cents"},
+		"£":   {Major: "livre", Majors: "livres", Minor: "penny", Minors: "pence", Feminine: true},
+		"GBP": {Major: "livre", Majors: "livres", Minor: "penny", Minors: "pence", Feminine: true},
+		"₹":   {Major: "roupie", Majors: "roupies", Minor: "paisa", Minors: "paisas", Feminine: true},
+		"INR": {Major: "roupie", Majors: "roupies", Minor: "paisa", Minors: "paisas", Feminine: true},
+	},
+	// 1er, 1re, 1ère, 2e, 2ème, 3es
+	OrdinalPattern: regexp.MustCompile(`\b(\d+)(ères|ère|èmes|ème|ers|er|res|re|es|e)([^\p{L}]|$)`),
+	// 14:30, 14h30, 14 h 30 or 14h
+	TimePattern: regexp.MustCompile(`\b([01]?\d|2[0-3])(?::([0-5]\d)|\s?h\s?([0-5]\d)?)\b`),
+	Cardinal:    frenchCardinal,
+	Ordinal:     frenchOrdinal,
+	Money: func(major, minor int64, currency Currency) string {
+		words := make([]string, 0, 2)
+		if major > 0 || minor == 0 {
+			unit := frenchPlural(major, currency.Major, currency.Majors)
+			// un million d'euros
+			if major >= 1_000_000 && major%1_000_000 == 0 {
+				if strings.ContainsAny(unit[:1], "aeiouéè") {
+					unit = "d'" + unit
+				} else {
+					unit = "de " + unit
+				}
+			}
+			words = append(words, frenchCount(major, currency.Feminine)+" "+unit)
+		}
+		if minor > 0 {
+			words = append(words, frenchCount(minor, false)+" "+frenchPlural(minor, currency.Minor, currency.Minors))
+		}
+		return strings.Join(words, " et ")
+	},
+	Date: func(day, month, year int, preceding string) string {
+		dayWord := frenchCardinal(int64(day))
+		if day == 1 {
+			dayWord = "premier"
+		}
+		return dayWord + " " + frenchMonths[month-1] + " " + frenchCardinal(int64(year))
+	},
+	Time: func(hour, minute int) string {
+		words := frenchCount(int64(hour), true) + " " + frenchPlural(int64(hour), "heure", "heures")
+		if minute == 0 {
+			return words
+		}
+		return words + " " + frenchCount(int64(minute), true)
+	},
+}
+
+func frenchUnder100(n int64) string {
+	switch {
+	case n < 17:
+		return frenchUnits[n]
+	case n < 20:
+		return "dix-" + frenchUnits[n-10]
+	}
+	tens, unit := n/10, n%10
+	switch tens {
+	case 7:
+		if unit == 1 {
+			return "soixante et onze"
+		}
+		return "soixante-" + frenchUnder100(10+unit)
+	case 8:
+		if unit == 0 {
+			return "quatre-vingts"
+		}
+		return "quatre-vingt-" + frenchUnits[unit]
+	case 9:
+		return "quatre-vingt-" + frenchUnder100(10+unit)
+	}
+	switch unit {
+	case 0:
+		return frenchTens[tens]
+	case 1:
+		return frenchTens[tens] + " et un"
+	}
+	return frenchTens[tens] + "-" + frenchUnits[unit]
+}
+
+func frenchUnder1000(n int64) string {
+	hundreds, rest := n/100, n%100
+	if hundreds == 0 {
+		return frenchUnder100(rest)
+	}
+	head := "cent"
+	if hundreds > 1 {
+		head = frenchUnits[hundreds] + " cent"
+		if rest == 0 {
+			head += "s"
+		}
+	}
+	if rest == 0 {
+		return head
+	}
+	return head + " " + frenchUnder100(rest)
+}
+
+func frenchCardinal(n int64) string {
+	if n == 0 {
+		return "zéro"
+	}
+	words := make([]string, 0, 4)
+	scales := []struct {
+		value       int64
+		one, plural string
+	}{
+		{1_000_000_000, "milliard", "milliards"},
+		{1_000_000, "million", "millions"},
+	}
+	for _, scale := range scales {
+		if count := n / scale.value; count > 0 {
+			words = append(words, frenchCardinal(count)+" "+frenchPlural(count, scale.one, scale.plural))
+			n %= scale.value
+		}
+	}
+	if thousands := n / 1_000; thousands > 0 {
+		if thousands == 1 {
+			words = append(words, "mille")
+		} else {
+			// vingt and cent lose their plural before mille, quatre-vingt mille and deux cent mille
+			head := frenchUnder1000(thousands)
+			if strings.HasSuffix(head, "vingts") || strings.HasSuffix(head, "cents") {
+				head = strings.TrimSuffix(head, "s")
+			}
+			words = append(words, head+" mille")
+		}
+		n %= 1_000
+	}
+	if n > 0 {
+		words = append(words, frenchUnder1000(n))
+	}
+	return strings.Join(words, " ")
+}
+
+// frenchCount reads number before a noun, une heure and vingt et une livres
+func frenchCount(n int64, feminine bool) string {
+	words := frenchCardinal(n)
+	if feminine && strings.HasSuffix(words, "un") {
+		return words + "e"
+	}
+	return words
+}
+
+// frenchPlural uses singular for zero and one
+func frenchPlural(n int64, one, many string) string {
+	if n <= 1 {
+		return one
+	}
+	return many
+}
+
+// frenchOrdinal builds ordinal from cardinal, re and ère give feminine première
+func frenchOrdinal(n int64, form string) string {
+	plural := strings.HasSuffix(form, "s")
+	var word string
+	switch cardinal := frenchCardinal(n); {
+	case n == 1 && (strings.HasPrefix(form, "r") || strings.HasPrefix(form, "è")):
+		word = "première"
+	case n == 1:
+		word = "premier"
+	case strings.HasSuffix(cardinal, "cinq"):
+		word = cardinal + "uième"
+	case strings.HasSuffix(cardinal, "neuf"):
+		word = strings.TrimSuffix(cardinal, "f") + "vième"
+	case strings.HasSuffix(cardinal, "vingts"), strings.HasSuffix(cardinal, "cents"):
+		word = strings.TrimSuffix(cardinal, "s") + "ième"
+	case strings.HasSuffix(cardinal, "e"):
+		word = strings.TrimSuffix(cardinal, "e") + "ième"
+	default:
+		word = cardinal + "ième"
+	}
+	if plural {
+		return word + "s"
+	}
+	return word
+}
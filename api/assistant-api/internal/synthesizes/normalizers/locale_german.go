@@ -0,0 +1,173 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_normalizers
+
+import (
+	"regexp"
+	"strings"
+)
+
+var germanUnits = [20]string{
+	"null", "eins", "zwei", "drei", "vier", "fünf", "sechs", "sieben", "acht", "neun",
+	"zehn", "elf", "zwölf", "dreizehn", "vierzehn", "fünfzehn", "sechzehn", "siebzehn", "achtzehn", "neunzehn",
+}
+
+var germanTens = [10]string{"", "", "zwanzig", "dreißig", "vierzig", "fünfzig", "sechzig", "siebzig", "achtzig", "neunzig"}
+
+var germanMonths = [12]string{
+	"Januar", "Februar", "März", "April", "Mai", "Juni",
+	"Juli", "August", "September", "Oktober", "November", "Dezember",
+}
+
+var german = &Locale{
+	Language:         "de",
+	NumberPattern:    `\d{1,3}(?:\.\d{3})+|\d+`,
+	GroupSeparators:  ".",
+	DecimalSeparator: ",",
+	DecimalWord:      "Komma",
+	Currencies: map[string]Currency{
+		"€":   {Major: "Euro", Majors: "Euro", Minor: "Cent", Minors: "Cent"},
+		"EUR": {Major: "Euro", Majors: "Euro", Minor: "Cent", Minors: "Cent"},
+		"$":   {Major: "Dollar", Majors: "Dollar", Minor: "Cent", Minors: "Cent"},
+		"USD": {Major: "Dollar", Majors: "Dollar", Minor: "Cent", Minors: "Cent"},
+		"£":   {Major: "Pfund", Majors: "Pfund", Minor: "Penny", Minors: "Pence"},
+		"GBP": {Major: "Pfund", Majors: "Pfund", Minor: "Penny", Minors: "Pence"},
+		"₹":   {Major: "Rupie", Majors: "Rupien", Minor: "Paisa", Minors: "Paise", Feminine: true},
+		"INR": {Major: "Rupie", Majors: "Rupien", Minor: "Paisa", Minors: "Paise", Feminine: true},
+	},
+	// ordinal is written with a dot, only taken before a capitalized noun (am 3. Mai, der 2. Platz)
+	// as a number at the end of sentence also ends with a dot
+	OrdinalPattern: regexp.MustCompile(`\b(\d{1,3})(\.)(\s+\p{Lu})`),
+	// 14:30, 14:30 Uhr, 14.30 Uhr or 14 Uhr
+	TimePattern: regexp.MustCompile(`\b([01]?\d|2[0-3])(?::([0-5]\d)(?:\s?Uhr)?|\.([0-5]\d)\s?Uhr|\s?Uhr)`),
+	Cardinal:    germanCardinal,
+	Ordinal:     germanOrdinal,
+	Money: func(major, minor int64, currency Currency) string {
+		words := make([]string, 0, 2)
+		if major > 0 || minor == 0 {
+			words = append(words, germanCount(major, currency.Feminine)+" "+plural(major, currency.Major, currency.Majors))
+		}
+		if minor > 0 {
+			words = append(words, germanCount(minor, false)+" "+plural(minor, currency.Minor, currency.Minors))
+		}
+		return strings.Join(words, " und ")
+	},
+	Date: func(day, month, year int, preceding string) string {
+		return germanOrdinal(int64(day), preceding) + " " + germanMonths[month-1] + " " + germanYear(year)
+	},
+	Time: func(hour, minute int) string {
+		words := germanCount(int64(hour), false) + " Uhr"
+		if minute == 0 {
+			return words
+		}
+		return words + " " + germanCardinal(int64(minute))
+	},
+}
+
+// germanUnder1000 reads number below thousand as one word, eins becomes ein inside compounds
+func germanUnder1000(n int64) string {
+	var word string
+	if hundreds := n / 100; hundreds > 0 {
+		word = germanCompound(hundreds) + "hundert"
+		n %= 100
+	}
+	switch {
+	case n == 0:
+		return word
+	case n < 20:
+		return word + germanUnits[n]
+	case n%10 == 0:
+		return word + germanTens[n/10]
+	}
+	return word + germanCompound(n%10) + "und" + germanTens[n/10]
+}
+
+// germanCompound is the form of number inside a longer word, einhundert and einundzwanzig
+func germanCompound(n int64) string {
+	if n == 1 {
+		return "ein"
+	}
+	return germanUnder1000(n)
+}
+
+func germanCardinal(n int64) string {
+	if n == 0 {
+		return germanUnits[0]
+	}
+	words := make([]string, 0, 3)
+	scales := []struct {
+		value       int64
+		one, plural string
+	}{
+		{1_000_000_000, "Milliarde", "Milliarden"},
+		{1_000_000, "Million", "Millionen"},
+	}
+	for _, scale := range scales {
+		if count := n / scale.value; count > 0 {
+			// eine Million, zwei Millionen
+			words = append(words, germanCount(count, true)+" "+plural(count, scale.one, scale.plural))
+			n %= scale.value
+		}
+	}
+	// numbers below million are written as one word
+	var word string
+	if thousands := n / 1_000; thousands > 0 {
+		word = germanCompound(thousands) + "tausend"
+		n %= 1_000
+	}
+	if n > 0 {
+		word += germanUnder1000(n)
+	}
+	if word != "" {
+		words = append(words, word)
+	}
+	return strings.Join(words, " ")
+}
+
+// germanCount reads number before a noun, ein Euro and eine Million
+func germanCount(n int64, feminine bool) string {
+	words := germanCardinal(n)
+	if !strings.HasSuffix(words, "eins") {
+		return words
+	}
+	if feminine {
+		return strings.TrimSuffix(words, "s") + "e"
+	}
+	return strings.TrimSuffix(words, "s")
+}
+
+// germanYear reads years before 2000 in hundreds, neunzehnhundertneunundneunzig
+func germanYear(year int) string {
+	if year >= 1100 && year < 2000 {
+		return germanUnder1000(int64(year/100)) + "hundert" + germanUnder1000(int64(year%100))
+	}
+	return germanCardinal(int64(year))
+}
+
+// germanOrdinal takes the preceding word as form, the ending follows the article or preposition
+// before it (am dritten, der dritte, ein dritter)
+func germanOrdinal(n int64, form string) string {
+	irregular := map[int64]string{1: "erst", 3: "dritt", 7: "siebt", 8: "acht"}
+	var stem string
+	switch rest := n % 100; {
+	case irregular[rest] != "" && n > rest:
+		// hunderterst, tausenddritt
+		stem = germanCardinal(n-rest) + irregular[rest]
+	case irregular[rest] != "":
+		stem = irregular[rest]
+	case rest > 0 && rest < 20:
+		stem = germanCardinal(n) + "t"
+	default:
+		stem = germanCardinal(n) + "st"
+	}
+	switch strings.ToLower(form) {
+	case "am", "im", "zum", "zur", "vom", "beim", "dem", "den", "des", "seit", "bis":
+		return stem + "en"
+	case "der", "die", "das":
+		return stem + "e"
+	}
+	return stem + "er"
+}
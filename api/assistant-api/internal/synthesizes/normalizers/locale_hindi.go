@@ -0,0 +1,129 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_normalizers
+
+import (
+	"regexp"
+	"strings"
+)
+
+// hindi numbers up to hundred are irregular words, bigger numbers use indian grouping of lakh and crore
+var hindiNumbers = [100]string{
+	"शून्य", "एक", "दो", "तीन", "चार", "पाँच", "छह", "सात", "आठ", "नौ",
+	"दस", "ग्यारह", "बारह", "तेरह", "चौदह", "पंद्रह", "सोलह", "सत्रह", "अठारह", "उन्नीस",
+	"बीस", "इक्कीस", "बाईस", "तेईस", "चौबीस", "पच्चीस", "छब्बीस", "सत्ताईस", "अट्ठाईस", "उनतीस",
+	"तीस", "इकतीस", "बत्तीस", "तैंतीस", "चौंतीस", "पैंतीस", "छत्तीस", "सैंतीस", "अड़तीस", "उनतालीस",
+	"चालीस", "इकतालीस", "बयालीस", "तैंतालीस", "चवालीस", "पैंतालीस", "छियालीस", "सैंतालीस", "अड़तालीस", "उनचास",
+	"पचास", "इक्यावन", "बावन", "तिरपन", "चौवन", "पचपन", "छप्पन", "सत्तावन", "अट्ठावन", "उनसठ",
+	"साठ", "इकसठ", "बासठ", "तिरसठ", "चौंसठ", "पैंसठ", "छियासठ", "सड़सठ", "अड़सठ", "उनहत्तर",
+	"सत्तर", "इकहत्तर", "बहत्तर", "तिहत्तर", "चौहत्तर", "पचहत्तर", "छिहत्तर", "सतहत्तर", "अठहत्तर", "उन्यासी",
+	"अस्सी", "इक्यासी", "बयासी", "तिरासी", "चौरासी", "पचासी", "छियासी", "सत्तासी", "अट्ठासी", "नवासी",
+	"नब्बे", "इक्यानबे", "बानबे", "तिरानबे", "चौरानबे", "पचानबे", "छियानबे", "सत्तानबे", "अट्ठानबे", "निन्यानबे",
+}
+
+var hindiMonths = [12]string{
+	"जनवरी", "फ़रवरी", "मार्च", "अप्रैल", "मई", "जून",
+	"जुलाई", "अगस्त", "सितंबर", "अक्टूबर", "नवंबर", "दिसंबर",
+}
+
+var hindi = &Locale{
+	Language: "hi",
+	// western grouping or indian grouping like 1,25,000
+	NumberPattern:    `\d{1,3}(?:,\d{3})+|\d{1,2}(?:,\d{2})*,\d{3}|\d+`,
+	GroupSeparators:  ",",
+	DecimalSeparator: ".",
+	DecimalWord:      "दशमलव",
+	Currencies: map[string]Currency{
+		"₹":   {Major: "रुपया", Majors: "रुपये", Minor: "पैसा", Minors: "पैसे"},
+		"Rs.": {Major: "रुपया", Majors: "रुपये", Minor: "पैसा", Minors: "पैसे"},
+		"Rs":  {Major: "रुपया", Majors: "रुपये", Minor: "पैसा", Minors: "पैसे"},
+		"INR": {Major: "रुपया", Majors: "रुपये", Minor: "पैसा", Minors: "पैसे"},
+		"$":   {Major: "डॉलर", Majors: "डॉलर", Minor: "सेंट", Minors: "सेंट"},
+		"USD": {Major: "डॉलर", Majors: "डॉलर", Minor: "सेंट", Minors: "सेंट"},
+		"€":   {Major: "यूरो", Majors: "यूरो", Minor: "सेंट", Minors: "सेंट"},
+		"EUR": {Major: "यूरो", Majors: "यूरो", Minor: "सेंट", Minors: "सेंट"},
+		"£":   {Major: "पाउंड", Majors: "पाउंड", Minor: "पेंस", Minors: "पेंस"},
+		"GBP": {Major: "पाउंड", Majors: "पाउंड", Minor: "पेंस", Minors: "पेंस"},
+	},
+	// 1ला, 2री, 5वाँ, 10वें
+	OrdinalPattern: regexp.MustCompile(`(\d+)(ला|ली|ले|रा|री|रे|था|थी|थे|ठा|ठी|ठे|वाँ|वां|वीं|वी|वें|वे)([^\p{L}\p{M}]|$)`),
+	TimePattern:    regexp.MustCompile(`\b([01]?\d|2[0-3]):([0-5]\d)\b`),
+	Cardinal:       hindiCardinal,
+	Ordinal:        hindiOrdinal,
+	Money: func(major, minor int64, currency Currency) string {
+		words := make([]string, 0, 2)
+		if major > 0 || minor == 0 {
+			words = append(words, hindiCardinal(major)+" "+plural(major, currency.Major, currency.Majors))
+		}
+		if minor > 0 {
+			words = append(words, hindiCardinal(minor)+" "+plural(minor, currency.Minor, currency.Minors))
+		}
+		return strings.Join(words, " और ")
+	},
+	Date: func(day, month, year int, preceding string) string {
+		return hindiCardinal(int64(day)) + " " + hindiMonths[month-1] + " " + hindiCardinal(int64(year))
+	},
+	Time: func(hour, minute int) string {
+		// read on 12 hour clock as spoken, 14:30 is two bajkar thirty minutes
+		hour = hour % 12
+		if hour == 0 {
+			hour = 12
+		}
+		if minute == 0 {
+			return hindiCardinal(int64(hour)) + " बजे"
+		}
+		return hindiCardinal(int64(hour)) + " बजकर " + hindiCardinal(int64(minute)) + " मिनट"
+	},
+}
+
+func hindiCardinal(n int64) string {
+	if n < 100 {
+		return hindiNumbers[n]
+	}
+	scales := []struct {
+		value int64
+		word  string
+	}{
+		{1_000_000_000, "अरब"},
+		{10_000_000, "करोड़"},
+		{100_000, "लाख"},
+		{1_000, "हज़ार"},
+		{100, "सौ"},
+	}
+	words := make([]string, 0)
+	for _, scale := range scales {
+		if n >= scale.value {
+			words = append(words, hindiCardinal(n/scale.value)+" "+scale.word)
+			n %= scale.value
+		}
+	}
+	if n > 0 {
+		words = append(words, hindiNumbers[n])
+	}
+	return strings.Join(words, " ")
+}
+
+// hindiOrdinal agrees with the gender of suffix, ा masculine, ी feminine and े oblique
+func hindiOrdinal(n int64, form string) string {
+	ending := "ा"
+	switch {
+	case strings.HasSuffix(form, "ी"), strings.HasSuffix(form, "ीं"):
+		ending = "ी"
+	case strings.HasSuffix(form, "े"), strings.HasSuffix(form, "ें"):
+		ending = "े"
+	}
+	irregular := map[int64]string{1: "पहल", 2: "दूसर", 3: "तीसर", 4: "चौथ", 6: "छठ"}
+	if stem, ok := irregular[n]; ok {
+		return stem + ending
+	}
+	switch ending {
+	case "ी":
+		return hindiCardinal(n) + "वीं"
+	case "े":
+		return hindiCardinal(n) + "वें"
+	}
+	return hindiCardinal(n) + "वाँ"
+}
@@ -0,0 +1,201 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_normalizers
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rapidaai/pkg/commons"
+)
+
+type localeNumberNormalizer struct {
+	logger commons.Logger
+	locale *Locale
+	re     *regexp.Regexp
+}
+
+// NewLocaleNumberNormalizer reads integers and decimals written with separators of the locale
+func NewLocaleNumberNormalizer(logger commons.Logger, locale *Locale) Normalizer {
+	return &localeNumberNormalizer{
+		logger: logger,
+		locale: locale,
+		re:     regexp.MustCompile(`\b` + locale.numberRegex() + `\b`),
+	}
+}
+
+func (ln *localeNumberNormalizer) Normalize(s string) string {
+	return ln.re.ReplaceAllStringFunc(s, func(match string) string {
+		parts := ln.re.FindStringSubmatch(match)
+		n, fraction, ok := ln.locale.parseNumber(parts[1], parts[2])
+		if !ok {
+			return match
+		}
+		if fraction == "" {
+			return ln.locale.Cardinal(n)
+		}
+		return ln.locale.Cardinal(n) + " " + ln.locale.DecimalWord + " " + ln.locale.spellDigits(fraction)
+	})
+}
+
+type localeCurrencyNormalizer struct {
+	logger commons.Logger
+	locale *Locale
+	re     *regexp.Regexp
+}
+
+// NewLocaleCurrencyNormalizer reads money written with symbol or iso code before or after the amount
+func NewLocaleCurrencyNormalizer(logger commons.Logger, locale *Locale) Normalizer {
+	symbol, number := locale.currencyRegex(), locale.numberRegex()
+	return &localeCurrencyNormalizer{
+		logger: logger,
+		locale: locale,
+		re:     regexp.MustCompile(symbol + `\s?` + number + `|\b` + number + `\s?` + symbol),
+	}
+}
+
+func (lc *localeCurrencyNormalizer) Normalize(s string) string {
+	return lc.re.ReplaceAllStringFunc(s, func(match string) string {
+		parts := lc.re.FindStringSubmatch(match)
+		symbol, integer, fraction := parts[1], parts[2], parts[3]
+		if symbol == "" {
+			integer, fraction, symbol = parts[4], parts[5], parts[6]
+		}
+		major, fraction, ok := lc.locale.parseNumber(integer, fraction)
+		if !ok {
+			return match
+		}
+		// minor unit is read from first two digits of fraction
+		fraction = (fraction + "00")[:2]
+		minor, err := strconv.ParseInt(fraction, 10, 64)
+		if err != nil {
+			lc.logger.Warn("Failed to parse minor amount", "error", err, "amount", match)
+			return match
+		}
+		return lc.locale.Money(major, minor, lc.locale.Currencies[symbol])
+	})
+}
+
+type localeDateNormalizer struct {
+	logger commons.Logger
+	locale *Locale
+	re     *regexp.Regexp
+}
+
+// NewLocaleDateNormalizer reads numeric dates, outside of english day is written before month
+func NewLocaleDateNormalizer(logger commons.Logger, locale *Locale) Normalizer {
+	return &localeDateNormalizer{
+		logger: logger,
+		locale: locale,
+		re: regexp.MustCompile(
+			`\b(\d{4})-(\d{2})-(\d{2})\b|` + // YYYY-MM-DD
+				`\b(\d{1,2})[./-](\d{1,2})[./-](\d{4})\b`, // DD/MM/YYYY, DD.MM.YYYY or DD-MM-YYYY
+		),
+	}
+}
+
+func (ld *localeDateNormalizer) Normalize(s string) string {
+	var builder strings.Builder
+	last := 0
+	for _, loc := range ld.re.FindAllStringSubmatchIndex(s, -1) {
+		group := func(i int) int {
+			if loc[2*i] < 0 {
+				return -1
+			}
+			v, _ := strconv.Atoi(s[loc[2*i]:loc[2*i+1]])
+			return v
+		}
+		year, month, day := group(1), group(2), group(3)
+		if year < 0 {
+			day, month, year = group(4), group(5), group(6)
+		}
+		builder.WriteString(s[last:loc[0]])
+		last = loc[1]
+		date := time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+		if month < 1 || month > 12 || date.Day() != day {
+			ld.logger.Warn("Failed to parse date", "date", s[loc[0]:loc[1]])
+			builder.WriteString(s[loc[0]:loc[1]])
+			continue
+		}
+		builder.WriteString(ld.locale.Date(day, month, year, precedingWord(s, loc[0])))
+	}
+	builder.WriteString(s[last:])
+	return builder.String()
+}
+
+type localeTimeNormalizer struct {
+	logger commons.Logger
+	locale *Locale
+}
+
+// NewLocaleTimeNormalizer reads time of the day in 24 hour clock
+func NewLocaleTimeNormalizer(logger commons.Logger, locale *Locale) Normalizer {
+	return &localeTimeNormalizer{
+		logger: logger,
+		locale: locale,
+	}
+}
+
+func (lt *localeTimeNormalizer) Normalize(s string) string {
+	re := lt.locale.TimePattern
+	return re.ReplaceAllStringFunc(s, func(match string) string {
+		parts := re.FindStringSubmatch(match)
+		hour, err := strconv.Atoi(parts[1])
+		if err != nil {
+			lt.logger.Warn("Failed to parse time", "error", err, "time", match)
+			return match
+		}
+		minute := 0
+		for _, part := range parts[2:] {
+			if part != "" {
+				minute, _ = strconv.Atoi(part)
+				break
+			}
+		}
+		return lt.locale.Time(hour, minute)
+	})
+}
+
+type ordinalNormalizer struct {
+	logger commons.Logger
+	locale *Locale
+}
+
+// NewOrdinalNormalizer reads ordinals like 1st, 2º, 3e or 4. as written in the locale
+func NewOrdinalNormalizer(logger commons.Logger, locale *Locale) Normalizer {
+	return &ordinalNormalizer{
+		logger: logger,
+		locale: locale,
+	}
+}
+
+func (on *ordinalNormalizer) Normalize(s string) string {
+	re := on.locale.OrdinalPattern
+	var builder strings.Builder
+	last := 0
+	for _, loc := range re.FindAllStringSubmatchIndex(s, -1) {
+		builder.WriteString(s[last:loc[0]])
+		last = loc[1]
+		n, err := strconv.ParseInt(s[loc[2]:loc[3]], 10, 64)
+		if err != nil {
+			on.logger.Warn("Failed to parse ordinal", "error", err, "ordinal", s[loc[0]:loc[1]])
+			builder.WriteString(s[loc[0]:loc[1]])
+			continue
+		}
+		form := s[loc[4]:loc[5]]
+		if on.locale.Language == "de" {
+			form = precedingWord(s, loc[0])
+		}
+		builder.WriteString(on.locale.Ordinal(n, form))
+		if len(loc) > 6 && loc[6] >= 0 {
+			builder.WriteString(s[loc[6]:loc[7]])
+		}
+	}
+	builder.WriteString(s[last:])
+	return builder.String()
+}
@@ -0,0 +1,113 @@
+package internal_normalizers
+
+import (
+	"testing"
+
+	"github.com/rapidaai/pkg/commons"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetLocale(t *testing.T) {
+	for language, expected := range map[string]string{
+		"hi-IN": "hi",
+		"es_ES": "es",
+		"fr-FR": "fr",
+		"DE-de": "de",
+		"en":    "en",
+	} {
+		locale, ok := GetLocale(language)
+		require.True(t, ok, language)
+		assert.Equal(t, expected, locale.Language)
+	}
+	_, ok := GetLocale("ja-JP")
+	assert.False(t, ok)
+	_, ok = GetLocale("")
+	assert.False(t, ok)
+}
+
+func TestLocaleNormalizers(t *testing.T) {
+	logger := commons.NewApplicationLoggerWithOptions(commons.EnableFile(false), commons.Level("error"))
+	logger.InitLogger()
+
+	normalizers := map[string]func(commons.Logger, *Locale) Normalizer{
+		"numeral":  NewLocaleNumberNormalizer,
+		"currency": NewLocaleCurrencyNormalizer,
+		"date":     NewLocaleDateNormalizer,
+		"time":     NewLocaleTimeNormalizer,
+		"ordinal":  NewOrdinalNormalizer,
+	}
+
+	tests := []struct {
+		language   string
+		normalizer string
+		input      string
+		expected   string
+	}{
+		// hindi
+		{"hi-IN", "numeral", "कुल 1,25,000 लोग", "कुल एक लाख पच्चीस हज़ार लोग"},
+		{"hi-IN", "numeral", "3.75 किलो", "तीन दशमलव सात पाँच किलो"},
+		{"hi-IN", "numeral", "पिन 0123", "पिन 0123"},
+		{"hi-IN", "currency", "₹1,250 दीजिए", "एक हज़ार दो सौ पचास रुपये दीजिए"},
+		{"hi-IN", "currency", "Rs. 1 और 50 पैसे", "एक रुपया और 50 पैसे"},
+		{"hi-IN", "currency", "$12.50", "बारह डॉलर और पचास सेंट"},
+		{"hi-IN", "date", "15/08/2025 को", "पंद्रह अगस्त दो हज़ार पच्चीस को"},
+		{"hi-IN", "date", "31/02/2025", "31/02/2025"},
+		{"hi-IN", "time", "14:30 पर", "दो बजकर तीस मिनट पर"},
+		{"hi-IN", "time", "09:00", "नौ बजे"},
+		{"hi-IN", "ordinal", "1ला इनाम और 2री बार", "पहला इनाम और दूसरी बार"},
+		{"hi-IN", "ordinal", "5वें दिन", "पाँचवें दिन"},
+
+		// spanish
+		{"es-ES", "numeral", "1.250 personas", "mil doscientos cincuenta personas"},
+		{"es-ES", "numeral", "21 y 3,5", "veintiuno y tres coma cinco"},
+		{"es-ES", "currency", "1.250,50 €", "mil doscientos cincuenta euros con cincuenta céntimos"},
+		{"es-ES", "currency", "$1", "un dólar"},
+		{"es-ES", "currency", "201 £", "doscientas una libras"},
+		{"es-ES", "currency", "1.000.000 €", "un millón de euros"},
+		{"es-ES", "date", "15/08/2025", "quince de agosto de dos mil veinticinco"},
+		{"es-ES", "time", "14:30", "catorce horas y treinta minutos"},
+		{"es-ES", "time", "1:01", "una hora y un minuto"},
+		{"es-ES", "ordinal", "el 1º y la 3.ª", "el primero y la tercera"},
+		{"es-ES", "ordinal", "21º", "vigésimo primero"},
+
+		// french
+		{"fr-FR", "numeral", "1 250 personnes", "mille deux cent cinquante personnes"},
+		{"fr-FR", "numeral", "71, 80, 91 et 200", "soixante et onze, quatre-vingts, quatre-vingt-onze et deux cents"},
+		{"fr-FR", "numeral", "80 000", "quatre-vingt mille"},
+		{"fr-FR", "numeral", "2,5", "deux virgule cinq"},
+		{"fr-FR", "currency", "12,50 €", "douze euros et cinquante centimes"},
+		{"fr-FR", "currency", "21 £", "vingt et une livres"},
+		{"fr-FR", "currency", "€1.000.000", "un million d'euros"},
+		{"fr-FR", "date", "01/05/2025", "premier mai deux mille vingt-cinq"},
+		{"fr-FR", "time", "14h30", "quatorze heures trente"},
+		{"fr-FR", "time", "1:00", "une heure"},
+		{"fr-FR", "time", "21 h", "vingt et une heures"},
+		{"fr-FR", "ordinal", "le 1er et la 1re", "le premier et la première"},
+		{"fr-FR", "ordinal", "5e, 9e et 21es", "cinquième, neuvième et vingt et unièmes"},
+
+		// german
+		{"de-DE", "numeral", "1.250 Personen", "eintausendzweihundertfünfzig Personen"},
+		{"de-DE", "numeral", "21 und 2.000.000", "einundzwanzig und zwei Millionen"},
+		{"de-DE", "numeral", "3,14", "drei Komma eins vier"},
+		{"de-DE", "currency", "1,50 €", "ein Euro und fünfzig Cent"},
+		{"de-DE", "currency", "EUR 101", "einhundertein Euro"},
+		{"de-DE", "date", "am 03.10.1990", "am dritten Oktober neunzehnhundertneunzig"},
+		{"de-DE", "date", "der 15.08.2025", "der fünfzehnte August zweitausendfünfundzwanzig"},
+		{"de-DE", "time", "14:30 Uhr", "vierzehn Uhr dreißig"},
+		{"de-DE", "time", "1 Uhr", "ein Uhr"},
+		{"de-DE", "ordinal", "am 3. Mai", "am dritten Mai"},
+		{"de-DE", "ordinal", "der 20. Platz", "der zwanzigste Platz"},
+
+		// english keeps its normalizers and gains ordinals
+		{"en-US", "ordinal", "the 1st, 22nd and 40th", "the first, twenty-second and fortieth"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.language+"/"+tt.normalizer+"/"+tt.input, func(t *testing.T) {
+			locale, ok := GetLocale(tt.language)
+			require.True(t, ok)
+			assert.Equal(t, tt.expected, normalizers[tt.normalizer](logger, locale).Normalize(tt.input))
+		})
+	}
+}
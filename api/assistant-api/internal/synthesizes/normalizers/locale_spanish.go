@@ -0,0 +1,177 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_normalizers
+
+import (
+	"regexp"
+	"strings"
+)
+
+var spanishUnits = [30]string{
+	"", "uno", "dos", "tres", "cuatro", "cinco", "seis", "siete", "ocho", "nueve",
+	"diez", "once", "doce", "trece", "catorce", "quince", "dieciséis", "diecisiete", "dieciocho", "diecinueve",
+	"veinte", "veintiuno", "veintidós", "veintitrés", "veinticuatro", "veinticinco", "veintiséis", "veintisiete", "veintiocho", "veintinueve",
+}
+
+var spanishTens = [10]string{"", "", "", "treinta", "cuarenta", "cincuenta", "sesenta", "setenta", "ochenta", "noventa"}
+
+var spanishHundreds = [10]string{"", "ciento", "doscientos", "trescientos", "cuatrocientos", "quinientos", "seiscientos", "setecientos", "ochocientos", "novecientos"}
+
+var spanishMonths = [12]string{
+	"enero", "febrero", "marzo", "abril", "mayo", "junio",
+	"julio", "agosto", "septiembre", "octubre", "noviembre", "diciembre",
+}
+
+var spanishOrdinalUnits = [10]string{"", "primero", "segundo", "tercero", "cuarto", "quinto", "sexto", "séptimo", "octavo", "noveno"}
+
+var spanishOrdinalTens = [10]string{"", "décimo", "vigésimo", "trigésimo", "cuadragésimo", "quincuagésimo", "sexagésimo", "septuagésimo", "octogésimo", "nonagésimo"}
+
+var spanish = &Locale{
+	Language:         "es",
+	NumberPattern:    `\d{1,3}(?:\.\d{3})+|\d+`,
+	GroupSeparators:  ".",
+	DecimalSeparator: ",",
+	DecimalWord:      "coma",
+	Currencies: map[string]Currency{
+		"€":   {Major: "euro", Majors: "euros", Minor: "céntimo", Minors: "céntimos"},
+		"EUR": {Major: "euro", Majors: "euros", Minor: "céntimo", Minors: "céntimos"},
+		"$":   {Major: "dólar", Majors: "dólares", Minor: "centavo", Minors: "centavos"},
+		"USD": {Major: "dólar", Majors: "dólares", Minor: "centavo", Minors: "centavos"},
+		"£":   {Major: "libra", Majors: "libras", Minor: "penique", Minors: "peniques", Feminine: true},
+		"GBP": {Major: "libra", Majors: "libras", Minor: "penique", Minors: "peniques", Feminine: true},
+		"₹":   {Major: "rupia", Majors: "rupias", Minor: "paisa", Minors: "paisas", Feminine: true},
+		"INR": {Major: "rupia", Majors: "rupias", Minor: "paisa", Minors: "paisas", Feminine: true},
+	},
+	// 1º, 2.ª
+	OrdinalPattern: regexp.MustCompile(`\b(\d+)\.?(º|ª)`),
+	TimePattern:    regexp.MustCompile(`\b([01]?\d|2[0-3]):([0-5]\d)\b`),
+	Cardinal: func(n int64) string {
+		return spanishCardinal(n, "uno")
+	},
+	Ordinal: spanishOrdinal,
+	Money: func(major, minor int64, currency Currency) string {
+		words := make([]string, 0, 2)
+		if major > 0 || minor == 0 {
+			unit := plural(major, currency.Major, currency.Majors)
+			// un millón de euros
+			if major >= 1_000_000 && major%1_000_000 == 0 {
+				unit = "de " + unit
+			}
+			words = append(words, spanishCardinal(major, spanishOne(currency.Feminine))+" "+unit)
+		}
+		if minor > 0 {
+			words = append(words, spanishCardinal(minor, "un")+" "+plural(minor, currency.Minor, currency.Minors))
+		}
+		return strings.Join(words, " con ")
+	},
+	Date: func(day, month, year int, preceding string) string {
+		return spanishCardinal(int64(day), "uno") + " de " + spanishMonths[month-1] + " de " + spanishCardinal(int64(year), "uno")
+	},
+	Time: func(hour, minute int) string {
+		// hora and minuto are counted, una hora and un minuto
+		words := spanishCardinal(int64(hour), "una") + " " + plural(int64(hour), "hora", "horas")
+		if minute == 0 {
+			return words
+		}
+		return words + " y " + spanishCardinal(int64(minute), "un") + " " + plural(int64(minute), "minuto", "minutos")
+	},
+}
+
+// spanishOne is the form of one before a noun, un euro and una libra
+func spanishOne(feminine bool) string {
+	if feminine {
+		return "una"
+	}
+	return "un"
+}
+
+// spanishUnder100 reads number below hundred, one is the form of final uno as it agrees with the noun
+func spanishUnder100(n int64, one string) string {
+	switch {
+	case n == 1:
+		return one
+	case n == 21:
+		return map[string]string{"uno": "veintiuno", "un": "veintiún", "una": "veintiuna"}[one]
+	case n < 30:
+		return spanishUnits[n]
+	case n%10 == 0:
+		return spanishTens[n/10]
+	}
+	return spanishTens[n/10] + " y " + spanishUnder100(n%10, one)
+}
+
+func spanishUnder1000(n int64, one string) string {
+	if n == 100 {
+		return "cien"
+	}
+	words := make([]string, 0, 2)
+	if n >= 100 {
+		hundreds := spanishHundreds[n/100]
+		// doscientas libras
+		if one == "una" && n >= 200 {
+			hundreds = strings.TrimSuffix(hundreds, "os") + "as"
+		}
+		words = append(words, hundreds)
+	}
+	if n%100 > 0 {
+		words = append(words, spanishUnder100(n%100, one))
+	}
+	return strings.Join(words, " ")
+}
+
+func spanishCardinal(n int64, one string) string {
+	if n == 0 {
+		return "cero"
+	}
+	words := make([]string, 0, 3)
+	if millions := n / 1_000_000; millions > 0 {
+		if millions == 1 {
+			words = append(words, "un millón")
+		} else {
+			words = append(words, spanishCardinal(millions, "un")+" millones")
+		}
+	}
+	if thousands := n % 1_000_000 / 1_000; thousands > 0 {
+		if thousands == 1 {
+			words = append(words, "mil")
+		} else if one == "una" {
+			words = append(words, spanishUnder1000(thousands, "una")+" mil")
+		} else {
+			words = append(words, spanishUnder1000(thousands, "un")+" mil")
+		}
+	}
+	if rest := n % 1_000; rest > 0 {
+		words = append(words, spanishUnder1000(rest, one))
+	}
+	return strings.Join(words, " ")
+}
+
+// spanishOrdinal reads ordinals below hundred, ª gives feminine form
+func spanishOrdinal(n int64, form string) string {
+	if n <= 0 || n >= 100 {
+		return spanishCardinal(n, "uno")
+	}
+	var words []string
+	switch {
+	case n == 11:
+		words = []string{"undécimo"}
+	case n == 12:
+		words = []string{"duodécimo"}
+	default:
+		if n >= 10 {
+			words = append(words, spanishOrdinalTens[n/10])
+		}
+		if n%10 > 0 {
+			words = append(words, spanishOrdinalUnits[n%10])
+		}
+	}
+	if form == "ª" {
+		for i, word := range words {
+			words[i] = strings.TrimSuffix(word, "o") + "a"
+		}
+	}
+	return strings.Join(words, " ")
+}
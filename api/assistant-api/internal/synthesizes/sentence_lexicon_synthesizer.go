@@ -0,0 +1,245 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_synthesizers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"html"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	internal_assistant_entity "github.com/rapidaai/api/assistant-api/internal/entity/assistants"
+	"github.com/rapidaai/pkg/commons"
+)
+
+const (
+	LEXICON_MATCH_WORD  = "word"
+	LEXICON_MATCH_REGEX = "regex"
+
+	LEXICON_ALPHABET_IPA     = "ipa"
+	LEXICON_ALPHABET_X_SAMPA = "x-sampa"
+)
+
+// LexiconFormat is the way pronunciation of lexicon reaches the speaker
+type LexiconFormat struct {
+	// alphabets the speaker reads inline as ssml <phoneme>, empty when phonemes are not read inline
+	Alphabets []string
+
+	// speaker reads ssml <sub alias>, otherwise alias replaces the phrase in text
+	Sub bool
+
+	// speaker takes phonemes of words with its own request, the word is kept in text
+	CustomPronunciation bool
+}
+
+// LexiconPlainText replaces phrases by their alias, for speakers without ssml
+var LexiconPlainText = LexiconFormat{}
+
+func (lf LexiconFormat) readsAlphabet(alphabet string) bool {
+	for _, v := range lf.Alphabets {
+		if v == alphabet {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateLexicon checks the lexicon before it is saved so the speaker never fails on it
+func ValidateLexicon(phrase, matchType, alias, phoneme, alphabet string) error {
+	if strings.TrimSpace(phrase) == "" {
+		return errors.New("phrase is required")
+	}
+	switch matchType {
+	case LEXICON_MATCH_WORD:
+	case LEXICON_MATCH_REGEX:
+		if _, err := regexp.Compile(phrase); err != nil {
+			return fmt.Errorf("phrase is not a valid regular expression: %w", err)
+		}
+	default:
+		return fmt.Errorf("match type must be %s or %s", LEXICON_MATCH_WORD, LEXICON_MATCH_REGEX)
+	}
+	if strings.TrimSpace(alias) == "" && strings.TrimSpace(phoneme) == "" {
+		return errors.New("either alias or phoneme is required")
+	}
+	if phoneme != "" && alphabet != LEXICON_ALPHABET_IPA && alphabet != LEXICON_ALPHABET_X_SAMPA {
+		return fmt.Errorf("alphabet of phoneme must be %s or %s", LEXICON_ALPHABET_IPA, LEXICON_ALPHABET_X_SAMPA)
+	}
+	return nil
+}
+
+type lexiconRule struct {
+	lexicon *internal_assistant_entity.AssistantLexicon
+	re      *regexp.Regexp
+}
+
+type lexiconMatch struct {
+	start, end int
+	groups     []int
+	rule       *lexiconRule
+}
+
+type sentenceLexiconSynthesizer struct {
+	logger commons.Logger
+	format LexiconFormat
+	rules  []*lexiconRule
+}
+
+func NewSentenceLexiconSynthesizer(logger commons.Logger, opts SynthesizerOptions) (SentenceSynthesizer, error) {
+	rules := make([]*lexiconRule, 0, len(opts.Lexicons))
+	for _, lexicon := range opts.Lexicons {
+		pattern := lexicon.GetPhrase()
+		if lexicon.GetMatchType() != LEXICON_MATCH_REGEX {
+			pattern = regexp.QuoteMeta(pattern)
+		}
+		if !lexicon.IsCaseSensitive() {
+			pattern = "(?i)" + pattern
+		}
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			logger.Warnf("skipping lexicon %d with invalid phrase %v", lexicon.Id, err)
+			continue
+		}
+		rules = append(rules, &lexiconRule{lexicon: lexicon, re: re})
+	}
+	if len(rules) == 0 {
+		return nil, errors.New("no lexicon applied")
+	}
+	return &sentenceLexiconSynthesizer{
+		logger: logger,
+		format: opts.LexiconFormat,
+		rules:  rules,
+	}, nil
+}
+
+func (ls *sentenceLexiconSynthesizer) Synthesize(
+	ctx context.Context,
+	contextId string,
+	text string,
+) string {
+	matches := ls.match(text)
+	if len(matches) == 0 {
+		return text
+	}
+
+	// once a tag is written the text is ssml and the rest of it is escaped
+	ssml := false
+	for _, m := range matches {
+		if ls.isTag(m.rule) {
+			ssml = true
+			break
+		}
+	}
+	escape := func(s string) string {
+		if ssml {
+			return html.EscapeString(s)
+		}
+		return s
+	}
+
+	var builder strings.Builder
+	last := 0
+	for _, m := range matches {
+		builder.WriteString(escape(text[last:m.start]))
+		builder.WriteString(ls.render(m, text, escape))
+		last = m.end
+	}
+	builder.WriteString(escape(text[last:]))
+	return builder.String()
+}
+
+// match finds phrases of all rules, earliest and then longest match wins when they overlap
+func (ls *sentenceLexiconSynthesizer) match(text string) []*lexiconMatch {
+	candidates := make([]*lexiconMatch, 0)
+	for _, rule := range ls.rules {
+		for _, loc := range rule.re.FindAllStringSubmatchIndex(text, -1) {
+			if loc[0] == loc[1] {
+				continue
+			}
+			if rule.lexicon.GetMatchType() != LEXICON_MATCH_REGEX && !isWordBoundary(text, loc[0], loc[1]) {
+				continue
+			}
+			candidates = append(candidates, &lexiconMatch{start: loc[0], end: loc[1], groups: loc, rule: rule})
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if candidates[i].start != candidates[j].start {
+			return candidates[i].start < candidates[j].start
+		}
+		return candidates[i].end > candidates[j].end
+	})
+	matches := make([]*lexiconMatch, 0, len(candidates))
+	end := 0
+	for _, m := range candidates {
+		if m.start < end {
+			continue
+		}
+		matches = append(matches, m)
+		end = m.end
+	}
+	return matches
+}
+
+// isTag tells if the rule is written as ssml tag in format of the speaker
+func (ls *sentenceLexiconSynthesizer) isTag(rule *lexiconRule) bool {
+	lexicon := rule.lexicon
+	if lexicon.GetPhoneme() != "" && ls.format.readsAlphabet(lexicon.GetAlphabet()) {
+		return true
+	}
+	return lexicon.GetAlias() != "" && ls.format.Sub && !ls.keepsWord(rule)
+}
+
+// keepsWord tells if the word is left for the speaker to pronounce from its custom pronunciations
+func (ls *sentenceLexiconSynthesizer) keepsWord(rule *lexiconRule) bool {
+	return ls.format.CustomPronunciation &&
+		rule.lexicon.GetPhoneme() != "" &&
+		rule.lexicon.GetMatchType() != LEXICON_MATCH_REGEX
+}
+
+func (ls *sentenceLexiconSynthesizer) render(m *lexiconMatch, text string, escape func(string) string) string {
+	lexicon := m.rule.lexicon
+	matched := text[m.start:m.end]
+	if lexicon.GetPhoneme() != "" && ls.format.readsAlphabet(lexicon.GetAlphabet()) {
+		return fmt.Sprintf(`<phoneme alphabet="%s" ph="%s">%s</phoneme>`,
+			lexicon.GetAlphabet(), html.EscapeString(lexicon.GetPhoneme()), escape(matched))
+	}
+	if ls.keepsWord(m.rule) || lexicon.GetAlias() == "" {
+		return escape(matched)
+	}
+	alias := lexicon.GetAlias()
+	if lexicon.GetMatchType() == LEXICON_MATCH_REGEX {
+		// alias of regular expression can refer to its groups as $1
+		alias = string(m.rule.re.ExpandString(nil, alias, text, m.groups))
+	}
+	if ls.format.Sub {
+		return fmt.Sprintf(`<sub alias="%s">%s</sub>`, html.EscapeString(alias), escape(matched))
+	}
+	return escape(alias)
+}
+
+// isWordBoundary tells if the phrase at start and end is not a part of a longer word
+func isWordBoundary(text string, start, end int) bool {
+	isWord := func(r rune) bool {
+		return unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.IsMark(r)
+	}
+	first, _ := utf8.DecodeRuneInString(text[start:end])
+	if isWord(first) && start > 0 {
+		if before, _ := utf8.DecodeLastRuneInString(text[:start]); isWord(before) {
+			return false
+		}
+	}
+	last, _ := utf8.DecodeLastRuneInString(text[start:end])
+	if isWord(last) && end < len(text) {
+		if after, _ := utf8.DecodeRuneInString(text[end:]); isWord(after) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,77 @@
+package internal_synthesizers
+
+import (
+	"context"
+	"testing"
+
+	internal_assistant_entity "github.com/rapidaai/api/assistant-api/internal/entity/assistants"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateLexicon(t *testing.T) {
+	assert.NoError(t, ValidateLexicon("Rapida", LEXICON_MATCH_WORD, "rah-pee-dah", "", ""))
+	assert.NoError(t, ValidateLexicon("Rapida", LEXICON_MATCH_WORD, "", "ɹəˈpiːdə", LEXICON_ALPHABET_IPA))
+	assert.NoError(t, ValidateLexicon(`INV-(\d+)`, LEXICON_MATCH_REGEX, "invoice $1", "", ""))
+	assert.Error(t, ValidateLexicon(" ", LEXICON_MATCH_WORD, "alias", "", ""))
+	assert.Error(t, ValidateLexicon("Rapida", "prefix", "alias", "", ""))
+	assert.Error(t, ValidateLexicon(`INV-(\d+`, LEXICON_MATCH_REGEX, "invoice", "", ""))
+	assert.Error(t, ValidateLexicon("Rapida", LEXICON_MATCH_WORD, "", "", ""))
+	assert.Error(t, ValidateLexicon("Rapida", LEXICON_MATCH_WORD, "", "ɹəˈpiːdə", "arpabet"))
+}
+
+func TestSentenceLexiconSynthesizer(t *testing.T) {
+	logger := commons.NewApplicationLoggerWithOptions(commons.EnableFile(false), commons.Level("error"))
+	logger.InitLogger()
+
+	lexicons := []*internal_assistant_entity.AssistantLexicon{
+		{Phrase: "Rapida", MatchType: LEXICON_MATCH_WORD, Alias: "rah-pee-dah", Phoneme: "ɹəˈpiːdə", Alphabet: LEXICON_ALPHABET_IPA},
+		{Phrase: "SQL", MatchType: LEXICON_MATCH_WORD, CaseSensitive: true, Alias: "sequel"},
+		{Phrase: "Nguyen", MatchType: LEXICON_MATCH_WORD, Phoneme: "w i n", Alphabet: LEXICON_ALPHABET_X_SAMPA},
+		{Phrase: `INV-(\d+)`, MatchType: LEXICON_MATCH_REGEX, Alias: "invoice $1"},
+		{Phrase: "New York", MatchType: LEXICON_MATCH_WORD, Alias: "the big apple"},
+		{Phrase: "New", MatchType: LEXICON_MATCH_WORD, Alias: "knew"},
+	}
+
+	azure := LexiconFormat{Alphabets: []string{LEXICON_ALPHABET_IPA, LEXICON_ALPHABET_X_SAMPA}, Sub: true}
+	elevenlabs := LexiconFormat{Alphabets: []string{LEXICON_ALPHABET_IPA}}
+	google := LexiconFormat{CustomPronunciation: true}
+
+	tests := []struct {
+		name     string
+		format   LexiconFormat
+		input    string
+		expected string
+	}{
+		{"plain alias", LexiconPlainText, "Welcome to Rapida.", "Welcome to rah-pee-dah."},
+		{"plain keeps phoneme only word", LexiconPlainText, "Ask Nguyen", "Ask Nguyen"},
+		{"plain case sensitive", LexiconPlainText, "SQL and sql", "sequel and sql"},
+		{"plain word boundary", LexiconPlainText, "Rapidash and Rapida's", "Rapidash and rah-pee-dah's"},
+		{"plain regex groups", LexiconPlainText, "Pay INV-2041 today", "Pay invoice 2041 today"},
+		{"plain longest match", LexiconPlainText, "New York is New", "the big apple is knew"},
+		{"plain without match", LexiconPlainText, "nothing to <do>", "nothing to <do>"},
+
+		{"azure phoneme", azure, "Rapida & co", `<phoneme alphabet="ipa" ph="ɹəˈpiːdə">Rapida</phoneme> &amp; co`},
+		{"azure x-sampa", azure, "Ask Nguyen", `Ask <phoneme alphabet="x-sampa" ph="w i n">Nguyen</phoneme>`},
+		{"azure sub", azure, "Run SQL", `Run <sub alias="sequel">SQL</sub>`},
+		{"azure regex sub", azure, "INV-7", `<sub alias="invoice 7">INV-7</sub>`},
+
+		{"elevenlabs ipa only", elevenlabs, "Rapida and Nguyen", `<phoneme alphabet="ipa" ph="ɹəˈpiːdə">Rapida</phoneme> and Nguyen`},
+		{"elevenlabs alias in text", elevenlabs, "Run SQL", "Run sequel"},
+
+		{"google keeps word for custom pronunciation", google, "Welcome to Rapida", "Welcome to Rapida"},
+		{"google alias in text", google, "Run SQL on INV-3", "Run sequel on invoice 3"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			synthesizer, err := NewSentenceLexiconSynthesizer(logger, SynthesizerOptions{Lexicons: lexicons, LexiconFormat: tt.format})
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, synthesizer.Synthesize(context.Background(), "ctx", tt.input))
+		})
+	}
+
+	_, err := NewSentenceLexiconSynthesizer(logger, SynthesizerOptions{})
+	assert.Error(t, err)
+}
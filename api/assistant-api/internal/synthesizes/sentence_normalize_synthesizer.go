@@ -32,15 +32,43 @@ var normalizerMap = map[string]func(commons.Logger) internal_normalizers.Normali
 	"symbol":               internal_normalizers.NewSymbolNormalizer,
 }
 
+// localeNormalizerMap holds normalizers which read text in the language of speaker,
+// dictionaries missing here are english only and skipped for other languages
+var localeNormalizerMap = map[string]func(commons.Logger, *internal_normalizers.Locale) internal_normalizers.Normalizer{
+	"currency": internal_normalizers.NewLocaleCurrencyNormalizer,
+	"date":     internal_normalizers.NewLocaleDateNormalizer,
+	"time":     internal_normalizers.NewLocaleTimeNormalizer,
+	"numeral":  internal_normalizers.NewLocaleNumberNormalizer,
+	"ordinal":  internal_normalizers.NewOrdinalNormalizer,
+}
+
 func NewSentenceNormalizeSynthesizer(logger commons.Logger, opts SynthesizerOptions) (SentenceSynthesizer, error) {
 	dictionariesInterface, err := opts.SpeakerOptions.GetString("speaker.pronunciation.dictionaries")
 	if err != nil {
 		return nil, errors.New("no synthesizer applied")
 	}
 	dictionaries := strings.Split(dictionariesInterface, commons.SEPARATOR)
+	language, _ := opts.SpeakerOptions.GetString("speak.language")
+	locale, ok := internal_normalizers.GetLocale(language)
+	if !ok {
+		// unknown language keeps english normalizers
+		locale, _ = internal_normalizers.GetLocale("en")
+	}
 	normalizers := make([]internal_normalizers.Normalizer, 0, len(dictionaries))
 	for _, dict := range dictionaries {
-		if normalizerFunc, ok := normalizerMap[strings.TrimSpace(dict)]; ok {
+		dict = strings.TrimSpace(dict)
+		if dict == "ordinal" {
+			// ordinal runs first so 21st is not read as a plain number by numeral
+			normalizers = append([]internal_normalizers.Normalizer{internal_normalizers.NewOrdinalNormalizer(logger, locale)}, normalizers...)
+			continue
+		}
+		if locale.Language != "en" {
+			if normalizerFunc, ok := localeNormalizerMap[dict]; ok {
+				normalizers = append(normalizers, normalizerFunc(logger, locale))
+			}
+			continue
+		}
+		if normalizerFunc, ok := normalizerMap[dict]; ok {
 			normalizers = append(normalizers, normalizerFunc(logger))
 		}
 	}
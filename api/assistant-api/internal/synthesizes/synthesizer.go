@@ -8,6 +8,8 @@ package internal_synthesizers
 import (
 	"context"
 
+	internal_assistant_entity "github.com/rapidaai/api/assistant-api/internal/entity/assistants"
+
 	"github.com/rapidaai/pkg/utils"
 )
 
@@ -25,4 +27,8 @@ type SentenceSynthesizer interface {
 
 type SynthesizerOptions struct {
 	SpeakerOptions utils.Option
+
+	// pronunciation lexicon of assistant and the way speaker reads it
+	Lexicons      []*internal_assistant_entity.AssistantLexicon
+	LexiconFormat LexiconFormat
 }
@@ -0,0 +1,219 @@
+package internal_assistant_telemetry_exporters
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"fmt"
+
+	internal_telemetry "github.com/rapidaai/api/assistant-api/internal/telemetry"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/configs"
+	"github.com/rapidaai/pkg/types"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const otlpInstrumentationScope = "github.com/rapidaai/api/assistant-api/internal/telemetry"
+
+// OtlpTraceExporter is a long lived exporter, spans are batched and shipped
+// in background so it must be shutdown to flush pending spans.
+type OtlpTraceExporter interface {
+	internal_telemetry.TraceExporter
+	Shutdown(ctx context.Context) error
+}
+
+type otlpExporter struct {
+	logger   commons.Logger
+	provider *sdktrace.TracerProvider
+	tracer   trace.Tracer
+}
+
+// NewOtlpAssistantTraceExporter creates an exporter which ships every stage of
+// the conversation as a span to an OTLP collector over grpc or http/protobuf.
+func NewOtlpAssistantTraceExporter(
+	ctx context.Context,
+	logger commons.Logger,
+	cfg *configs.OtlpConfig,
+) (OtlpTraceExporter, error) {
+	var (
+		exporter sdktrace.SpanExporter
+		err      error
+	)
+	switch cfg.GetProtocol() {
+	case configs.OTLP_HTTP:
+		opts := []otlptracehttp.Option{
+			otlptracehttp.WithEndpoint(cfg.Endpoint),
+			otlptracehttp.WithHeaders(cfg.GetHeaders()),
+		}
+		if cfg.URLPath != "" {
+			opts = append(opts, otlptracehttp.WithURLPath(cfg.URLPath))
+		}
+		if cfg.Insecure {
+			opts = append(opts, otlptracehttp.WithInsecure())
+		}
+		exporter, err = otlptracehttp.New(ctx, opts...)
+	default:
+		opts := []otlptracegrpc.Option{
+			otlptracegrpc.WithEndpoint(cfg.Endpoint),
+			otlptracegrpc.WithHeaders(cfg.GetHeaders()),
+		}
+		if cfg.Insecure {
+			opts = append(opts, otlptracegrpc.WithInsecure())
+		}
+		exporter, err = otlptracegrpc.New(ctx, opts...)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to create otlp %s exporter: %w", cfg.GetProtocol(), err)
+	}
+
+	batchOpts := make([]sdktrace.BatchSpanProcessorOption, 0, 3)
+	if cfg.BatchSize > 0 {
+		batchOpts = append(batchOpts, sdktrace.WithMaxExportBatchSize(cfg.BatchSize))
+	}
+	if cfg.BatchTimeout > 0 {
+		batchOpts = append(batchOpts, sdktrace.WithBatchTimeout(cfg.BatchTimeout))
+	}
+	if cfg.MaxQueueSize > 0 {
+		batchOpts = append(batchOpts, sdktrace.WithMaxQueueSize(cfg.MaxQueueSize))
+	}
+
+	serviceName := cfg.ServiceName
+	if serviceName == "" {
+		serviceName = "assistant-api"
+	}
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithResource(resource.NewSchemaless(semconv.ServiceName(serviceName))),
+		sdktrace.WithSpanProcessor(sdktrace.NewBatchSpanProcessor(exporter, batchOpts...)),
+		sdktrace.WithSampler(sdktrace.AlwaysSample()),
+		sdktrace.WithIDGenerator(otlpIDGenerator{}),
+	)
+	return &otlpExporter{
+		logger:   logger,
+		provider: provider,
+		tracer:   provider.Tracer(otlpInstrumentationScope),
+	}, nil
+}
+
+// Export implements internal_telemetry.TraceExporter.
+func (e *otlpExporter) Export(
+	ctx context.Context,
+	iauth types.SimplePrinciple,
+	options internal_telemetry.ExportOption,
+	stages []*internal_telemetry.Telemetry) error {
+	opts, ok := options.(*internal_telemetry.VoiceAgentExportOption)
+	if !ok || len(stages) == 0 {
+		return nil
+	}
+
+	attrs := []attribute.KeyValue{
+		attribute.Int64("rapida.assistant.id", int64(opts.AssistantId)),
+		attribute.Int64("rapida.assistant.version", int64(opts.AssistantProviderModelId)),
+		attribute.Int64("rapida.assistant.conversation.id", int64(opts.AssistantConversationId)),
+	}
+	if iauth != nil {
+		if projectId := iauth.GetCurrentProjectId(); projectId != nil {
+			attrs = append(attrs, attribute.Int64("rapida.project.id", int64(*projectId)))
+		}
+		if organizationId := iauth.GetCurrentOrganizationId(); organizationId != nil {
+			attrs = append(attrs, attribute.Int64("rapida.organization.id", int64(*organizationId)))
+		}
+	}
+	traceId := otlpTraceID(opts)
+
+	for _, stg := range stages {
+		e.record(ctx, traceId, attrs, stg)
+	}
+	return nil
+}
+
+// Shutdown flushes the pending spans and closes the connection to the collector.
+func (e *otlpExporter) Shutdown(ctx context.Context) error {
+	return e.provider.Shutdown(ctx)
+}
+
+// record replays the stage as a span of the trace with the timestamps of the stage,
+// ids of the span and its parent are derived from the stage through the context.
+func (e *otlpExporter) record(ctx context.Context, traceId trace.TraceID, attrs []attribute.KeyValue, stg *internal_telemetry.Telemetry) {
+	attributes := make([]attribute.KeyValue, 0, len(attrs)+len(stg.Attributes))
+	attributes = append(attributes, attrs...)
+	for k, v := range stg.Attributes {
+		attributes = append(attributes, attribute.String(k, v))
+	}
+
+	endTime := stg.EndTime
+	if endTime.IsZero() {
+		// span never ended, usually interrupted by the end of the session
+		endTime = stg.StartTime
+	}
+
+	ctx = context.WithValue(ctx, otlpIDsKey{}, otlpIDs{traceId: traceId, spanId: otlpSpanID(stg.SpanID)})
+	startOpts := []trace.SpanStartOption{
+		trace.WithTimestamp(stg.StartTime),
+		trace.WithSpanKind(trace.SpanKindInternal),
+		trace.WithAttributes(attributes...),
+	}
+	if stg.ParentID != "" {
+		ctx = trace.ContextWithRemoteSpanContext(ctx, trace.NewSpanContext(trace.SpanContextConfig{
+			TraceID:    traceId,
+			SpanID:     otlpSpanID(stg.ParentID),
+			TraceFlags: trace.FlagsSampled,
+			Remote:     true,
+		}))
+	} else {
+		startOpts = append(startOpts, trace.WithNewRoot())
+	}
+	_, span := e.tracer.Start(ctx, stg.StageName, startOpts...)
+	span.End(trace.WithTimestamp(endTime))
+}
+
+type otlpIDsKey struct{}
+
+type otlpIDs struct {
+	traceId trace.TraceID
+	spanId  trace.SpanID
+}
+
+// otlpIDGenerator gives the span the ids derived from its stage, stages are exported
+// after they ended so the ids must match the ones their children refer to.
+type otlpIDGenerator struct{}
+
+func (otlpIDGenerator) NewIDs(ctx context.Context) (trace.TraceID, trace.SpanID) {
+	if ids, ok := ctx.Value(otlpIDsKey{}).(otlpIDs); ok {
+		return ids.traceId, ids.spanId
+	}
+	var (
+		traceId trace.TraceID
+		spanId  trace.SpanID
+	)
+	_, _ = rand.Read(traceId[:])
+	_, _ = rand.Read(spanId[:])
+	return traceId, spanId
+}
+
+func (g otlpIDGenerator) NewSpanID(ctx context.Context, traceID trace.TraceID) trace.SpanID {
+	_, spanId := g.NewIDs(ctx)
+	return spanId
+}
+
+// otlpTraceID derives the trace id from the conversation so every export of
+// the same conversation lands in the same trace.
+func otlpTraceID(opts *internal_telemetry.VoiceAgentExportOption) trace.TraceID {
+	var id trace.TraceID
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%d/%d", opts.AssistantId, opts.AssistantConversationId)))
+	copy(id[:], sum[:len(id)])
+	return id
+}
+
+// otlpSpanID maps the uuid span id of the stage to an 8 byte otel span id.
+func otlpSpanID(spanId string) trace.SpanID {
+	var id trace.SpanID
+	sum := sha256.Sum256([]byte(spanId))
+	copy(id[:], sum[:len(id)])
+	return id
+}
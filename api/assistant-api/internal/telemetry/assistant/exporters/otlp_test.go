@@ -0,0 +1,199 @@
+package internal_assistant_telemetry_exporters
+
+import (
+	"context"
+	"encoding/hex"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	internal_telemetry "github.com/rapidaai/api/assistant-api/internal/telemetry"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/configs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	collectortrace "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+)
+
+// collectorStub records every resource span received over grpc or http.
+type collectorStub struct {
+	collectortrace.UnimplementedTraceServiceServer
+	mu      sync.Mutex
+	spans   []*tracepb.ResourceSpans
+	headers []string
+}
+
+func (c *collectorStub) Export(ctx context.Context, req *collectortrace.ExportTraceServiceRequest) (*collectortrace.ExportTraceServiceResponse, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	c.record(req, md.Get("x-api-key"))
+	return &collectortrace.ExportTraceServiceResponse{}, nil
+}
+
+func (c *collectorStub) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+	req := &collectortrace.ExportTraceServiceRequest{}
+	if err := proto.Unmarshal(body, req); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	c.record(req, r.Header.Values("X-Api-Key"))
+	out, _ := proto.Marshal(&collectortrace.ExportTraceServiceResponse{})
+	w.Header().Set("Content-Type", "application/x-protobuf")
+	_, _ = w.Write(out)
+}
+
+func (c *collectorStub) record(req *collectortrace.ExportTraceServiceRequest, headers []string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.spans = append(c.spans, req.GetResourceSpans()...)
+	c.headers = append(c.headers, headers...)
+}
+
+func testOtlpStages() []*internal_telemetry.Telemetry {
+	start := time.Now().UTC()
+	return []*internal_telemetry.Telemetry{
+		{
+			StageName: "assistant-session",
+			StartTime: start,
+			EndTime:   start.Add(2 * time.Second),
+			SpanID:    "0b5c2d8e-8f0b-4c59-9a47-1f3b0f9b7a10",
+			Attributes: map[string]string{
+				"source": "phone-call",
+			},
+		},
+		{
+			StageName: "assistant-listening",
+			StartTime: start.Add(time.Second),
+			EndTime:   start.Add(1500 * time.Millisecond),
+			SpanID:    "6f1e9d3a-3c2b-4b8e-9a51-2d7c4e8f9b21",
+			ParentID:  "0b5c2d8e-8f0b-4c59-9a47-1f3b0f9b7a10",
+			Attributes: map[string]string{
+				"messageId": "msg-1",
+			},
+		},
+	}
+}
+
+func assertOtlpSpans(t *testing.T, stub *collectorStub) {
+	stub.mu.Lock()
+	defer stub.mu.Unlock()
+
+	require.Len(t, stub.spans, 1)
+	serviceName := ""
+	for _, kv := range stub.spans[0].GetResource().GetAttributes() {
+		if kv.GetKey() == "service.name" {
+			serviceName = kv.GetValue().GetStringValue()
+		}
+	}
+	assert.Equal(t, "assistant-api-test", serviceName)
+
+	spans := map[string]*tracepb.Span{}
+	for _, ss := range stub.spans[0].GetScopeSpans() {
+		assert.Equal(t, otlpInstrumentationScope, ss.GetScope().GetName())
+		for _, s := range ss.GetSpans() {
+			spans[s.GetName()] = s
+		}
+	}
+	require.Len(t, spans, 2)
+	root, child := spans["assistant-session"], spans["assistant-listening"]
+	assert.Empty(t, root.GetParentSpanId())
+	assert.Equal(t, root.GetTraceId(), child.GetTraceId())
+	assert.Equal(t, hex.EncodeToString(root.GetTraceId()), otlpTraceID(&internal_telemetry.VoiceAgentExportOption{
+		AssistantId:             101,
+		AssistantConversationId: 303,
+	}).String())
+	assert.Equal(t, root.GetSpanId(), child.GetParentSpanId())
+	assert.Equal(t, hex.EncodeToString(root.GetSpanId()), otlpSpanID("0b5c2d8e-8f0b-4c59-9a47-1f3b0f9b7a10").String())
+	assert.Equal(t, uint64(2*time.Second), root.GetEndTimeUnixNano()-root.GetStartTimeUnixNano())
+	assert.Equal(t, uint64(500*time.Millisecond), child.GetEndTimeUnixNano()-child.GetStartTimeUnixNano())
+	assert.Equal(t, uint64(time.Second), child.GetStartTimeUnixNano()-root.GetStartTimeUnixNano())
+
+	attributes := map[string]interface{}{}
+	for _, kv := range child.GetAttributes() {
+		if v, ok := kv.GetValue().GetValue().(*commonpb.AnyValue_IntValue); ok {
+			attributes[kv.GetKey()] = v.IntValue
+			continue
+		}
+		attributes[kv.GetKey()] = kv.GetValue().GetStringValue()
+	}
+	assert.Equal(t, map[string]interface{}{
+		"rapida.assistant.id":              int64(101),
+		"rapida.assistant.version":         int64(202),
+		"rapida.assistant.conversation.id": int64(303),
+		"messageId":                        "msg-1",
+	}, attributes)
+
+	assert.Equal(t, []string{"secret"}, stub.headers)
+}
+
+func TestOtlpExporter_GRPC(t *testing.T) {
+	logger := commons.NewApplicationLoggerWithOptions(commons.EnableFile(false), commons.Level("error"))
+	logger.InitLogger()
+	stub := &collectorStub{}
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := grpc.NewServer()
+	collectortrace.RegisterTraceServiceServer(server, stub)
+	go server.Serve(listener)
+	defer server.Stop()
+
+	exporter, err := NewOtlpAssistantTraceExporter(context.Background(), logger, &configs.OtlpConfig{
+		Protocol:     "grpc",
+		Endpoint:     listener.Addr().String(),
+		Insecure:     true,
+		ServiceName:  "assistant-api-test",
+		Headers:      "x-api-key=secret",
+		BatchSize:    10,
+		BatchTimeout: time.Minute,
+	})
+	require.NoError(t, err)
+
+	err = exporter.Export(context.Background(), nil, &internal_telemetry.VoiceAgentExportOption{
+		AssistantId:              101,
+		AssistantProviderModelId: 202,
+		AssistantConversationId:  303,
+	}, testOtlpStages())
+	assert.NoError(t, err)
+
+	// batch timeout is long, shutdown must flush the pending spans
+	require.NoError(t, exporter.Shutdown(context.Background()))
+	assertOtlpSpans(t, stub)
+}
+
+func TestOtlpExporter_HTTP(t *testing.T) {
+	logger := commons.NewApplicationLoggerWithOptions(commons.EnableFile(false), commons.Level("error"))
+	logger.InitLogger()
+	stub := &collectorStub{}
+	server := httptest.NewServer(stub)
+	defer server.Close()
+
+	exporter, err := NewOtlpAssistantTraceExporter(context.Background(), logger, &configs.OtlpConfig{
+		Protocol:     "http",
+		Endpoint:     strings.TrimPrefix(server.URL, "http://"),
+		Insecure:     true,
+		ServiceName:  "assistant-api-test",
+		Headers:      "X-Api-Key=secret",
+		BatchTimeout: time.Minute,
+	})
+	require.NoError(t, err)
+
+	err = exporter.Export(context.Background(), nil, &internal_telemetry.VoiceAgentExportOption{
+		AssistantId:              101,
+		AssistantProviderModelId: 202,
+		AssistantConversationId:  303,
+	}, testOtlpStages())
+	assert.NoError(t, err)
+
+	require.NoError(t, exporter.Shutdown(context.Background()))
+	assertOtlpSpans(t, stub)
+}
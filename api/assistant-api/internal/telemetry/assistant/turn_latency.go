@@ -0,0 +1,105 @@
+package internal_assistant_telemetry
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/rapidaai/pkg/types"
+	type_enums "github.com/rapidaai/pkg/types/enums"
+	"github.com/rapidaai/pkg/utils"
+)
+
+// TurnEvent is a point of a conversation turn, a turn is identified by the
+// message id which is also the context id of the spoken response.
+type TurnEvent int
+
+const (
+	// final transcript received from speech to text
+	TurnTranscribed TurnEvent = iota
+	// end of speech detected and user message completed
+	TurnEndOfSpeech
+	// user message handed to the assistant executor
+	TurnGenerationRequested
+	// first token of the assistant response
+	TurnFirstToken
+	// first sentence handed to text to speech
+	TurnSpeechRequested
+	// first byte of synthesized audio
+	TurnFirstAudio
+
+	turnEventCount
+)
+
+type turn struct {
+	events    [turnEventCount]time.Time
+	completed bool
+}
+
+// TurnLatencyAggregator correlates the events of every turn of a conversation
+// and produces the user perceived voice to voice latency of the turn.
+type TurnLatencyAggregator struct {
+	mu    sync.Mutex
+	turns map[string]*turn
+}
+
+func NewTurnLatencyAggregator() *TurnLatencyAggregator {
+	return &TurnLatencyAggregator{
+		turns: make(map[string]*turn),
+	}
+}
+
+// Mark records event for the turn, only the first occurrence is kept except
+// transcripts where the latest final transcript before end of speech counts.
+func (t *TurnLatencyAggregator) Mark(messageId string, event TurnEvent, at time.Time) {
+	if messageId == "" || event < 0 || event >= turnEventCount {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	tr, ok := t.turns[messageId]
+	if !ok {
+		tr = &turn{}
+		t.turns[messageId] = tr
+	}
+	if tr.completed {
+		return
+	}
+	if event == TurnTranscribed && tr.events[TurnEndOfSpeech].IsZero() {
+		tr.events[event] = at
+		return
+	}
+	if tr.events[event].IsZero() {
+		tr.events[event] = at
+	}
+}
+
+// Complete returns latency metrics of the turn, a turn is completed only once
+// and metrics are skipped for events which never happened.
+func (t *TurnLatencyAggregator) Complete(messageId string, description string) []*types.Metric {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	tr, ok := t.turns[messageId]
+	if !ok || tr.completed {
+		return nil
+	}
+	tr.completed = true
+
+	metrics := make([]*types.Metric, 0, 4)
+	for _, l := range []struct {
+		name     type_enums.MetricName
+		from, to TurnEvent
+	}{
+		{type_enums.END_OF_SPEECH_DELAY, TurnTranscribed, TurnEndOfSpeech},
+		{type_enums.LLM_TIME_TO_FIRST_TOKEN, TurnGenerationRequested, TurnFirstToken},
+		{type_enums.TTS_TIME_TO_FIRST_BYTE, TurnSpeechRequested, TurnFirstAudio},
+		{type_enums.MOUTH_TO_EAR_LATENCY, TurnTranscribed, TurnFirstAudio},
+	} {
+		from, to := tr.events[l.from], tr.events[l.to]
+		if from.IsZero() || to.IsZero() || to.Before(from) {
+			continue
+		}
+		metrics = append(metrics, types.NewMetric(l.name.String(), fmt.Sprintf("%d", to.Sub(from)), utils.Ptr(description)))
+	}
+	return metrics
+}
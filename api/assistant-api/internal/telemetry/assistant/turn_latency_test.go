@@ -0,0 +1,58 @@
+package internal_assistant_telemetry
+
+import (
+	"testing"
+	"time"
+
+	type_enums "github.com/rapidaai/pkg/types/enums"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTurnLatencyAggregator_Complete(t *testing.T) {
+	start := time.Now()
+	at := func(ms int) time.Time { return start.Add(time.Duration(ms) * time.Millisecond) }
+
+	aggregator := NewTurnLatencyAggregator()
+	aggregator.Mark("msg-1", TurnTranscribed, at(0))
+	aggregator.Mark("msg-1", TurnTranscribed, at(100)) // later final transcript of same utterance
+	aggregator.Mark("msg-1", TurnEndOfSpeech, at(600))
+	aggregator.Mark("msg-1", TurnTranscribed, at(700)) // after end of speech, ignored
+	aggregator.Mark("msg-1", TurnGenerationRequested, at(610))
+	aggregator.Mark("msg-1", TurnFirstToken, at(910))
+	aggregator.Mark("msg-1", TurnFirstToken, at(950))
+	aggregator.Mark("msg-1", TurnSpeechRequested, at(1000))
+	aggregator.Mark("msg-1", TurnFirstAudio, at(1200))
+
+	metrics := aggregator.Complete("msg-1", "deepgram/openai/cartesia")
+	got := map[string]string{}
+	for _, m := range metrics {
+		got[m.GetName()] = m.GetValue()
+		assert.Equal(t, "deepgram/openai/cartesia", m.GetDescription())
+	}
+	assert.Equal(t, map[string]string{
+		type_enums.END_OF_SPEECH_DELAY.String():     "500000000",
+		type_enums.LLM_TIME_TO_FIRST_TOKEN.String(): "300000000",
+		type_enums.TTS_TIME_TO_FIRST_BYTE.String():  "200000000",
+		type_enums.MOUTH_TO_EAR_LATENCY.String():    "1100000000",
+	}, got)
+
+	// completed only once
+	assert.Empty(t, aggregator.Complete("msg-1", ""))
+	aggregator.Mark("msg-1", TurnFirstAudio, at(2000))
+	assert.Empty(t, aggregator.Complete("msg-1", ""))
+}
+
+func TestTurnLatencyAggregator_TextTurn(t *testing.T) {
+	start := time.Now()
+	aggregator := NewTurnLatencyAggregator()
+	aggregator.Mark("msg-2", TurnEndOfSpeech, start)
+	aggregator.Mark("msg-2", TurnGenerationRequested, start)
+	aggregator.Mark("msg-2", TurnFirstToken, start.Add(250*time.Millisecond))
+
+	metrics := aggregator.Complete("msg-2", "")
+	assert.Len(t, metrics, 1)
+	assert.Equal(t, type_enums.LLM_TIME_TO_FIRST_TOKEN.String(), metrics[0].GetName())
+	assert.Equal(t, "250000000", metrics[0].GetValue())
+
+	assert.Empty(t, aggregator.Complete("unknown", ""))
+}
@@ -201,3 +201,32 @@ func (tpc *exotelTelephony) GetCaller(c *gin.Context) (string, bool) {
 	clientNumber, ok := queryParams["CallFrom"]
 	return clientNumber, ok
 }
+
+func (tpc *exotelTelephony) GetCallReference(c *gin.Context) (string, bool) {
+	callSid := c.Query("CallSid")
+	return callSid, callSid != ""
+}
+
+// CanTransfer is false for exotel, the voicebot applet can not be redirected through the
+// api so the call is never moved to the destination.
+func (tpc *exotelTelephony) CanTransfer() bool {
+	return false
+}
+
+// TransferCall is not supported for exotel, see CanTransfer.
+func (tpc *exotelTelephony) TransferCall(
+	auth types.SimplePrinciple,
+	assistantId, assistantConversationId uint64,
+	callReference string,
+	transfer *internal_telephony.Transfer,
+	vaultCredential *protos.VaultCredential,
+	opts utils.Option,
+) ([]*types.Metric, []*types.Event, error) {
+	return []*types.Metric{types.NewMetric("STATUS", "FAILED", utils.Ptr("Status of telephony api"))},
+		[]*types.Event{types.NewEvent("transfer", map[string]interface{}{"destination": transfer.Destination, "mode": transfer.Mode})},
+		internal_telephony.ErrTransferNotSupported
+}
+
+func (tpc *exotelTelephony) TransferInstruction(c *gin.Context, transfer *internal_telephony.Transfer, whisper bool) error {
+	return internal_telephony.ErrTransferNotSupported
+}
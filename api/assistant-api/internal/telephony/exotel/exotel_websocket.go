@@ -228,7 +228,8 @@ func (exotel *exotelWebsocketStreamer) Send(response *protos.AssistantMessagingR
 			exotel.logger.Errorf("Error sending clear command:", err)
 		}
 	case *protos.AssistantMessagingResponse_Action:
-		if data.Action.GetAction() == protos.AssistantConversationAction_END_CONVERSATION {
+		if data.Action.GetAction() == protos.AssistantConversationAction_END_CONVERSATION ||
+			data.Action.GetAction() == protos.AssistantConversationAction_TRANSFER_CALL {
 			if err := exotel.conn.Close(); err != nil {
 				// terminate the conversation as end tool call is triggered
 				exotel.logger.Errorf("Error disconnecting command:", err)
@@ -0,0 +1,232 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_outbound_telephony
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rapidaai/api/assistant-api/config"
+	internal_conversation_gorm "github.com/rapidaai/api/assistant-api/internal/entity/conversations"
+	internal_factories "github.com/rapidaai/api/assistant-api/internal/factory"
+	telephony "github.com/rapidaai/api/assistant-api/internal/factory/telephony"
+	internal_services "github.com/rapidaai/api/assistant-api/internal/services"
+	web_client "github.com/rapidaai/pkg/clients/web"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/types"
+	type_enums "github.com/rapidaai/pkg/types/enums"
+	"github.com/rapidaai/pkg/utils"
+)
+
+// Error is returned when the call could not be placed, message is safe to show to the caller
+type Error struct {
+	Err     error
+	Message string
+}
+
+func (e *Error) Error() string {
+	return e.Err.Error()
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+type Request struct {
+	AssistantId      uint64
+	AssistantVersion string
+	ToNumber         string
+	// empty uses the number of the phone deployment
+	FromNumber string
+	Metadata   map[string]interface{}
+	Arguments  map[string]interface{}
+	Options    map[string]interface{}
+}
+
+// Caller places outbound calls through the telephony of the phone deployment of the assistant
+type Caller struct {
+	cfg                          *config.AssistantConfig
+	logger                       commons.Logger
+	assistantService             internal_services.AssistantService
+	assistantConversationService internal_services.AssistantConversationService
+	vaultClient                  web_client.VaultClient
+}
+
+func NewCaller(
+	cfg *config.AssistantConfig,
+	logger commons.Logger,
+	assistantService internal_services.AssistantService,
+	assistantConversationService internal_services.AssistantConversationService,
+	vaultClient web_client.VaultClient,
+) *Caller {
+	return &Caller{
+		cfg:                          cfg,
+		logger:                       logger,
+		assistantService:             assistantService,
+		assistantConversationService: assistantConversationService,
+		vaultClient:                  vaultClient,
+	}
+}
+
+// Call creates the conversation and places the call. When the telephony rejects the call the conversation
+// is returned with the error, the events of the telephony are already applied on it.
+func (c *Caller) Call(ctx context.Context, auth types.SimplePrinciple, ir *Request) (*internal_conversation_gorm.AssistantConversation, error) {
+	if utils.IsEmpty(ir.ToNumber) {
+		return nil, &Error{fmt.Errorf("missing to_phone parameter"), "Please provide the required to_phone parameter."}
+	}
+
+	assistant, err := c.assistantService.Get(ctx,
+		auth,
+		ir.AssistantId,
+		utils.GetVersionDefinition(ir.AssistantVersion),
+		&internal_services.GetAssistantOption{
+			InjectPhoneDeployment: true,
+		})
+	if err != nil {
+		c.logger.Debugf("illegal unable to find assistant %v", err)
+		return nil, &Error{err, "Invalid assistant id, please check and try again."}
+	}
+
+	if !assistant.IsPhoneDeploymentEnable() {
+		c.logger.Debugf("illegal deployment for phone %v", err)
+		return nil, &Error{fmt.Errorf("phone deployment is not enabled"), "Phone deployment not enabled or incomplete, please check rapida console and update the deployment"}
+	}
+
+	// creating conversation
+	conversation, err := c.assistantConversationService.
+		CreateConversation(
+			ctx,
+			auth,
+			internal_factories.Identifier(utils.PhoneCall, ctx, auth, ir.ToNumber),
+			assistant.Id,
+			assistant.AssistantProviderId,
+			type_enums.DIRECTION_OUTBOUND,
+			utils.PhoneCall,
+		)
+	if err != nil {
+		c.logger.Errorf("unable to create conversation %+v", err)
+		return nil, &Error{err, "Unable to create conversation session, please check and try again."}
+	}
+	o, err := c.assistantConversationService.
+		ApplyConversationOption(
+			ctx, auth, assistant.Id, conversation.Id, ir.Options,
+		)
+	if err != nil {
+		c.logger.Debugf("unable to create options %v", err)
+		return nil, &Error{err, "Unable to create conversation options, please check and try again."}
+	}
+	conversation.Options = o
+	// updating arguments
+	arguments, err := c.assistantConversationService.
+		ApplyConversationArgument(
+			ctx, auth, assistant.Id, conversation.Id, ir.Arguments,
+		)
+	if err != nil {
+		c.logger.Debugf("unable to create argument %v", err)
+		return nil, &Error{err, "Unable to create conversation arguments, please check and try again."}
+	}
+	conversation.Arguments = arguments
+	// updating metadata
+	metadatas, err := c.assistantConversationService.ApplyConversationMetadata(
+		ctx, auth, assistant.Id, conversation.Id,
+		types.NewMetadataList(ir.Metadata),
+	)
+	if err != nil {
+		c.logger.Debugf("unable to create metadatas %v", err)
+		return nil, &Error{err, "Unable to create conversation metadata, please check and try again."}
+	}
+	conversation.Metadatas = metadatas
+
+	failed := func() {
+		c.assistantConversationService.
+			ApplyConversationMetrics(
+				ctx, auth, assistant.Id, conversation.Id, []*types.Metric{types.NewStatusMetric(type_enums.RECORD_FAILED)},
+			)
+	}
+	credentialID, err := assistant.
+		AssistantPhoneDeployment.
+		GetOptions().
+		GetUint64("rapida.credential_id")
+	if err != nil {
+		failed()
+		return nil, &Error{err, "Please check the credential for telephony, please check and try again."}
+	}
+	vltC, err := c.vaultClient.GetCredential(ctx, auth, credentialID)
+	if err != nil {
+		failed()
+		return nil, &Error{err, "Please check the credential for telephony, please check and try again."}
+	}
+
+	telephony, err := telephony.GetTelephony(
+		telephony.Telephony(
+			assistant.
+				AssistantPhoneDeployment.
+				TelephonyProvider),
+		c.cfg,
+		c.logger,
+	)
+	if err != nil {
+		failed()
+		return nil, &Error{err, "Please check the configuration for telephony, please check and try again."}
+	}
+
+	fromPhone := ir.FromNumber
+	if utils.IsEmpty(fromPhone) {
+		fromNumber, err := assistant.
+			AssistantPhoneDeployment.
+			GetOptions().
+			GetString("phone")
+		if err != nil {
+			failed()
+			return nil, &Error{fmt.Errorf("failed to get Twilio phone number"), "Unable to retrieve the default phone number."}
+		}
+		fromPhone = fromNumber
+	}
+
+	meta, metric, event, callErr := telephony.MakeCall(
+		auth,
+		ir.ToNumber,
+		fromPhone,
+		ir.AssistantId,
+		conversation.Id,
+		vltC,
+		assistant.
+			AssistantPhoneDeployment.
+			GetOptions(),
+	)
+	if callErr != nil {
+		c.logger.Errorf("telephony call return error %v", callErr)
+	}
+
+	if metric != nil {
+		metrics, err := c.assistantConversationService.
+			ApplyConversationMetrics(
+				ctx, auth, assistant.Id, conversation.Id, metric,
+			)
+		if err == nil {
+			conversation.Metrics = append(conversation.Metrics, metrics...)
+		}
+	}
+	if meta != nil {
+		mtds, err := c.assistantConversationService.
+			ApplyConversationMetadata(
+				ctx, auth, assistant.Id, conversation.Id, meta,
+			)
+		if err == nil {
+			conversation.Metadatas = append(conversation.Metadatas, mtds...)
+		}
+	}
+	if event != nil {
+		evts, err := c.assistantConversationService.
+			ApplyConversationTelephonyEvent(
+				ctx, auth, assistant.AssistantPhoneDeployment.TelephonyProvider, assistant.Id, conversation.Id, event,
+			)
+		if err == nil {
+			conversation.TelephonyEvents = append(conversation.TelephonyEvents, evts...)
+		}
+	}
+	return conversation, callErr
+}
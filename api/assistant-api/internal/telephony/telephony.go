@@ -6,7 +6,10 @@
 package internal_telephony
 
 import (
+	"errors"
 	"fmt"
+	"net/url"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 	"github.com/gorilla/websocket"
@@ -42,6 +45,100 @@ type Telephony interface {
 
 	//
 	GetCaller(c *gin.Context) (string, bool)
+
+	// reference of the live call given by provider while receiving the call
+	GetCallReference(c *gin.Context) (string, bool)
+
+	// whether the live call can be transferred, the transfer_call tool is left out otherwise
+	CanTransfer() bool
+
+	// transfer the live call to the destination, callReference is the call identifier of provider
+	TransferCall(
+		auth types.SimplePrinciple,
+		assistantId, assistantConversationId uint64,
+		callReference string,
+		transfer *Transfer,
+		vaultCredential *protos.VaultCredential,
+		opts utils.Option,
+	) ([]*types.Metric, []*types.Event, error)
+
+	// instruction served to provider while transferring the call, whisper is the leg
+	// of destination where summary is spoken before the caller is connected
+	TransferInstruction(c *gin.Context, transfer *Transfer, whisper bool) error
+}
+
+var ErrTransferNotSupported = errors.New("transfer is not supported by telephony")
+
+type TransferMode string
+
+const (
+	// caller is connected to destination directly
+	COLD_TRANSFER TransferMode = "cold"
+
+	// summary of the conversation is spoken to destination before the caller is connected
+	WARM_TRANSFER TransferMode = "warm"
+)
+
+func (m TransferMode) IsValid() bool {
+	return m == COLD_TRANSFER || m == WARM_TRANSFER
+}
+
+// TransferDestinations returns the destinations a transfer_call tool is allowed to
+// transfer to, configured as comma separated tool.transfer_to.
+func TransferDestinations(opts utils.Option) ([]string, error) {
+	transferTo, err := opts.GetString("tool.transfer_to")
+	if err != nil {
+		return nil, fmt.Errorf("tool.transfer_to is required for transfer call")
+	}
+	destinations := make([]string, 0)
+	for _, d := range strings.Split(transferTo, ",") {
+		if d = strings.TrimSpace(d); d != "" {
+			destinations = append(destinations, d)
+		}
+	}
+	if len(destinations) == 0 {
+		return nil, fmt.Errorf("tool.transfer_to does not have any destination")
+	}
+	return destinations, nil
+}
+
+type Transfer struct {
+	// phone number in E.164 format or sip uri
+	Destination string
+	Mode        TransferMode
+	Summary     string
+	// caller id presented to the destination
+	From string
+}
+
+func (t *Transfer) IsSip() bool {
+	return strings.HasPrefix(strings.ToLower(t.Destination), "sip:")
+}
+
+func (t *Transfer) IsWarm() bool {
+	return t.Mode == WARM_TRANSFER
+}
+
+func (t *Transfer) Query() url.Values {
+	values := url.Values{}
+	values.Set("destination", t.Destination)
+	values.Set("mode", string(t.Mode))
+	if t.From != "" {
+		values.Set("from", t.From)
+	}
+	if t.IsWarm() {
+		values.Set("summary", t.Summary)
+	}
+	return values
+}
+
+func NewTransferFromQuery(values url.Values) *Transfer {
+	return &Transfer{
+		Destination: values.Get("destination"),
+		Mode:        TransferMode(values.Get("mode")),
+		Summary:     values.Get("summary"),
+		From:        values.Get("from"),
+	}
 }
 
 func GetAnswerPath(provider string, auth types.SimplePrinciple, assistantId uint64, assistantConversationId uint64, toPhone string) string {
@@ -83,3 +180,29 @@ func GetEventPath(provider string, auth types.SimplePrinciple, assistantId, assi
 			*auth.GetCurrentProjectId())
 	}
 }
+
+func GetTransferPath(provider string, auth types.SimplePrinciple, assistantId, assistantConversationId uint64) string {
+	switch auth.Type() {
+	case "project":
+		return fmt.Sprintf("v1/talk/%s/prj/transfer/%d/%d/%s",
+			provider,
+			assistantId,
+			assistantConversationId,
+			auth.GetCurrentToken())
+	default:
+		return fmt.Sprintf("v1/talk/%s/usr/transfer/%d/%d/%s/%d/%d",
+			provider,
+			assistantId,
+			assistantConversationId,
+			auth.GetCurrentToken(),
+			*auth.GetUserId(),
+			*auth.GetCurrentProjectId())
+	}
+}
+
+// GetWhisperUrl gives the url of whisper instruction for the transfer served on the request
+func GetWhisperUrl(c *gin.Context, host string, transfer *Transfer) string {
+	values := transfer.Query()
+	values.Set("stage", "whisper")
+	return fmt.Sprintf("https://%s%s?%s", host, c.Request.URL.Path, values.Encode())
+}
@@ -1,9 +1,11 @@
 package internal_twilio_telephony
 
 import (
+	"encoding/xml"
 	"fmt"
 	"net/http"
 	"net/url"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 	"github.com/gorilla/websocket"
@@ -198,3 +200,74 @@ func (tpc *twilioTelephony) GetCaller(c *gin.Context) (string, bool) {
 	return clientNumber, ok
 
 }
+
+func (tpc *twilioTelephony) GetCallReference(c *gin.Context) (string, bool) {
+	callSid := c.Query("CallSid")
+	return callSid, callSid != ""
+}
+
+func (tpc *twilioTelephony) CanTransfer() bool {
+	return true
+}
+
+// TransferCall redirects the live call to the transfer instruction, twilio stops the media stream
+// of assistant once the call is redirected
+func (tpc *twilioTelephony) TransferCall(
+	auth types.SimplePrinciple,
+	assistantId, assistantConversationId uint64,
+	callReference string,
+	transfer *internal_telephony.Transfer,
+	vaultCredential *protos.VaultCredential,
+	opts utils.Option,
+) ([]*types.Metric, []*types.Event, error) {
+	event := []*types.Event{
+		types.NewEvent("transfer", map[string]interface{}{"destination": transfer.Destination, "mode": transfer.Mode}),
+	}
+	client, err := tpc.TwilioClient(vaultCredential, opts)
+	if err != nil {
+		return []*types.Metric{types.NewMetric("STATUS", "FAILED", utils.Ptr("Status of telephony api"))}, event, err
+	}
+
+	params := &openapi.UpdateCallParams{}
+	params.SetUrl(fmt.Sprintf("https://%s/%s?%s", tpc.appCfg.PublicAssistantHost, internal_telephony.GetTransferPath("twilio", auth, assistantId, assistantConversationId), transfer.Query().Encode()))
+	params.SetMethod("GET")
+	resp, err := client.Api.UpdateCall(callReference, params)
+	if err != nil {
+		event = append(event, types.NewEvent("transfer-failed", map[string]interface{}{"error": err.Error()}))
+		return []*types.Metric{types.NewMetric("STATUS", "FAILED", utils.Ptr("Status of telephony api"))}, event, err
+	}
+	event = append(event, types.NewEvent("transferred", resp))
+	return []*types.Metric{types.NewMetric("STATUS", "SUCCESS", utils.Ptr("Status of telephony api"))}, event, nil
+}
+
+func (tpc *twilioTelephony) TransferInstruction(c *gin.Context, transfer *internal_telephony.Transfer, whisper bool) error {
+	if whisper {
+		c.Data(http.StatusOK, "text/xml", []byte(fmt.Sprintf(`<Response><Say>%s</Say></Response>`, xmlEscape(transfer.Summary))))
+		return nil
+	}
+	c.Data(http.StatusOK, "text/xml", []byte(tpc.CreateTransferTwinML(transfer, internal_telephony.GetWhisperUrl(c, tpc.appCfg.PublicAssistantHost, transfer))))
+	return nil
+}
+
+func (tpc *twilioTelephony) CreateTransferTwinML(transfer *internal_telephony.Transfer, whisperUrl string) string {
+	dial := "<Dial>"
+	if transfer.From != "" {
+		dial = fmt.Sprintf(`<Dial callerId="%s">`, xmlEscape(transfer.From))
+	}
+	noun := "Number"
+	if transfer.IsSip() {
+		noun = "Sip"
+	}
+	if transfer.IsWarm() {
+		return fmt.Sprintf(`<Response>%s<%s url="%s" method="GET">%s</%s></Dial></Response>`,
+			dial, noun, xmlEscape(whisperUrl), xmlEscape(transfer.Destination), noun)
+	}
+	return fmt.Sprintf(`<Response>%s<%s>%s</%s></Dial></Response>`,
+		dial, noun, xmlEscape(transfer.Destination), noun)
+}
+
+func xmlEscape(s string) string {
+	var b strings.Builder
+	xml.EscapeText(&b, []byte(s))
+	return b.String()
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 	"github.com/gorilla/websocket"
@@ -129,6 +130,7 @@ func (vt *vonageTelephony) MakeCall(
 	}
 
 	mtds = append(mtds, types.NewMetadata("telephony.conversation_reference", result.ConversationUuid))
+	mtds = append(mtds, types.NewMetadata("telephony.call_reference", result.Uuid))
 	event = append(event, types.NewEvent(result.Status, result))
 	return mtds, []*types.Metric{types.NewMetric("STATUS", "SUCCESS", utils.Ptr("Status of telephony api"))}, event, nil
 }
@@ -171,3 +173,67 @@ func (tpc *vonageTelephony) GetCaller(c *gin.Context) (string, bool) {
 	return clientNumber, ok
 
 }
+
+func (tpc *vonageTelephony) GetCallReference(c *gin.Context) (string, bool) {
+	uuid := c.Query("uuid")
+	return uuid, uuid != ""
+}
+
+func (vt *vonageTelephony) CanTransfer() bool {
+	return true
+}
+
+// TransferCall transfers the call leg to the ncco served by transfer instruction,
+// the websocket of assistant is disconnected by vonage once the ncco is replaced
+func (vt *vonageTelephony) TransferCall(
+	auth types.SimplePrinciple,
+	assistantId, assistantConversationId uint64,
+	callReference string,
+	transfer *internal_telephony.Transfer,
+	vaultCredential *protos.VaultCredential,
+	opts utils.Option,
+) ([]*types.Metric, []*types.Event, error) {
+	event := []*types.Event{
+		types.NewEvent("transfer", map[string]interface{}{"destination": transfer.Destination, "mode": transfer.Mode}),
+	}
+	if transfer.IsWarm() && transfer.IsSip() {
+		return []*types.Metric{types.NewMetric("STATUS", "FAILED", utils.Ptr("Status of telephony api"))}, event, fmt.Errorf("warm transfer to sip endpoint is not supported by vonage")
+	}
+
+	cAuth, err := vt.Auth(vaultCredential, opts)
+	if err != nil {
+		return []*types.Metric{types.NewMetric("STATUS", "FAILED", utils.Ptr("Status of telephony api"))}, event, err
+	}
+	result, vErr, err := vonage.NewVoiceClient(cAuth).TransferCall(vonage.TransferCallOpts{
+		Uuid: callReference,
+		AnswerUrl: []string{
+			fmt.Sprintf("https://%s/%s?%s", vt.appCfg.PublicAssistantHost, internal_telephony.GetTransferPath("vonage", auth, assistantId, assistantConversationId), transfer.Query().Encode()),
+		},
+	})
+	if err != nil {
+		event = append(event, types.NewEvent("transfer-failed", map[string]interface{}{"error": err.Error(), "response": vErr.Error}))
+		return []*types.Metric{types.NewMetric("STATUS", "FAILED", utils.Ptr("Status of telephony api"))}, event, err
+	}
+	event = append(event, types.NewEvent("transferred", result))
+	return []*types.Metric{types.NewMetric("STATUS", "SUCCESS", utils.Ptr("Status of telephony api"))}, event, nil
+}
+
+func (vt *vonageTelephony) TransferInstruction(c *gin.Context, transfer *internal_telephony.Transfer, whisper bool) error {
+	if whisper {
+		c.JSON(http.StatusOK, []gin.H{{"action": "talk", "text": transfer.Summary}})
+		return nil
+	}
+
+	endpoint := gin.H{"type": "phone", "number": strings.TrimPrefix(transfer.Destination, "+")}
+	if transfer.IsSip() {
+		endpoint = gin.H{"type": "sip", "uri": transfer.Destination}
+	} else if transfer.IsWarm() {
+		endpoint["onAnswer"] = gin.H{"url": internal_telephony.GetWhisperUrl(c, vt.appCfg.PublicAssistantHost, transfer)}
+	}
+	connect := gin.H{"action": "connect", "endpoint": []gin.H{endpoint}}
+	if transfer.From != "" {
+		connect["from"] = strings.TrimPrefix(transfer.From, "+")
+	}
+	c.JSON(http.StatusOK, []gin.H{connect})
+	return nil
+}
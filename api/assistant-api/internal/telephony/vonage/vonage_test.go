@@ -0,0 +1,41 @@
+package internal_vonage_telephony
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rapidaai/api/assistant-api/config"
+	internal_telephony "github.com/rapidaai/api/assistant-api/internal/telephony"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVonageTelephony_TransferInstruction(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	logger := commons.NewApplicationLoggerWithOptions(commons.EnableFile(false), commons.Level("error"))
+	logger.InitLogger()
+	tpc, err := NewVonageTelephony(&config.AssistantConfig{PublicAssistantHost: "assistant.rapida.ai"}, logger)
+	require.NoError(t, err)
+	assert.True(t, tpc.CanTransfer())
+
+	instruction := func(transfer *internal_telephony.Transfer, whisper bool) string {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodGet, "/v1/talk/vonage/prj/transfer/1/2/key?"+transfer.Query().Encode(), nil)
+		require.NoError(t, tpc.TransferInstruction(c, transfer, whisper))
+		return w.Body.String()
+	}
+
+	assert.JSONEq(t, `[{"action":"connect","endpoint":[{"type":"phone","number":"14155550100"}]}]`,
+		instruction(&internal_telephony.Transfer{Destination: "+14155550100", Mode: internal_telephony.COLD_TRANSFER}, false))
+	assert.JSONEq(t, `[{"action":"connect","from":"14155550199","endpoint":[{"type":"sip","uri":"sip:agent@example.com"}]}]`,
+		instruction(&internal_telephony.Transfer{Destination: "sip:agent@example.com", Mode: internal_telephony.COLD_TRANSFER, From: "+14155550199"}, false))
+
+	warm := &internal_telephony.Transfer{Destination: "+14155550100", Mode: internal_telephony.WARM_TRANSFER, Summary: "Caller wants a refund"}
+	assert.JSONEq(t, `[{"action":"connect","endpoint":[{"type":"phone","number":"14155550100","onAnswer":{"url":"https://assistant.rapida.ai/v1/talk/vonage/prj/transfer/1/2/key?destination=%2B14155550100&mode=warm&stage=whisper&summary=Caller+wants+a+refund"}}]}]`,
+		instruction(warm, false))
+	assert.JSONEq(t, `[{"action":"talk","text":"Caller wants a refund"}]`, instruction(warm, true))
+}
@@ -0,0 +1,126 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_transformer_aws
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	aws_session "github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/polly"
+	"github.com/aws/aws-sdk-go/service/transcribestreamingservice"
+	internal_audio "github.com/rapidaai/api/assistant-api/internal/audio"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/utils"
+	"github.com/rapidaai/protos"
+)
+
+const (
+	DEFAULT_REGION    = "us-east-1"
+	STT_LANGUAGE      = "en-US"
+	TTS_VOICE         = "Joanna"
+	TTS_ENGINE        = "neural"
+	AWS_SAMPLE_RATE   = 16000
+	AWS_MAX_CHUNK_LEN = 32 * 1024
+)
+
+type awsOption struct {
+	logger      commons.Logger
+	session     *aws_session.Session
+	mdlOpts     utils.Option
+	audioConfig *protos.AudioConfig
+	resampler   *internal_audio.AudioResampler
+}
+
+func NewAwsOption(
+	logger commons.Logger,
+	vaultCredential *protos.VaultCredential,
+	audioConfig *protos.AudioConfig,
+	opts utils.Option) (*awsOption, error) {
+	credential := vaultCredential.GetValue().AsMap()
+	accessKeyId, ok := credential["access_key_id"].(string)
+	if !ok {
+		return nil, fmt.Errorf("aws: illegal vault config, access_key_id is missing")
+	}
+	secretAccessKey, ok := credential["secret_access_key"].(string)
+	if !ok {
+		return nil, fmt.Errorf("aws: illegal vault config, secret_access_key is missing")
+	}
+	region, ok := credential["region"].(string)
+	if !ok || region == "" {
+		region = DEFAULT_REGION
+	}
+	session, err := aws_session.NewSession(&aws.Config{
+		Region:      aws.String(region),
+		Credentials: credentials.NewStaticCredentials(accessKeyId, secretAccessKey, ""),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("aws: unable to create session %w", err)
+	}
+	return &awsOption{
+		logger:      logger,
+		session:     session,
+		mdlOpts:     opts,
+		audioConfig: audioConfig,
+		resampler:   internal_audio.NewAudioResampler(),
+	}, nil
+}
+
+// transcribe and polly both work with 16khz pcm16 mono
+func awsAudioConfig() *protos.AudioConfig {
+	return &protos.AudioConfig{
+		SampleRate:  AWS_SAMPLE_RATE,
+		AudioFormat: protos.AudioConfig_LINEAR16,
+		Channels:    1,
+	}
+}
+
+func (ao *awsOption) SpeechToTextInput() *transcribestreamingservice.StartStreamTranscriptionInput {
+	input := &transcribestreamingservice.StartStreamTranscriptionInput{
+		LanguageCode:                      aws.String(STT_LANGUAGE),
+		MediaEncoding:                     aws.String(transcribestreamingservice.MediaEncodingPcm),
+		MediaSampleRateHertz:              aws.Int64(AWS_SAMPLE_RATE),
+		EnablePartialResultsStabilization: aws.Bool(true),
+		PartialResultsStability:           aws.String(transcribestreamingservice.PartialResultsStabilityMedium),
+	}
+	if language, err := ao.mdlOpts.GetString("listen.language"); err == nil {
+		input.LanguageCode = aws.String(language)
+	}
+	if vocabulary, err := ao.mdlOpts.GetString("listen.vocabulary"); err == nil {
+		input.VocabularyName = aws.String(vocabulary)
+	}
+	return input
+}
+
+func (ao *awsOption) speechToTextAudio(in []byte) ([]byte, error) {
+	return ao.resampler.Resample(in, ao.audioConfig, awsAudioConfig())
+}
+
+func (ao *awsOption) TextToSpeechInput(text string) *polly.SynthesizeSpeechInput {
+	input := &polly.SynthesizeSpeechInput{
+		Engine:       aws.String(TTS_ENGINE),
+		OutputFormat: aws.String(polly.OutputFormatPcm),
+		SampleRate:   aws.String(fmt.Sprintf("%d", AWS_SAMPLE_RATE)),
+		Text:         aws.String(text),
+		TextType:     aws.String(polly.TextTypeText),
+		VoiceId:      aws.String(TTS_VOICE),
+	}
+	if voice, err := ao.mdlOpts.GetString("speak.voice.id"); err == nil {
+		input.VoiceId = aws.String(voice)
+	}
+	if engine, err := ao.mdlOpts.GetString("speak.model"); err == nil {
+		input.Engine = aws.String(engine)
+	}
+	if language, err := ao.mdlOpts.GetString("speak.language"); err == nil {
+		input.LanguageCode = aws.String(language)
+	}
+	return input
+}
+
+func (ao *awsOption) textToSpeechAudio(in []byte) ([]byte, error) {
+	return ao.resampler.Resample(in, awsAudioConfig(), ao.audioConfig)
+}
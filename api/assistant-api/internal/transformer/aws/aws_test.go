@@ -0,0 +1,219 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_transformer_aws
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	aws_session "github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/private/protocol/eventstream"
+	"github.com/aws/aws-sdk-go/private/protocol/eventstream/eventstreamapi"
+	"github.com/aws/aws-sdk-go/private/protocol/eventstream/eventstreamtest"
+	internal_audio "github.com/rapidaai/api/assistant-api/internal/audio"
+	internal_transformer "github.com/rapidaai/api/assistant-api/internal/transformer"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/utils"
+	"github.com/rapidaai/protos"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func testLogger(t *testing.T) commons.Logger {
+	logger := commons.NewApplicationLoggerWithOptions(commons.EnableFile(false), commons.Level("error"))
+	if err := logger.InitLogger(); err != nil {
+		t.Fatal(err)
+	}
+	return logger
+}
+
+func testCredential(t *testing.T) *protos.VaultCredential {
+	value, err := structpb.NewStruct(map[string]interface{}{
+		"access_key_id":     "AKID",
+		"secret_access_key": "SECRET",
+		"region":            "us-west-2",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &protos.VaultCredential{Value: value}
+}
+
+func transcriptEvent(t *testing.T, transcript string, partial bool) eventstream.Message {
+	payload, err := json.Marshal(map[string]interface{}{
+		"Transcript": map[string]interface{}{
+			"Results": []map[string]interface{}{{
+				"IsPartial":    partial,
+				"LanguageCode": "en-GB",
+				"Alternatives": []map[string]interface{}{{
+					"Transcript": transcript,
+					"Items": []map[string]interface{}{
+						{"Content": "hello", "Confidence": 0.6},
+						{"Content": "world", "Confidence": 1.0},
+					},
+				}},
+			}},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return eventstream.Message{
+		Headers: eventstream.Headers{
+			eventstreamtest.EventMessageTypeHeader,
+			{Name: eventstreamapi.EventTypeHeader, Value: eventstream.StringValue("TranscriptEvent")},
+		},
+		Payload: payload,
+	}
+}
+
+func TestAWSSpeechToText(t *testing.T) {
+	audio := []byte("sixteen khz pcm audio")
+	session, cleanup, err := eventstreamtest.SetupEventStreamSession(t,
+		&eventstreamtest.ServeEventStream{
+			T:             t,
+			BiDirectional: true,
+			Events: []eventstream.Message{
+				transcriptEvent(t, "hello", true),
+				transcriptEvent(t, "hello world", false),
+			},
+			ClientEvents: []eventstream.Message{{
+				Headers: eventstream.Headers{
+					eventstreamtest.EventMessageTypeHeader,
+					{Name: ":content-type", Value: eventstream.StringValue("application/octet-stream")},
+					{Name: eventstreamapi.EventTypeHeader, Value: eventstream.StringValue("AudioEvent")},
+				},
+				Payload: audio,
+			}},
+		}, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cleanup()
+
+	type result struct {
+		text       string
+		confidence float64
+		language   string
+		final      bool
+	}
+	results := make(chan result, 4)
+	stt, err := NewAWSSpeechToText(context.Background(), testLogger(t), testCredential(t),
+		&internal_transformer.SpeechToTextInitializeOptions{
+			AudioConfig:  internal_audio.NewLinear16khzMonoAudioConfig(),
+			ModelOptions: utils.Option{"listen.language": "en-GB"},
+			OnTranscript: func(text string, confidence float64, language string, final bool) error {
+				results <- result{text, confidence, language, final}
+				return nil
+			},
+		})
+	if err != nil {
+		t.Fatal(err)
+	}
+	stt.(*awsSpeechToText).session = session
+	if err := stt.Initialize(); err != nil {
+		t.Fatal(err)
+	}
+	if err := stt.Transform(context.Background(), audio, &internal_transformer.SpeechToTextOption{}); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []result{{"hello", 0.8, "en-GB", false}, {"hello world", 0.8, "en-GB", true}}
+	for _, want := range expected {
+		select {
+		case got := <-results:
+			if got != want {
+				t.Errorf("expected %v, got %v", want, got)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for transcript")
+		}
+	}
+	if err := stt.Close(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestAWSTextToSpeech(t *testing.T) {
+	pcm := make([]byte, 3200)
+	for i := range pcm {
+		pcm[i] = byte(i)
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&request)
+		if r.URL.Path != "/v1/speech" || request["VoiceId"] != "Matthew" ||
+			request["OutputFormat"] != "pcm" || request["SampleRate"] != "16000" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "audio/pcm")
+		// odd sized writes, samples are split across chunks
+		for offset := 0; offset < len(pcm); offset += 333 {
+			w.Write(pcm[offset:min(offset+333, len(pcm))])
+			w.(http.Flusher).Flush()
+		}
+	}))
+	defer server.Close()
+
+	var mu sync.Mutex
+	var audio bytes.Buffer
+	completed := make(chan string, 1)
+	tts, err := NewAWSTextToSpeech(context.Background(), testLogger(t), testCredential(t),
+		&internal_transformer.TextToSpeechInitializeOptions{
+			AudioConfig:  internal_audio.NewLinear16khzMonoAudioConfig(),
+			ModelOptions: utils.Option{"speak.voice.id": "Matthew"},
+			OnSpeech: func(contextId string, chunk []byte) error {
+				mu.Lock()
+				defer mu.Unlock()
+				if len(chunk)%2 != 0 {
+					t.Errorf("audio chunk of %d bytes splits a sample", len(chunk))
+				}
+				audio.Write(chunk)
+				return nil
+			},
+			OnComplete: func(contextId string) error {
+				completed <- contextId
+				return nil
+			},
+		})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tts.(*awsTextToSpeech).session = aws_session.Must(aws_session.NewSession(&aws.Config{
+		Region:      aws.String("us-west-2"),
+		Endpoint:    aws.String(server.URL),
+		Credentials: credentials.NewStaticCredentials("AKID", "SECRET", ""),
+	}))
+	if err := tts.Initialize(); err != nil {
+		t.Fatal(err)
+	}
+	defer tts.Close(context.Background())
+
+	tts.Transform(context.Background(), "hello there.", &internal_transformer.TextToSpeechOption{ContextId: "ctx-1"})
+	tts.Transform(context.Background(), "", &internal_transformer.TextToSpeechOption{ContextId: "ctx-1", IsComplete: true})
+
+	select {
+	case contextId := <-completed:
+		if contextId != "ctx-1" {
+			t.Errorf("expected completion of ctx-1, got %s", contextId)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for completion")
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if !bytes.Equal(audio.Bytes(), pcm) {
+		t.Errorf("expected %d bytes of audio, got %d", len(pcm), audio.Len())
+	}
+}
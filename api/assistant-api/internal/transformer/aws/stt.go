@@ -7,16 +7,157 @@ package internal_transformer_aws
 
 import (
 	"context"
+	"fmt"
+	"strings"
+	"sync"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/transcribestreamingservice"
 	internal_transformer "github.com/rapidaai/api/assistant-api/internal/transformer"
 	"github.com/rapidaai/pkg/commons"
 	"github.com/rapidaai/protos"
 )
 
+type awsSpeechToText struct {
+	*awsOption
+	mu                 sync.Mutex
+	logger             commons.Logger
+	ctx                context.Context
+	ctxCancel          context.CancelFunc
+	stream             *transcribestreamingservice.StartStreamTranscriptionEventStream
+	transformerOptions *internal_transformer.SpeechToTextInitializeOptions
+}
+
 func NewAWSSpeechToText(
 	ctx context.Context,
 	logger commons.Logger,
 	vaultCredential *protos.VaultCredential,
 	opts *internal_transformer.SpeechToTextInitializeOptions) (internal_transformer.SpeechToTextTransformer, error) {
-	return nil, nil
+	awsOpts, err := NewAwsOption(logger, vaultCredential, opts.AudioConfig, opts.ModelOptions)
+	if err != nil {
+		logger.Errorf("aws-stt: intializing aws failed %+v", err)
+		return nil, err
+	}
+	ct, cancel := context.WithCancel(ctx)
+	return &awsSpeechToText{
+		awsOption:          awsOpts,
+		logger:             logger,
+		ctx:                ct,
+		ctxCancel:          cancel,
+		transformerOptions: opts,
+	}, nil
+}
+
+// Name implements internal_transformer.SpeechToTextTransformer.
+func (*awsSpeechToText) Name() string {
+	return "aws-speech-to-text"
+}
+
+func (ast *awsSpeechToText) Initialize() error {
+	ast.mu.Lock()
+	defer ast.mu.Unlock()
+
+	client := transcribestreamingservice.New(ast.session)
+	resp, err := client.StartStreamTranscriptionWithContext(ast.ctx, ast.SpeechToTextInput())
+	if err != nil {
+		ast.logger.Errorf("aws-stt: unable to start stream transcription %v", err)
+		return err
+	}
+	ast.stream = resp.GetStream()
+	go ast.speechToTextCallback(ast.stream, ast.ctx)
+	ast.logger.Debugf("aws-stt: connection established")
+	return nil
+}
+
+func (ast *awsSpeechToText) speechToTextCallback(stream *transcribestreamingservice.StartStreamTranscriptionEventStream, ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			ast.logger.Infof("aws-stt: context cancelled, stopping response listener")
+			return
+		case event, ok := <-stream.Events():
+			if !ok {
+				if err := stream.Err(); err != nil {
+					ast.logger.Errorf("aws-stt: transcription stream failed %v", err)
+				}
+				return
+			}
+			transcriptEvent, ok := event.(*transcribestreamingservice.TranscriptEvent)
+			if !ok || transcriptEvent.Transcript == nil {
+				continue
+			}
+			for _, result := range transcriptEvent.Transcript.Results {
+				ast.onResult(result)
+			}
+		}
+	}
+}
+
+func (ast *awsSpeechToText) onResult(result *transcribestreamingservice.Result) {
+	if len(result.Alternatives) == 0 || ast.transformerOptions.OnTranscript == nil {
+		return
+	}
+	transcript := strings.TrimSpace(aws.StringValue(result.Alternatives[0].Transcript))
+	if transcript == "" {
+		return
+	}
+	language := aws.StringValue(result.LanguageCode)
+	if language == "" {
+		language = aws.StringValue(ast.SpeechToTextInput().LanguageCode)
+	}
+	confidence := 0.9
+	if items := result.Alternatives[0].Items; len(items) > 0 {
+		// transcribe reports confidence per word, average it for the segment
+		var total float64
+		var scored int
+		for _, item := range items {
+			if item.Confidence != nil {
+				total += *item.Confidence
+				scored++
+			}
+		}
+		if scored > 0 {
+			confidence = total / float64(scored)
+		}
+	}
+	ast.transformerOptions.OnTranscript(transcript, confidence, language, !aws.BoolValue(result.IsPartial))
+}
+
+func (ast *awsSpeechToText) Transform(ctx context.Context, in []byte, opts *internal_transformer.SpeechToTextOption) error {
+	ast.mu.Lock()
+	defer ast.mu.Unlock()
+
+	if ast.stream == nil {
+		return fmt.Errorf("aws-stt: transcription stream is not initialized")
+	}
+	audio, err := ast.speechToTextAudio(in)
+	if err != nil {
+		return fmt.Errorf("error during resampling: %w", err)
+	}
+	for len(audio) > 0 {
+		size := min(len(audio), AWS_MAX_CHUNK_LEN)
+		if err := ast.stream.Send(ast.ctx, &transcribestreamingservice.AudioEvent{
+			AudioChunk: audio[:size],
+		}); err != nil {
+			return fmt.Errorf("failed to send audio data: %w", err)
+		}
+		audio = audio[size:]
+	}
+	return nil
+}
+
+func (ast *awsSpeechToText) Close(ctx context.Context) error {
+	ast.mu.Lock()
+	defer ast.mu.Unlock()
+	if ast.stream != nil {
+		// closing writer tells transcribe no more audio is coming
+		if err := ast.stream.Writer.Close(); err != nil {
+			ast.logger.Debugf("aws-stt: error closing audio stream %v", err)
+		}
+		ast.stream.Close()
+		ast.stream = nil
+		ast.logger.Info("aws-stt: transcription stream closed")
+	}
+	ast.ctxCancel()
+	return nil
 }
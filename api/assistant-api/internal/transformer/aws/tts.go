@@ -8,33 +8,79 @@ package internal_transformer_aws
 import (
 	"context"
 
+	"github.com/aws/aws-sdk-go/service/polly"
 	internal_transformer "github.com/rapidaai/api/assistant-api/internal/transformer"
-	"github.com/rapidaai/config"
 	"github.com/rapidaai/pkg/commons"
 	"github.com/rapidaai/protos"
 )
 
-type awsSpeechToTextTransformer struct {
-	cfg    *config.AppConfig
-	logger commons.Logger
+// awsTextToSpeech synthesizes sentences with polly, audio stream of each
+// request is forwarded as it arrives. sentences are queued on the shared
+// sentence queue of transformer.
+type awsTextToSpeech struct {
+	*awsOption
+	ctx       context.Context
+	ctxCancel context.CancelFunc
+	logger    commons.Logger
+	client    *polly.Polly
+	options   *internal_transformer.TextToSpeechInitializeOptions
+	sentences *internal_transformer.SentenceQueue
 }
 
 func NewAWSTextToSpeech(ctx context.Context, logger commons.Logger,
 	vaultCredential *protos.VaultCredential,
 	opts *internal_transformer.TextToSpeechInitializeOptions) (internal_transformer.TextToSpeechTransformer, error) {
-	return nil, nil
-	// return &awsSpeechToTextTransformer{
-	// 	cfg:    cfg,
-	// 	logger: logger,
-	// }
+	awsOpts, err := NewAwsOption(logger, vaultCredential, opts.AudioConfig, opts.ModelOptions)
+	if err != nil {
+		logger.Errorf("aws-tts: intializing aws failed %+v", err)
+		return nil, err
+	}
+	ct, cancel := context.WithCancel(ctx)
+	at := &awsTextToSpeech{
+		awsOption: awsOpts,
+		ctx:       ct,
+		ctxCancel: cancel,
+		logger:    logger,
+		options:   opts,
+	}
+	at.sentences = internal_transformer.NewSentenceQueue(ct, logger, "aws-tts", opts.OnComplete, at.synthesize)
+	return at, nil
 }
 
-// a implements internal_transformer.SpeechToTextTransformer.
-func (*awsSpeechToTextTransformer) Name() string {
-	panic("unimplemented")
+// Name implements internal_transformer.TextToSpeechTransformer.
+func (*awsTextToSpeech) Name() string {
+	return "aws-text-to-speech"
 }
 
-// trf implements internal_transformer.SpeechToTextTransformer.
-func (*awsSpeechToTextTransformer) Transform(a []byte) (string, error) {
-	panic("unimplemented")
+func (at *awsTextToSpeech) Initialize() error {
+	at.client = polly.New(at.session)
+	at.sentences.Start()
+	at.logger.Debugf("aws-tts: initialized")
+	return nil
+}
+
+func (at *awsTextToSpeech) Transform(ctx context.Context, in string, opts *internal_transformer.TextToSpeechOption) error {
+	return at.sentences.Push(in, opts)
+}
+
+func (at *awsTextToSpeech) synthesize(ctx context.Context, contextId, text string) error {
+	resp, err := at.client.SynthesizeSpeechWithContext(ctx, at.TextToSpeechInput(text))
+	if err != nil {
+		return err
+	}
+	defer resp.AudioStream.Close()
+
+	return internal_transformer.ReadPCM(resp.AudioStream, 3200, func(chunk []byte) error {
+		audio, err := at.textToSpeechAudio(chunk)
+		if err != nil {
+			return err
+		}
+		_ = at.options.OnSpeech(contextId, audio)
+		return nil
+	})
+}
+
+func (at *awsTextToSpeech) Close(ctx context.Context) error {
+	at.ctxCancel()
+	return nil
 }
@@ -8,6 +8,7 @@ package internal_transformer_azure
 
 import (
 	"fmt"
+	"html"
 
 	"github.com/Microsoft/cognitive-services-speech-sdk-go/audio"
 	"github.com/Microsoft/cognitive-services-speech-sdk-go/common"
@@ -105,3 +106,19 @@ func (az *azureOption) GetAudioStreamFormat() *audio.AudioStreamFormat {
 		return v
 	}
 }
+
+// SpeakSsml wraps the ssml fragment of a sentence into a speak document for the configured voice,
+// azure reads ssml only as a full document with the voice in it
+func (az *azureOption) SpeakSsml(fragment string) string {
+	language, err := az.mdlOpts.GetString("speak.language")
+	if err != nil || language == "" {
+		language = "en-US"
+	}
+	voice, err := az.mdlOpts.GetString("speak.voice.id")
+	if err != nil || voice == "" {
+		voice = "en-US-JennyNeural"
+	}
+	return fmt.Sprintf(
+		`<speak version="1.0" xmlns="http://www.w3.org/2001/10/synthesis" xml:lang="%s"><voice name="%s">%s</voice></speak>`,
+		html.EscapeString(language), html.EscapeString(voice), fragment)
+}
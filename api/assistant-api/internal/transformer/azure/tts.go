@@ -103,7 +103,13 @@ func (azure *azureTextToSpeech) Transform(ctx context.Context, text string, opts
 		return fmt.Errorf("azure-tts: you are calling transform without initilize")
 	}
 
-	res := <-azure.client.SpeakTextAsync(text)
+	var res speech.SpeechSynthesisOutcome
+	if internal_transformer.IsSSML(text) {
+		// pronunciation of lexicon comes as ssml phoneme and sub tags
+		res = <-azure.client.SpeakSsmlAsync(azure.SpeakSsml(text))
+	} else {
+		res = <-azure.client.SpeakTextAsync(text)
+	}
 	if res.Error != nil {
 		return res.Error
 	}
@@ -12,6 +12,7 @@ import (
 
 	"cloud.google.com/go/speech/apiv2/speechpb"
 	"cloud.google.com/go/texttospeech/apiv1/texttospeechpb"
+	internal_transformer "github.com/rapidaai/api/assistant-api/internal/transformer"
 	"github.com/rapidaai/pkg/commons"
 	"github.com/rapidaai/pkg/utils"
 	"github.com/rapidaai/protos"
@@ -129,7 +130,7 @@ func (gog *googleOption) SpeechToTextOptions() *speechpb.StreamingRecognitionCon
 }
 
 // TextToSpeechOptions generates a configuration for Google Text-to-Speech streaming synthesis.
-func (goog *googleOption) TextToSpeechOptions() *texttospeechpb.StreamingSynthesizeConfig {
+func (goog *googleOption) TextToSpeechOptions(pronunciations []*internal_transformer.Pronunciation) *texttospeechpb.StreamingSynthesizeConfig {
 	audioEncoding := goog.GetTextToSpeechEncodingByName(goog.audioConfig.GetAudioFormat())
 
 	options := &texttospeechpb.StreamingSynthesizeConfig{
@@ -149,6 +150,22 @@ func (goog *googleOption) TextToSpeechOptions() *texttospeechpb.StreamingSynthes
 		goog.logger.Warn("Voice not specified, defaulting to " + DefaultVoice)
 	}
 
+	// streaming synthesis takes no ssml, phonemes of lexicon go with the config
+	params := make([]*texttospeechpb.CustomPronunciationParams, 0, len(pronunciations))
+	for _, pronunciation := range pronunciations {
+		encoding := texttospeechpb.CustomPronunciationParams_PHONETIC_ENCODING_IPA
+		if pronunciation.Alphabet == "x-sampa" {
+			encoding = texttospeechpb.CustomPronunciationParams_PHONETIC_ENCODING_X_SAMPA
+		}
+		params = append(params, &texttospeechpb.CustomPronunciationParams{
+			Phrase:           &pronunciation.Phrase,
+			PhoneticEncoding: &encoding,
+			Pronunciation:    &pronunciation.Phoneme,
+		})
+	}
+	if len(params) > 0 {
+		options.CustomPronunciations = &texttospeechpb.CustomPronunciations{Pronunciations: params}
+	}
 	return options
 }
 
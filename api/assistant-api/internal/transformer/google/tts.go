@@ -106,7 +106,7 @@ func (google *googleTextToSpeech) Initialize() error {
 	req := texttospeechpb.StreamingSynthesizeRequest{
 		StreamingRequest: &texttospeechpb.
 			StreamingSynthesizeRequest_StreamingConfig{
-			StreamingConfig: google.TextToSpeechOptions(),
+			StreamingConfig: google.TextToSpeechOptions(google.transformerOptions.Pronunciations),
 		},
 	}
 	google.streamClient = stream
@@ -0,0 +1,178 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+
+package internal_transformer_openai
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/url"
+
+	internal_audio "github.com/rapidaai/api/assistant-api/internal/audio"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/utils"
+	"github.com/rapidaai/protos"
+)
+
+const (
+	API_URL           = "https://api.openai.com/v1"
+	REALTIME_URL      = "wss://api.openai.com/v1/realtime"
+	STT_MODEL         = "gpt-4o-transcribe"
+	TTS_MODEL         = "gpt-4o-mini-tts"
+	TTS_VOICE         = "alloy"
+	OPENAI_SAMPLERATE = 24000
+)
+
+// transcription models as they are configured in assistant options
+var transcriptionModels = map[string]string{
+	"gpt4o-transcribe":      "gpt-4o-transcribe",
+	"gpt4o-mini-transcribe": "gpt-4o-mini-transcribe",
+	"whisper":               "whisper-1",
+}
+
+type RealtimeEvent struct {
+	Type       string `json:"type"`
+	ItemID     string `json:"item_id"`
+	Delta      string `json:"delta"`
+	Transcript string `json:"transcript"`
+	Error      *struct {
+		Type    string `json:"type"`
+		Code    string `json:"code"`
+		Message string `json:"message"`
+	} `json:"error,omitempty"`
+}
+
+type openaiOption struct {
+	logger      commons.Logger
+	key         string
+	apiUrl      string
+	realtimeUrl string
+	mdlOpts     utils.Option
+	audioConfig *protos.AudioConfig
+	resampler   *internal_audio.AudioResampler
+}
+
+func NewOpenaiOption(
+	logger commons.Logger,
+	vaultCredential *protos.VaultCredential,
+	audioConfig *protos.AudioConfig,
+	opts utils.Option) (*openaiOption, error) {
+	cx, ok := vaultCredential.GetValue().AsMap()["key"]
+	if !ok {
+		return nil, fmt.Errorf("openai: illegal vault config")
+	}
+	return &openaiOption{
+		logger:      logger,
+		key:         cx.(string),
+		apiUrl:      API_URL,
+		realtimeUrl: REALTIME_URL,
+		mdlOpts:     opts,
+		audioConfig: audioConfig,
+		resampler:   internal_audio.NewAudioResampler(),
+	}, nil
+}
+
+func openaiAudioConfig() *protos.AudioConfig {
+	return &protos.AudioConfig{
+		SampleRate:  OPENAI_SAMPLERATE,
+		AudioFormat: protos.AudioConfig_LINEAR16,
+		Channels:    1,
+	}
+}
+
+func (oo *openaiOption) GetKey() string {
+	return oo.key
+}
+
+// openai realtime accepts g711 ulaw at 8khz as is, everything else is sent as 24khz pcm16
+func (oo *openaiOption) GetEncoding() string {
+	if oo.audioConfig.GetAudioFormat() == protos.AudioConfig_MuLaw8 {
+		return "g711_ulaw"
+	}
+	return "pcm16"
+}
+
+func (oo *openaiOption) speechToTextUrl() string {
+	params := url.Values{}
+	params.Add("intent", "transcription")
+	return fmt.Sprintf("%s?%s", oo.realtimeUrl, params.Encode())
+}
+
+func (oo *openaiOption) SpeechToTextSession() map[string]interface{} {
+	transcription := map[string]interface{}{
+		"model": STT_MODEL,
+	}
+	if model, err := oo.mdlOpts.GetString("listen.model"); err == nil {
+		if mdl, ok := transcriptionModels[model]; ok {
+			model = mdl
+		}
+		transcription["model"] = model
+	}
+	if language, err := oo.mdlOpts.GetString("listen.language"); err == nil {
+		transcription["language"] = language
+	}
+	if prompt, err := oo.mdlOpts.GetString("listen.prompt"); err == nil {
+		transcription["prompt"] = prompt
+	}
+	return map[string]interface{}{
+		"type": "transcription_session.update",
+		"session": map[string]interface{}{
+			"input_audio_format":        oo.GetEncoding(),
+			"input_audio_transcription": transcription,
+			"turn_detection": map[string]interface{}{
+				"type":                "server_vad",
+				"threshold":           0.5,
+				"prefix_padding_ms":   300,
+				"silence_duration_ms": 500,
+			},
+		},
+	}
+}
+
+func (oo *openaiOption) speechToTextMessage(in []byte) (map[string]interface{}, error) {
+	if oo.GetEncoding() == "pcm16" {
+		var err error
+		in, err = oo.resampler.Resample(in, oo.audioConfig, openaiAudioConfig())
+		if err != nil {
+			return nil, fmt.Errorf("error during resampling: %w", err)
+		}
+	}
+	return map[string]interface{}{
+		"type":  "input_audio_buffer.append",
+		"audio": base64.StdEncoding.EncodeToString(in),
+	}, nil
+}
+
+func (oo *openaiOption) textToSpeechUrl() string {
+	return fmt.Sprintf("%s/audio/speech", oo.apiUrl)
+}
+
+// openai streams raw pcm at 24khz, it is converted to requested audio config before sending out
+func (oo *openaiOption) TextToSpeechRequest(text string) map[string]interface{} {
+	request := map[string]interface{}{
+		"model":           TTS_MODEL,
+		"voice":           TTS_VOICE,
+		"input":           text,
+		"response_format": "pcm",
+	}
+	if model, err := oo.mdlOpts.GetString("speak.model"); err == nil {
+		request["model"] = model
+	}
+	if voice, err := oo.mdlOpts.GetString("speak.voice.id"); err == nil {
+		request["voice"] = voice
+	}
+	if instructions, err := oo.mdlOpts.GetString("speak.instructions"); err == nil {
+		request["instructions"] = instructions
+	}
+	if speed, err := oo.mdlOpts.GetFloat64("speak.speed"); err == nil {
+		request["speed"] = speed
+	}
+	return request
+}
+
+func (oo *openaiOption) textToSpeechAudio(in []byte) ([]byte, error) {
+	return oo.resampler.Resample(in, openaiAudioConfig(), oo.audioConfig)
+}
@@ -0,0 +1,214 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+
+package internal_transformer_openai
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	internal_audio "github.com/rapidaai/api/assistant-api/internal/audio"
+	internal_transformer "github.com/rapidaai/api/assistant-api/internal/transformer"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/utils"
+	"github.com/rapidaai/protos"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+type transcript struct {
+	text     string
+	language string
+	final    bool
+}
+
+func testLogger(t *testing.T) commons.Logger {
+	logger := commons.NewApplicationLoggerWithOptions(commons.EnableFile(false), commons.Level("error"))
+	if err := logger.InitLogger(); err != nil {
+		t.Fatal(err)
+	}
+	return logger
+}
+
+func testCredential(t *testing.T) *protos.VaultCredential {
+	value, err := structpb.NewStruct(map[string]interface{}{"key": "test-key"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &protos.VaultCredential{Value: value}
+}
+
+func TestOpenaiSpeechToText(t *testing.T) {
+	received := make(chan map[string]interface{}, 8)
+	upgrader := websocket.Upgrader{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer test-key" || r.URL.Query().Get("intent") != "transcription" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		for i := 0; i < 2; i++ {
+			var msg map[string]interface{}
+			if err := conn.ReadJSON(&msg); err != nil {
+				return
+			}
+			received <- msg
+		}
+		for _, event := range []RealtimeEvent{
+			{Type: "conversation.item.input_audio_transcription.delta", ItemID: "item_1", Delta: "hello"},
+			{Type: "conversation.item.input_audio_transcription.delta", ItemID: "item_1", Delta: " world"},
+			{Type: "conversation.item.input_audio_transcription.completed", ItemID: "item_1", Transcript: "hello world."},
+		} {
+			conn.WriteJSON(event)
+		}
+		conn.ReadMessage()
+	}))
+	defer server.Close()
+
+	var mu sync.Mutex
+	var transcripts []transcript
+	done := make(chan struct{})
+	stt, err := NewOpenaiSpeechToText(context.Background(), testLogger(t), testCredential(t),
+		&internal_transformer.SpeechToTextInitializeOptions{
+			AudioConfig:  internal_audio.NewLinear16khzMonoAudioConfig(),
+			ModelOptions: utils.Option{"listen.language": "en"},
+			OnTranscript: func(text string, confidence float64, language string, final bool) error {
+				mu.Lock()
+				defer mu.Unlock()
+				transcripts = append(transcripts, transcript{text, language, final})
+				if final {
+					close(done)
+				}
+				return nil
+			},
+		})
+	if err != nil {
+		t.Fatal(err)
+	}
+	stt.(*openaiSpeechToText).realtimeUrl = "ws" + strings.TrimPrefix(server.URL, "http")
+	if err := stt.Initialize(); err != nil {
+		t.Fatal(err)
+	}
+	defer stt.Close(context.Background())
+
+	// 10ms of 16khz audio is upsampled to 10ms of 24khz audio
+	if err := stt.Transform(context.Background(), make([]byte, 320), &internal_transformer.SpeechToTextOption{}); err != nil {
+		t.Fatal(err)
+	}
+
+	session := <-received
+	if session["type"] != "transcription_session.update" {
+		t.Fatalf("expected session update first, got %v", session["type"])
+	}
+	audio := <-received
+	if audio["type"] != "input_audio_buffer.append" {
+		t.Fatalf("expected audio append, got %v", audio["type"])
+	}
+	if encoded, _ := audio["audio"].(string); len(encoded) != 640 {
+		t.Errorf("expected 480 bytes of base64 encoded 24khz audio, got %d characters", len(encoded))
+	}
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for final transcript")
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	expected := []transcript{
+		{"hello", "en", false},
+		{"hello world", "en", false},
+		{"hello world.", "en", true},
+	}
+	if len(transcripts) != len(expected) {
+		t.Fatalf("expected %d transcripts, got %v", len(expected), transcripts)
+	}
+	for i := range expected {
+		if transcripts[i] != expected[i] {
+			t.Errorf("transcript %d: expected %v, got %v", i, expected[i], transcripts[i])
+		}
+	}
+}
+
+func TestOpenaiTextToSpeech(t *testing.T) {
+	pcm := make([]byte, 4801)
+	for i := range pcm {
+		pcm[i] = byte(i)
+	}
+	pcm = pcm[:4800]
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&request)
+		if r.URL.Path != "/audio/speech" || request["voice"] != "nova" || request["response_format"] != "pcm" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		// odd sized writes, samples are split across chunks
+		for offset := 0; offset < len(pcm); offset += 1001 {
+			w.Write(pcm[offset:min(offset+1001, len(pcm))])
+			w.(http.Flusher).Flush()
+		}
+	}))
+	defer server.Close()
+
+	var mu sync.Mutex
+	var audio bytes.Buffer
+	completed := make(chan string, 1)
+	tts, err := NewOpenaiTextToSpeech(context.Background(), testLogger(t), testCredential(t),
+		&internal_transformer.TextToSpeechInitializeOptions{
+			AudioConfig:  internal_audio.NewLinear24khzMonoAudioConfig(),
+			ModelOptions: utils.Option{"speak.voice.id": "nova"},
+			OnSpeech: func(contextId string, chunk []byte) error {
+				mu.Lock()
+				defer mu.Unlock()
+				if len(chunk)%2 != 0 {
+					t.Errorf("audio chunk of %d bytes splits a sample", len(chunk))
+				}
+				audio.Write(chunk)
+				return nil
+			},
+			OnComplete: func(contextId string) error {
+				completed <- contextId
+				return nil
+			},
+		})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tts.(*openaiTextToSpeech).apiUrl = server.URL
+	if err := tts.Initialize(); err != nil {
+		t.Fatal(err)
+	}
+	defer tts.Close(context.Background())
+
+	tts.Transform(context.Background(), "hello there.", &internal_transformer.TextToSpeechOption{ContextId: "ctx-1"})
+	tts.Transform(context.Background(), "", &internal_transformer.TextToSpeechOption{ContextId: "ctx-1", IsComplete: true})
+
+	select {
+	case contextId := <-completed:
+		if contextId != "ctx-1" {
+			t.Errorf("expected completion of ctx-1, got %s", contextId)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for completion")
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if !bytes.Equal(audio.Bytes(), pcm) {
+		t.Errorf("expected %d bytes of audio, got %d", len(pcm), audio.Len())
+	}
+}
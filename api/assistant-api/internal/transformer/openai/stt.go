@@ -8,52 +8,160 @@ package internal_transformer_openai
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
 
-	openai "github.com/openai/openai-go"
-	"github.com/openai/openai-go/option"
+	"github.com/gorilla/websocket"
 	internal_transformer "github.com/rapidaai/api/assistant-api/internal/transformer"
 	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/protos"
 )
 
 type openaiSpeechToText struct {
-	logger commons.Logger
-	client openai.Client
-	ctx    context.Context
-	cancel context.CancelFunc
+	*openaiOption
+	mu                 sync.Mutex
+	logger             commons.Logger
+	ctx                context.Context
+	ctxCancel          context.CancelFunc
+	connection         *websocket.Conn
+	transformerOptions *internal_transformer.SpeechToTextInitializeOptions
+
+	// partial transcript of each conversation item, openai sends deltas
+	transcripts map[string]string
 }
 
-func (o *openaiSpeechToText) Initialize() error {
-	o.ctx, o.cancel = context.WithCancel(context.Background())
-	o.client = openai.NewClient(option.WithAPIKey("YOUR_API_KEY"))
-	return nil
+func NewOpenaiSpeechToText(
+	ctx context.Context,
+	logger commons.Logger,
+	credential *protos.VaultCredential,
+	transformerOptions *internal_transformer.SpeechToTextInitializeOptions,
+) (internal_transformer.SpeechToTextTransformer, error) {
+	openaiOpts, err := NewOpenaiOption(logger,
+		credential,
+		transformerOptions.AudioConfig,
+		transformerOptions.ModelOptions)
+	if err != nil {
+		logger.Errorf("openai-stt: intializing openai failed %+v", err)
+		return nil, err
+	}
+	ct, cancel := context.WithCancel(ctx)
+	return &openaiSpeechToText{
+		ctx:                ct,
+		ctxCancel:          cancel,
+		logger:             logger,
+		openaiOption:       openaiOpts,
+		transformerOptions: transformerOptions,
+		transcripts:        make(map[string]string),
+	}, nil
 }
 
-func (o *openaiSpeechToText) Close(ctx context.Context) error {
-	if o.cancel != nil {
-		o.cancel()
+// Name implements internal_transformer.SpeechToTextTransformer.
+func (*openaiSpeechToText) Name() string {
+	return "openai-speech-to-text"
+}
+
+func (ost *openaiSpeechToText) Initialize() error {
+	ost.mu.Lock()
+	defer ost.mu.Unlock()
+
+	header := http.Header{}
+	header.Set("Authorization", fmt.Sprintf("Bearer %s", ost.GetKey()))
+	header.Set("OpenAI-Beta", "realtime=v1")
+	conn, _, err := websocket.DefaultDialer.DialContext(ost.ctx, ost.speechToTextUrl(), header)
+	if err != nil {
+		ost.logger.Errorf("openai-stt: failed to connect to openai realtime %v", err)
+		return err
 	}
-	o.logger.Infof("OpenAI SpeechToText connection closed.")
+	if err := conn.WriteJSON(ost.SpeechToTextSession()); err != nil {
+		conn.Close()
+		ost.logger.Errorf("openai-stt: failed to configure transcription session %v", err)
+		return err
+	}
+	ost.connection = conn
+	go ost.speechToTextCallback(conn, ost.ctx)
+	ost.logger.Debugf("openai-stt: connection established")
 	return nil
 }
 
-func (o *openaiSpeechToText) Name() string {
-	return "openai-speech-to-text"
+func (ost *openaiSpeechToText) speechToTextCallback(conn *websocket.Conn, ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			ost.logger.Infof("openai-stt: context cancelled, stopping response listener")
+			return
+		default:
+			_, msg, err := conn.ReadMessage()
+			if err != nil {
+				ost.logger.Debugf("openai-stt: stopped reading from openai realtime %v", err)
+				return
+			}
+			var event RealtimeEvent
+			if err := json.Unmarshal(msg, &event); err != nil {
+				ost.logger.Errorf("openai-stt: invalid json from openai error : %v", err)
+				continue
+			}
+			ost.onEvent(&event)
+		}
+	}
 }
 
-// Transform receives a stream of bytes (audioStream) and prints transcribed text in realtime.
-func (o *openaiSpeechToText) Transform(ctx context.Context,
-	byt []byte,
-	opt *internal_transformer.SpeechToTextOption) error {
+func (ost *openaiSpeechToText) onEvent(event *RealtimeEvent) {
+	if ost.transformerOptions.OnTranscript == nil {
+		return
+	}
+	language, _ := ost.mdlOpts.GetString("listen.language")
+	switch event.Type {
+	case "conversation.item.input_audio_transcription.delta":
+		ost.mu.Lock()
+		transcript := ost.transcripts[event.ItemID] + event.Delta
+		ost.transcripts[event.ItemID] = transcript
+		ost.mu.Unlock()
+		if transcript != "" {
+			ost.transformerOptions.OnTranscript(transcript, 0.9, language, false)
+		}
+	case "conversation.item.input_audio_transcription.completed":
+		ost.mu.Lock()
+		delete(ost.transcripts, event.ItemID)
+		ost.mu.Unlock()
+		if event.Transcript != "" {
+			ost.transformerOptions.OnTranscript(event.Transcript, 0.9, language, true)
+		}
+	case "error":
+		if event.Error != nil {
+			ost.logger.Errorf("openai-stt: error from openai realtime %s: %s", event.Error.Code, event.Error.Message)
+		}
+	}
+}
+
+func (ost *openaiSpeechToText) Transform(ctx context.Context, in []byte, opts *internal_transformer.SpeechToTextOption) error {
+	ost.mu.Lock()
+	defer ost.mu.Unlock()
+
+	if ost.connection == nil {
+		return fmt.Errorf("openai-stt: websocket connection is not initialized")
+	}
+	message, err := ost.speechToTextMessage(in)
+	if err != nil {
+		return err
+	}
+	if err := ost.connection.WriteJSON(message); err != nil {
+		return fmt.Errorf("failed to send audio data: %w", err)
+	}
 	return nil
 }
 
-func NewOpenaiSpeechToText(
-	ctx context.Context,
-	logger commons.Logger,
-	opts internal_transformer.SpeechToTextTransformer,
-) (internal_transformer.SpeechToTextTransformer, error) {
-	stt := &openaiSpeechToText{
-		logger: logger,
+func (ost *openaiSpeechToText) Close(ctx context.Context) error {
+	ost.ctxCancel()
+	ost.mu.Lock()
+	defer ost.mu.Unlock()
+	if ost.connection != nil {
+		if err := ost.connection.Close(); err != nil {
+			return fmt.Errorf("error closing WebSocket connection: %w", err)
+		}
+		ost.connection = nil
+		ost.logger.Info("openai-stt: openai websocket connection closed")
 	}
-	return stt, nil
+	return nil
 }
@@ -7,15 +7,103 @@
 package internal_transformer_openai
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
 
 	internal_transformer "github.com/rapidaai/api/assistant-api/internal/transformer"
 	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/protos"
 )
 
+// openaiTextToSpeech synthesizes every sentence with a streaming http request
+// queued on the shared sentence queue of transformer.
+type openaiTextToSpeech struct {
+	*openaiOption
+	ctx       context.Context
+	ctxCancel context.CancelFunc
+	logger    commons.Logger
+	client    *http.Client
+	options   *internal_transformer.TextToSpeechInitializeOptions
+	sentences *internal_transformer.SentenceQueue
+}
+
 func NewOpenaiTextToSpeech(
 	ctx context.Context,
 	logger commons.Logger,
-	onSpeech func([]byte) error) (internal_transformer.TextToSpeechTransformer, error) {
-	return nil, nil
+	credential *protos.VaultCredential,
+	opts *internal_transformer.TextToSpeechInitializeOptions) (internal_transformer.TextToSpeechTransformer, error) {
+	openaiOpts, err := NewOpenaiOption(logger,
+		credential,
+		opts.AudioConfig,
+		opts.ModelOptions)
+	if err != nil {
+		logger.Errorf("openai-tts: intializing openai failed %+v", err)
+		return nil, err
+	}
+	ct, cancel := context.WithCancel(ctx)
+	ot := &openaiTextToSpeech{
+		openaiOption: openaiOpts,
+		ctx:          ct,
+		ctxCancel:    cancel,
+		logger:       logger,
+		client:       &http.Client{},
+		options:      opts,
+	}
+	ot.sentences = internal_transformer.NewSentenceQueue(ct, logger, "openai-tts", opts.OnComplete, ot.synthesize)
+	return ot, nil
+}
+
+// Name implements internal_transformer.TextToSpeechTransformer.
+func (*openaiTextToSpeech) Name() string {
+	return "openai-text-to-speech"
+}
+
+func (ot *openaiTextToSpeech) Initialize() error {
+	ot.sentences.Start()
+	ot.logger.Debugf("openai-tts: initialized")
+	return nil
+}
+
+func (ot *openaiTextToSpeech) Transform(ctx context.Context, in string, opts *internal_transformer.TextToSpeechOption) error {
+	return ot.sentences.Push(in, opts)
+}
+
+func (ot *openaiTextToSpeech) synthesize(ctx context.Context, contextId, text string) error {
+	payload, err := json.Marshal(ot.TextToSpeechRequest(text))
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, ot.textToSpeechUrl(), bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", ot.GetKey()))
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := ot.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("openai returned %d: %s", resp.StatusCode, string(body))
+	}
+
+	return internal_transformer.ReadPCM(resp.Body, 4800, func(chunk []byte) error {
+		audio, err := ot.textToSpeechAudio(chunk)
+		if err != nil {
+			return err
+		}
+		_ = ot.options.OnSpeech(contextId, audio)
+		return nil
+	})
+}
+
+func (ot *openaiTextToSpeech) Close(ctx context.Context) error {
+	ot.ctxCancel()
+	return nil
 }
@@ -15,16 +15,25 @@ import (
 )
 
 const (
-	RESEMBLE_URL = "f.cluster.resemble.ai/stream"
+	RESEMBLE_URL = "wss://websocket.cluster.resemble.ai/stream"
 	VOICE_ID     = "1dcf0222"
 )
 
+type TextToSpeechOutput struct {
+	Type         string `json:"type"`
+	RequestID    int    `json:"request_id"`
+	AudioContent string `json:"audio_content"`
+	Message      string `json:"message"`
+	StatusCode   int    `json:"status_code"`
+}
+
 type resembleOption struct {
 	logger      commons.Logger
 	audioConfig *protos.AudioConfig
 	modelOpts   utils.Option
 	key         string
 	projectId   string
+	url         string
 }
 
 func NewResembleOption(logger commons.Logger,
@@ -47,6 +56,7 @@ func NewResembleOption(logger commons.Logger,
 		modelOpts:   option,
 		key:         cx.(string),
 		projectId:   prj.(string),
+		url:         RESEMBLE_URL,
 	}, nil
 }
 
@@ -69,15 +79,25 @@ func (ro *resembleOption) GetEncoding() string {
 	}
 }
 
-func (ro *resembleOption) GetTextToSpeechRequest(contextId, text string) map[string]interface{} {
+func (ro *resembleOption) GetVoice() string {
+	if voice, err := ro.modelOpts.GetString("speak.voice.id"); err == nil {
+		return voice
+	}
+	return VOICE_ID
+}
+
+// resemble identifies requests on a connection by an integer, audio is
+// requested as json base64 chunks without wav header so it can be streamed as is
+func (ro *resembleOption) GetTextToSpeechRequest(requestId int, text string) map[string]interface{} {
 	return map[string]interface{}{
-		"voice_uuid":      VOICE_ID,
-		"request_id":      contextId,
+		"voice_uuid":      ro.GetVoice(),
+		"request_id":      requestId,
 		"project_uuid":    ro.GetProject(),
 		"data":            text,
-		"binary_response": true,
+		"binary_response": false,
+		"no_audio_header": true,
+		"output_format":   "wav",
 		"precision":       ro.GetEncoding(),
 		"sample_rate":     ro.audioConfig.GetSampleRate(),
 	}
-
 }
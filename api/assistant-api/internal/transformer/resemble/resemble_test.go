@@ -0,0 +1,139 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+
+package internal_transformer_resemble
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	internal_audio "github.com/rapidaai/api/assistant-api/internal/audio"
+	internal_transformer "github.com/rapidaai/api/assistant-api/internal/transformer"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/utils"
+	"github.com/rapidaai/protos"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func testLogger(t *testing.T) commons.Logger {
+	logger := commons.NewApplicationLoggerWithOptions(commons.EnableFile(false), commons.Level("error"))
+	if err := logger.InitLogger(); err != nil {
+		t.Fatal(err)
+	}
+	return logger
+}
+
+func testCredential(t *testing.T) *protos.VaultCredential {
+	value, err := structpb.NewStruct(map[string]interface{}{"key": "test-key", "project_id": "project"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &protos.VaultCredential{Value: value}
+}
+
+func TestResembleTextToSpeech(t *testing.T) {
+	requests := make(chan map[string]interface{}, 4)
+	upgrader := websocket.Upgrader{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer test-key" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		for {
+			var request map[string]interface{}
+			if err := conn.ReadJSON(&request); err != nil {
+				return
+			}
+			requests <- request
+			// every request streams its text back as audio in two chunks
+			text := request["data"].(string)
+			for _, part := range []string{text[:len(text)/2], text[len(text)/2:]} {
+				conn.WriteJSON(map[string]interface{}{
+					"type":          "audio",
+					"request_id":    request["request_id"],
+					"audio_content": base64.StdEncoding.EncodeToString([]byte(part)),
+				})
+			}
+			conn.WriteJSON(map[string]interface{}{"type": "audio_end", "request_id": request["request_id"]})
+		}
+	}))
+	defer server.Close()
+
+	var mu sync.Mutex
+	var audio bytes.Buffer
+	completed := make(chan string, 2)
+	tts, err := NewResembleTextToSpeech(context.Background(), testLogger(t), testCredential(t),
+		&internal_transformer.TextToSpeechInitializeOptions{
+			AudioConfig:  internal_audio.NewMulaw8khzMonoAudioConfig(),
+			ModelOptions: utils.Option{"speak.voice.id": "voice"},
+			OnSpeech: func(contextId string, chunk []byte) error {
+				mu.Lock()
+				defer mu.Unlock()
+				if contextId != "ctx-1" {
+					t.Errorf("unexpected audio for %s", contextId)
+				}
+				audio.Write(chunk)
+				return nil
+			},
+			OnComplete: func(contextId string) error {
+				completed <- contextId
+				return nil
+			},
+		})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tts.(*resembleTTS).url = "ws" + strings.TrimPrefix(server.URL, "http")
+	if err := tts.Initialize(); err != nil {
+		t.Fatal(err)
+	}
+	defer tts.Close(context.Background())
+
+	tts.Transform(context.Background(), "hello there. ", &internal_transformer.TextToSpeechOption{ContextId: "ctx-1"})
+	tts.Transform(context.Background(), "how are you?", &internal_transformer.TextToSpeechOption{ContextId: "ctx-1"})
+	tts.Transform(context.Background(), "", &internal_transformer.TextToSpeechOption{ContextId: "ctx-1", IsComplete: true})
+
+	select {
+	case contextId := <-completed:
+		if contextId != "ctx-1" {
+			t.Errorf("expected completion of ctx-1, got %s", contextId)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for completion")
+	}
+
+	first, second := <-requests, <-requests
+	if first["request_id"] != float64(1) || second["request_id"] != float64(2) {
+		t.Errorf("expected sequential request ids, got %v and %v", first["request_id"], second["request_id"])
+	}
+	if first["voice_uuid"] != "voice" || first["precision"] != "MULAW" || first["no_audio_header"] != true {
+		t.Errorf("unexpected request %v", first)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if audio.String() != "hello there. how are you?" {
+		t.Errorf("unexpected audio %q", audio.String())
+	}
+	select {
+	case contextId := <-completed:
+		t.Errorf("context %s completed twice", contextId)
+	default:
+	}
+}
@@ -11,7 +11,6 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
-	"log"
 	"sync"
 
 	"github.com/gorilla/websocket"
@@ -23,9 +22,17 @@ import (
 type resembleTTS struct {
 	*resembleOption
 	ctx       context.Context
+	ctxCancel context.CancelFunc
 	mu        sync.Mutex
 	contextId string
 
+	// every sentence is a separate resemble request, the context is complete
+	// once all of its requests have finished streaming
+	nextRequestId int
+	requests      map[int]string
+	pending       map[string]int
+	completing    map[string]bool
+
 	logger     commons.Logger
 	connection *websocket.Conn
 	options    *internal_transformer.TextToSpeechInitializeOptions
@@ -46,11 +53,16 @@ func NewResembleTextToSpeech(
 		logger.Errorf("resemble-tts: intializing resembleai failed %+v", err)
 		return nil, err
 	}
+	ct, cancel := context.WithCancel(ctx)
 	return &resembleTTS{
 		resembleOption: rsmblOpts,
-		ctx:            ctx,
+		ctx:            ct,
+		ctxCancel:      cancel,
 		logger:         logger,
 		options:        options,
+		requests:       make(map[int]string),
+		pending:        make(map[string]int),
+		completing:     make(map[string]bool),
 	}, nil
 }
 
@@ -62,13 +74,14 @@ func (rt *resembleTTS) Initialize() error {
 	headers := map[string][]string{
 		"Authorization": {"Bearer " + rt.GetKey()},
 	}
-	conn, _, err := websocket.DefaultDialer.Dial("wss://websocket.cluster.resemble.ai/stream", headers)
+	conn, _, err := websocket.DefaultDialer.Dial(rt.url, headers)
 	if err != nil {
 		rt.logger.Errorf("resemble-tts: unable to connect to websocket err: %v", err)
 		return err
 	}
 	rt.connection = conn
-	go rt.textToSpeechCallback(rt.ctx)
+	go rt.textToSpeechCallback(conn, rt.ctx)
+	rt.logger.Debugf("resemble-tts: connection established")
 	return nil
 }
 
@@ -77,63 +90,121 @@ func (*resembleTTS) Name() string {
 	return "resemble-text-to-speech"
 }
 
-func (rt *resembleTTS) textToSpeechCallback(ctx context.Context) {
-
+func (rt *resembleTTS) textToSpeechCallback(conn *websocket.Conn, ctx context.Context) {
 	for {
 		select {
 		case <-ctx.Done():
-			rt.logger.Infof("sarvam-tts: context cancelled, stopping response listener")
+			rt.logger.Infof("resemble-tts: context cancelled, stopping response listener")
 			return
 		default:
-			_, audioChunk, err := rt.connection.ReadMessage()
+			_, msg, err := conn.ReadMessage()
 			if err != nil {
-				rt.logger.Errorf("resemble-tts: error reading from Resemble WebSocket: %v", err)
-				continue
+				rt.logger.Debugf("resemble-tts: stopped reading from resemble websocket: %v", err)
+				return
 			}
-
-			var audioData map[string]interface{}
-			if err := json.Unmarshal(audioChunk, &audioData); err != nil {
+			var payload TextToSpeechOutput
+			if err := json.Unmarshal(msg, &payload); err != nil {
 				rt.logger.Errorf("resemble-tts: error parsing audio chunk: %v", err)
 				continue
 			}
-			if audioData["type"] == "audio_end" {
-				break
-			}
-			if audioData["type"] == "audio" {
-				payload, ok := audioData["audio_content"].(string)
+			switch payload.Type {
+			case "audio":
+				contextId, ok := rt.activeContext(payload.RequestID)
 				if !ok {
 					continue
 				}
-				rawAudioData, err := base64.StdEncoding.DecodeString(payload)
+				rawAudioData, err := base64.StdEncoding.DecodeString(payload.AudioContent)
 				if err != nil {
-					log.Fatalf("Error decoding base64 string: %v", err)
+					rt.logger.Errorf("resemble-tts: failed to decode audio payload error: %v", err)
+					continue
 				}
-				rt.options.OnSpeech(rt.contextId, rawAudioData)
+				_ = rt.options.OnSpeech(contextId, rawAudioData)
+			case "error":
+				rt.logger.Errorf("resemble-tts: request %d failed with %d: %s", payload.RequestID, payload.StatusCode, payload.Message)
+				rt.finishRequest(payload.RequestID)
+			case "audio_end":
+				rt.finishRequest(payload.RequestID)
 			}
 		}
 	}
-
 }
 
-func (rt *resembleTTS) Transform(ctx context.Context, in string, opts *internal_transformer.TextToSpeechOption) error {
+// activeContext resolves the context of a request, false when the user has
+// interrupted and the context is no longer being spoken
+func (rt *resembleTTS) activeContext(requestId int) (string, bool) {
 	rt.mu.Lock()
 	defer rt.mu.Unlock()
+	contextId, ok := rt.requests[requestId]
+	return contextId, ok && contextId == rt.contextId
+}
 
+func (rt *resembleTTS) finishRequest(requestId int) {
+	rt.mu.Lock()
+	contextId, ok := rt.requests[requestId]
+	if !ok {
+		rt.mu.Unlock()
+		return
+	}
+	delete(rt.requests, requestId)
+	rt.pending[contextId]--
+	complete := rt.pending[contextId] <= 0 && rt.completing[contextId]
+	if rt.pending[contextId] <= 0 {
+		delete(rt.pending, contextId)
+		delete(rt.completing, contextId)
+	}
+	active := contextId == rt.contextId
+	rt.mu.Unlock()
+
+	if complete && active {
+		_ = rt.options.OnComplete(contextId)
+	}
+}
+
+func (rt *resembleTTS) Transform(ctx context.Context, in string, opts *internal_transformer.TextToSpeechOption) error {
+	rt.mu.Lock()
 	if rt.connection == nil {
+		rt.mu.Unlock()
 		return fmt.Errorf("resemble-tts: connection is not initialized")
 	}
-
 	rt.contextId = opts.ContextId
-	if err := rt.connection.WriteJSON(rt.GetTextToSpeechRequest(opts.ContextId, in)); err != nil {
-		rt.logger.Errorf("resemble-tts: error while writing request to websocket %v", err)
-		return err
+
+	if in != "" {
+		rt.nextRequestId++
+		rt.requests[rt.nextRequestId] = opts.ContextId
+		rt.pending[opts.ContextId]++
+		if err := rt.connection.WriteJSON(rt.GetTextToSpeechRequest(rt.nextRequestId, in)); err != nil {
+			delete(rt.requests, rt.nextRequestId)
+			rt.pending[opts.ContextId]--
+			rt.mu.Unlock()
+			rt.logger.Errorf("resemble-tts: error while writing request to websocket %v", err)
+			return err
+		}
+	}
+
+	complete := false
+	if opts.IsComplete {
+		if rt.pending[opts.ContextId] > 0 {
+			rt.completing[opts.ContextId] = true
+		} else {
+			delete(rt.pending, opts.ContextId)
+			complete = true
+		}
+	}
+	rt.mu.Unlock()
+
+	if complete {
+		_ = rt.options.OnComplete(opts.ContextId)
 	}
 	return nil
 }
 
 func (rt *resembleTTS) Close(ctx context.Context) error {
+	rt.ctxCancel()
+	rt.mu.Lock()
+	defer rt.mu.Unlock()
 	if rt.connection != nil {
 		rt.connection.Close()
+		rt.connection = nil
 	}
 	return nil
 }
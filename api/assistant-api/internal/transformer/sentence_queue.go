@@ -0,0 +1,154 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+
+package internal_transformer
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/rapidaai/pkg/commons"
+)
+
+// Synthesize requests the speech of a single sentence and delivers its audio for the context.
+type Synthesize func(ctx context.Context, contextId, text string) error
+
+type sentence struct {
+	contextId  string
+	text       string
+	isComplete bool
+}
+
+// SentenceQueue is shared by text to speech transformers which synthesize every sentence with
+// a request of their own, sentences are queued so Push never blocks the caller and audio of a
+// context is delivered in order. Sentences of an interrupted context are dropped and its
+// request in flight is cancelled.
+type SentenceQueue struct {
+	mu         sync.Mutex
+	ctx        context.Context
+	logger     commons.Logger
+	name       string
+	synthesize Synthesize
+	onComplete func(contextId string) error
+
+	sentences chan *sentence
+	// context currently being spoken, sentences of older contexts are dropped
+	contextId     string
+	requestCancel context.CancelFunc
+}
+
+// NewSentenceQueue creates the queue of transformer, ctx is the context of transformer and
+// name prefixes the logs.
+func NewSentenceQueue(
+	ctx context.Context,
+	logger commons.Logger,
+	name string,
+	onComplete func(contextId string) error,
+	synthesize Synthesize,
+) *SentenceQueue {
+	return &SentenceQueue{
+		ctx:        ctx,
+		logger:     logger,
+		name:       name,
+		synthesize: synthesize,
+		onComplete: onComplete,
+		sentences:  make(chan *sentence, 64),
+	}
+}
+
+// Start synthesizes the queued sentences in background until the context of transformer is done.
+func (q *SentenceQueue) Start() {
+	go q.run()
+}
+
+// Push queues the text of the context, a new context interrupts the one being spoken.
+func (q *SentenceQueue) Push(in string, opts *TextToSpeechOption) error {
+	q.mu.Lock()
+	if q.contextId != opts.ContextId {
+		// user interrupted, stop whatever is being synthesized for previous context
+		if q.requestCancel != nil {
+			q.requestCancel()
+			q.requestCancel = nil
+		}
+		q.contextId = opts.ContextId
+	}
+	q.mu.Unlock()
+
+	select {
+	case <-q.ctx.Done():
+		return fmt.Errorf("%s: transformer is closed", q.name)
+	case q.sentences <- &sentence{
+		contextId:  opts.ContextId,
+		text:       in,
+		isComplete: opts.IsComplete,
+	}:
+	}
+	return nil
+}
+
+func (q *SentenceQueue) run() {
+	for {
+		select {
+		case <-q.ctx.Done():
+			q.logger.Infof("%s: context cancelled, stopping synthesis", q.name)
+			return
+		case s := <-q.sentences:
+			reqCtx, ok := q.requestContext(s.contextId)
+			if !ok {
+				continue
+			}
+			if s.text != "" {
+				if err := q.synthesize(reqCtx, s.contextId, s.text); err != nil && reqCtx.Err() == nil {
+					q.logger.Errorf("%s: unable to synthesize speech %v", q.name, err)
+				}
+			}
+			if s.isComplete && reqCtx.Err() == nil {
+				_ = q.onComplete(s.contextId)
+			}
+		}
+	}
+}
+
+// requestContext returns a cancellable context for the sentence, false when the
+// sentence belongs to a context which is no longer being spoken.
+func (q *SentenceQueue) requestContext(contextId string) (context.Context, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if contextId != q.contextId {
+		return nil, false
+	}
+	reqCtx, cancel := context.WithCancel(q.ctx)
+	q.requestCancel = cancel
+	return reqCtx, true
+}
+
+// ReadPCM reads the pcm16 audio stream in chunks of up to size bytes until EOF, samples split
+// across reads are carried to the next chunk so every chunk holds whole samples.
+func ReadPCM(r io.Reader, size int, onChunk func(chunk []byte) error) error {
+	buffer := make([]byte, size)
+	var leftover []byte
+	for {
+		n, err := r.Read(buffer)
+		if n > 0 {
+			chunk := append(leftover, buffer[:n]...)
+			even := len(chunk) &^ 1
+			leftover = append([]byte(nil), chunk[even:]...)
+			if even > 0 {
+				if cErr := onChunk(chunk[:even]); cErr != nil {
+					return cErr
+				}
+			}
+		}
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,86 @@
+package internal_transformer
+
+import (
+	"bytes"
+	"context"
+	"sync"
+	"testing"
+	"testing/iotest"
+	"time"
+
+	"github.com/rapidaai/pkg/commons"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadPCM(t *testing.T) {
+	audio := []byte{1, 2, 3, 4, 5, 6, 7}
+	var chunks [][]byte
+	// reads of 3 bytes split the samples, the odd byte is carried to the next chunk
+	err := ReadPCM(iotest.OneByteReader(bytes.NewReader(audio)), 3, func(chunk []byte) error {
+		chunks = append(chunks, append([]byte(nil), chunk...))
+		return nil
+	})
+	require.NoError(t, err)
+	for _, chunk := range chunks {
+		assert.Zero(t, len(chunk)%2)
+	}
+	assert.Equal(t, []byte{1, 2, 3, 4, 5, 6}, bytes.Join(chunks, nil))
+
+	chunks = nil
+	err = ReadPCM(bytes.NewReader(audio), 3, func(chunk []byte) error {
+		chunks = append(chunks, append([]byte(nil), chunk...))
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, [][]byte{{1, 2}, {3, 4, 5, 6}}, chunks)
+}
+
+func TestSentenceQueue_Interrupt(t *testing.T) {
+	logger := commons.NewApplicationLoggerWithOptions(commons.EnableFile(false), commons.Level("error"))
+	logger.InitLogger()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var mu sync.Mutex
+	var spoken, completed []string
+	started := make(chan struct{}, 1)
+	queue := NewSentenceQueue(ctx, logger, "test-tts",
+		func(contextId string) error {
+			mu.Lock()
+			defer mu.Unlock()
+			completed = append(completed, contextId)
+			return nil
+		},
+		func(ctx context.Context, contextId, text string) error {
+			if text == "slow" {
+				// blocks until the context is interrupted
+				started <- struct{}{}
+				<-ctx.Done()
+				return ctx.Err()
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			spoken = append(spoken, contextId+":"+text)
+			return nil
+		})
+	queue.Start()
+
+	require.NoError(t, queue.Push("slow", &TextToSpeechOption{ContextId: "a"}))
+	<-started
+	require.NoError(t, queue.Push("dropped", &TextToSpeechOption{ContextId: "a", IsComplete: true}))
+	require.NoError(t, queue.Push("hello", &TextToSpeechOption{ContextId: "b"}))
+	require.NoError(t, queue.Push("world", &TextToSpeechOption{ContextId: "b", IsComplete: true}))
+
+	assert.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return len(completed) == 1
+	}, time.Second, 10*time.Millisecond)
+
+	mu.Lock()
+	defer mu.Unlock()
+	// interrupted context is neither spoken nor completed
+	assert.Equal(t, []string{"b:hello", "b:world"}, spoken)
+	assert.Equal(t, []string{"b"}, completed)
+}
@@ -0,0 +1,126 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+
+package internal_transformer_speechmatics
+
+import (
+	"fmt"
+
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/utils"
+	"github.com/rapidaai/protos"
+)
+
+const (
+	REALTIME_URL    = "wss://eu2.rt.speechmatics.com/v2"
+	STT_LANGUAGE    = "en"
+	OPERATING_POINT = "enhanced"
+	MAX_DELAY       = 1.0
+)
+
+type RecognitionResultAlternative struct {
+	Content    string  `json:"content"`
+	Confidence float64 `json:"confidence"`
+	Language   string  `json:"language"`
+}
+
+type RecognitionResult struct {
+	Type         string                         `json:"type"`
+	StartTime    float64                        `json:"start_time"`
+	EndTime      float64                        `json:"end_time"`
+	Alternatives []RecognitionResultAlternative `json:"alternatives"`
+}
+
+type RealtimeMessage struct {
+	Message  string `json:"message"`
+	SeqNo    int    `json:"seq_no"`
+	Type     string `json:"type"`
+	Reason   string `json:"reason"`
+	Metadata struct {
+		Transcript string  `json:"transcript"`
+		StartTime  float64 `json:"start_time"`
+		EndTime    float64 `json:"end_time"`
+	} `json:"metadata"`
+	Results []RecognitionResult `json:"results"`
+}
+
+type speechmaticsOption struct {
+	logger      commons.Logger
+	key         string
+	realtimeUrl string
+	mdlOpts     utils.Option
+	audioConfig *protos.AudioConfig
+}
+
+func NewSpeechmaticsOption(
+	logger commons.Logger,
+	vaultCredential *protos.VaultCredential,
+	audioConfig *protos.AudioConfig,
+	opts utils.Option) (*speechmaticsOption, error) {
+	cx, ok := vaultCredential.GetValue().AsMap()["key"]
+	if !ok {
+		return nil, fmt.Errorf("speechmatics: illegal vault config")
+	}
+	return &speechmaticsOption{
+		logger:      logger,
+		key:         cx.(string),
+		realtimeUrl: REALTIME_URL,
+		mdlOpts:     opts,
+		audioConfig: audioConfig,
+	}, nil
+}
+
+func (so *speechmaticsOption) GetKey() string {
+	return so.key
+}
+
+// speechmatics accepts raw audio at any sample rate, audio is sent as it is received
+func (so *speechmaticsOption) GetEncoding() string {
+	switch so.audioConfig.GetAudioFormat() {
+	case protos.AudioConfig_MuLaw8:
+		return "mulaw"
+	default:
+		return "pcm_s16le"
+	}
+}
+
+func (so *speechmaticsOption) GetLanguage() string {
+	if language, err := so.mdlOpts.GetString("listen.language"); err == nil {
+		return language
+	}
+	return STT_LANGUAGE
+}
+
+func (so *speechmaticsOption) StartRecognition() map[string]interface{} {
+	transcriptionConfig := map[string]interface{}{
+		"language":        so.GetLanguage(),
+		"enable_partials": true,
+		"max_delay":       MAX_DELAY,
+		"operating_point": OPERATING_POINT,
+	}
+	if operatingPoint, err := so.mdlOpts.GetString("listen.model"); err == nil {
+		transcriptionConfig["operating_point"] = operatingPoint
+	}
+	if maxDelay, err := so.mdlOpts.GetFloat64("listen.max_delay"); err == nil {
+		transcriptionConfig["max_delay"] = maxDelay
+	}
+	return map[string]interface{}{
+		"message": "StartRecognition",
+		"audio_format": map[string]interface{}{
+			"type":        "raw",
+			"encoding":    so.GetEncoding(),
+			"sample_rate": so.audioConfig.GetSampleRate(),
+		},
+		"transcription_config": transcriptionConfig,
+	}
+}
+
+func (so *speechmaticsOption) EndOfStream(lastSeqNo int) map[string]interface{} {
+	return map[string]interface{}{
+		"message":     "EndOfStream",
+		"last_seq_no": lastSeqNo,
+	}
+}
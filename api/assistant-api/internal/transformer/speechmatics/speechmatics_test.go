@@ -0,0 +1,165 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+
+package internal_transformer_speechmatics
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	internal_audio "github.com/rapidaai/api/assistant-api/internal/audio"
+	internal_transformer "github.com/rapidaai/api/assistant-api/internal/transformer"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/utils"
+	"github.com/rapidaai/protos"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func testLogger(t *testing.T) commons.Logger {
+	logger := commons.NewApplicationLoggerWithOptions(commons.EnableFile(false), commons.Level("error"))
+	if err := logger.InitLogger(); err != nil {
+		t.Fatal(err)
+	}
+	return logger
+}
+
+func testCredential(t *testing.T) *protos.VaultCredential {
+	value, err := structpb.NewStruct(map[string]interface{}{"key": "test-key"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &protos.VaultCredential{Value: value}
+}
+
+func transcriptMessage(message, transcript string, confidence float64) map[string]interface{} {
+	return map[string]interface{}{
+		"message":  message,
+		"metadata": map[string]interface{}{"transcript": transcript},
+		"results": []map[string]interface{}{{
+			"type":         "word",
+			"alternatives": []map[string]interface{}{{"content": transcript, "confidence": confidence, "language": "de"}},
+		}},
+	}
+}
+
+func TestSpeechmaticsSpeechToText(t *testing.T) {
+	var (
+		mu         sync.Mutex
+		start      map[string]interface{}
+		audioBytes int
+		endOfAudio = make(chan string, 1)
+	)
+	upgrader := websocket.Upgrader{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer test-key" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		var msg map[string]interface{}
+		if err := conn.ReadJSON(&msg); err != nil {
+			return
+		}
+		mu.Lock()
+		start = msg
+		mu.Unlock()
+		conn.WriteJSON(map[string]interface{}{"message": "RecognitionStarted", "id": "session"})
+		for {
+			kind, raw, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+			if kind == websocket.BinaryMessage {
+				mu.Lock()
+				audioBytes += len(raw)
+				mu.Unlock()
+				conn.WriteJSON(transcriptMessage("AddPartialTranscript", "guten", 0.5))
+				conn.WriteJSON(transcriptMessage("AddTranscript", "guten tag", 0.8))
+				continue
+			}
+			endOfAudio <- string(raw)
+			return
+		}
+	}))
+	defer server.Close()
+
+	type result struct {
+		text       string
+		confidence float64
+		language   string
+		final      bool
+	}
+	results := make(chan result, 4)
+	stt, err := NewSpeechmaticsSpeechToText(context.Background(), testLogger(t), testCredential(t),
+		&internal_transformer.SpeechToTextInitializeOptions{
+			AudioConfig:  internal_audio.NewMulaw8khzMonoAudioConfig(),
+			ModelOptions: utils.Option{"listen.language": "de"},
+			OnTranscript: func(text string, confidence float64, language string, final bool) error {
+				results <- result{text, confidence, language, final}
+				return nil
+			},
+		})
+	if err != nil {
+		t.Fatal(err)
+	}
+	stt.(*speechmaticsSpeechToText).realtimeUrl = "ws" + strings.TrimPrefix(server.URL, "http")
+	if err := stt.Initialize(); err != nil {
+		t.Fatal(err)
+	}
+
+	mu.Lock()
+	audioFormat, _ := start["audio_format"].(map[string]interface{})
+	transcriptionConfig, _ := start["transcription_config"].(map[string]interface{})
+	mu.Unlock()
+	if audioFormat["encoding"] != "mulaw" || audioFormat["sample_rate"] != float64(8000) {
+		t.Errorf("unexpected audio format %v", audioFormat)
+	}
+	if transcriptionConfig["language"] != "de" || transcriptionConfig["enable_partials"] != true {
+		t.Errorf("unexpected transcription config %v", transcriptionConfig)
+	}
+
+	if err := stt.Transform(context.Background(), make([]byte, 160), &internal_transformer.SpeechToTextOption{}); err != nil {
+		t.Fatal(err)
+	}
+	expected := []result{{"guten", 0.5, "de", false}, {"guten tag", 0.8, "de", true}}
+	for _, want := range expected {
+		select {
+		case got := <-results:
+			if got != want {
+				t.Errorf("expected %v, got %v", want, got)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for transcript")
+		}
+	}
+
+	if err := stt.Close(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case raw := <-endOfAudio:
+		if !strings.Contains(raw, `"EndOfStream"`) || !strings.Contains(raw, `"last_seq_no":1`) {
+			t.Errorf("unexpected end of stream %s", raw)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for end of stream")
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if audioBytes != 160 {
+		t.Errorf("expected 160 bytes of audio, got %d", audioBytes)
+	}
+}
@@ -8,16 +8,192 @@ package internal_transformer_speechmatics
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
 
+	"github.com/gorilla/websocket"
 	internal_transformer "github.com/rapidaai/api/assistant-api/internal/transformer"
 	"github.com/rapidaai/pkg/commons"
 	"github.com/rapidaai/protos"
 )
 
+const recognitionStartTimeout = 10 * time.Second
+
+type speechmaticsSpeechToText struct {
+	*speechmaticsOption
+	mu                 sync.Mutex
+	logger             commons.Logger
+	ctx                context.Context
+	ctxCancel          context.CancelFunc
+	connection         *websocket.Conn
+	transformerOptions *internal_transformer.SpeechToTextInitializeOptions
+
+	// number of audio chunks sent, required to end the stream
+	seqNo int
+}
+
 func NewSpeechmaticsSpeechToText(
 	ctx context.Context,
 	logger commons.Logger,
 	credential *protos.VaultCredential,
-	opts internal_transformer.SpeechToTextInitializeOptions) (internal_transformer.SpeechToTextTransformer, error) {
-	return nil, nil
+	opts *internal_transformer.SpeechToTextInitializeOptions) (internal_transformer.SpeechToTextTransformer, error) {
+	speechmaticsOpts, err := NewSpeechmaticsOption(logger,
+		credential,
+		opts.AudioConfig,
+		opts.ModelOptions)
+	if err != nil {
+		logger.Errorf("speechmatics-stt: intializing speechmatics failed %+v", err)
+		return nil, err
+	}
+	ct, cancel := context.WithCancel(ctx)
+	return &speechmaticsSpeechToText{
+		speechmaticsOption: speechmaticsOpts,
+		logger:             logger,
+		ctx:                ct,
+		ctxCancel:          cancel,
+		transformerOptions: opts,
+	}, nil
+}
+
+// Name implements internal_transformer.SpeechToTextTransformer.
+func (*speechmaticsSpeechToText) Name() string {
+	return "speechmatics-speech-to-text"
+}
+
+func (sst *speechmaticsSpeechToText) Initialize() error {
+	sst.mu.Lock()
+	defer sst.mu.Unlock()
+
+	header := http.Header{}
+	header.Set("Authorization", fmt.Sprintf("Bearer %s", sst.GetKey()))
+	conn, _, err := websocket.DefaultDialer.DialContext(sst.ctx, sst.realtimeUrl, header)
+	if err != nil {
+		sst.logger.Errorf("speechmatics-stt: failed to connect to speechmatics %v", err)
+		return err
+	}
+	if err := conn.WriteJSON(sst.StartRecognition()); err != nil {
+		conn.Close()
+		sst.logger.Errorf("speechmatics-stt: failed to start recognition %v", err)
+		return err
+	}
+
+	// audio is rejected until recognition has started
+	conn.SetReadDeadline(time.Now().Add(recognitionStartTimeout))
+	for {
+		var msg RealtimeMessage
+		if err := conn.ReadJSON(&msg); err != nil {
+			conn.Close()
+			sst.logger.Errorf("speechmatics-stt: failed to start recognition %v", err)
+			return err
+		}
+		if msg.Message == "Error" {
+			conn.Close()
+			return fmt.Errorf("speechmatics-stt: %s %s", msg.Type, msg.Reason)
+		}
+		if msg.Message == "RecognitionStarted" {
+			break
+		}
+	}
+	conn.SetReadDeadline(time.Time{})
+
+	sst.connection = conn
+	sst.seqNo = 0
+	go sst.speechToTextCallback(conn, sst.ctx)
+	sst.logger.Debugf("speechmatics-stt: connection established")
+	return nil
+}
+
+func (sst *speechmaticsSpeechToText) speechToTextCallback(conn *websocket.Conn, ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			sst.logger.Infof("speechmatics-stt: context cancelled, stopping response listener")
+			return
+		default:
+			_, raw, err := conn.ReadMessage()
+			if err != nil {
+				sst.logger.Debugf("speechmatics-stt: stopped reading from speechmatics %v", err)
+				return
+			}
+			var msg RealtimeMessage
+			if err := json.Unmarshal(raw, &msg); err != nil {
+				sst.logger.Errorf("speechmatics-stt: invalid json from speechmatics error : %v", err)
+				continue
+			}
+			switch msg.Message {
+			case "AddPartialTranscript":
+				sst.onTranscript(&msg, false)
+			case "AddTranscript":
+				sst.onTranscript(&msg, true)
+			case "Warning":
+				sst.logger.Warnf("speechmatics-stt: %s %s", msg.Type, msg.Reason)
+			case "Error":
+				sst.logger.Errorf("speechmatics-stt: %s %s", msg.Type, msg.Reason)
+			case "EndOfTranscript":
+				return
+			}
+		}
+	}
+}
+
+func (sst *speechmaticsSpeechToText) onTranscript(msg *RealtimeMessage, isFinal bool) {
+	transcript := strings.TrimSpace(msg.Metadata.Transcript)
+	if transcript == "" || sst.transformerOptions.OnTranscript == nil {
+		return
+	}
+	language := sst.GetLanguage()
+	confidence := 0.9
+	var total float64
+	var scored int
+	for _, result := range msg.Results {
+		if len(result.Alternatives) == 0 {
+			continue
+		}
+		if result.Alternatives[0].Language != "" {
+			language = result.Alternatives[0].Language
+		}
+		if result.Type == "word" {
+			total += result.Alternatives[0].Confidence
+			scored++
+		}
+	}
+	if scored > 0 {
+		confidence = total / float64(scored)
+	}
+	sst.transformerOptions.OnTranscript(transcript, confidence, language, isFinal)
+}
+
+func (sst *speechmaticsSpeechToText) Transform(ctx context.Context, in []byte, opts *internal_transformer.SpeechToTextOption) error {
+	sst.mu.Lock()
+	defer sst.mu.Unlock()
+
+	if sst.connection == nil {
+		return fmt.Errorf("speechmatics-stt: websocket connection is not initialized")
+	}
+	if err := sst.connection.WriteMessage(websocket.BinaryMessage, in); err != nil {
+		return fmt.Errorf("failed to send audio data: %w", err)
+	}
+	sst.seqNo++
+	return nil
+}
+
+func (sst *speechmaticsSpeechToText) Close(ctx context.Context) error {
+	sst.mu.Lock()
+	defer sst.mu.Unlock()
+	sst.ctxCancel()
+	if sst.connection != nil {
+		if err := sst.connection.WriteJSON(sst.EndOfStream(sst.seqNo)); err != nil {
+			sst.logger.Debugf("speechmatics-stt: unable to send end of stream %v", err)
+		}
+		if err := sst.connection.Close(); err != nil {
+			return fmt.Errorf("error closing WebSocket connection: %w", err)
+		}
+		sst.connection = nil
+		sst.logger.Info("speechmatics-stt: speechmatics websocket connection closed")
+	}
+	return nil
 }
@@ -1,23 +0,0 @@
-// Copyright (c) 2023-2025 RapidaAI
-// Author: Prashant Srivastav <prashant@rapida.ai>
-//
-// Licensed under GPL-2.0 with Rapida Additional Terms.
-// See LICENSE.md or contact sales@rapida.ai for commercial usage.
-
-package internal_transformer_speechmatics
-
-import (
-	"context"
-
-	internal_transformer "github.com/rapidaai/api/assistant-api/internal/transformer"
-	"github.com/rapidaai/pkg/commons"
-	"github.com/rapidaai/protos"
-)
-
-func NewSpeechmaticsTextToSpeech(
-	ctx context.Context,
-	logger commons.Logger,
-	credential *protos.VaultCredential,
-	onSpeech func([]byte) error) (internal_transformer.TextToSpeechTransformer, error) {
-	return nil, nil
-}
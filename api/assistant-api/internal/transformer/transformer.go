@@ -8,6 +8,7 @@ package internal_transformer
 
 import (
 	"context"
+	"strings"
 
 	"github.com/rapidaai/pkg/utils"
 	"github.com/rapidaai/protos"
@@ -119,6 +120,22 @@ type TextToSpeechInitializeOptions struct {
 
 	// options of model
 	ModelOptions utils.Option
+
+	// pronunciations of words for speakers which take them with the request
+	// instead of inline ssml
+	Pronunciations []*Pronunciation
+}
+
+// Pronunciation of a word as phoneme in the given alphabet (ipa or x-sampa)
+type Pronunciation struct {
+	Phrase   string
+	Phoneme  string
+	Alphabet string
+}
+
+// IsSSML tells if the text carries ssml tags written by the lexicon synthesizer
+func IsSSML(text string) bool {
+	return strings.Contains(text, "<phoneme ") || strings.Contains(text, "<sub ")
 }
 
 type TransformOption interface{}
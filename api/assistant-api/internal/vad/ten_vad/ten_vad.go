@@ -1,12 +1,155 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
 package internal_ten_vad
 
 import (
+	"encoding/binary"
+	"sync"
+
+	internal_audio "github.com/rapidaai/api/assistant-api/internal/audio"
 	internal_vad "github.com/rapidaai/api/assistant-api/internal/vad"
 	"github.com/rapidaai/pkg/commons"
 	"github.com/rapidaai/pkg/utils"
 	"github.com/rapidaai/protos"
 )
 
-func NewTenVad(logger commons.Logger, inputAudio *protos.AudioConfig, options utils.Option) (internal_vad.Vad, error) {
-	return nil, nil
+const (
+	// 16ms of 16khz audio, recommended hop size for ten vad
+	tenVadHopSize = 256
+	// silence required after speech before reporting end of speech
+	tenVadMinSilenceMs = 100
+)
+
+// TenVAD implements Vad using TEN VAD
+type TenVAD struct {
+	mu           sync.Mutex
+	logger       commons.Logger
+	inputConfig  *protos.AudioConfig
+	vadConfig    *protos.AudioConfig
+	detector     *TenVadNative
+	onActivity   internal_vad.VADCallback
+	audioSampler *internal_audio.AudioResampler
+
+	// samples waiting for a complete frame
+	pending []int16
+	// total samples processed, used for speech timestamps
+	processed int64
+
+	speaking         bool
+	speechStart      int64
+	speechEnd        int64
+	silenceFrames    int
+	minSilenceFrames int
+}
+
+// NewTenVad creates a new TenVAD
+func NewTenVad(logger commons.Logger,
+	inputAudio *protos.AudioConfig,
+	callback internal_vad.VADCallback, options utils.Option) (internal_vad.Vad, error) {
+	vadAudioConfig := internal_audio.NewLinear16khzMonoAudioConfig()
+	threshold := 0.5
+	if thr, err := options.GetFloat64("microphone.vad.threshold"); err == nil {
+		threshold = thr
+	}
+	detector, err := NewTenVadNative(tenVadHopSize, float32(threshold))
+	if err != nil {
+		return nil, err
+	}
+	frameMs := tenVadHopSize * 1000 / int(vadAudioConfig.SampleRate)
+	return &TenVAD{
+		logger:           logger,
+		inputConfig:      inputAudio,
+		vadConfig:        vadAudioConfig,
+		detector:         detector,
+		onActivity:       callback,
+		audioSampler:     internal_audio.NewAudioResampler(),
+		minSilenceFrames: (tenVadMinSilenceMs + frameMs - 1) / frameMs,
+	}, nil
+}
+
+func (tv *TenVAD) Name() string {
+	return "ten_vad"
+}
+
+// Process buffers incoming audio into hop sized frames and reports
+// speech start and end through callback
+func (tv *TenVAD) Process(input []byte) error {
+	idi, err := tv.audioSampler.Resample(input, tv.inputConfig, tv.vadConfig)
+	if err != nil {
+		tv.logger.Debugf("Resampling failed: %+v", err)
+		return err
+	}
+
+	var activities []*internal_vad.VadResult
+	tv.mu.Lock()
+	for i := 0; i+1 < len(idi); i += 2 {
+		tv.pending = append(tv.pending, int16(binary.LittleEndian.Uint16(idi[i:])))
+	}
+	for len(tv.pending) >= tenVadHopSize {
+		frame := tv.pending[:tenVadHopSize]
+		_, voiced, err := tv.detector.ProcessFrame(frame)
+		tv.pending = tv.pending[tenVadHopSize:]
+		if err != nil {
+			tv.mu.Unlock()
+			return err
+		}
+		tv.processed += tenVadHopSize
+		if activity := tv.track(voiced); activity != nil {
+			activities = append(activities, activity)
+		}
+	}
+	// avoid holding on to the backing array forever
+	tv.pending = append([]int16(nil), tv.pending...)
+	tv.mu.Unlock()
+
+	for _, activity := range activities {
+		if err := tv.onActivity(activity); err != nil {
+			tv.logger.Debugf("vad callback failed %+v", err)
+		}
+	}
+	return nil
+}
+
+// track updates speech state with a frame decision, returns activity only on the
+// transitions so the same utterance is reported once when it starts and once when
+// it ends with the timestamps of the whole segment
+func (tv *TenVAD) track(voiced bool) *internal_vad.VadResult {
+	if voiced {
+		tv.silenceFrames = 0
+		tv.speechEnd = tv.processed
+		if !tv.speaking {
+			tv.speaking = true
+			tv.speechStart = tv.processed - tenVadHopSize
+			return &internal_vad.VadResult{
+				StartSec: tv.seconds(tv.speechStart),
+				EndSec:   tv.seconds(tv.speechEnd),
+			}
+		}
+		return nil
+	}
+	if !tv.speaking {
+		return nil
+	}
+	tv.silenceFrames++
+	if tv.silenceFrames < tv.minSilenceFrames {
+		return nil
+	}
+	tv.speaking = false
+	tv.silenceFrames = 0
+	return &internal_vad.VadResult{
+		StartSec: tv.seconds(tv.speechStart),
+		EndSec:   tv.seconds(tv.speechEnd),
+		Ended:    true,
+	}
+}
+
+func (tv *TenVAD) seconds(samples int64) float64 {
+	return float64(samples) / float64(tv.vadConfig.SampleRate)
+}
+
+func (tv *TenVAD) Close() error {
+	return tv.detector.Destroy()
 }
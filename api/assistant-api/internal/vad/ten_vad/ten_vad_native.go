@@ -0,0 +1,82 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_ten_vad
+
+/*
+#cgo CFLAGS: -I${SRCDIR}
+#cgo LDFLAGS: -L${SRCDIR}/models -lten_vad
+#include <ten_vad.h>
+#include <stdlib.h>
+*/
+import "C"
+
+import (
+	"fmt"
+	"sync"
+	"unsafe"
+)
+
+// TenVadNative wraps the TEN VAD C library, a frame is exactly hop size
+// samples of 16khz linear16 audio
+type TenVadNative struct {
+	mu      sync.Mutex
+	handle  C.ten_vad_handle_t
+	hopSize int
+}
+
+// NewTenVadNative creates a new TEN VAD instance
+func NewTenVadNative(hopSize int, threshold float32) (*TenVadNative, error) {
+	var handle C.ten_vad_handle_t
+	if C.ten_vad_create(&handle, C.size_t(hopSize), C.float(threshold)) != 0 || handle == nil {
+		return nil, fmt.Errorf("failed to create ten vad instance")
+	}
+	return &TenVadNative{
+		handle:  handle,
+		hopSize: hopSize,
+	}, nil
+}
+
+// ProcessFrame returns voice probability and detection flag for a single frame
+func (tv *TenVadNative) ProcessFrame(frame []int16) (float32, bool, error) {
+	if len(frame) != tv.hopSize {
+		return 0, false, fmt.Errorf("frame must be exactly %d samples, got %d", tv.hopSize, len(frame))
+	}
+
+	tv.mu.Lock()
+	defer tv.mu.Unlock()
+	if tv.handle == nil {
+		return 0, false, fmt.Errorf("ten vad is not initialized")
+	}
+
+	var probability C.float
+	var flag C.int
+	if C.ten_vad_process(
+		tv.handle,
+		(*C.int16_t)(unsafe.Pointer(&frame[0])),
+		C.size_t(len(frame)),
+		&probability,
+		&flag) != 0 {
+		return 0, false, fmt.Errorf("ten vad failed to process frame")
+	}
+	return float32(probability), flag == 1, nil
+}
+
+// Destroy releases the native instance
+func (tv *TenVadNative) Destroy() error {
+	tv.mu.Lock()
+	defer tv.mu.Unlock()
+	if tv.handle == nil {
+		return fmt.Errorf("double-free attempt")
+	}
+	C.ten_vad_destroy(&tv.handle)
+	tv.handle = nil
+	return nil
+}
+
+// Version returns version of linked ten vad library
+func Version() string {
+	return C.GoString(C.ten_vad_get_version())
+}
@@ -0,0 +1,40 @@
+package internal_ten_vad
+
+import (
+	"testing"
+
+	internal_audio "github.com/rapidaai/api/assistant-api/internal/audio"
+	internal_vad "github.com/rapidaai/api/assistant-api/internal/vad"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTenVAD_Track(t *testing.T) {
+	tv := &TenVAD{
+		vadConfig:        internal_audio.NewLinear16khzMonoAudioConfig(),
+		minSilenceFrames: 3,
+	}
+	frames := []bool{false, true, true, false, true, false, false, false, false, true}
+
+	var activities []*internal_vad.VadResult
+	for _, voiced := range frames {
+		tv.processed += tenVadHopSize
+		if activity := tv.track(voiced); activity != nil {
+			activities = append(activities, activity)
+		}
+	}
+
+	// speech starts, a short pause is kept within the segment, the segment ends after
+	// enough silence and the next speech starts a new segment
+	require.Len(t, activities, 3)
+	frame := float64(tenVadHopSize) / 16000
+	assert.False(t, activities[0].IsEnded())
+	assert.InDelta(t, 1*frame, activities[0].StartSec, 1e-9)
+
+	assert.True(t, activities[1].IsEnded())
+	assert.InDelta(t, 1*frame, activities[1].StartSec, 1e-9)
+	assert.InDelta(t, 5*frame, activities[1].EndSec, 1e-9)
+
+	assert.False(t, activities[2].IsEnded())
+	assert.InDelta(t, 9*frame, activities[2].StartSec, 1e-9)
+}
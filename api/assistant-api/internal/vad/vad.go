@@ -6,11 +6,14 @@ type VADCallback func(*VadResult) error
 type VadResult struct {
 	StartSec float64
 	EndSec   float64
+	// the speech segment has ended, otherwise speech is in progress
+	Ended bool
 }
 
 func (a *VadResult) GetSpeechStartAt() float64 { return a.StartSec }
 func (a *VadResult) GetSpeechEndAt() float64   { return a.EndSec }
 func (a *VadResult) GetDuration() float64      { return a.EndSec - a.StartSec }
+func (a *VadResult) IsEnded() bool             { return a.Ended }
 
 type Vad interface {
 	Name() string
@@ -0,0 +1,52 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_webhook
+
+import (
+	"math/rand/v2"
+	"slices"
+	"strconv"
+	"time"
+
+	type_enums "github.com/rapidaai/pkg/types/enums"
+)
+
+const (
+	baseBackoff = 2 * time.Second
+	maxBackoff  = 10 * time.Minute
+)
+
+// Backoff returns the delay before the given retry, exponential from 2s capped at
+// 10 minutes with jitter of up to half the delay so receivers are not hit in bursts.
+func Backoff(retry uint32) time.Duration {
+	delay := maxBackoff
+	if n := max(retry, 1) - 1; n < 20 {
+		delay = min(baseBackoff<<n, maxBackoff)
+	}
+	half := delay / 2
+	return half + rand.N(half+1)
+}
+
+// Outcome decides the state of the delivery after an attempt, retry is true when
+// the delivery has to be attempted again after backoff.
+func Outcome(
+	statusCode int,
+	err error,
+	retryCount, maxRetryCount uint32,
+	retryStatusCodes []string,
+) (state type_enums.RecordState, retry bool) {
+	retryable := err != nil || slices.Contains(retryStatusCodes, strconv.Itoa(statusCode))
+	if !retryable {
+		if statusCode >= 200 && statusCode < 300 {
+			return type_enums.RECORD_COMPLETE, false
+		}
+		return type_enums.RECORD_FAILED, false
+	}
+	if retryCount < maxRetryCount {
+		return type_enums.RECORD_QUEUED, true
+	}
+	return type_enums.RECORD_DEAD_LETTER, false
+}
@@ -0,0 +1,84 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// t=<unix timestamp>,v1=<hex hmac sha256 of "<timestamp>.<body>">
+	SignatureHeader = "X-Rapida-Signature"
+	// stable across retries and redelivery of the same webhook log
+	IdempotencyKeyHeader = "X-Rapida-Idempotency-Key"
+
+	// key of the secret in vault credential of the webhook
+	SecretCredentialKey = "secret"
+)
+
+var (
+	ErrInvalidSignature = errors.New("invalid webhook signature")
+	ErrExpiredSignature = errors.New("webhook signature timestamp is outside the tolerance")
+)
+
+// Sign returns the value of X-Rapida-Signature for the body sent at timestamp.
+func Sign(secret string, timestamp time.Time, body []byte) string {
+	ts := strconv.FormatInt(timestamp.Unix(), 10)
+	return fmt.Sprintf("t=%s,v1=%s", ts, signature(secret, ts, body))
+}
+
+// Verify checks the X-Rapida-Signature header against the body, receivers should reject
+// signatures older than tolerance to avoid replays.
+func Verify(secret, header string, body []byte, tolerance time.Duration) error {
+	var ts, v1 string
+	for _, part := range strings.Split(header, ",") {
+		k, v, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if !ok {
+			continue
+		}
+		switch k {
+		case "t":
+			ts = v
+		case "v1":
+			v1 = v
+		}
+	}
+	unix, err := strconv.ParseInt(ts, 10, 64)
+	if err != nil || v1 == "" {
+		return ErrInvalidSignature
+	}
+	if tolerance > 0 && time.Since(time.Unix(unix, 0)).Abs() > tolerance {
+		return ErrExpiredSignature
+	}
+	if !hmac.Equal([]byte(v1), []byte(signature(secret, ts, body))) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+func signature(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// IdempotencyKey derives the key of the delivery from the webhook, conversation,
+// event and payload so the same delivery is never enqueued twice.
+func IdempotencyKey(webhookId, conversationId uint64, event string, request []byte) string {
+	h := sha256.New()
+	fmt.Fprintf(h, "%d:%d:%s:", webhookId, conversationId, event)
+	h.Write(request)
+	return fmt.Sprintf("whk_%s", hex.EncodeToString(h.Sum(nil))[:40])
+}
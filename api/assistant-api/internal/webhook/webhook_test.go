@@ -0,0 +1,80 @@
+package internal_webhook
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	type_enums "github.com/rapidaai/pkg/types/enums"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSign_Verify(t *testing.T) {
+	body := []byte(`{"event":"conversation.completed"}`)
+	header := Sign("whsec", time.Now(), body)
+
+	assert.Regexp(t, `^t=\d+,v1=[0-9a-f]{64}$`, header)
+	assert.NoError(t, Verify("whsec", header, body, 5*time.Minute))
+	assert.ErrorIs(t, Verify("other", header, body, 5*time.Minute), ErrInvalidSignature)
+	assert.ErrorIs(t, Verify("whsec", header, []byte(`{}`), 5*time.Minute), ErrInvalidSignature)
+	assert.ErrorIs(t, Verify("whsec", "v1=abc", body, 0), ErrInvalidSignature)
+
+	old := Sign("whsec", time.Now().Add(-time.Hour), body)
+	assert.ErrorIs(t, Verify("whsec", old, body, 5*time.Minute), ErrExpiredSignature)
+	assert.NoError(t, Verify("whsec", old, body, 0))
+}
+
+func TestIdempotencyKey(t *testing.T) {
+	key := IdempotencyKey(1, 2, "conversation.begin", []byte(`{}`))
+	assert.Equal(t, key, IdempotencyKey(1, 2, "conversation.begin", []byte(`{}`)))
+	assert.NotEqual(t, key, IdempotencyKey(1, 2, "conversation.begin", []byte(`{"resume":true}`)))
+	assert.NotEqual(t, key, IdempotencyKey(1, 3, "conversation.begin", []byte(`{}`)))
+	assert.Len(t, key, 44)
+}
+
+func TestBackoff(t *testing.T) {
+	for _, tc := range []struct {
+		retry    uint32
+		min, max time.Duration
+	}{
+		{0, time.Second, 2 * time.Second},
+		{1, time.Second, 2 * time.Second},
+		{2, 2 * time.Second, 4 * time.Second},
+		{5, 16 * time.Second, 32 * time.Second},
+		{12, 5 * time.Minute, 10 * time.Minute},
+		{100, 5 * time.Minute, 10 * time.Minute},
+	} {
+		t.Run(fmt.Sprintf("retry_%d", tc.retry), func(t *testing.T) {
+			for range 50 {
+				d := Backoff(tc.retry)
+				assert.GreaterOrEqual(t, d, tc.min)
+				assert.LessOrEqual(t, d, tc.max)
+			}
+		})
+	}
+}
+
+func TestOutcome(t *testing.T) {
+	retryCodes := []string{"429", "500", "503"}
+	for _, tc := range []struct {
+		name       string
+		statusCode int
+		err        error
+		retryCount uint32
+		state      type_enums.RecordState
+		retry      bool
+	}{
+		{"delivered", 200, nil, 0, type_enums.RECORD_COMPLETE, false},
+		{"rejected", 400, nil, 0, type_enums.RECORD_FAILED, false},
+		{"retry status", 503, nil, 1, type_enums.RECORD_QUEUED, true},
+		{"network error", 0, errors.New("timeout"), 2, type_enums.RECORD_QUEUED, true},
+		{"retries exhausted", 500, nil, 3, type_enums.RECORD_DEAD_LETTER, false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			state, retry := Outcome(tc.statusCode, tc.err, tc.retryCount, 3, retryCodes)
+			assert.Equal(t, tc.state, state)
+			assert.Equal(t, tc.retry, retry)
+		})
+	}
+}
@@ -0,0 +1,231 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_webhook
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/rapidaai/api/assistant-api/config"
+	internal_assistant_entity "github.com/rapidaai/api/assistant-api/internal/entity/assistants"
+	internal_services "github.com/rapidaai/api/assistant-api/internal/services"
+	internal_assistant_service "github.com/rapidaai/api/assistant-api/internal/services/assistant"
+	"github.com/rapidaai/pkg/clients/rest"
+	web_client "github.com/rapidaai/pkg/clients/web"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/connectors"
+	storage_files "github.com/rapidaai/pkg/storages/file-storage"
+	"github.com/rapidaai/pkg/types"
+	type_enums "github.com/rapidaai/pkg/types/enums"
+	"github.com/rapidaai/pkg/utils"
+)
+
+const (
+	pollInterval = time.Second
+	claimBatch   = 20
+	// deliveries in progress longer than lease are considered abandoned by a dead worker
+	claimLease = 5 * time.Minute
+)
+
+// DeliveryWorker delivers the webhook logs queued in postgres, multiple workers can run
+// across pods as deliveries are claimed with skip locked.
+type DeliveryWorker struct {
+	logger         commons.Logger
+	webhookService internal_services.AssistantWebhookService
+	vaultClient    web_client.VaultClient
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func NewDeliveryWorker(
+	cfg *config.AssistantConfig,
+	logger commons.Logger,
+	postgres connectors.PostgresConnector,
+	redis connectors.RedisConnector,
+) *DeliveryWorker {
+	return &DeliveryWorker{
+		logger: logger,
+		webhookService: internal_assistant_service.NewAssistantWebhookService(logger, postgres,
+			storage_files.NewStorage(cfg.AssetStoreConfig, logger)),
+		vaultClient: web_client.NewVaultClientGRPC(&cfg.AppConfig, logger, redis),
+	}
+}
+
+// Start polls the queue in background until Stop is called.
+func (w *DeliveryWorker) Start(ctx context.Context) {
+	ctx, w.cancel = context.WithCancel(ctx)
+	w.wg.Add(1)
+	go func() {
+		defer w.wg.Done()
+		ticker := time.NewTicker(pollInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				w.poll(ctx)
+			}
+		}
+	}()
+}
+
+// Stop waits for the in flight deliveries, unfinished ones are claimed again after the lease.
+func (w *DeliveryWorker) Stop(ctx context.Context) error {
+	if w.cancel != nil {
+		w.cancel()
+	}
+	done := make(chan struct{})
+	go func() {
+		w.wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (w *DeliveryWorker) poll(ctx context.Context) {
+	webhookLogs, err := w.webhookService.ClaimLogs(ctx, claimBatch, claimLease)
+	if err != nil {
+		return
+	}
+	var wg sync.WaitGroup
+	for _, wl := range webhookLogs {
+		wg.Add(1)
+		utils.Go(ctx, func() {
+			defer wg.Done()
+			w.deliver(context.WithoutCancel(ctx), wl)
+		})
+	}
+	wg.Wait()
+}
+
+func (w *DeliveryWorker) deliver(ctx context.Context, wl *internal_assistant_entity.AssistantWebhookLog) {
+	start := time.Now()
+	webhook, err := w.webhookService.Get(ctx, nil, wl.WebhookId, wl.AssistantId)
+	if err != nil || webhook.Status != type_enums.RECORD_ACTIVE {
+		w.complete(ctx, wl, type_enums.RECORD_DEAD_LETTER, 0, start, wl.RetryCount, "webhook is not active", nil)
+		return
+	}
+
+	request, _, _ := w.webhookService.GetLogObject(ctx, wl.OrganizationId, wl.ProjectId, wl.Id)
+	if request == nil {
+		w.complete(ctx, wl, type_enums.RECORD_DEAD_LETTER, 0, start, wl.RetryCount, "webhook request is not available", nil)
+		return
+	}
+
+	res, err := w.send(ctx, wl, webhook, request)
+	statusCode := 0
+	var response []byte
+	if res != nil {
+		statusCode = res.StatusCode
+		response, _ = res.ToJSON()
+	}
+	lastError := ""
+	if err != nil {
+		lastError = err.Error()
+		w.logger.Errorf("webhook delivery %d failed %v", wl.Id, err)
+	}
+
+	state, retry := Outcome(statusCode, err, wl.RetryCount, webhook.GetMaxRetryCount(), webhook.GetRetryStatusCode())
+	if !retry {
+		w.complete(ctx, wl, state, statusCode, start, wl.RetryCount, lastError, response)
+		return
+	}
+	retryCount := wl.RetryCount + 1
+	if err := w.webhookService.UpdateLogDelivery(ctx, wl, state,
+		int64(statusCode), int64(time.Since(start)), retryCount,
+		time.Now().Add(Backoff(retryCount)), lastError, response); err != nil {
+		w.logger.Errorf("unable to reschedule webhook delivery %d %v", wl.Id, err)
+	}
+}
+
+func (w *DeliveryWorker) complete(
+	ctx context.Context,
+	wl *internal_assistant_entity.AssistantWebhookLog,
+	state type_enums.RecordState,
+	statusCode int,
+	start time.Time,
+	retryCount uint32,
+	lastError string,
+	response []byte,
+) {
+	if err := w.webhookService.UpdateLogDelivery(ctx, wl, state,
+		int64(statusCode), int64(time.Since(start)), retryCount,
+		time.Time(wl.NextAttemptDate), lastError, response); err != nil {
+		w.logger.Errorf("unable to complete webhook delivery %d %v", wl.Id, err)
+	}
+}
+
+func (w *DeliveryWorker) send(
+	ctx context.Context,
+	wl *internal_assistant_entity.AssistantWebhookLog,
+	webhook *internal_assistant_entity.AssistantWebhook,
+	request []byte,
+) (*rest.APIResponse, error) {
+	body, err := json.Marshal(json.RawMessage(request))
+	if err != nil {
+		return nil, fmt.Errorf("invalid webhook request %w", err)
+	}
+
+	headers := make(map[string]string, len(webhook.GetHeaders())+2)
+	for k, v := range webhook.GetHeaders() {
+		headers[k] = v
+	}
+	headers[IdempotencyKeyHeader] = wl.IdempotencyKey
+
+	// get requests carry the arguments as query params, signature covers the empty body
+	signed := body
+	if webhook.GetMethod() != "POST" && webhook.GetMethod() != "PUT" && webhook.GetMethod() != "PATCH" {
+		signed = nil
+	}
+	if webhook.GetSecretCredentialId() > 0 {
+		secret, err := w.secret(ctx, wl, webhook.GetSecretCredentialId())
+		if err != nil {
+			return nil, err
+		}
+		headers[SignatureHeader] = Sign(secret, time.Now(), signed)
+	}
+
+	client := rest.NewRestClientWithConfig(webhook.GetUrl(), headers, webhook.GetTimeoutSecond())
+	switch webhook.GetMethod() {
+	case "POST":
+		return client.Post(ctx, "", json.RawMessage(body), headers)
+	case "PUT":
+		return client.Put(ctx, "", json.RawMessage(body), headers)
+	case "PATCH":
+		return client.Patch(ctx, "", json.RawMessage(body), headers)
+	default:
+		params := make(map[string]interface{})
+		if err := json.Unmarshal(body, &params); err != nil {
+			return nil, fmt.Errorf("invalid webhook request %w", err)
+		}
+		return client.Get(ctx, "", params, headers)
+	}
+}
+
+func (w *DeliveryWorker) secret(ctx context.Context, wl *internal_assistant_entity.AssistantWebhookLog, credentialId uint64) (string, error) {
+	credential, err := w.vaultClient.GetCredential(ctx, &types.ServiceScope{
+		OrganizationId: &wl.OrganizationId,
+		ProjectId:      &wl.ProjectId,
+	}, credentialId)
+	if err != nil {
+		return "", fmt.Errorf("unable to get webhook secret %w", err)
+	}
+	secret, ok := credential.GetValue().AsMap()[SecretCredentialKey].(string)
+	if !ok || secret == "" {
+		return "", fmt.Errorf("webhook secret credential does not have %s", SecretCredentialKey)
+	}
+	return secret, nil
+}
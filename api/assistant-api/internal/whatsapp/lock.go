@@ -0,0 +1,59 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_whatsapp
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rapidaai/pkg/connectors"
+)
+
+const (
+	// a turn holding the lock longer than this is considered dead and the lock expires
+	senderLockTTL  = 5 * time.Minute
+	senderLockPoll = 100 * time.Millisecond
+
+	// set only when the key is absent, 1 when the lock is acquired
+	senderLockAcquire = `return redis.call('SET', KEYS[1], ARGV[1], 'NX', 'PX', ARGV[2]) and 1 or 0`
+	// delete only when the lock is still held by the caller
+	senderLockRelease = `if redis.call('GET', KEYS[1]) == ARGV[1] then return redis.call('DEL', KEYS[1]) end return 0`
+)
+
+// LockSender serializes the turns of a sender of the assistant across instances,
+// messages arriving together would otherwise all start a new conversation. It waits
+// for the turn holding the lock and returns the release of the acquired lock.
+func LockSender(ctx context.Context, redis connectors.RedisConnector, assistantId uint64, sender string) (func(), error) {
+	key := fmt.Sprintf("whatsapp:sender:%d:%s", assistantId, sender)
+	token := uuid.NewString()
+	ttl := strconv.FormatInt(senderLockTTL.Milliseconds(), 10)
+
+	// a stale lock always expires within the wait
+	deadline := time.Now().Add(senderLockTTL + senderLockPoll)
+	for {
+		res := redis.Cmd(ctx, "EVAL", []string{senderLockAcquire, "1", key, token, ttl})
+		if res.HasError() {
+			return nil, res.Error()
+		}
+		if acquired, _ := res.Result.(int64); acquired == 1 {
+			break
+		}
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("timed out waiting for the turn of sender %s", sender)
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(senderLockPoll):
+		}
+	}
+	return func() {
+		redis.Cmd(context.WithoutCancel(ctx), "EVAL", []string{senderLockRelease, "1", key, token})
+	}, nil
+}
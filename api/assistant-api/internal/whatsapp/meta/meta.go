@@ -0,0 +1,160 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_meta_whatsapp
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rapidaai/api/assistant-api/config"
+	internal_whatsapp "github.com/rapidaai/api/assistant-api/internal/whatsapp"
+	"github.com/rapidaai/pkg/clients/rest"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/protos"
+)
+
+const (
+	signatureHeader = "X-Hub-Signature-256"
+	// trailing slash is required to resolve the phone number id relative to the version
+	graphApiUrl  = "https://graph.facebook.com/v21.0/"
+	messageLimit = 4096
+	sendTimeout  = 15
+)
+
+// payload of the whatsapp cloud api webhook, only the fields required for text messages
+type webhookPayload struct {
+	Entry []struct {
+		Changes []struct {
+			Value struct {
+				Metadata struct {
+					PhoneNumberId string `json:"phone_number_id"`
+				} `json:"metadata"`
+				Messages []struct {
+					Id   string `json:"id"`
+					From string `json:"from"`
+					Type string `json:"type"`
+					Text struct {
+						Body string `json:"body"`
+					} `json:"text"`
+				} `json:"messages"`
+			} `json:"value"`
+		} `json:"changes"`
+	} `json:"entry"`
+}
+
+type metaWhatsapp struct {
+	appCfg *config.AssistantConfig
+	logger commons.Logger
+}
+
+func NewMetaWhatsapp(
+	config *config.AssistantConfig,
+	logger commons.Logger) (internal_whatsapp.Whatsapp, error) {
+	return &metaWhatsapp{
+		appCfg: config,
+		logger: logger,
+	}, nil
+}
+
+func (mw *metaWhatsapp) value(vaultCredential *protos.VaultCredential, key string) (string, error) {
+	v, ok := vaultCredential.GetValue().AsMap()[key].(string)
+	if !ok || v == "" {
+		return "", fmt.Errorf("illegal vault config %s is not found", key)
+	}
+	return v, nil
+}
+
+// VerifySignature validates X-Hub-Signature-256, the HMAC-SHA256 of the body signed with the app secret.
+func (mw *metaWhatsapp) VerifySignature(c *gin.Context, body []byte, vaultCredential *protos.VaultCredential) error {
+	appSecret, err := mw.value(vaultCredential, "app_secret")
+	if err != nil {
+		return err
+	}
+	signature, ok := strings.CutPrefix(c.GetHeader(signatureHeader), "sha256=")
+	if !ok {
+		return internal_whatsapp.ErrInvalidSignature
+	}
+	mac := hmac.New(sha256.New, []byte(appSecret))
+	mac.Write(body)
+	if !hmac.Equal([]byte(signature), []byte(hex.EncodeToString(mac.Sum(nil)))) {
+		return internal_whatsapp.ErrInvalidSignature
+	}
+	return nil
+}
+
+func (mw *metaWhatsapp) Receive(c *gin.Context, body []byte) ([]*internal_whatsapp.InboundMessage, error) {
+	var payload webhookPayload
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, fmt.Errorf("failed to parse request body %w", err)
+	}
+	messages := make([]*internal_whatsapp.InboundMessage, 0)
+	for _, entry := range payload.Entry {
+		for _, change := range entry.Changes {
+			for _, msg := range change.Value.Messages {
+				// status updates and media messages are not handled by the text channel
+				if msg.Type != "text" || strings.TrimSpace(msg.Text.Body) == "" {
+					continue
+				}
+				messages = append(messages, &internal_whatsapp.InboundMessage{
+					Id:   msg.Id,
+					From: msg.From,
+					To:   change.Value.Metadata.PhoneNumberId,
+					Text: msg.Text.Body,
+				})
+			}
+		}
+	}
+	return messages, nil
+}
+
+func (mw *metaWhatsapp) Send(ctx context.Context, vaultCredential *protos.VaultCredential, in *internal_whatsapp.InboundMessage, text string) error {
+	accessToken, err := mw.value(vaultCredential, "access_token")
+	if err != nil {
+		return err
+	}
+	headers := map[string]string{
+		"Authorization": fmt.Sprintf("Bearer %s", accessToken),
+		"Content-Type":  "application/json",
+	}
+	restClient := rest.NewRestClientWithConfig(graphApiUrl, headers, sendTimeout)
+	for _, chunk := range internal_whatsapp.Chunks(text, messageLimit) {
+		res, err := restClient.Post(ctx, fmt.Sprintf("%s/messages", in.To), map[string]interface{}{
+			"messaging_product": "whatsapp",
+			"recipient_type":    "individual",
+			"to":                in.From,
+			"type":              "text",
+			"text": map[string]interface{}{
+				"body": chunk,
+			},
+		}, nil)
+		if err != nil {
+			return fmt.Errorf("failed to send meta whatsapp message %w", err)
+		}
+		if res.StatusCode >= 300 {
+			return fmt.Errorf("failed to send meta whatsapp message, status code: %d %s", res.StatusCode, res.ToString())
+		}
+	}
+	return nil
+}
+
+// Challenge answers the subscription verification of the webhook when the verify token matches.
+func (mw *metaWhatsapp) Challenge(c *gin.Context, vaultCredential *protos.VaultCredential) (string, error) {
+	verifyToken, err := mw.value(vaultCredential, "verify_token")
+	if err != nil {
+		return "", err
+	}
+	if c.Query("hub.mode") != "subscribe" ||
+		!hmac.Equal([]byte(c.Query("hub.verify_token")), []byte(verifyToken)) {
+		return "", internal_whatsapp.ErrInvalidSignature
+	}
+	return c.Query("hub.challenge"), nil
+}
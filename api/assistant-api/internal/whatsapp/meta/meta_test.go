@@ -0,0 +1,87 @@
+package internal_meta_whatsapp
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rapidaai/api/assistant-api/config"
+	internal_whatsapp "github.com/rapidaai/api/assistant-api/internal/whatsapp"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/protos"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+const testPayload = `{"object":"whatsapp_business_account","entry":[{"id":"1","changes":[{"field":"messages","value":{
+	"messaging_product":"whatsapp","metadata":{"display_phone_number":"15550199","phone_number_id":"pn-1"},
+	"messages":[
+		{"from":"14155550100","id":"wamid.1","timestamp":"1700000000","type":"text","text":{"body":"hello there"}},
+		{"from":"14155550100","id":"wamid.2","timestamp":"1700000001","type":"image","image":{"id":"media-1"}}
+	]}}]}]}`
+
+func testMeta(t *testing.T) (internal_whatsapp.Whatsapp, *protos.VaultCredential) {
+	gin.SetMode(gin.TestMode)
+	logger := commons.NewApplicationLoggerWithOptions(commons.EnableFile(false), commons.Level("error"))
+	logger.InitLogger()
+	mw, err := NewMetaWhatsapp(&config.AssistantConfig{}, logger)
+	require.NoError(t, err)
+	value, err := structpb.NewStruct(map[string]interface{}{
+		"access_token": "token",
+		"app_secret":   "secret",
+		"verify_token": "verify",
+	})
+	require.NoError(t, err)
+	return mw, &protos.VaultCredential{Value: value}
+}
+
+func TestMetaWhatsapp_VerifyAndReceive(t *testing.T) {
+	mw, credential := testMeta(t)
+	body := []byte(testPayload)
+
+	request := func(signature string) *gin.Context {
+		c, _ := gin.CreateTestContext(httptest.NewRecorder())
+		c.Request = httptest.NewRequest(http.MethodPost, "/v1/talk/meta/whatsapp/101/key", strings.NewReader(testPayload))
+		c.Request.Header.Set(signatureHeader, signature)
+		return c
+	}
+	mac := hmac.New(sha256.New, []byte("secret"))
+	mac.Write(body)
+	valid := "sha256=" + hex.EncodeToString(mac.Sum(nil))
+
+	assert.NoError(t, mw.VerifySignature(request(valid), body, credential))
+	assert.ErrorIs(t, mw.VerifySignature(request(valid), []byte(testPayload+" "), credential), internal_whatsapp.ErrInvalidSignature)
+	assert.ErrorIs(t, mw.VerifySignature(request(strings.TrimPrefix(valid, "sha256=")), body, credential), internal_whatsapp.ErrInvalidSignature)
+
+	messages, err := mw.Receive(request(valid), body)
+	require.NoError(t, err)
+	require.Len(t, messages, 1)
+	assert.Equal(t, &internal_whatsapp.InboundMessage{
+		Id:   "wamid.1",
+		From: "14155550100",
+		To:   "pn-1",
+		Text: "hello there",
+	}, messages[0])
+}
+
+func TestMetaWhatsapp_Challenge(t *testing.T) {
+	mw, credential := testMeta(t)
+	challenge := func(query string) (string, error) {
+		c, _ := gin.CreateTestContext(httptest.NewRecorder())
+		c.Request = httptest.NewRequest(http.MethodGet, "/v1/talk/meta/whatsapp/101/key?"+query, nil)
+		return mw.Challenge(c, credential)
+	}
+
+	out, err := challenge("hub.mode=subscribe&hub.verify_token=verify&hub.challenge=1158201444")
+	require.NoError(t, err)
+	assert.Equal(t, "1158201444", out)
+
+	_, err = challenge("hub.mode=subscribe&hub.verify_token=wrong&hub.challenge=1158201444")
+	assert.ErrorIs(t, err, internal_whatsapp.ErrInvalidSignature)
+}
@@ -0,0 +1,96 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_whatsapp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/rapidaai/pkg/connectors"
+	"github.com/rapidaai/pkg/types"
+)
+
+const (
+	// sorted set of the conversations scored by the expiry of their session window
+	sessionKey = "whatsapp:sessions"
+	// inbound message ids are remembered for as long as providers retry their webhooks
+	messageTTL = 24 * time.Hour
+
+	// set only when the message id is absent, 1 when the message is claimed
+	messageClaim = `return redis.call('SET', KEYS[1], '1', 'NX', 'EX', ARGV[1]) and 1 or 0`
+	// members of the expired sessions are removed as they are read so only one worker ends them
+	sessionClaim = `local members = redis.call('ZRANGEBYSCORE', KEYS[1], '-inf', ARGV[1], 'LIMIT', 0, ARGV[2])
+if #members > 0 then redis.call('ZREM', KEYS[1], unpack(members)) end
+return members`
+)
+
+// Session is the conversation of a sender which ends once its session window expires.
+type Session struct {
+	OrganizationId          uint64 `json:"organizationId"`
+	ProjectId               uint64 `json:"projectId"`
+	AssistantId             uint64 `json:"assistantId"`
+	AssistantConversationId uint64 `json:"assistantConversationId"`
+	Identifier              string `json:"identifier"`
+}
+
+// Auth is the principal of the project ending the conversation outside of a request.
+func (s *Session) Auth() types.SimplePrinciple {
+	return &types.ServiceScope{
+		OrganizationId: &s.OrganizationId,
+		ProjectId:      &s.ProjectId,
+	}
+}
+
+// ScheduleSession (re)schedules the end of the conversation, every turn pushes it to the
+// end of the session window.
+func ScheduleSession(ctx context.Context, redis connectors.RedisConnector, session *Session, expireAt time.Time) error {
+	member, err := json.Marshal(session)
+	if err != nil {
+		return err
+	}
+	res := redis.Cmd(ctx, "ZADD", []string{sessionKey, strconv.FormatInt(expireAt.Unix(), 10), string(member)})
+	if res.HasError() {
+		return res.Error()
+	}
+	return nil
+}
+
+// ClaimExpiredSessions returns up to limit sessions whose window has expired by now,
+// a session is returned to only one of the callers.
+func ClaimExpiredSessions(ctx context.Context, redis connectors.RedisConnector, now time.Time, limit int) ([]*Session, error) {
+	res := redis.Cmd(ctx, "EVAL", []string{sessionClaim, "1", sessionKey, strconv.FormatInt(now.Unix(), 10), strconv.Itoa(limit)})
+	if res.HasError() {
+		return nil, res.Error()
+	}
+	members, err := res.ResultStringSlice()
+	if err != nil {
+		return nil, err
+	}
+	sessions := make([]*Session, 0, len(members))
+	for _, member := range members {
+		session := &Session{}
+		if err := json.Unmarshal([]byte(member), session); err != nil {
+			continue
+		}
+		sessions = append(sessions, session)
+	}
+	return sessions, nil
+}
+
+// ClaimMessage returns false when the inbound message has been claimed already, providers
+// deliver a webhook again when it is not acknowledged in time.
+func ClaimMessage(ctx context.Context, redis connectors.RedisConnector, assistantId uint64, messageId string) (bool, error) {
+	key := fmt.Sprintf("whatsapp:message:%d:%s", assistantId, messageId)
+	res := redis.Cmd(ctx, "EVAL", []string{messageClaim, "1", key, strconv.FormatInt(int64(messageTTL.Seconds()), 10)})
+	if res.HasError() {
+		return false, res.Error()
+	}
+	claimed, _ := res.Result.(int64)
+	return claimed == 1, nil
+}
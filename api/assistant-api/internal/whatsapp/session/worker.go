@@ -0,0 +1,108 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_session_whatsapp
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/rapidaai/api/assistant-api/config"
+	internal_adapter_request_talking_whatsapp "github.com/rapidaai/api/assistant-api/internal/adapters/whatsapp/talking"
+	internal_whatsapp "github.com/rapidaai/api/assistant-api/internal/whatsapp"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/connectors"
+	"github.com/rapidaai/pkg/storages"
+	storage_files "github.com/rapidaai/pkg/storages/file-storage"
+)
+
+const (
+	sessionPollInterval = 10 * time.Second
+	sessionBatch        = 100
+)
+
+// SessionWorker ends the whatsapp conversations whose session window has expired, turns only
+// release their session so analysis, webhooks and evaluation of the conversation run once.
+// multiple workers can run across pods as the expired sessions are claimed from redis.
+type SessionWorker struct {
+	cfg        *config.AssistantConfig
+	logger     commons.Logger
+	postgres   connectors.PostgresConnector
+	redis      connectors.RedisConnector
+	opensearch connectors.OpenSearchConnector
+	storage    storages.Storage
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func NewSessionWorker(
+	cfg *config.AssistantConfig,
+	logger commons.Logger,
+	postgres connectors.PostgresConnector,
+	redis connectors.RedisConnector,
+	opensearch connectors.OpenSearchConnector,
+) *SessionWorker {
+	return &SessionWorker{
+		cfg:        cfg,
+		logger:     logger,
+		postgres:   postgres,
+		redis:      redis,
+		opensearch: opensearch,
+		storage:    storage_files.NewStorage(cfg.AssetStoreConfig, logger),
+	}
+}
+
+// Start polls the expired sessions in background until Stop is called.
+func (w *SessionWorker) Start(ctx context.Context) {
+	ctx, w.cancel = context.WithCancel(ctx)
+	w.wg.Add(1)
+	go func() {
+		defer w.wg.Done()
+		ticker := time.NewTicker(sessionPollInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				w.poll(ctx)
+			}
+		}
+	}()
+}
+
+// Stop waits for the conversations being ended.
+func (w *SessionWorker) Stop(ctx context.Context) error {
+	if w.cancel != nil {
+		w.cancel()
+	}
+	done := make(chan struct{})
+	go func() {
+		w.wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (w *SessionWorker) poll(ctx context.Context) {
+	sessions, err := internal_whatsapp.ClaimExpiredSessions(ctx, w.redis, time.Now(), sessionBatch)
+	if err != nil {
+		w.logger.Errorf("unable to claim expired whatsapp sessions %v", err)
+		return
+	}
+	for _, session := range sessions {
+		ender := internal_adapter_request_talking_whatsapp.NewEnder(ctx, w.cfg, w.logger, w.postgres, w.opensearch, w.redis, w.storage)
+		if err := ender.EndConversation(session.Auth(), session.Identifier, session.AssistantId, session.AssistantConversationId); err != nil {
+			w.logger.Errorf("unable to end whatsapp conversation %d %v", session.AssistantConversationId, err)
+		}
+	}
+}
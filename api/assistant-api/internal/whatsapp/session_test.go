@@ -0,0 +1,79 @@
+package internal_whatsapp
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/rapidaai/pkg/connectors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubRedis records the commands and answers them with the given results in order
+type stubRedis struct {
+	connectors.RedisConnector
+	cmds    [][]string
+	results []*connectors.RedisResponse
+}
+
+func (r *stubRedis) Cmd(ctx context.Context, cmd string, args []string) *connectors.RedisResponse {
+	r.cmds = append(r.cmds, append([]string{cmd}, args...))
+	res := r.results[0]
+	r.results = r.results[1:]
+	return res
+}
+
+func TestScheduleSession(t *testing.T) {
+	redis := &stubRedis{results: []*connectors.RedisResponse{{Result: int64(1)}}}
+	session := &Session{OrganizationId: 1, ProjectId: 2, AssistantId: 3, AssistantConversationId: 4, Identifier: "+15550001"}
+	expireAt := time.Unix(1700000000, 0)
+
+	require.NoError(t, ScheduleSession(context.Background(), redis, session, expireAt))
+	require.Len(t, redis.cmds, 1)
+	cmd := redis.cmds[0]
+	assert.Equal(t, []string{"ZADD", sessionKey, "1700000000"}, cmd[:3])
+
+	// the member is stable so the next turn moves the expiry of the same session
+	var member Session
+	require.NoError(t, json.Unmarshal([]byte(cmd[3]), &member))
+	assert.Equal(t, *session, member)
+}
+
+func TestClaimExpiredSessions(t *testing.T) {
+	member, _ := json.Marshal(&Session{OrganizationId: 1, ProjectId: 2, AssistantId: 3, AssistantConversationId: 4, Identifier: "+15550001"})
+	redis := &stubRedis{results: []*connectors.RedisResponse{
+		{Result: []interface{}{string(member), "invalid"}},
+		{Result: []interface{}{}},
+	}}
+
+	sessions, err := ClaimExpiredSessions(context.Background(), redis, time.Unix(1700000000, 0), 10)
+	require.NoError(t, err)
+	require.Len(t, sessions, 1)
+	assert.Equal(t, uint64(4), sessions[0].AssistantConversationId)
+	assert.Equal(t, uint64(2), *sessions[0].Auth().GetCurrentProjectId())
+	assert.Equal(t, uint64(1), *sessions[0].Auth().GetCurrentOrganizationId())
+	assert.Equal(t, []string{"1", sessionKey, "1700000000", "10"}, redis.cmds[0][2:])
+
+	sessions, err = ClaimExpiredSessions(context.Background(), redis, time.Now(), 10)
+	require.NoError(t, err)
+	assert.Empty(t, sessions)
+}
+
+func TestClaimMessage(t *testing.T) {
+	redis := &stubRedis{results: []*connectors.RedisResponse{
+		{Result: int64(1)},
+		{Result: int64(0)},
+	}}
+
+	claimed, err := ClaimMessage(context.Background(), redis, 7, "wamid.1")
+	require.NoError(t, err)
+	assert.True(t, claimed)
+	assert.Equal(t, "whatsapp:message:7:wamid.1", redis.cmds[0][3])
+
+	// redelivery of the same message is dropped
+	claimed, err = ClaimMessage(context.Background(), redis, 7, "wamid.1")
+	require.NoError(t, err)
+	assert.False(t, claimed)
+}
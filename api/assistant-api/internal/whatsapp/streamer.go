@@ -0,0 +1,140 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_whatsapp
+
+import (
+	"context"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	internal_streamers "github.com/rapidaai/api/assistant-api/internal/streamers"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/types"
+	"github.com/rapidaai/protos"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// replies not completed within the turn timeout are dropped and the session is closed
+const turnTimeout = 2 * time.Minute
+
+// Reply delivers the text of assistant to the user.
+type Reply func(ctx context.Context, text string) error
+
+// turnStreamer runs a single turn of the whatsapp conversation, it feeds the configuration
+// and the message of the user to the talker and closes once the assistant has replied.
+type turnStreamer struct {
+	ctx     context.Context
+	logger  commons.Logger
+	reply   Reply
+	resumed bool
+
+	requests chan *protos.AssistantMessagingRequest
+	timeout  *time.Timer
+
+	mu        sync.Mutex
+	messageId string
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+func NewTurnStreamer(
+	ctx context.Context,
+	logger commons.Logger,
+	assistantId uint64,
+	assistantConversationId uint64,
+	text string,
+	reply Reply,
+) internal_streamers.Streamer {
+	requests := make(chan *protos.AssistantMessagingRequest, 2)
+	requests <- &protos.AssistantMessagingRequest{
+		Request: &protos.AssistantMessagingRequest_Configuration{
+			Configuration: &protos.AssistantConversationConfiguration{
+				AssistantConversationId: assistantConversationId,
+				Assistant: &protos.AssistantDefinition{
+					AssistantId: assistantId,
+					Version:     "latest",
+				},
+				Time: timestamppb.Now(),
+			},
+		},
+	}
+	requests <- &protos.AssistantMessagingRequest{
+		Request: &protos.AssistantMessagingRequest_Message{
+			Message: &protos.AssistantConversationUserMessage{
+				Message: &protos.AssistantConversationUserMessage_Text{
+					Text: &protos.AssistantConversationMessageTextContent{
+						Content: text,
+					},
+				},
+				Completed: true,
+				Time:      timestamppb.Now(),
+			},
+		},
+	}
+	close(requests)
+	return &turnStreamer{
+		ctx:      ctx,
+		logger:   logger,
+		reply:    reply,
+		resumed:  assistantConversationId > 0,
+		requests: requests,
+		timeout:  time.NewTimer(turnTimeout),
+		done:     make(chan struct{}),
+	}
+}
+
+func (ts *turnStreamer) Context() context.Context {
+	return ts.ctx
+}
+
+// Recv returns the configuration and the message of the user, then blocks until the
+// turn is completed and returns io.EOF to end the session.
+func (ts *turnStreamer) Recv() (*protos.AssistantMessagingRequest, error) {
+	if req, ok := <-ts.requests; ok {
+		return req, nil
+	}
+	select {
+	case <-ts.done:
+	case <-ts.timeout.C:
+		ts.logger.Warnf("whatsapp turn is not completed in %s, closing the session", turnTimeout)
+	case <-ts.ctx.Done():
+	}
+	ts.timeout.Stop()
+	return nil, io.EOF
+}
+
+func (ts *turnStreamer) Send(response *protos.AssistantMessagingResponse) error {
+	switch data := response.GetData().(type) {
+	case *protos.AssistantMessagingResponse_User:
+		ts.mu.Lock()
+		if ts.messageId == "" {
+			ts.messageId = data.User.GetId()
+		}
+		ts.mu.Unlock()
+	case *protos.AssistantMessagingResponse_Message:
+		ts.mu.Lock()
+		messageId := ts.messageId
+		ts.mu.Unlock()
+
+		isReply := messageId != "" && data.Message.GetMessageId() == messageId
+		// greeting is only sent when the conversation begins
+		if !isReply && ts.resumed {
+			return nil
+		}
+		text := strings.TrimSpace(types.ToMessage(data.Message.GetResponse()).String())
+		if text != "" {
+			if err := ts.reply(ts.ctx, text); err != nil {
+				ts.logger.Errorf("unable to send whatsapp reply %v", err)
+			}
+		}
+		if isReply {
+			ts.closeOnce.Do(func() { close(ts.done) })
+		}
+	}
+	return nil
+}
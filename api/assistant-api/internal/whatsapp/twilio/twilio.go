@@ -0,0 +1,120 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_twilio_whatsapp
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rapidaai/api/assistant-api/config"
+	internal_whatsapp "github.com/rapidaai/api/assistant-api/internal/whatsapp"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/protos"
+	"github.com/twilio/twilio-go"
+	"github.com/twilio/twilio-go/client"
+	openapi "github.com/twilio/twilio-go/rest/api/v2010"
+)
+
+const (
+	signatureHeader = "X-Twilio-Signature"
+	// twilio rejects whatsapp messages longer than 1600 characters
+	messageLimit = 1600
+)
+
+type twilioWhatsapp struct {
+	appCfg *config.AssistantConfig
+	logger commons.Logger
+}
+
+func NewTwilioWhatsapp(
+	config *config.AssistantConfig,
+	logger commons.Logger) (internal_whatsapp.Whatsapp, error) {
+	return &twilioWhatsapp{
+		appCfg: config,
+		logger: logger,
+	}, nil
+}
+
+func (tw *twilioWhatsapp) credential(vaultCredential *protos.VaultCredential) (string, string, error) {
+	accountSid, ok := vaultCredential.GetValue().AsMap()["account_sid"].(string)
+	if !ok {
+		return "", "", fmt.Errorf("illegal vault config accountSid is not found")
+	}
+	authToken, ok := vaultCredential.GetValue().AsMap()["account_token"].(string)
+	if !ok {
+		return "", "", fmt.Errorf("illegal vault config account_token not found")
+	}
+	return accountSid, authToken, nil
+}
+
+// VerifySignature validates X-Twilio-Signature, the HMAC-SHA1 of the public url of
+// the webhook followed by the sorted form parameters signed with the auth token.
+func (tw *twilioWhatsapp) VerifySignature(c *gin.Context, body []byte, vaultCredential *protos.VaultCredential) error {
+	_, authToken, err := tw.credential(vaultCredential)
+	if err != nil {
+		return err
+	}
+	values, err := url.ParseQuery(string(body))
+	if err != nil {
+		return fmt.Errorf("failed to parse request body %w", err)
+	}
+	params := make(map[string]string, len(values))
+	for k := range values {
+		params[k] = values.Get(k)
+	}
+	webhookUrl := fmt.Sprintf("https://%s%s", tw.appCfg.PublicAssistantHost, c.Request.URL.RequestURI())
+	validator := client.NewRequestValidator(authToken)
+	if !validator.Validate(webhookUrl, params, c.GetHeader(signatureHeader)) {
+		return internal_whatsapp.ErrInvalidSignature
+	}
+	return nil
+}
+
+func (tw *twilioWhatsapp) Receive(c *gin.Context, body []byte) ([]*internal_whatsapp.InboundMessage, error) {
+	values, err := url.ParseQuery(string(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse request body %w", err)
+	}
+	text := values.Get("Body")
+	if values.Get("From") == "" || strings.TrimSpace(text) == "" {
+		tw.logger.Debugf("ignoring twilio whatsapp event without text %s", values.Get("MessageSid"))
+		return nil, nil
+	}
+	return []*internal_whatsapp.InboundMessage{{
+		Id:   values.Get("MessageSid"),
+		From: values.Get("From"),
+		To:   values.Get("To"),
+		Text: text,
+	}}, nil
+}
+
+func (tw *twilioWhatsapp) Send(ctx context.Context, vaultCredential *protos.VaultCredential, in *internal_whatsapp.InboundMessage, text string) error {
+	accountSid, authToken, err := tw.credential(vaultCredential)
+	if err != nil {
+		return err
+	}
+	restClient := twilio.NewRestClientWithParams(twilio.ClientParams{
+		Username: accountSid,
+		Password: authToken,
+	})
+	for _, chunk := range internal_whatsapp.Chunks(text, messageLimit) {
+		params := &openapi.CreateMessageParams{}
+		params.SetTo(in.From)
+		params.SetFrom(in.To)
+		params.SetBody(chunk)
+		if _, err := restClient.Api.CreateMessage(params); err != nil {
+			return fmt.Errorf("failed to send twilio whatsapp message %w", err)
+		}
+	}
+	return nil
+}
+
+func (tw *twilioWhatsapp) Challenge(c *gin.Context, vaultCredential *protos.VaultCredential) (string, error) {
+	return "", internal_whatsapp.ErrNotSupported
+}
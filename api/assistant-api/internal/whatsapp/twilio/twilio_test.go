@@ -0,0 +1,80 @@
+package internal_twilio_whatsapp
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rapidaai/api/assistant-api/config"
+	internal_whatsapp "github.com/rapidaai/api/assistant-api/internal/whatsapp"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/protos"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func twilioSignature(authToken, webhookUrl string, values url.Values) string {
+	keys := make([]string, 0, len(values))
+	for k := range values {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	payload := webhookUrl
+	for _, k := range keys {
+		payload += k + values.Get(k)
+	}
+	mac := hmac.New(sha1.New, []byte(authToken))
+	mac.Write([]byte(payload))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func TestTwilioWhatsapp_VerifyAndReceive(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	logger := commons.NewApplicationLoggerWithOptions(commons.EnableFile(false), commons.Level("error"))
+	logger.InitLogger()
+	tw, err := NewTwilioWhatsapp(&config.AssistantConfig{PublicAssistantHost: "assistant.rapida.ai"}, logger)
+	require.NoError(t, err)
+
+	value, err := structpb.NewStruct(map[string]interface{}{"account_sid": "AC123", "account_token": "token"})
+	require.NoError(t, err)
+	credential := &protos.VaultCredential{Value: value}
+
+	path := "/v1/talk/twilio/whatsapp/101/key"
+	values := url.Values{
+		"MessageSid": {"SM1"},
+		"From":       {"whatsapp:+14155550100"},
+		"To":         {"whatsapp:+14155550199"},
+		"Body":       {"hello there"},
+	}
+	body := []byte(values.Encode())
+
+	request := func(signature string) *gin.Context {
+		c, _ := gin.CreateTestContext(httptest.NewRecorder())
+		c.Request = httptest.NewRequest(http.MethodPost, path, strings.NewReader(string(body)))
+		c.Request.Header.Set(signatureHeader, signature)
+		return c
+	}
+
+	valid := twilioSignature("token", "https://assistant.rapida.ai"+path, values)
+	assert.NoError(t, tw.VerifySignature(request(valid), body, credential))
+	assert.ErrorIs(t, tw.VerifySignature(request(twilioSignature("other", "https://assistant.rapida.ai"+path, values)), body, credential), internal_whatsapp.ErrInvalidSignature)
+	assert.ErrorIs(t, tw.VerifySignature(request(""), body, credential), internal_whatsapp.ErrInvalidSignature)
+
+	messages, err := tw.Receive(request(valid), body)
+	require.NoError(t, err)
+	require.Len(t, messages, 1)
+	assert.Equal(t, &internal_whatsapp.InboundMessage{
+		Id:   "SM1",
+		From: "whatsapp:+14155550100",
+		To:   "whatsapp:+14155550199",
+		Text: "hello there",
+	}, messages[0])
+}
@@ -0,0 +1,91 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_whatsapp
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rapidaai/pkg/utils"
+	"github.com/rapidaai/protos"
+)
+
+const (
+	// deployment option holding the vault credential of the provider
+	CredentialOption = "rapida.credential_id"
+	// deployment option, seconds of inactivity after which a new conversation is started
+	SessionWindowOption = "rapida.session_window"
+
+	DefaultSessionWindow = 24 * time.Hour
+)
+
+var (
+	ErrInvalidSignature = errors.New("invalid whatsapp webhook signature")
+	ErrNotSupported     = errors.New("not supported by whatsapp provider")
+)
+
+// InboundMessage is a text message received from the user on whatsapp.
+type InboundMessage struct {
+	// message id given by the provider
+	Id string
+	// whatsapp number of the user
+	From string
+	// business number or phone number id which received the message, replies are sent from it
+	To   string
+	Text string
+}
+
+// any whatsapp provider integration must impliment this interface to provide consistent behaviour
+type Whatsapp interface {
+	// validates the signature of the inbound webhook with the credential of the deployment
+	VerifySignature(c *gin.Context, body []byte, vaultCredential *protos.VaultCredential) error
+
+	// text messages of the inbound webhook, other events are ignored
+	Receive(c *gin.Context, body []byte) ([]*InboundMessage, error)
+
+	// sends the text as a reply to the inbound message
+	Send(ctx context.Context, vaultCredential *protos.VaultCredential, in *InboundMessage, text string) error
+
+	// challenge of the subscription verification of webhook
+	Challenge(c *gin.Context, vaultCredential *protos.VaultCredential) (string, error)
+}
+
+// SessionWindow returns the inactivity window of conversation configured on the deployment.
+func SessionWindow(opts utils.Option) time.Duration {
+	seconds, err := opts.GetUint64(SessionWindowOption)
+	if err != nil || seconds == 0 {
+		return DefaultSessionWindow
+	}
+	return time.Duration(seconds) * time.Second
+}
+
+// Chunks splits the text into parts of at most limit characters, preferring to
+// break on new lines and spaces so words are not split across messages.
+func Chunks(text string, limit int) []string {
+	text = strings.TrimSpace(text)
+	chunks := make([]string, 0, 1)
+	for utf8.RuneCountInString(text) > limit {
+		runes := []rune(text)
+		part := string(runes[:limit])
+		cut := strings.LastIndex(part, "\n")
+		if cut <= 0 {
+			cut = strings.LastIndex(part, " ")
+		}
+		if cut <= 0 {
+			cut = len(part)
+		}
+		chunks = append(chunks, strings.TrimSpace(part[:cut]))
+		text = strings.TrimSpace(text[cut:])
+	}
+	if text != "" {
+		chunks = append(chunks, text)
+	}
+	return chunks
+}
@@ -0,0 +1,95 @@
+package internal_whatsapp
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/types"
+	"github.com/rapidaai/pkg/utils"
+	"github.com/rapidaai/protos"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChunks(t *testing.T) {
+	assert.Equal(t, []string{"hello"}, Chunks("  hello ", 10))
+	assert.Empty(t, Chunks("   ", 10))
+	assert.Equal(t, []string{"hello", "world foo"}, Chunks("hello world foo", 10))
+	assert.Equal(t, []string{"first line", "second"}, Chunks("first line\nsecond", 12))
+	// words longer than the limit are split
+	assert.Equal(t, []string{"abcde", "fghij", "k"}, Chunks("abcdefghijk", 5))
+	// limit is in characters, not bytes
+	for _, c := range Chunks(strings.Repeat("नमस्ते ", 50), 20) {
+		assert.LessOrEqual(t, len([]rune(c)), 20)
+	}
+}
+
+func TestSessionWindow(t *testing.T) {
+	assert.Equal(t, DefaultSessionWindow, SessionWindow(utils.Option{}))
+	assert.Equal(t, DefaultSessionWindow, SessionWindow(utils.Option{SessionWindowOption: "invalid"}))
+	assert.Equal(t, 30*time.Minute, SessionWindow(utils.Option{SessionWindowOption: "1800"}))
+}
+
+func TestTurnStreamer(t *testing.T) {
+	logger := commons.NewApplicationLoggerWithOptions(commons.EnableFile(false), commons.Level("error"))
+	logger.InitLogger()
+
+	message := func(id, text string) *protos.AssistantMessagingResponse {
+		return &protos.AssistantMessagingResponse{
+			Data: &protos.AssistantMessagingResponse_Message{
+				Message: &protos.AssistantConversationMessage{
+					MessageId: id,
+					Response: types.NewMessage("assistant", &types.Content{
+						ContentType:   commons.TEXT_CONTENT.String(),
+						ContentFormat: commons.TEXT_CONTENT_FORMAT_RAW.String(),
+						Content:       []byte(text),
+					}).ToProto(),
+				},
+			},
+		}
+	}
+	user := &protos.AssistantMessagingResponse{
+		Data: &protos.AssistantMessagingResponse_User{
+			User: &protos.AssistantConversationUserMessage{Id: "msg-1"},
+		},
+	}
+
+	for _, tc := range []struct {
+		name           string
+		conversationId uint64
+		replies        []string
+	}{
+		{name: "begin", conversationId: 0, replies: []string{"welcome", "answer"}},
+		{name: "resume", conversationId: 42, replies: []string{"answer"}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var replies []string
+			streamer := NewTurnStreamer(context.Background(), logger, 7, tc.conversationId, "hello",
+				func(ctx context.Context, text string) error {
+					replies = append(replies, text)
+					return nil
+				})
+
+			req, err := streamer.Recv()
+			require.NoError(t, err)
+			assert.Equal(t, tc.conversationId, req.GetConfiguration().GetAssistantConversationId())
+			assert.Equal(t, uint64(7), req.GetConfiguration().GetAssistant().GetAssistantId())
+			req, err = streamer.Recv()
+			require.NoError(t, err)
+			assert.Equal(t, "hello", req.GetMessage().GetText().GetContent())
+
+			// greeting is sent before the message of user
+			require.NoError(t, streamer.Send(message("greet-1", "welcome")))
+			require.NoError(t, streamer.Send(user))
+			require.NoError(t, streamer.Send(message("msg-1", "answer")))
+
+			_, err = streamer.Recv()
+			assert.Equal(t, io.EOF, err)
+			assert.Equal(t, tc.replies, replies)
+		})
+	}
+}
@@ -0,0 +1 @@
+DROP INDEX IF EXISTS idx_assistant_conversation_message_metrics_name;
@@ -0,0 +1 @@
+CREATE INDEX IF NOT EXISTS idx_assistant_conversation_message_metrics_name ON public.assistant_conversation_message_metrics USING btree (name, assistant_conversation_id);
@@ -0,0 +1,10 @@
+DROP INDEX IF EXISTS idx_assistant_webhook_logs_delivery;
+DROP INDEX IF EXISTS uk_assistant_webhook_logs_idempotency_key;
+
+ALTER TABLE assistant_webhook_logs
+    DROP COLUMN IF EXISTS last_error,
+    DROP COLUMN IF EXISTS next_attempt_date,
+    DROP COLUMN IF EXISTS idempotency_key;
+
+ALTER TABLE assistant_webhooks
+    DROP COLUMN IF EXISTS secret_credential_id;
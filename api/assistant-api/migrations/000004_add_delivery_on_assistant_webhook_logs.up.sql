@@ -0,0 +1,10 @@
+ALTER TABLE assistant_webhooks
+    ADD COLUMN IF NOT EXISTS secret_credential_id bigint;
+
+ALTER TABLE assistant_webhook_logs
+    ADD COLUMN IF NOT EXISTS idempotency_key character varying(200),
+    ADD COLUMN IF NOT EXISTS next_attempt_date timestamp without time zone,
+    ADD COLUMN IF NOT EXISTS last_error text;
+
+CREATE UNIQUE INDEX IF NOT EXISTS uk_assistant_webhook_logs_idempotency_key ON public.assistant_webhook_logs USING btree (idempotency_key);
+CREATE INDEX IF NOT EXISTS idx_assistant_webhook_logs_delivery ON public.assistant_webhook_logs USING btree (status, next_attempt_date);
@@ -0,0 +1,5 @@
+ALTER TABLE assistant_api_deployments DROP COLUMN IF EXISTS ideal_timeout_retry;
+ALTER TABLE assistant_debugger_deployments DROP COLUMN IF EXISTS ideal_timeout_retry;
+ALTER TABLE assistant_phone_deployments DROP COLUMN IF EXISTS ideal_timeout_retry;
+ALTER TABLE assistant_web_plugin_deployments DROP COLUMN IF EXISTS ideal_timeout_retry;
+ALTER TABLE assistant_whatsapp_deployments DROP COLUMN IF EXISTS ideal_timeout_retry;
@@ -0,0 +1,5 @@
+ALTER TABLE assistant_api_deployments ADD COLUMN IF NOT EXISTS ideal_timeout_retry BIGINT;
+ALTER TABLE assistant_debugger_deployments ADD COLUMN IF NOT EXISTS ideal_timeout_retry BIGINT;
+ALTER TABLE assistant_phone_deployments ADD COLUMN IF NOT EXISTS ideal_timeout_retry BIGINT;
+ALTER TABLE assistant_web_plugin_deployments ADD COLUMN IF NOT EXISTS ideal_timeout_retry BIGINT;
+ALTER TABLE assistant_whatsapp_deployments ADD COLUMN IF NOT EXISTS ideal_timeout_retry BIGINT;
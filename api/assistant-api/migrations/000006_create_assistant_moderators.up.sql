@@ -0,0 +1,28 @@
+CREATE TABLE IF NOT EXISTS public.assistant_moderators (
+    id bigint PRIMARY KEY,
+    status character varying(50) DEFAULT 'ACTIVE'::character varying NOT NULL,
+    created_by bigint NOT NULL,
+    updated_by bigint,
+    created_date timestamp without time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    updated_date timestamp without time zone,
+    assistant_id bigint NOT NULL,
+    stage character varying(20) NOT NULL,
+    type character varying(20) NOT NULL,
+    name character varying(20) NOT NULL
+);
+CREATE INDEX IF NOT EXISTS idx_assistant_moderators_on_assistant_id_and_status ON public.assistant_moderators USING btree (assistant_id, status);
+
+CREATE TABLE IF NOT EXISTS public.assistant_moderator_options (
+    id bigint PRIMARY KEY,
+    status character varying(50) DEFAULT 'ACTIVE'::character varying NOT NULL,
+    created_by bigint NOT NULL,
+    updated_by bigint,
+    created_date timestamp without time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    updated_date timestamp without time zone,
+    key character varying(200) NOT NULL,
+    value text NOT NULL,
+    assistant_moderator_id bigint NOT NULL
+);
+ALTER TABLE ONLY public.assistant_moderator_options
+    ADD CONSTRAINT uk_assistant_moderator_id UNIQUE (key, assistant_moderator_id);
+CREATE INDEX IF NOT EXISTS idx_assistant_moderator_options_assistant_moderator_id ON public.assistant_moderator_options USING btree (assistant_moderator_id);
@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS public.assistant_lexicons (
+    id bigint PRIMARY KEY,
+    status character varying(50) DEFAULT 'ACTIVE'::character varying NOT NULL,
+    created_by bigint NOT NULL,
+    updated_by bigint,
+    created_date timestamp without time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    updated_date timestamp without time zone,
+    assistant_id bigint NOT NULL,
+    phrase character varying(400) NOT NULL,
+    match_type character varying(20) DEFAULT 'word'::character varying NOT NULL,
+    case_sensitive boolean DEFAULT false NOT NULL,
+    alias text,
+    phoneme text,
+    alphabet character varying(20),
+    description text
+);
+CREATE INDEX IF NOT EXISTS idx_assistant_lexicons_on_assistant_id_and_status ON public.assistant_lexicons USING btree (assistant_id, status);
@@ -0,0 +1,6 @@
+ALTER TABLE assistant_conversation_recordings DROP COLUMN IF EXISTS codec;
+ALTER TABLE assistant_conversation_recordings DROP COLUMN IF EXISTS channels;
+ALTER TABLE assistant_conversation_recordings DROP COLUMN IF EXISTS sample_rate;
+ALTER TABLE assistant_conversation_recordings DROP COLUMN IF EXISTS duration;
+ALTER TABLE assistant_conversation_recordings DROP COLUMN IF EXISTS size;
+ALTER TABLE assistant_conversation_recordings DROP COLUMN IF EXISTS interruptions;
@@ -0,0 +1,6 @@
+ALTER TABLE assistant_conversation_recordings ADD COLUMN IF NOT EXISTS codec VARCHAR(20);
+ALTER TABLE assistant_conversation_recordings ADD COLUMN IF NOT EXISTS channels INT;
+ALTER TABLE assistant_conversation_recordings ADD COLUMN IF NOT EXISTS sample_rate INT;
+ALTER TABLE assistant_conversation_recordings ADD COLUMN IF NOT EXISTS duration BIGINT;
+ALTER TABLE assistant_conversation_recordings ADD COLUMN IF NOT EXISTS size BIGINT;
+ALTER TABLE assistant_conversation_recordings ADD COLUMN IF NOT EXISTS interruptions JSONB;
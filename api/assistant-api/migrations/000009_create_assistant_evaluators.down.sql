@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS public.assistant_conversation_evaluations;
+DROP TABLE IF EXISTS public.assistant_evaluator_options;
+DROP TABLE IF EXISTS public.assistant_evaluators;
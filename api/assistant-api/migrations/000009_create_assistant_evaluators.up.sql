@@ -0,0 +1,50 @@
+CREATE TABLE IF NOT EXISTS public.assistant_evaluators (
+    id bigint PRIMARY KEY,
+    status character varying(50) DEFAULT 'ACTIVE'::character varying NOT NULL,
+    created_by bigint NOT NULL,
+    updated_by bigint,
+    created_date timestamp without time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    updated_date timestamp without time zone,
+    assistant_id bigint NOT NULL,
+    type character varying(50) NOT NULL,
+    name character varying(200) NOT NULL,
+    description text
+);
+CREATE INDEX IF NOT EXISTS idx_assistant_evaluators_on_assistant_id_and_status ON public.assistant_evaluators USING btree (assistant_id, status);
+
+CREATE TABLE IF NOT EXISTS public.assistant_evaluator_options (
+    id bigint PRIMARY KEY,
+    status character varying(50) DEFAULT 'ACTIVE'::character varying NOT NULL,
+    created_by bigint NOT NULL,
+    updated_by bigint,
+    created_date timestamp without time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    updated_date timestamp without time zone,
+    key character varying(200) NOT NULL,
+    value text NOT NULL,
+    assistant_evaluator_id bigint NOT NULL
+);
+ALTER TABLE ONLY public.assistant_evaluator_options
+    ADD CONSTRAINT uk_assistant_evaluator_id UNIQUE (key, assistant_evaluator_id);
+CREATE INDEX IF NOT EXISTS idx_assistant_evaluator_options_assistant_evaluator_id ON public.assistant_evaluator_options USING btree (assistant_evaluator_id);
+
+CREATE TABLE IF NOT EXISTS public.assistant_conversation_evaluations (
+    id bigint PRIMARY KEY,
+    status character varying(50) DEFAULT 'ACTIVE'::character varying NOT NULL,
+    created_by bigint,
+    updated_by bigint,
+    created_date timestamp with time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    updated_date timestamp with time zone,
+    project_id bigint NOT NULL,
+    organization_id bigint NOT NULL,
+    assistant_id bigint NOT NULL,
+    assistant_provider_model_id bigint NOT NULL,
+    assistant_conversation_id bigint NOT NULL,
+    assistant_evaluator_id bigint NOT NULL,
+    name character varying(200) NOT NULL,
+    type character varying(50) NOT NULL,
+    score double precision DEFAULT 0 NOT NULL,
+    passed boolean DEFAULT false NOT NULL,
+    reason text
+);
+CREATE INDEX IF NOT EXISTS idx_assistant_conversation_evaluations_on_assistant_conversation_id ON public.assistant_conversation_evaluations USING btree (assistant_conversation_id);
+CREATE INDEX IF NOT EXISTS idx_assistant_conversation_evaluations_on_assistant_id_and_created_date ON public.assistant_conversation_evaluations USING btree (assistant_id, created_date);
@@ -0,0 +1,50 @@
+CREATE TABLE IF NOT EXISTS public.assistant_campaigns (
+    id bigint PRIMARY KEY,
+    status character varying(50) DEFAULT 'ACTIVE'::character varying NOT NULL,
+    created_by bigint NOT NULL,
+    updated_by bigint,
+    created_date timestamp without time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    updated_date timestamp without time zone,
+    project_id bigint NOT NULL,
+    organization_id bigint NOT NULL,
+    name character varying(200) NOT NULL,
+    assistant_id bigint NOT NULL,
+    assistant_version character varying(50),
+    from_number character varying(50),
+    state character varying(50) NOT NULL,
+    concurrency integer DEFAULT 1 NOT NULL,
+    calls_per_second double precision DEFAULT 1 NOT NULL,
+    timezone character varying(100),
+    calling_hour_start character varying(5),
+    calling_hour_end character varying(5),
+    start_date timestamp without time zone,
+    max_attempts integer DEFAULT 1 NOT NULL,
+    retry_delay_second integer DEFAULT 0 NOT NULL,
+    retry_outcomes text,
+    credential_token text NOT NULL,
+    last_dial_date timestamp without time zone
+);
+CREATE INDEX IF NOT EXISTS idx_assistant_campaigns_on_assistant_id_and_status ON public.assistant_campaigns USING btree (assistant_id, status);
+CREATE INDEX IF NOT EXISTS idx_assistant_campaigns_on_state ON public.assistant_campaigns USING btree (state, status);
+
+CREATE TABLE IF NOT EXISTS public.assistant_campaign_contacts (
+    id bigint PRIMARY KEY,
+    status character varying(50) DEFAULT 'ACTIVE'::character varying NOT NULL,
+    created_by bigint NOT NULL,
+    updated_by bigint,
+    created_date timestamp without time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    updated_date timestamp without time zone,
+    assistant_campaign_id bigint NOT NULL,
+    to_number character varying(50) NOT NULL,
+    arguments jsonb,
+    metadata jsonb,
+    options jsonb,
+    timezone character varying(100),
+    state character varying(50) NOT NULL,
+    attempts integer DEFAULT 0 NOT NULL,
+    next_attempt_date timestamp without time zone,
+    assistant_conversation_id bigint,
+    last_outcome character varying(50),
+    last_error text
+);
+CREATE INDEX IF NOT EXISTS idx_assistant_campaign_contacts_dialing ON public.assistant_campaign_contacts USING btree (assistant_campaign_id, state, next_attempt_date);
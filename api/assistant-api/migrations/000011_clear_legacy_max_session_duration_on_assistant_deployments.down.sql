@@ -0,0 +1,2 @@
+-- cleared values were never enforced, nothing to restore
+SELECT 1;
@@ -0,0 +1,7 @@
+-- max session duration is in milliseconds and enforced by the supervisor, values below a minute are
+-- left from the earlier 5000-15000ms slider which was never enforced and would end sessions in seconds
+UPDATE assistant_api_deployments SET max_session_duration = NULL WHERE max_session_duration < 60000;
+UPDATE assistant_debugger_deployments SET max_session_duration = NULL WHERE max_session_duration < 60000;
+UPDATE assistant_phone_deployments SET max_session_duration = NULL WHERE max_session_duration < 60000;
+UPDATE assistant_web_plugin_deployments SET max_session_duration = NULL WHERE max_session_duration < 60000;
+UPDATE assistant_whatsapp_deployments SET max_session_duration = NULL WHERE max_session_duration < 60000;
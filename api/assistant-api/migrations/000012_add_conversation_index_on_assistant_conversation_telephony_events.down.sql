@@ -0,0 +1 @@
+DROP INDEX IF EXISTS public.idx_assistant_conversation_telephony_events_on_conversation_id;
@@ -0,0 +1 @@
+CREATE INDEX IF NOT EXISTS idx_assistant_conversation_telephony_events_on_conversation_id ON public.assistant_conversation_telephony_events USING btree (assistant_conversation_id);
@@ -1,11 +1,17 @@
 package assistant_router
 
 import (
+	"context"
+
 	"github.com/gin-gonic/gin"
 	assistantApi "github.com/rapidaai/api/assistant-api/api/assistant"
 	assistantDeploymentApi "github.com/rapidaai/api/assistant-api/api/assistant-deployment"
 	assistantTalkApi "github.com/rapidaai/api/assistant-api/api/talk"
 	"github.com/rapidaai/api/assistant-api/config"
+	internal_adapter_request_generic "github.com/rapidaai/api/assistant-api/internal/adapters/generic"
+	internal_campaign "github.com/rapidaai/api/assistant-api/internal/campaign"
+	internal_webhook "github.com/rapidaai/api/assistant-api/internal/webhook"
+	internal_session_whatsapp "github.com/rapidaai/api/assistant-api/internal/whatsapp/session"
 	"github.com/rapidaai/pkg/commons"
 	"github.com/rapidaai/pkg/connectors"
 	workflow_api "github.com/rapidaai/protos"
@@ -30,6 +36,52 @@ func AssistantApiRoute(
 		))
 }
 
+// AssistantWebhookWorker starts delivering queued webhooks and returns the closer of the worker.
+func AssistantWebhookWorker(
+	ctx context.Context,
+	Cfg *config.AssistantConfig,
+	Logger commons.Logger,
+	Postgres connectors.PostgresConnector,
+	Redis connectors.RedisConnector,
+) func(context.Context) error {
+	worker := internal_webhook.NewDeliveryWorker(Cfg, Logger, Postgres, Redis)
+	worker.Start(ctx)
+	return worker.Stop
+}
+
+// AssistantTraceExporter returns the closer of the trace exporters shared by the sessions.
+func AssistantTraceExporter() func(context.Context) error {
+	return internal_adapter_request_generic.ShutdownTraceExporters
+}
+
+// AssistantCampaignWorker starts dialing the running campaigns and returns the closer of the worker.
+func AssistantCampaignWorker(
+	ctx context.Context,
+	Cfg *config.AssistantConfig,
+	Logger commons.Logger,
+	Postgres connectors.PostgresConnector,
+	Redis connectors.RedisConnector,
+	Opensearch connectors.OpenSearchConnector,
+) func(context.Context) error {
+	worker := internal_campaign.NewDialingWorker(Cfg, Logger, Postgres, Redis, Opensearch)
+	worker.Start(ctx)
+	return worker.Stop
+}
+
+// AssistantWhatsappWorker starts ending the expired whatsapp conversations and returns the closer of the worker.
+func AssistantWhatsappWorker(
+	ctx context.Context,
+	Cfg *config.AssistantConfig,
+	Logger commons.Logger,
+	Postgres connectors.PostgresConnector,
+	Redis connectors.RedisConnector,
+	Opensearch connectors.OpenSearchConnector,
+) func(context.Context) error {
+	worker := internal_session_whatsapp.NewSessionWorker(Cfg, Logger, Postgres, Redis, Opensearch)
+	worker.Start(ctx)
+	return worker.Stop
+}
+
 func AssistantDeploymentApiRoute(Cfg *config.AssistantConfig,
 	S *grpc.Server,
 	Logger commons.Logger,
@@ -83,8 +135,15 @@ func TalkCallbackApiRoute(
 		apiv1.GET("/:telephony/prj/event/:assistantId/:conversationId/:x-api-key", talkRpcApi.Callback)
 		apiv1.POST("/:telephony/prj/event/:assistantId/:conversationId/:x-api-key", talkRpcApi.Callback)
 
-		// twilio whatsapp
-		apiv1.POST("/twilio/whatsapp/:assistantToken", talkRpcApi.WhatsappReciever)
+		// transfer instruction of call
+		apiv1.GET("/:telephony/usr/transfer/:assistantId/:conversationId/:authorization/:x-auth-id/:x-project-id", talkRpcApi.TransferInstruction)
+		apiv1.POST("/:telephony/usr/transfer/:assistantId/:conversationId/:authorization/:x-auth-id/:x-project-id", talkRpcApi.TransferInstruction)
+		apiv1.GET("/:telephony/prj/transfer/:assistantId/:conversationId/:x-api-key", talkRpcApi.TransferInstruction)
+		apiv1.POST("/:telephony/prj/transfer/:assistantId/:conversationId/:x-api-key", talkRpcApi.TransferInstruction)
+
+		// whatsapp
+		apiv1.GET("/:telephony/whatsapp/:assistantId/:x-api-key", talkRpcApi.WhatsappVerifier)
+		apiv1.POST("/:telephony/whatsapp/:assistantId/:x-api-key", talkRpcApi.WhatsappReciever)
 
 		// vonage call
 		apiv1.GET("/:telephony/call/:assistantId", talkRpcApi.CallReciever)
@@ -30,7 +30,9 @@ func (endpointGRPCApi *endpointGRPCApi) CreateEndpointCacheConfiguration(ctx con
 		eRequest.GetEndpointId(),
 		internal_gorm.Cache(eRequest.GetData().GetCacheType()),
 		eRequest.GetData().GetExpiryInterval(),
-		eRequest.GetData().GetMatchThreshold())
+		eRequest.GetData().GetMatchThreshold(),
+		eRequest.GetData().GetEmbeddingModelProviderName(),
+		eRequest.GetData().GetEmbeddingModelOptions())
 	if err != nil {
 		return utils.Error[endpoint_grpc_api.CreateEndpointCacheConfigurationResponse](
 			err,
@@ -0,0 +1,70 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package endpoint_api
+
+import (
+	"context"
+	"errors"
+
+	internal_gorm "github.com/rapidaai/api/endpoint-api/internal/entity"
+	"github.com/rapidaai/pkg/types"
+	"github.com/rapidaai/pkg/utils"
+	endpoint_grpc_api "github.com/rapidaai/protos"
+)
+
+func (endpointGRPCApi *endpointGRPCApi) CreateEndpointResponseSchemaConfiguration(ctx context.Context, eRequest *endpoint_grpc_api.CreateEndpointResponseSchemaConfigurationRequest) (*endpoint_grpc_api.CreateEndpointResponseSchemaConfigurationResponse, error) {
+	iAuth, isAuthenticated := types.GetSimplePrincipleGRPC(ctx)
+	if !isAuthenticated {
+		endpointGRPCApi.logger.Errorf("unauthenticated request for CreateEndpointResponseSchemaConfiguration")
+		return utils.Error[endpoint_grpc_api.CreateEndpointResponseSchemaConfigurationResponse](
+			errors.New("unauthenticated request for CreateEndpointResponseSchemaConfiguration"),
+			"Please provide valid service credentials to perform invoke, read docs @ docs.rapida.ai",
+		)
+	}
+
+	ers, err := endpointGRPCApi.configureResponseSchema(ctx, iAuth, eRequest.GetEndpointId(), eRequest.GetData())
+	if err != nil {
+		return utils.Error[endpoint_grpc_api.CreateEndpointResponseSchemaConfigurationResponse](
+			err,
+			"Unable to configure endpoint response schema, please check the schema and try again",
+		)
+	}
+
+	out := &endpoint_grpc_api.EndpointResponseSchemaConfiguration{}
+	err = utils.Cast(ers, out)
+	if err != nil {
+		endpointGRPCApi.logger.Errorf("unable to cast the endpoint response schema configuration to the response object")
+	}
+
+	return utils.Success[endpoint_grpc_api.CreateEndpointResponseSchemaConfigurationResponse, *endpoint_grpc_api.EndpointResponseSchemaConfiguration](out)
+}
+
+// configureResponseSchema validates the json schema of the configuration before it is configured for the endpoint
+func (endpointGRPCApi *endpointGRPCApi) configureResponseSchema(ctx context.Context,
+	auth types.SimplePrinciple,
+	endpointId uint64,
+	configuration *endpoint_grpc_api.EndpointResponseSchemaConfiguration,
+) (*internal_gorm.EndpointResponseSchema, error) {
+	responseSchema := &internal_gorm.EndpointResponseSchema{Schema: configuration.GetSchema()}
+	schema, err := responseSchema.GetSchema()
+	if err != nil {
+		return nil, err
+	}
+	if schema != nil && schema["type"] != "object" {
+		return nil, errors.New("response schema should be of type object")
+	}
+	if schema != nil && configuration.GetName() == "" {
+		return nil, errors.New("name of the response schema is required")
+	}
+	return endpointGRPCApi.endpointService.ConfigureEndpointResponseSchema(ctx,
+		auth,
+		endpointId,
+		configuration.GetName(),
+		configuration.GetSchema(),
+		configuration.GetStrict(),
+		configuration.GetMaxRepairAttempts(),
+	)
+}
@@ -33,6 +33,7 @@ func (endpointGRPCApi *endpointGRPCApi) CreateEndpointRetryConfiguration(ctx con
 		eRequest.GetData().GetDelaySeconds(),
 		eRequest.GetData().GetExponentialBackoff(),
 		eRequest.GetData().GetRetryables(),
+		eRequest.GetData().GetFailoverEndpointProviderModelId(),
 	)
 	if err != nil {
 		return utils.Error[endpoint_grpc_api.CreateEndpointRetryConfigurationResponse](
@@ -0,0 +1,63 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package endpoint_api
+
+import (
+	"context"
+	"errors"
+
+	internal_services "github.com/rapidaai/api/endpoint-api/internal/service"
+	"github.com/rapidaai/pkg/types"
+	"github.com/rapidaai/pkg/utils"
+	endpoint_grpc_api "github.com/rapidaai/protos"
+)
+
+func (endpointGRPCApi *endpointGRPCApi) CreateEndpointTrafficSplit(ctx context.Context, eRequest *endpoint_grpc_api.CreateEndpointTrafficSplitRequest) (*endpoint_grpc_api.CreateEndpointTrafficSplitResponse, error) {
+	iAuth, isAuthenticated := types.GetSimplePrincipleGRPC(ctx)
+	if !isAuthenticated || !iAuth.HasProject() {
+		endpointGRPCApi.logger.Errorf("unauthenticated request for create endpoint traffic split")
+		return utils.Error[endpoint_grpc_api.CreateEndpointTrafficSplitResponse](
+			errors.New("unauthenticated request for create endpoint traffic split"),
+			"Please provide valid service credentials to perform invoke, read docs @ docs.rapida.ai",
+		)
+	}
+
+	var total uint32
+	for _, split := range eRequest.GetSplits() {
+		total += split.GetWeight()
+	}
+	if len(eRequest.GetSplits()) > 0 && total == 0 {
+		return utils.ErrorWithCode[endpoint_grpc_api.CreateEndpointTrafficSplitResponse](
+			400,
+			errors.New("traffic split without weight"),
+			"Please provide the weight of the versions of the traffic split.",
+		)
+	}
+
+	// the endpoint is only accessible to its project
+	_, err := endpointGRPCApi.endpointService.Get(ctx, iAuth, eRequest.GetEndpointId(), nil, internal_services.NewGetEndpointOption())
+	if err != nil {
+		return utils.Error[endpoint_grpc_api.CreateEndpointTrafficSplitResponse](
+			err,
+			"Unable to get the endpoint for given endpoint id.",
+		)
+	}
+
+	splits, err := endpointGRPCApi.endpointService.ConfigureEndpointTrafficSplit(ctx, iAuth, eRequest.GetEndpointId(), eRequest.GetSplits())
+	if err != nil {
+		return utils.Error[endpoint_grpc_api.CreateEndpointTrafficSplitResponse](
+			err,
+			"Unable to configure endpoint traffic split, please check the versions and try again",
+		)
+	}
+
+	out := make([]*endpoint_grpc_api.EndpointTrafficSplit, 0, len(splits))
+	err = utils.Cast(splits, &out)
+	if err != nil {
+		endpointGRPCApi.logger.Errorf("unable to cast the endpoint traffic split to the response object")
+	}
+	return utils.Success[endpoint_grpc_api.CreateEndpointTrafficSplitResponse, []*endpoint_grpc_api.EndpointTrafficSplit](out)
+}
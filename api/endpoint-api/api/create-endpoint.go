@@ -64,6 +64,7 @@ func (endpointGRPCApi *endpointGRPCApi) CreateEndpoint(ctx context.Context, cer
 			cer.GetRetryConfiguration().GetDelaySeconds(),
 			cer.GetRetryConfiguration().GetExponentialBackoff(),
 			cer.GetRetryConfiguration().GetRetryables(),
+			cer.GetRetryConfiguration().GetFailoverEndpointProviderModelId(),
 		)
 		if err != nil {
 			return utils.Error[protos.CreateEndpointResponse](
@@ -79,7 +80,9 @@ func (endpointGRPCApi *endpointGRPCApi) CreateEndpoint(ctx context.Context, cer
 			endpoint.Id,
 			internal_gorm.Cache(cer.GetCacheConfiguration().GetCacheType()),
 			cer.GetCacheConfiguration().GetExpiryInterval(),
-			cer.GetCacheConfiguration().GetMatchThreshold())
+			cer.GetCacheConfiguration().GetMatchThreshold(),
+			cer.GetCacheConfiguration().GetEmbeddingModelProviderName(),
+			cer.GetCacheConfiguration().GetEmbeddingModelOptions())
 		if err != nil {
 			return utils.Error[protos.CreateEndpointResponse](
 				errors.New("unauthenticated request for CreateEndpointProviderModel"),
@@ -88,6 +91,15 @@ func (endpointGRPCApi *endpointGRPCApi) CreateEndpoint(ctx context.Context, cer
 
 		}
 	}
+	if cer.GetResponseSchemaConfiguration() != nil {
+		_, err = endpointGRPCApi.configureResponseSchema(ctx, iAuth, endpoint.Id, cer.GetResponseSchemaConfiguration())
+		if err != nil {
+			return utils.Error[protos.CreateEndpointResponse](
+				err,
+				"Unable to configure endpoint response schema, please check the schema and try again",
+			)
+		}
+	}
 	_, err = endpointGRPCApi.endpointService.CreateOrUpdateEndpointTag(ctx, iAuth, endpoint.Id, cer.GetTags())
 	if err != nil {
 		return utils.Error[protos.CreateEndpointResponse](
@@ -8,6 +8,7 @@ package endpoint_api
 import (
 	"github.com/rapidaai/api/endpoint-api/config"
 	internal_services "github.com/rapidaai/api/endpoint-api/internal/service"
+	internal_cache_service "github.com/rapidaai/api/endpoint-api/internal/service/cache"
 	internal_endpoint_service "github.com/rapidaai/api/endpoint-api/internal/service/endpoint"
 	internal_log_service "github.com/rapidaai/api/endpoint-api/internal/service/log"
 	"github.com/rapidaai/pkg/commons"
@@ -21,6 +22,8 @@ type endpointApi struct {
 	postgres           connectors.PostgresConnector
 	endpointService    internal_services.EndpointService
 	endpointLogService internal_services.EndpointLogService
+
+	endpointCacheService internal_services.EndpointCacheService
 }
 
 type endpointGRPCApi struct {
@@ -38,6 +41,8 @@ func NewEndpointGRPCApi(config *config.EndpointConfig, logger commons.Logger,
 			postgres:           postgres,
 			endpointService:    internal_endpoint_service.NewEndpointService(config, logger, postgres),
 			endpointLogService: internal_log_service.NewEndpointLogService(logger, postgres),
+
+			endpointCacheService: internal_cache_service.NewEndpointCacheService(config, logger, redis),
 		},
 	}
 }
@@ -0,0 +1,63 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package endpoint_api
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	internal_services "github.com/rapidaai/api/endpoint-api/internal/service"
+	"github.com/rapidaai/pkg/types"
+	"github.com/rapidaai/pkg/utils"
+	endpoint_grpc_api "github.com/rapidaai/protos"
+)
+
+// GetEndpointExperiment reports the latency, cost, error rate and feedback of every version of the endpoint
+// invoked in the period, with the weight of the version in the traffic split so the winner can be promoted
+func (endpointGRPCApi *endpointGRPCApi) GetEndpointExperiment(ctx context.Context, eRequest *endpoint_grpc_api.GetEndpointExperimentRequest) (*endpoint_grpc_api.GetEndpointExperimentResponse, error) {
+	iAuth, isAuthenticated := types.GetSimplePrincipleGRPC(ctx)
+	if !isAuthenticated || !iAuth.HasProject() {
+		endpointGRPCApi.logger.Errorf("unauthenticated request for get endpoint experiment")
+		return utils.Error[endpoint_grpc_api.GetEndpointExperimentResponse](
+			errors.New("unauthenticated request for get endpoint experiment"),
+			"Please provide valid service credentials to perform invoke, read docs @ docs.rapida.ai",
+		)
+	}
+
+	endpoint, err := endpointGRPCApi.endpointService.Get(ctx, iAuth, eRequest.GetEndpointId(), nil, &internal_services.GetEndpointOption{InjectTrafficSplit: true})
+	if err != nil {
+		return utils.Error[endpoint_grpc_api.GetEndpointExperimentResponse](
+			err,
+			"Unable to get the endpoint for given endpoint id.",
+		)
+	}
+
+	from := time.Now().AddDate(0, 0, -7)
+	if eRequest.GetFrom() != nil {
+		from = eRequest.GetFrom().AsTime()
+	}
+	variants, err := endpointGRPCApi.endpointLogService.GetEndpointVariantAnalytics(ctx, iAuth, endpoint.Id, from, eRequest.GetFeedbackMetrics())
+	if err != nil {
+		return utils.Error[endpoint_grpc_api.GetEndpointExperimentResponse](
+			err,
+			"Unable to get the analytics of the endpoint versions, please try again later",
+		)
+	}
+
+	// versions of the split without any invoke are reported as well
+	for _, split := range endpoint.EndpointTrafficSplits {
+		idx := utils.IndexFunc(variants, func(v *endpoint_grpc_api.EndpointVariantAnalytics) bool {
+			return v.GetEndpointProviderModelId() == split.EndpointProviderModelId
+		})
+		if idx < 0 {
+			variants = append(variants, &endpoint_grpc_api.EndpointVariantAnalytics{EndpointProviderModelId: split.EndpointProviderModelId})
+			idx = len(variants) - 1
+		}
+		variants[idx].Weight = split.Weight
+	}
+	return utils.Success[endpoint_grpc_api.GetEndpointExperimentResponse, []*endpoint_grpc_api.EndpointVariantAnalytics](variants)
+}
@@ -0,0 +1,63 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package endpoint_api
+
+import (
+	"context"
+	"fmt"
+
+	internal_gorm "github.com/rapidaai/api/endpoint-api/internal/entity"
+	internal_services "github.com/rapidaai/api/endpoint-api/internal/service"
+	"github.com/rapidaai/pkg/types"
+	invoker_api "github.com/rapidaai/protos"
+)
+
+// metrics of the cache recorded on the endpoint log and returned with the response
+const (
+	CACHE_HIT_METRIC        = internal_services.CACHE_HIT_METRIC
+	CACHE_SIMILARITY_METRIC = "CACHE_SIMILARITY"
+)
+
+// lookup returns the lookup of the request in the cache of the endpoint, nil when caching is not enabled
+// or the cache is not reachable as the invoke should not fail because of the cache
+func (invokeApi *invokerGRPCApi) lookup(ctx context.Context,
+	auth types.SimplePrinciple,
+	endpoint *internal_gorm.Endpoint,
+	request *invoker_api.ChatRequest,
+) *internal_services.EndpointCacheLookup {
+	if !endpoint.CacheEnable || endpoint.EndpointCaching == nil || endpoint.EndpointCaching.CacheType == internal_gorm.NEVER_CACHE {
+		return nil
+	}
+	lookup, err := invokeApi.endpointCacheService.Lookup(ctx, auth, endpoint, request)
+	if err != nil {
+		invokeApi.logger.Warnf("unable to lookup the cache of endpoint %d %v", endpoint.Id, err)
+		return nil
+	}
+	return lookup
+}
+
+// cacheMetrics flags if the response of the invoke is served from the cache
+func cacheMetrics(endpoint *internal_gorm.Endpoint, lookup *internal_services.EndpointCacheLookup) []*invoker_api.Metric {
+	if lookup == nil {
+		return nil
+	}
+	if lookup.Response == nil {
+		return []*invoker_api.Metric{{
+			Name:        CACHE_HIT_METRIC,
+			Value:       "false",
+			Description: string(endpoint.EndpointCaching.CacheType),
+		}}
+	}
+	return []*invoker_api.Metric{{
+		Name:        CACHE_HIT_METRIC,
+		Value:       "true",
+		Description: string(endpoint.EndpointCaching.CacheType),
+	}, {
+		Name:        CACHE_SIMILARITY_METRIC,
+		Value:       fmt.Sprintf("%.4f", lookup.Similarity),
+		Description: "Similarity of the prompt with the cached prompt",
+	}}
+}
@@ -9,10 +9,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"time"
 
 	config "github.com/rapidaai/api/endpoint-api/config"
+	internal_gorm "github.com/rapidaai/api/endpoint-api/internal/entity"
 	internal_services "github.com/rapidaai/api/endpoint-api/internal/service"
+	internal_cache_service "github.com/rapidaai/api/endpoint-api/internal/service/cache"
 	internal_endpoint_service "github.com/rapidaai/api/endpoint-api/internal/service/endpoint"
 	internal_log_service "github.com/rapidaai/api/endpoint-api/internal/service/log"
 	integration_client "github.com/rapidaai/pkg/clients/integration"
@@ -24,6 +27,7 @@ import (
 	"github.com/rapidaai/pkg/types"
 	"github.com/rapidaai/pkg/utils"
 	invoker_api "github.com/rapidaai/protos"
+	"google.golang.org/protobuf/types/known/structpb"
 )
 
 type invokerApi struct {
@@ -35,6 +39,8 @@ type invokerApi struct {
 	integrationClient  integration_client.IntegrationServiceClient
 	inputBuilder       integration_client_builders.InputChatBuilder
 	vaultClient        web_client.VaultClient
+
+	endpointCacheService internal_services.EndpointCacheService
 }
 
 type invokerGRPCApi struct {
@@ -54,6 +60,8 @@ func NewInvokerGRPCApi(config *config.EndpointConfig, logger commons.Logger,
 			inputBuilder:       integration_client_builders.NewChatInputBuilder(logger),
 			vaultClient:        web_client.NewVaultClientGRPC(&config.AppConfig, logger, redis),
 			endpointLogService: internal_log_service.NewEndpointLogService(logger, postgres),
+
+			endpointCacheService: internal_cache_service.NewEndpointCacheService(config, logger, redis),
 		},
 	}
 }
@@ -66,6 +74,98 @@ func (invokeApi *invokerGRPCApi) Invoke(ctx context.Context, iRequest *invoker_a
 	}
 
 	requestID := gorm_generator.ID()
+	endpoint, request, failed, err := invokeApi.prepare(ctx, iAuth, iRequest, requestID)
+	if err != nil {
+		return failed, err
+	}
+
+	schema, _ := endpoint.EndpointResponseSchema.GetSchema()
+	lookup := invokeApi.lookup(ctx, iAuth, endpoint, request)
+	if lookup != nil && lookup.Response != nil {
+		metrics := cacheMetrics(endpoint, lookup)
+		var structuredOutput *structpb.Struct
+		if schema != nil {
+			structuredOutput, _ = structured(schema, lookup.Response)
+		}
+		utils.Go(context.Background(), func() {
+			invokeApi.endpointLogService.UpdateEndpointLog(
+				context.Background(),
+				iAuth,
+				requestID,
+				metrics,
+				uint64(time.Since(start)),
+			)
+		})
+		return &invoker_api.InvokeResponse{
+			RequestId: requestID,
+			Code:      200,
+			Success:   true,
+			TimeTaken: uint64(time.Since(start).Microseconds()),
+			Data:      lookup.Response.GetData().GetContents(),
+			Metrics:   metrics,
+			Output:    structuredOutput,
+		}, nil
+	}
+
+	output, code, attempts, err := invokeApi.invoke(ctx, iAuth, endpoint, request, iRequest)
+	var (
+		structuredOutput *structpb.Struct
+		repairs          []*invoker_api.Metric
+	)
+	if err == nil {
+		structuredOutput, output, repairs, err = invokeApi.structure(ctx, iAuth, endpoint, request, output)
+		if err != nil {
+			code = 422
+		}
+	}
+	cached := cacheMetrics(endpoint, lookup)
+	if err != nil {
+		attempts = failedStatus(output, attempts)
+	}
+	utils.Go(context.Background(), func() {
+		invokeApi.endpointLogService.UpdateEndpointLog(
+			context.Background(),
+			iAuth,
+			requestID,
+			slices.Concat(output.GetMetrics(), attempts, repairs, cached),
+			uint64(time.Since(start)),
+		)
+	})
+	if err != nil {
+		return utils.ErrorWithCode[invoker_api.InvokeResponse](int32(code), err, "Unable to execute the endpoint, please check and try again.")
+	}
+	// a response which does not match the schema is not cached, the next invoke gets another chance
+	if lookup != nil && (schema == nil || structuredOutput != nil) {
+		utils.Go(context.Background(), func() {
+			if err := invokeApi.endpointCacheService.Store(context.Background(), endpoint, lookup, output); err != nil {
+				invokeApi.logger.Warnf("unable to cache the response of endpoint %d %v", endpoint.Id, err)
+			}
+		})
+	}
+
+	return &invoker_api.InvokeResponse{
+		RequestId: requestID,
+		Code:      200,
+		Success:   true,
+		TimeTaken: uint64(time.Since(start).Microseconds()),
+		Data:      output.GetData().GetContents(),
+		Metrics:   slices.Concat(output.GetMetrics(), repairs, cached),
+		Output:    structuredOutput,
+	}, nil
+
+}
+
+// prepare resolves the endpoint version of the request, records the endpoint log and builds the chat request
+// with the arguments templated into the prompt of the endpoint
+func (invokeApi *invokerGRPCApi) prepare(ctx context.Context,
+	iAuth types.SimplePrinciple,
+	iRequest *invoker_api.InvokeRequest,
+	requestID uint64,
+) (*internal_gorm.Endpoint, *invoker_api.ChatRequest, *invoker_api.InvokeResponse, error) {
+	fail := func(code int32, err error, humanMessage string) (*internal_gorm.Endpoint, *invoker_api.ChatRequest, *invoker_api.InvokeResponse, error) {
+		failed, err := utils.ErrorWithCode[invoker_api.InvokeResponse](code, err, humanMessage)
+		return nil, nil, failed, err
+	}
 	clientSource, ok := utils.GetClientSource(ctx)
 	if !ok {
 		clientSource = utils.SDK
@@ -73,117 +173,62 @@ func (invokeApi *invokerGRPCApi) Invoke(ctx context.Context, iRequest *invoker_a
 
 	arguments, err := utils.AnyMapToInterfaceMap(iRequest.GetArgs())
 	if err != nil {
-		return utils.ErrorWithCode[invoker_api.InvokeResponse](400, err, "Please check and provide a valid arguments.")
+		return fail(400, err, "Please check and provide a valid arguments.")
 	}
 	mtds, err := utils.AnyMapToInterfaceMap(iRequest.GetMetadata())
 	if err != nil {
-		return utils.ErrorWithCode[invoker_api.InvokeResponse](400, err, "Please check and provide a valid metadata.")
+		return fail(400, err, "Please check and provide a valid metadata.")
 	}
 	opts, err := utils.AnyMapToInterfaceMap(iRequest.GetOptions())
 	if err != nil {
-		return utils.ErrorWithCode[invoker_api.InvokeResponse](400, err, "Please check and provide a valid options.")
+		return fail(400, err, "Please check and provide a valid options.")
 	}
 
+	version := utils.GetVersionDefinition(iRequest.GetEndpoint().GetVersion())
+	option := &internal_services.GetEndpointOption{
+		InjectRetry:          true,
+		InjectCaching:        true,
+		InjectResponseSchema: true,
+		InjectTrafficSplit:   version == nil,
+	}
 	endpoint, err := invokeApi.endpointService.Get(ctx,
 		iAuth,
 		iRequest.GetEndpoint().GetEndpointId(),
-		utils.GetVersionDefinition(iRequest.GetEndpoint().GetVersion()),
-		internal_services.NewGetEndpointOption())
+		version,
+		option)
 
 	if err != nil {
-		return utils.ErrorWithCode[invoker_api.InvokeResponse](400, err, "Please check endpoint configuration and try again.")
+		return fail(400, err, "Please check endpoint configuration and try again.")
 	}
 
-	utils.Go(ctx, func() {
-		invokeApi.endpointLogService.CreateEndpointLog(
-			ctx,
-			iAuth,
-			clientSource,
-			endpoint.Id,
-			endpoint.EndpointProviderModelId,
-			requestID,
-			arguments, mtds, opts,
-		)
-	})
-
-	credentialID, err := endpoint.
-		EndpointProviderModel.
-		GetOptions().GetUint64("rapida.credential_id")
-	if err != nil {
-		return utils.ErrorWithCode[invoker_api.InvokeResponse](400, errors.New("rapida.credential_id not found in model options"), "Please check endpoint configuration and try again.")
-	}
-	vlt, err := invokeApi.vaultClient.GetCredential(ctx, iAuth, credentialID)
-	if err != nil {
-		return utils.ErrorWithCode[invoker_api.InvokeResponse](400, err, "Please check credential for provider and update it.")
+	// the traffic split applies only when the endpoint is invoked without a version
+	if variant := endpoint.Variant(iRequest.GetAssignmentKey()); variant != nil && *variant != endpoint.EndpointProviderModel.Id {
+		option.InjectTrafficSplit = false
+		endpoint, err = invokeApi.endpointService.Get(ctx, iAuth, endpoint.Id, variant, option)
+		if err != nil {
+			return fail(400, err, "Please check traffic split of the endpoint and try again.")
+		}
 	}
 
-	output, err := invokeApi.
-		integrationClient.
-		Chat(ctx,
-			iAuth,
-			endpoint.
-				EndpointProviderModel.
-				ModelProviderName,
-			invokeApi.
-				inputBuilder.
-				Chat(
-					&invoker_api.Credential{
-						Id:    vlt.GetId(),
-						Value: vlt.GetValue(),
-					},
-					invokeApi.
-						inputBuilder.
-						Options(
-							endpoint.
-								EndpointProviderModel.
-								GetOptions(),
-							iRequest.
-								GetOptions(),
-						),
-					nil,
-					map[string]string{
-						"endpoint_id":                fmt.Sprintf("%d", endpoint.Id),
-						"vault_id":                   fmt.Sprintf("%d", vlt.Id),
-						"endpoint_provider_model_id": fmt.Sprintf("%d", endpoint.EndpointProviderModel.Id),
-					},
-					invokeApi.
-						inputBuilder.
-						Message(
-							endpoint.
-								EndpointProviderModel.
-								Request.
-								GetTextChatCompleteTemplate().
-								Prompt,
-							invokeApi.
-								inputBuilder.
-								Arguments(endpoint.
-									EndpointProviderModel.
-									Request.
-									GetTextChatCompleteTemplate().Variables, iRequest.GetArgs()),
-						)...,
-				))
+	// the log records the version which is invoked for the analytics of the traffic split, it is created
+	// before the invoke so the update of a fast response never creates the log without its version
+	if _, err := invokeApi.endpointLogService.CreateEndpointLog(
+		context.WithoutCancel(ctx),
+		iAuth,
+		clientSource,
+		endpoint.Id,
+		endpoint.EndpointProviderModel.Id,
+		requestID,
+		arguments, mtds, opts,
+	); err != nil {
+		invokeApi.logger.Errorf("unable to create endpoint log %d: %v", requestID, err)
+	}
 
-	utils.Go(context.Background(), func() {
-		invokeApi.endpointLogService.UpdateEndpointLog(
-			context.Background(),
-			iAuth,
-			requestID,
-			output.GetMetrics(),
-			uint64(time.Since(start)),
-		)
-	})
+	request, err := invokeApi.chatRequest(ctx, iAuth, endpoint, iRequest)
 	if err != nil {
-		return utils.ErrorWithCode[invoker_api.InvokeResponse](400, err, "Unable to execute the endpoint, please check and try again.")
+		return fail(400, err, "Please check credential for provider and update it.")
 	}
-
-	return &invoker_api.InvokeResponse{
-		RequestId: requestID,
-		Code:      200,
-		Success:   true,
-		TimeTaken: uint64(time.Since(start).Microseconds()),
-		Data:      output.GetData().GetContents(),
-		Metrics:   output.GetMetrics(),
-	}, nil
+	return endpoint, request, nil, nil
 
 }
 
@@ -195,10 +240,26 @@ func (endpoint *invokerGRPCApi) Probe(ctx context.Context, rpv *invoker_api.Prob
 	return nil, nil
 }
 
+// Update attaches the feedback of the client to the log of the invoke, every key of the metadata is stored
+// as a metric of the log and numeric feedback is aggregated by the version in the experiment of the endpoint
 func (endpoint *invokerGRPCApi) Update(ctx context.Context, ur *invoker_api.UpdateRequest) (*invoker_api.UpdateResponse, error) {
 	iAuth, isAuthenticated := types.GetSimplePrincipleGRPC(ctx)
 	if !isAuthenticated || iAuth.GetCurrentProjectId() == nil {
 		return utils.AuthenticateError[invoker_api.UpdateResponse]()
 	}
-	return nil, nil
+	feedbacks := make([]*types.Metric, 0, len(ur.GetMetadata().GetFields()))
+	for name, value := range ur.GetMetadata().AsMap() {
+		feedbacks = append(feedbacks, &types.Metric{
+			Name:        name,
+			Value:       fmt.Sprintf("%v", value),
+			Description: "Feedback of the client",
+		})
+	}
+	if len(feedbacks) == 0 {
+		return utils.ErrorWithCode[invoker_api.UpdateResponse](400, errors.New("empty feedback"), "Please provide the feedback as metadata.")
+	}
+	if _, err := endpoint.endpointLogService.ApplyFeedback(ctx, iAuth, ur.GetRequestId(), feedbacks); err != nil {
+		return utils.ErrorWithCode[invoker_api.UpdateResponse](400, err, "Unable to attach the feedback, please check the request id.")
+	}
+	return &invoker_api.UpdateResponse{Code: 200, Success: true}, nil
 }
@@ -0,0 +1,254 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package endpoint_api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+
+	internal_gorm "github.com/rapidaai/api/endpoint-api/internal/entity"
+	internal_services "github.com/rapidaai/api/endpoint-api/internal/service"
+	"github.com/rapidaai/pkg/types"
+	type_enums "github.com/rapidaai/pkg/types/enums"
+	invoker_api "github.com/rapidaai/protos"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// metrics of the attempts recorded on the endpoint log
+const (
+	ATTEMPTS_METRIC = "ATTEMPTS"
+	ATTEMPT_METRIC  = "ATTEMPT_%d"
+	FAILOVER_METRIC = "FAILOVER_ENDPOINT_PROVIDER_MODEL_ID"
+)
+
+// status code of the provider leading the error message of the integration, ex: 429 Too Many Requests
+var providerStatus = regexp.MustCompile(`^\s*(\d{3})\b`)
+
+// chatRequest builds the chat request for the provider model of the endpoint with its credential
+func (invokeApi *invokerGRPCApi) chatRequest(ctx context.Context,
+	auth types.SimplePrinciple,
+	endpoint *internal_gorm.Endpoint,
+	iRequest *invoker_api.InvokeRequest,
+) (*invoker_api.ChatRequest, error) {
+	credentialID, err := endpoint.
+		EndpointProviderModel.
+		GetOptions().GetUint64("rapida.credential_id")
+	if err != nil {
+		return nil, errors.New("rapida.credential_id not found in model options")
+	}
+	vlt, err := invokeApi.vaultClient.GetCredential(ctx, auth, credentialID)
+	if err != nil {
+		return nil, err
+	}
+	schema, err := endpoint.EndpointResponseSchema.GetSchema()
+	if err != nil {
+		return nil, err
+	}
+
+	options := endpoint.EndpointProviderModel.GetOptions()
+	conversations := invokeApi.
+		inputBuilder.
+		Message(
+			endpoint.
+				EndpointProviderModel.
+				Request.
+				GetTextChatCompleteTemplate().
+				Prompt,
+			invokeApi.
+				inputBuilder.
+				Arguments(endpoint.
+					EndpointProviderModel.
+					Request.
+					GetTextChatCompleteTemplate().Variables, iRequest.GetArgs()),
+		)
+	if schema != nil {
+		if format := responseFormat(endpoint.EndpointProviderModel.ModelProviderName, endpoint.EndpointResponseSchema, schema); format != nil {
+			options["model.response_format"] = format
+		} else {
+			conversations = append(conversations, textMessage("system", schemaInstruction(endpoint.EndpointResponseSchema)))
+		}
+	}
+	return invokeApi.
+		inputBuilder.
+		Chat(
+			&invoker_api.Credential{
+				Id:    vlt.GetId(),
+				Value: vlt.GetValue(),
+			},
+			invokeApi.
+				inputBuilder.
+				Options(
+					options,
+					iRequest.
+						GetOptions(),
+				),
+			nil,
+			map[string]string{
+				"endpoint_id":                fmt.Sprintf("%d", endpoint.Id),
+				"vault_id":                   fmt.Sprintf("%d", vlt.Id),
+				"endpoint_provider_model_id": fmt.Sprintf("%d", endpoint.EndpointProviderModel.Id),
+			},
+			conversations...,
+		), nil
+}
+
+// invoke executes the chat request on the provider model of the endpoint, retrying as configured for the endpoint
+// and falling back to the failover provider model once the attempts are exhausted.
+// It returns the status code of the last attempt with the metrics of every attempt.
+func (invokeApi *invokerGRPCApi) invoke(ctx context.Context,
+	auth types.SimplePrinciple,
+	endpoint *internal_gorm.Endpoint,
+	request *invoker_api.ChatRequest,
+	iRequest *invoker_api.InvokeRequest,
+) (*invoker_api.ChatResponse, int, []*invoker_api.Metric, error) {
+	retry := endpoint.EndpointRetry
+	if !endpoint.RetryEnable || retry == nil {
+		retry = &internal_gorm.EndpointRetry{RetryType: internal_gorm.NEVER_RETRY}
+	}
+
+	metrics := make([]*invoker_api.Metric, 0, retry.GetMaxAttempts()+2)
+	output, code, err := invokeApi.attempt(ctx, auth, endpoint, request, retry, &metrics)
+	if err != nil && retry.FailoverEndpointProviderModelId != nil && ctx.Err() == nil {
+		failover, ferr := invokeApi.endpointService.Get(ctx,
+			auth,
+			endpoint.Id,
+			retry.FailoverEndpointProviderModelId,
+			internal_services.NewGetEndpointOption())
+		if ferr == nil {
+			// response schema is of the endpoint and not of the provider model
+			failover.EndpointResponseSchema = endpoint.EndpointResponseSchema
+			request, ferr = invokeApi.chatRequest(ctx, auth, failover, iRequest)
+		}
+		if ferr != nil {
+			invokeApi.logger.Errorf("unable to failover endpoint %d to provider model %d %v", endpoint.Id, *retry.FailoverEndpointProviderModelId, ferr)
+		} else {
+			metrics = append(metrics, &invoker_api.Metric{
+				Name:        FAILOVER_METRIC,
+				Value:       fmt.Sprintf("%d", failover.EndpointProviderModel.Id),
+				Description: "Provider model invoked after the attempts are exhausted",
+			})
+			output, code, err = invokeApi.attempt(ctx, auth, failover, request, retry, &metrics)
+		}
+	}
+
+	attempts := 0
+	for _, mtr := range metrics {
+		if mtr.GetName() != FAILOVER_METRIC {
+			attempts++
+		}
+	}
+	metrics = append(metrics, &invoker_api.Metric{
+		Name:        ATTEMPTS_METRIC,
+		Value:       fmt.Sprintf("%d", attempts),
+		Description: "Attempts to execute the endpoint",
+	})
+	return output, code, metrics, err
+}
+
+// attempt executes the chat request until it succeeds, fails with a status which is not retryable
+// or the attempts are exhausted. The backoff between attempts never exceeds the deadline of the request.
+func (invokeApi *invokerGRPCApi) attempt(ctx context.Context,
+	auth types.SimplePrinciple,
+	endpoint *internal_gorm.Endpoint,
+	request *invoker_api.ChatRequest,
+	retry *internal_gorm.EndpointRetry,
+	metrics *[]*invoker_api.Metric,
+) (*invoker_api.ChatResponse, int, error) {
+	for n := uint64(1); ; n++ {
+		start := time.Now()
+		output, err := invokeApi.
+			integrationClient.
+			Chat(ctx, auth, endpoint.EndpointProviderModel.ModelProviderName, request)
+		code := statusCode(output, err)
+		if err == nil && !output.GetSuccess() {
+			err = errors.New(output.GetError().GetErrorMessage())
+		}
+
+		description := fmt.Sprintf("%s in %s", endpoint.EndpointProviderModel.ModelProviderName, time.Since(start))
+		if err != nil {
+			description = fmt.Sprintf("%s, %v", description, err)
+		}
+		*metrics = append(*metrics, &invoker_api.Metric{
+			Name:        fmt.Sprintf(ATTEMPT_METRIC, len(*metrics)+1),
+			Value:       strconv.Itoa(code),
+			Description: description,
+		})
+
+		if err == nil || n >= retry.GetMaxAttempts() || !retry.Retryable(code) {
+			return output, code, err
+		}
+		delay := retry.Backoff(n)
+		if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) <= delay {
+			return output, code, err
+		}
+		invokeApi.logger.Warnf("retrying endpoint %d after %s, attempt %d failed with %d %v", endpoint.Id, delay, n, code, err)
+		select {
+		case <-ctx.Done():
+			return output, code, err
+		case <-time.After(delay):
+		}
+	}
+}
+
+// failedStatus records the failure of the invoke when the provider did not respond with its status
+func failedStatus(output *invoker_api.ChatResponse, metrics []*invoker_api.Metric) []*invoker_api.Metric {
+	for _, mtr := range output.GetMetrics() {
+		if mtr.GetName() == type_enums.STATUS.String() {
+			return metrics
+		}
+	}
+	return append(metrics, &invoker_api.Metric{
+		Name:        type_enums.STATUS.String(),
+		Value:       type_enums.RECORD_FAILED.String(),
+		Description: "Status of the invoke",
+	})
+}
+
+// statusCode returns the http status of the attempt, for the integration errors it is the code of the response
+// when set, otherwise the status of the provider leading the error message or the status of the grpc call.
+func statusCode(output *invoker_api.ChatResponse, err error) int {
+	if err != nil {
+		st, _ := status.FromError(err)
+		switch st.Code() {
+		case codes.InvalidArgument, codes.FailedPrecondition, codes.OutOfRange:
+			return 400
+		case codes.Unauthenticated:
+			return 401
+		case codes.PermissionDenied:
+			return 403
+		case codes.NotFound:
+			return 404
+		case codes.Canceled:
+			return 499
+		case codes.ResourceExhausted:
+			return 429
+		case codes.Unimplemented:
+			return 501
+		case codes.Unavailable:
+			return 503
+		case codes.DeadlineExceeded:
+			return 504
+		default:
+			return 500
+		}
+	}
+	if output.GetSuccess() {
+		return 200
+	}
+	if output.GetCode() >= 400 {
+		return int(output.GetCode())
+	}
+	if match := providerStatus.FindStringSubmatch(output.GetError().GetErrorMessage()); match != nil {
+		code, _ := strconv.Atoi(match[1])
+		return code
+	}
+	return 500
+}
@@ -0,0 +1,273 @@
+package endpoint_api
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	internal_gorm "github.com/rapidaai/api/endpoint-api/internal/entity"
+	internal_services "github.com/rapidaai/api/endpoint-api/internal/service"
+	integration_client "github.com/rapidaai/pkg/clients/integration"
+	integration_client_builders "github.com/rapidaai/pkg/clients/integration/builders"
+	web_client "github.com/rapidaai/pkg/clients/web"
+	"github.com/rapidaai/pkg/commons"
+	gorm_model "github.com/rapidaai/pkg/models/gorm"
+	gorm_types "github.com/rapidaai/pkg/models/gorm/types"
+	"github.com/rapidaai/pkg/types"
+	invoker_api "github.com/rapidaai/protos"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// stubChat is the answer of the integration for a chat request
+type stubChat struct {
+	output *invoker_api.ChatResponse
+	err    error
+}
+
+// stubIntegration answers the chat requests with the given answers in order and records the providers invoked
+type stubIntegration struct {
+	integration_client.IntegrationServiceClient
+	mu        sync.Mutex
+	answers   []stubChat
+	providers []string
+	requests  []*invoker_api.ChatRequest
+}
+
+func (c *stubIntegration) Chat(ctx context.Context, auth types.SimplePrinciple, providerName string, request *invoker_api.ChatRequest) (*invoker_api.ChatResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.providers = append(c.providers, providerName)
+	c.requests = append(c.requests, request)
+	answer := c.answers[0]
+	if len(c.answers) > 1 {
+		c.answers = c.answers[1:]
+	}
+	return answer.output, answer.err
+}
+
+// stubEndpointService returns the endpoint of the requested provider model
+type stubEndpointService struct {
+	internal_services.EndpointService
+	endpoints map[uint64]*internal_gorm.Endpoint
+}
+
+func (s *stubEndpointService) Get(ctx context.Context, auth types.SimplePrinciple, endpointId uint64, endpointProviderModelId *uint64, opts *internal_services.GetEndpointOption) (*internal_gorm.Endpoint, error) {
+	id := uint64(0)
+	if endpointProviderModelId != nil {
+		id = *endpointProviderModelId
+	}
+	if endpoint, ok := s.endpoints[id]; ok {
+		return endpoint, nil
+	}
+	return nil, errors.New("endpoint not found")
+}
+
+type stubVault struct {
+	web_client.VaultClient
+}
+
+func (stubVault) GetCredential(ctx context.Context, auth types.SimplePrinciple, vaultId uint64) (*invoker_api.VaultCredential, error) {
+	return &invoker_api.VaultCredential{Id: vaultId}, nil
+}
+
+func testInvoker(integration *stubIntegration, endpoints ...*internal_gorm.Endpoint) *invokerGRPCApi {
+	logger := commons.NewApplicationLoggerWithOptions(commons.EnableFile(false), commons.Level("error"))
+	logger.InitLogger()
+	service := &stubEndpointService{endpoints: map[uint64]*internal_gorm.Endpoint{}}
+	for _, endpoint := range endpoints {
+		service.endpoints[endpoint.EndpointProviderModel.Id] = endpoint
+	}
+	return &invokerGRPCApi{invokerApi{
+		logger:            logger,
+		endpointService:   service,
+		integrationClient: integration,
+		inputBuilder:      integration_client_builders.NewChatInputBuilder(logger),
+		vaultClient:       stubVault{},
+	}}
+}
+
+func testEndpoint(providerModelId uint64, providerName string) *internal_gorm.Endpoint {
+	return &internal_gorm.Endpoint{
+		Audited:                 gorm_model.Audited{Id: 1},
+		EndpointProviderModelId: providerModelId,
+		EndpointProviderModel: &internal_gorm.EndpointProviderModel{
+			Audited:           gorm_model.Audited{Id: providerModelId},
+			ModelProviderName: providerName,
+			Request: gorm_types.PromptMap{
+				"prompt": []interface{}{map[string]interface{}{"role": "user", "content": "hello"}},
+			},
+			EndpointProviderModelOptions: []*internal_gorm.EndpointProviderModelOption{{
+				Metadata: gorm_model.Metadata{Key: "rapida.credential_id", Value: "7"},
+			}},
+		},
+	}
+}
+
+func failedChat(code int32, message string) stubChat {
+	return stubChat{output: &invoker_api.ChatResponse{
+		Code:  code,
+		Error: &invoker_api.Error{ErrorCode: uint64(code), ErrorMessage: message},
+	}}
+}
+
+func successChat() stubChat {
+	return stubChat{output: &invoker_api.ChatResponse{Code: 200, Success: true}}
+}
+
+func metricValues(metrics []*invoker_api.Metric) map[string]string {
+	values := map[string]string{}
+	for _, mtr := range metrics {
+		values[mtr.GetName()] = mtr.GetValue()
+	}
+	return values
+}
+
+func TestStatusCode(t *testing.T) {
+	tests := []struct {
+		name   string
+		output *invoker_api.ChatResponse
+		err    error
+		code   int
+	}{
+		{"success", &invoker_api.ChatResponse{Code: 200, Success: true}, nil, 200},
+		{"code of the response", &invoker_api.ChatResponse{Code: 429, Error: &invoker_api.Error{ErrorMessage: "500 upstream"}}, nil, 429},
+		{"leading status of the provider", &invoker_api.ChatResponse{Error: &invoker_api.Error{ErrorMessage: " 503 Service Unavailable"}}, nil, 503},
+		{"status within the message", &invoker_api.ChatResponse{Error: &invoker_api.Error{ErrorMessage: "prompt of 4096 tokens exceeds 404 limit"}}, nil, 500},
+		{"unknown failure", &invoker_api.ChatResponse{}, nil, 500},
+		{"grpc unavailable", nil, status.Error(codes.Unavailable, "connection refused"), 503},
+		{"grpc deadline", nil, status.Error(codes.DeadlineExceeded, "deadline"), 504},
+		{"grpc invalid argument", nil, status.Error(codes.InvalidArgument, "invalid"), 400},
+		{"plain error", nil, errors.New("failed"), 500},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := statusCode(tt.output, tt.err); got != tt.code {
+				t.Errorf("statusCode() = %d, want %d", got, tt.code)
+			}
+		})
+	}
+}
+
+func TestAttempt(t *testing.T) {
+	retry := &internal_gorm.EndpointRetry{RetryType: internal_gorm.STATUS_RETRY, MaxAttempts: 3, Retryables: []string{"5XX", "429"}}
+	tests := []struct {
+		name     string
+		retry    *internal_gorm.EndpointRetry
+		answers  []stubChat
+		timeout  time.Duration
+		attempts int
+		code     int
+		failed   bool
+	}{
+		{"success on first attempt", retry, []stubChat{successChat()}, 0, 1, 200, false},
+		{"success after retries", retry, []stubChat{failedChat(503, "unavailable"), failedChat(429, "rate limit"), successChat()}, 0, 3, 200, false},
+		{"attempts exhausted", retry, []stubChat{failedChat(500, "failed")}, 0, 3, 500, true},
+		{"status not retryable", retry, []stubChat{failedChat(400, "bad request")}, 0, 1, 400, true},
+		{"never retry", &internal_gorm.EndpointRetry{RetryType: internal_gorm.NEVER_RETRY, MaxAttempts: 3}, []stubChat{failedChat(500, "failed")}, 0, 1, 500, true},
+		{"backoff beyond the deadline", &internal_gorm.EndpointRetry{RetryType: internal_gorm.STATUS_RETRY, MaxAttempts: 3, DelaySeconds: 5, Retryables: []string{"5XX"}}, []stubChat{failedChat(503, "unavailable")}, time.Second, 1, 503, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			integration := &stubIntegration{answers: tt.answers}
+			invoker := testInvoker(integration)
+			ctx := context.Background()
+			if tt.timeout > 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, tt.timeout)
+				defer cancel()
+			}
+
+			var metrics []*invoker_api.Metric
+			start := time.Now()
+			_, code, err := invoker.attempt(ctx, &types.ServiceScope{}, testEndpoint(10, "openai"), &invoker_api.ChatRequest{}, tt.retry, &metrics)
+			if tt.timeout > 0 && time.Since(start) >= tt.timeout {
+				t.Errorf("attempt waited %s past the deadline", time.Since(start))
+			}
+			if (err != nil) != tt.failed {
+				t.Errorf("attempt() error = %v, want failed %v", err, tt.failed)
+			}
+			if code != tt.code {
+				t.Errorf("attempt() code = %d, want %d", code, tt.code)
+			}
+			if len(integration.providers) != tt.attempts || len(metrics) != tt.attempts {
+				t.Errorf("attempts = %d with %d metrics, want %d", len(integration.providers), len(metrics), tt.attempts)
+			}
+		})
+	}
+}
+
+func TestInvoke(t *testing.T) {
+	failover := uint64(20)
+	tests := []struct {
+		name      string
+		retry     *internal_gorm.EndpointRetry
+		answers   []stubChat
+		providers []string
+		code      int
+		failed    bool
+		metrics   map[string]string
+	}{
+		{
+			name:      "retry disabled",
+			answers:   []stubChat{failedChat(503, "unavailable")},
+			providers: []string{"openai"},
+			code:      503,
+			failed:    true,
+			metrics:   map[string]string{"ATTEMPT_1": "503", ATTEMPTS_METRIC: "1"},
+		},
+		{
+			name:      "failover after the attempts are exhausted",
+			retry:     &internal_gorm.EndpointRetry{RetryType: internal_gorm.STATUS_RETRY, MaxAttempts: 2, Retryables: []string{"5XX"}, FailoverEndpointProviderModelId: &failover},
+			answers:   []stubChat{failedChat(503, "unavailable"), failedChat(500, "failed"), successChat()},
+			providers: []string{"openai", "openai", "anthropic"},
+			code:      200,
+			metrics:   map[string]string{"ATTEMPT_1": "503", "ATTEMPT_2": "500", "ATTEMPT_4": "200", FAILOVER_METRIC: "20", ATTEMPTS_METRIC: "3"},
+		},
+		{
+			name:      "failover fails",
+			retry:     &internal_gorm.EndpointRetry{RetryType: internal_gorm.STATUS_RETRY, MaxAttempts: 1, FailoverEndpointProviderModelId: &failover},
+			answers:   []stubChat{failedChat(401, "unauthorized")},
+			providers: []string{"openai", "anthropic"},
+			code:      401,
+			failed:    true,
+			metrics:   map[string]string{"ATTEMPT_1": "401", "ATTEMPT_3": "401", FAILOVER_METRIC: "20", ATTEMPTS_METRIC: "2"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			endpoint := testEndpoint(10, "openai")
+			endpoint.RetryEnable = tt.retry != nil
+			endpoint.EndpointRetry = tt.retry
+			integration := &stubIntegration{answers: tt.answers}
+			invoker := testInvoker(integration, endpoint, testEndpoint(20, "anthropic"))
+
+			_, code, metrics, err := invoker.invoke(context.Background(), &types.ServiceScope{}, endpoint, &invoker_api.ChatRequest{}, &invoker_api.InvokeRequest{})
+			if (err != nil) != tt.failed {
+				t.Errorf("invoke() error = %v, want failed %v", err, tt.failed)
+			}
+			if code != tt.code {
+				t.Errorf("invoke() code = %d, want %d", code, tt.code)
+			}
+			if len(integration.providers) != len(tt.providers) {
+				t.Fatalf("providers = %v, want %v", integration.providers, tt.providers)
+			}
+			for i, provider := range tt.providers {
+				if integration.providers[i] != provider {
+					t.Errorf("provider of attempt %d = %s, want %s", i+1, integration.providers[i], provider)
+				}
+			}
+			values := metricValues(metrics)
+			if len(values) != len(tt.metrics) {
+				t.Errorf("metrics = %v, want %v", values, tt.metrics)
+			}
+			for name, value := range tt.metrics {
+				if values[name] != value {
+					t.Errorf("metric %s = %q, want %q", name, values[name], value)
+				}
+			}
+		})
+	}
+}
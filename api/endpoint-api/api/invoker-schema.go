@@ -0,0 +1,157 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package endpoint_api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	internal_gorm "github.com/rapidaai/api/endpoint-api/internal/entity"
+	internal_schema "github.com/rapidaai/api/endpoint-api/internal/schema"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/types"
+	invoker_api "github.com/rapidaai/protos"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// metrics of the structured output recorded on the endpoint log
+const (
+	STRUCTURED_OUTPUT_METRIC = "STRUCTURED_OUTPUT"
+	REPAIR_ATTEMPTS_METRIC   = "REPAIR_ATTEMPTS"
+)
+
+// responseFormat returns the response format option of the providers which support structured output natively,
+// anthropic has no response format and forces the schema as the input of a tool
+func responseFormat(providerName string, responseSchema *internal_gorm.EndpointResponseSchema, schema map[string]interface{}) map[string]interface{} {
+	switch strings.ToLower(providerName) {
+	case "openai", "azure-foundry", "anthropic":
+		return map[string]interface{}{
+			"type": "json_schema",
+			"json_schema": map[string]interface{}{
+				"name":   responseSchema.Name,
+				"schema": schema,
+				"strict": responseSchema.Strict,
+			},
+		}
+	case "gemini", "vertexai":
+		return map[string]interface{}{
+			"response_mime_type": "application/json",
+			"response_schema":    schema,
+		}
+	default:
+		return nil
+	}
+}
+
+// schemaInstruction is the instruction of the schema for the providers which do not support structured output
+func schemaInstruction(responseSchema *internal_gorm.EndpointResponseSchema) string {
+	return fmt.Sprintf("Respond only with a json object, without any other text, that matches the json schema:\n%s", responseSchema.Schema)
+}
+
+// textMessage returns the message of the role with the raw text content
+func textMessage(role, content string) *invoker_api.Message {
+	return &invoker_api.Message{
+		Role: role,
+		Contents: []*invoker_api.Content{{
+			ContentType:   commons.TEXT_CONTENT.String(),
+			ContentFormat: commons.TEXT_CONTENT_FORMAT_RAW.String(),
+			Content:       []byte(content),
+		}},
+	}
+}
+
+// structured returns the response parsed as the object of the schema with the violations of the schema
+func structured(schema map[string]interface{}, output *invoker_api.ChatResponse) (*structpb.Struct, []string) {
+	parsed, err := internal_schema.Parse(types.OnlyStringProtoContent(output.GetData().GetContents()))
+	if err != nil {
+		return nil, []string{err.Error()}
+	}
+	if violations := internal_schema.Validate(schema, parsed); len(violations) > 0 {
+		return nil, violations
+	}
+	st, err := structpb.NewStruct(parsed)
+	if err != nil {
+		return nil, []string{err.Error()}
+	}
+	return st, nil
+}
+
+// structure validates the response against the response schema of the endpoint, the model is re-prompted
+// with the violations until the response matches or the repair attempts are exhausted. A strict schema fails
+// the invoke when the response does not match, otherwise the response is returned without the object.
+func (invokeApi *invokerGRPCApi) structure(ctx context.Context,
+	auth types.SimplePrinciple,
+	endpoint *internal_gorm.Endpoint,
+	request *invoker_api.ChatRequest,
+	output *invoker_api.ChatResponse,
+) (*structpb.Struct, *invoker_api.ChatResponse, []*invoker_api.Metric, error) {
+	responseSchema := endpoint.EndpointResponseSchema
+	schema, err := responseSchema.GetSchema()
+	if err != nil || schema == nil {
+		return nil, output, nil, err
+	}
+
+	conversations := slices.Clone(request.GetConversations())
+	repairs := uint64(0)
+	metrics := func(valid bool) []*invoker_api.Metric {
+		return []*invoker_api.Metric{{
+			Name:        STRUCTURED_OUTPUT_METRIC,
+			Value:       fmt.Sprintf("%t", valid),
+			Description: fmt.Sprintf("Response matches the schema %s", responseSchema.Name),
+		}, {
+			Name:        REPAIR_ATTEMPTS_METRIC,
+			Value:       fmt.Sprintf("%d", repairs),
+			Description: "Re-prompts of the model to repair the response",
+		}}
+	}
+	for {
+		st, violations := structured(schema, output)
+		if len(violations) == 0 {
+			return st, output, metrics(true), nil
+		}
+		if repairs >= responseSchema.MaxRepairAttempts || ctx.Err() != nil {
+			err := fmt.Errorf("response does not match the schema %s: %s", responseSchema.Name, strings.Join(violations, "; "))
+			if !responseSchema.Strict {
+				invokeApi.logger.Warnf("unable to structure the response of endpoint %d %v", endpoint.Id, err)
+				err = nil
+			}
+			return nil, output, metrics(false), err
+		}
+
+		repairs++
+		start := time.Now()
+		conversations = append(conversations,
+			&invoker_api.Message{Role: "assistant", Contents: output.GetData().GetContents()},
+			textMessage("user", fmt.Sprintf(
+				"The response does not match the json schema:\n- %s\nRespond only with the corrected json object.",
+				strings.Join(violations, "\n- "))),
+		)
+		repaired, err := invokeApi.
+			integrationClient.
+			Chat(ctx, auth, endpoint.EndpointProviderModel.ModelProviderName, &invoker_api.ChatRequest{
+				Credential:      request.GetCredential(),
+				Conversations:   conversations,
+				ModelParameters: request.GetModelParameters(),
+				ToolDefinitions: request.GetToolDefinitions(),
+				AdditionalData:  request.GetAdditionalData(),
+			})
+		if err == nil && !repaired.GetSuccess() {
+			err = errors.New(repaired.GetError().GetErrorMessage())
+		}
+		if err != nil {
+			invokeApi.logger.Errorf("unable to repair the response of endpoint %d after %s %v", endpoint.Id, time.Since(start), err)
+			if responseSchema.Strict {
+				return nil, output, metrics(false), err
+			}
+			return nil, output, metrics(false), nil
+		}
+		output = repaired
+	}
+}
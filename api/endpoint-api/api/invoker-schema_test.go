@@ -0,0 +1,171 @@
+package endpoint_api
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	internal_gorm "github.com/rapidaai/api/endpoint-api/internal/entity"
+	internal_services "github.com/rapidaai/api/endpoint-api/internal/service"
+	"github.com/rapidaai/pkg/types"
+	"github.com/rapidaai/pkg/utils"
+	invoker_api "github.com/rapidaai/protos"
+)
+
+// stubEndpointLog records the metrics of the endpoint log once the invoke updates it
+type stubEndpointLog struct {
+	internal_services.EndpointLogService
+	updated chan []*invoker_api.Metric
+}
+
+func (s *stubEndpointLog) CreateEndpointLog(ctx context.Context, auth types.SimplePrinciple, source utils.RapidaSource, endpointId, endpointProviderModelId uint64, logId uint64, arguments, metadata, options map[string]interface{}) (*internal_gorm.EndpointLog, error) {
+	return &internal_gorm.EndpointLog{}, nil
+}
+
+func (s *stubEndpointLog) UpdateEndpointLog(ctx context.Context, auth types.SimplePrinciple, logId uint64, metrics []*invoker_api.Metric, timeTaken uint64) (*internal_gorm.EndpointLog, error) {
+	s.updated <- metrics
+	return &internal_gorm.EndpointLog{}, nil
+}
+
+func jsonChat(content string) stubChat {
+	return stubChat{output: &invoker_api.ChatResponse{Code: 200, Success: true, Data: textMessage("assistant", content)}}
+}
+
+func schemaEndpoint(strict bool, maxRepairAttempts uint64) *internal_gorm.Endpoint {
+	endpoint := testEndpoint(10, "openai")
+	endpoint.EndpointResponseSchema = &internal_gorm.EndpointResponseSchema{
+		Name:              "answer",
+		Schema:            `{"type":"object","properties":{"answer":{"type":"string"}},"required":["answer"]}`,
+		Strict:            strict,
+		MaxRepairAttempts: maxRepairAttempts,
+	}
+	return endpoint
+}
+
+func TestStructure(t *testing.T) {
+	tests := []struct {
+		name      string
+		strict    bool
+		output    stubChat
+		repairs   []stubChat
+		requests  int
+		structure bool
+		failed    bool
+		metrics   map[string]string
+	}{
+		{
+			name:      "response matches the schema",
+			output:    jsonChat(`{"answer":"yes"}`),
+			repairs:   []stubChat{jsonChat(`{}`)},
+			structure: true,
+			metrics:   map[string]string{STRUCTURED_OUTPUT_METRIC: "true", REPAIR_ATTEMPTS_METRIC: "0"},
+		},
+		{
+			name:      "repaired on the second attempt",
+			strict:    true,
+			output:    jsonChat(`{"answer":1}`),
+			repairs:   []stubChat{jsonChat("not json"), jsonChat("```json\n{\"answer\":\"yes\"}\n```")},
+			requests:  2,
+			structure: true,
+			metrics:   map[string]string{STRUCTURED_OUTPUT_METRIC: "true", REPAIR_ATTEMPTS_METRIC: "2"},
+		},
+		{
+			name:     "strict schema exhausts the repairs",
+			strict:   true,
+			output:   jsonChat(`{}`),
+			repairs:  []stubChat{jsonChat(`{"answer":null}`)},
+			requests: 2,
+			failed:   true,
+			metrics:  map[string]string{STRUCTURED_OUTPUT_METRIC: "false", REPAIR_ATTEMPTS_METRIC: "2"},
+		},
+		{
+			name:     "schema which is not strict keeps the response",
+			output:   jsonChat(`{}`),
+			repairs:  []stubChat{jsonChat(`{"answer":null}`)},
+			requests: 2,
+			metrics:  map[string]string{STRUCTURED_OUTPUT_METRIC: "false", REPAIR_ATTEMPTS_METRIC: "2"},
+		},
+		{
+			name:     "repair fails on a strict schema",
+			strict:   true,
+			output:   jsonChat(`{}`),
+			repairs:  []stubChat{failedChat(503, "unavailable")},
+			requests: 1,
+			failed:   true,
+			metrics:  map[string]string{STRUCTURED_OUTPUT_METRIC: "false", REPAIR_ATTEMPTS_METRIC: "1"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			endpoint := schemaEndpoint(tt.strict, 2)
+			integration := &stubIntegration{answers: tt.repairs}
+			invoker := testInvoker(integration)
+			request := &invoker_api.ChatRequest{Conversations: []*invoker_api.Message{textMessage("user", "hello")}}
+
+			st, output, metrics, err := invoker.structure(context.Background(), &types.ServiceScope{}, endpoint, request, tt.output.output)
+			if (err != nil) != tt.failed {
+				t.Errorf("structure() error = %v, want failed %v", err, tt.failed)
+			}
+			if (st != nil) != tt.structure {
+				t.Errorf("structure() object = %v, want structured %v", st, tt.structure)
+			}
+			if tt.structure && st.GetFields()["answer"].GetStringValue() != "yes" {
+				t.Errorf("structure() answer = %v, want yes", st.GetFields()["answer"])
+			}
+			if output == nil {
+				t.Error("structure() should always return the response")
+			}
+			if len(integration.requests) != tt.requests {
+				t.Errorf("repair requests = %d, want %d", len(integration.requests), tt.requests)
+			}
+			// every repair re-prompts with the previous response and its violations
+			for i, repair := range integration.requests {
+				if got, want := len(repair.GetConversations()), 1+2*(i+1); got != want {
+					t.Errorf("conversations of repair %d = %d, want %d", i+1, got, want)
+				}
+			}
+			values := metricValues(metrics)
+			for name, value := range tt.metrics {
+				if values[name] != value {
+					t.Errorf("metric %s = %q, want %q", name, values[name], value)
+				}
+			}
+		})
+	}
+}
+
+func TestInvoke_StrictSchema(t *testing.T) {
+	endpoint := schemaEndpoint(true, 1)
+	integration := &stubIntegration{answers: []stubChat{jsonChat(`{"answer":1}`)}}
+	logs := &stubEndpointLog{updated: make(chan []*invoker_api.Metric, 1)}
+	invoker := testInvoker(integration, endpoint)
+	invoker.endpointLogService = logs
+
+	project, organization := uint64(1), uint64(2)
+	ctx := context.WithValue(context.Background(), types.CTX_, &types.PlainClaimPrinciple[*types.ServiceScope]{
+		Info: &types.ServiceScope{ProjectId: &project, OrganizationId: &organization},
+	})
+	response, err := invoker.Invoke(ctx, &invoker_api.InvokeRequest{
+		Endpoint: &invoker_api.EndpointDefinition{EndpointId: 1, Version: utils.VERSION_PREFIX + "10"},
+	})
+	if err == nil {
+		t.Fatal("Invoke() should fail when the response does not match the strict schema")
+	}
+	// the provider responded, the response is unprocessable against the schema
+	if response.GetCode() != 422 || response.GetSuccess() {
+		t.Errorf("Invoke() code = %d success %v, want 422 and failed", response.GetCode(), response.GetSuccess())
+	}
+	if len(integration.requests) != 2 {
+		t.Errorf("chat requests = %d, want the invoke and one repair", len(integration.requests))
+	}
+
+	select {
+	case metrics := <-logs.updated:
+		values := metricValues(metrics)
+		if values[STRUCTURED_OUTPUT_METRIC] != "false" || values[REPAIR_ATTEMPTS_METRIC] != "1" || values[ATTEMPTS_METRIC] != "1" {
+			t.Errorf("log metrics = %v, want the failed structure after one repair", values)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("endpoint log is not updated")
+	}
+}
@@ -0,0 +1,96 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package endpoint_api
+
+import (
+	"context"
+	"errors"
+	"io"
+	"slices"
+	"time"
+
+	gorm_generator "github.com/rapidaai/pkg/models/gorm/generators"
+	"github.com/rapidaai/pkg/types"
+	"github.com/rapidaai/pkg/utils"
+	invoker_api "github.com/rapidaai/protos"
+)
+
+// InvokeStream streams the completion of the endpoint as it is generated by the provider,
+// every response carries the content delta and the last one the complete content with the metrics.
+func (invokeApi *invokerGRPCApi) InvokeStream(iRequest *invoker_api.InvokeRequest, stream invoker_api.Deployment_InvokeStreamServer) error {
+	start := time.Now()
+	ctx := stream.Context()
+	iAuth, isAuthenticated := types.GetSimplePrincipleGRPC(ctx)
+	if !isAuthenticated {
+		_, err := utils.AuthenticateError[invoker_api.InvokeResponse]()
+		return err
+	}
+
+	requestID := gorm_generator.ID()
+	endpoint, request, _, err := invokeApi.prepare(ctx, iAuth, iRequest, requestID)
+	if err != nil {
+		return err
+	}
+
+	schema, _ := endpoint.EndpointResponseSchema.GetSchema()
+	var metrics []*invoker_api.Metric
+	defer func() {
+		utils.Go(context.Background(), func() {
+			invokeApi.endpointLogService.UpdateEndpointLog(
+				context.Background(),
+				iAuth,
+				requestID,
+				metrics,
+				uint64(time.Since(start)),
+			)
+		})
+	}()
+
+	chat, err := invokeApi.
+		integrationClient.
+		StreamChat(ctx, iAuth, endpoint.EndpointProviderModel.ModelProviderName, request)
+	if err != nil {
+		metrics = failedStatus(nil, metrics)
+		_, err = utils.ErrorWithCode[invoker_api.InvokeResponse](int32(statusCode(nil, err)), err, "Unable to execute the endpoint, please check and try again.")
+		return err
+	}
+	for {
+		output, err := chat.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil || !output.GetSuccess() {
+			code := statusCode(output, err)
+			metrics = failedStatus(output, slices.Concat(metrics, output.GetMetrics()))
+			if err == nil {
+				err = errors.New(output.GetError().GetErrorMessage())
+			}
+			_, err = utils.ErrorWithCode[invoker_api.InvokeResponse](int32(code), err, "Unable to execute the endpoint, please check and try again.")
+			return err
+		}
+
+		response := &invoker_api.InvokeResponse{
+			RequestId: requestID,
+			Code:      200,
+			Success:   true,
+			Data:      output.GetData().GetContents(),
+		}
+		// the provider completes the stream with the complete content and the metrics
+		if len(output.GetMetrics()) > 0 {
+			metrics = output.GetMetrics()
+			response.Metrics = metrics
+			response.TimeTaken = uint64(time.Since(start).Microseconds())
+			// streamed response can not be repaired, the object is returned only when it matches the schema
+			if schema != nil {
+				response.Output, _ = structured(schema, output)
+			}
+		}
+		if err := stream.Send(response); err != nil {
+			invokeApi.logger.Errorf("unable to send the response of endpoint %d %v", endpoint.Id, err)
+			return err
+		}
+	}
+}
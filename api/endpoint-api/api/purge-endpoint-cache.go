@@ -0,0 +1,48 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package endpoint_api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	internal_services "github.com/rapidaai/api/endpoint-api/internal/service"
+	"github.com/rapidaai/pkg/types"
+	"github.com/rapidaai/pkg/utils"
+	endpoint_grpc_api "github.com/rapidaai/protos"
+)
+
+func (endpointGRPCApi *endpointGRPCApi) PurgeEndpointCache(ctx context.Context, eRequest *endpoint_grpc_api.PurgeEndpointCacheRequest) (*endpoint_grpc_api.BaseResponse, error) {
+	iAuth, isAuthenticated := types.GetSimplePrincipleGRPC(ctx)
+	if !isAuthenticated || !iAuth.HasProject() {
+		endpointGRPCApi.logger.Errorf("unauthenticated request for purge endpoint cache")
+		return utils.Error[endpoint_grpc_api.BaseResponse](
+			errors.New("unauthenticated request for purge endpoint cache"),
+			"Please provider valid service credentials to perfom invoke, read docs @ docs.rapida.ai",
+		)
+	}
+
+	// the endpoint is only accessible to its project
+	_, err := endpointGRPCApi.endpointService.Get(ctx, iAuth, eRequest.GetEndpointId(), nil, internal_services.NewGetEndpointOption())
+	if err != nil {
+		return utils.Error[endpoint_grpc_api.BaseResponse](
+			err,
+			"Unable to get the endpoint for given endpoint id.",
+		)
+	}
+
+	purged, err := endpointGRPCApi.endpointCacheService.Purge(ctx, eRequest.GetEndpointId())
+	if err != nil {
+		return utils.Error[endpoint_grpc_api.BaseResponse](
+			err,
+			"Unable to purge endpoint cache, please try again later",
+		)
+	}
+	return utils.Success[endpoint_grpc_api.BaseResponse](map[string]string{
+		"purged": fmt.Sprintf("%d", purged),
+	})
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 
 	gorm_model "github.com/rapidaai/pkg/models/gorm"
+	gorm_types "github.com/rapidaai/pkg/models/gorm/types"
 )
 
 type Cache string
@@ -32,4 +33,7 @@ type EndpointCaching struct {
 	MatchThreshold float32 `json:"matchThreshold" gorm:"type:float;size:20"`
 	CreatedBy      uint64  `json:"createdBy" gorm:"type:bigint;size:20;not null"`
 	UpdatedBy      uint64  `json:"updatedBy" gorm:"type:bigint;size:20;"`
+	// embedding model used to match the prompts of the sementic cache
+	EmbeddingModelProviderName string               `json:"embeddingModelProviderName" gorm:"type:string;size:200"`
+	EmbeddingModelOptions      gorm_types.StringMap `json:"embeddingModelOptions" gorm:"type:jsonb"`
 }
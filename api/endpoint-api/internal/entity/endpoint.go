@@ -28,6 +28,10 @@ type Endpoint struct {
 	EndpointRetry         *EndpointRetry         `json:"endpointRetry" gorm:"foreignKey:EndpointId"`
 	EndpointCaching       *EndpointCaching       `json:"endpointCaching" gorm:"foreignKey:EndpointId"`
 	EndpointTag           *EndpointTag           `json:"endpointTag" gorm:"foreignKey:EndpointId"`
+	// the response of the endpoint is structured when it has a response schema
+	EndpointResponseSchema *EndpointResponseSchema `json:"endpointResponseSchema" gorm:"foreignKey:EndpointId"`
+	// the traffic of the endpoint is split between the versions when it is not invoked with a version
+	EndpointTrafficSplits []*EndpointTrafficSplit `json:"endpointTrafficSplits" gorm:"foreignKey:EndpointId"`
 }
 
 // this table will immutatble used as version
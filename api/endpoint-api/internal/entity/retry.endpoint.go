@@ -3,6 +3,10 @@ package internal_entity
 import (
 	"database/sql/driver"
 	"encoding/json"
+	"math/rand/v2"
+	"strconv"
+	"strings"
+	"time"
 
 	gorm_model "github.com/rapidaai/pkg/models/gorm"
 	gorm_types "github.com/rapidaai/pkg/models/gorm/types"
@@ -34,4 +38,48 @@ type EndpointRetry struct {
 	// this is depends on retry type
 	// in case retry is status then it will be [4XX, 5XX]
 	Retryables gorm_types.StringArray `json:"retryables" gorm:"type:text;size:1000;null"`
+	// provider model of the endpoint which is invoked once the attempts are exhausted
+	FailoverEndpointProviderModelId *uint64 `json:"failoverEndpointProviderModelId" gorm:"type:bigint;size:20;null"`
+}
+
+// GetMaxAttempts returns the attempts of an invoke including the first one
+func (r *EndpointRetry) GetMaxAttempts() uint64 {
+	return max(r.MaxAttempts, 1)
+}
+
+// Retryable tells if the status code of a failed attempt matches any of the retryables,
+// either exact as 429 or a class as 5XX
+func (r *EndpointRetry) Retryable(code int) bool {
+	if r.RetryType != STATUS_RETRY {
+		return false
+	}
+	status := strconv.Itoa(code)
+	for _, retryable := range r.Retryables {
+		pattern := strings.ToUpper(strings.TrimSpace(retryable))
+		if len(pattern) != len(status) {
+			continue
+		}
+		matched := true
+		for i := range pattern {
+			if pattern[i] != 'X' && pattern[i] != status[i] {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return true
+		}
+	}
+	return false
+}
+
+// Backoff returns the delay before the nth retry, the exponential backoff doubles the delay
+// for every retry with a random jitter of up to half of it
+func (r *EndpointRetry) Backoff(retry uint64) time.Duration {
+	delay := time.Duration(r.DelaySeconds) * time.Second
+	if !r.ExponentialBackoff || delay == 0 || retry == 0 {
+		return delay
+	}
+	delay = delay << min(retry-1, 10)
+	return delay/2 + rand.N(delay/2+1)
 }
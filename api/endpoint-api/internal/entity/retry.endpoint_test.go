@@ -0,0 +1,49 @@
+package internal_entity
+
+import (
+	"testing"
+	"time"
+)
+
+func TestEndpointRetryRetryable(t *testing.T) {
+	retry := &EndpointRetry{RetryType: STATUS_RETRY, Retryables: []string{"5XX", "429"}}
+	tests := []struct {
+		code      int
+		retryable bool
+	}{
+		{500, true},
+		{503, true},
+		{429, true},
+		{400, false},
+		{200, false},
+	}
+	for _, tt := range tests {
+		if got := retry.Retryable(tt.code); got != tt.retryable {
+			t.Errorf("Retryable(%d) = %v, want %v", tt.code, got, tt.retryable)
+		}
+	}
+
+	retry.RetryType = NEVER_RETRY
+	if retry.Retryable(500) {
+		t.Error("no-retry should never retry")
+	}
+}
+
+func TestEndpointRetryBackoff(t *testing.T) {
+	retry := &EndpointRetry{DelaySeconds: 2}
+	if got := retry.Backoff(3); got != 2*time.Second {
+		t.Errorf("fixed backoff = %v, want 2s", got)
+	}
+
+	retry.ExponentialBackoff = true
+	for n, base := range map[uint64]time.Duration{1: 2 * time.Second, 2: 4 * time.Second, 3: 8 * time.Second} {
+		got := retry.Backoff(n)
+		if got < base/2 || got > base {
+			t.Errorf("Backoff(%d) = %v, want within [%v, %v]", n, got, base/2, base)
+		}
+	}
+
+	if (&EndpointRetry{}).GetMaxAttempts() != 1 {
+		t.Error("an invoke should be attempted at least once")
+	}
+}
@@ -0,0 +1,31 @@
+package internal_entity
+
+import (
+	"encoding/json"
+
+	gorm_model "github.com/rapidaai/pkg/models/gorm"
+)
+
+type EndpointResponseSchema struct {
+	gorm_model.Audited
+	gorm_model.Mutable
+	EndpointId uint64 `json:"endpointId" gorm:"type:bigint;not null"`
+	// name of the structured output passed to the providers
+	Name string `json:"name" gorm:"type:string;size:200;not null"`
+	// json schema of the response
+	Schema            string `json:"schema" gorm:"type:text;not null"`
+	Strict            bool   `json:"strict" gorm:"type:boolean;default:false"`
+	MaxRepairAttempts uint64 `json:"maxRepairAttempts" gorm:"type:bigint;size:20;not null"`
+}
+
+// GetSchema returns the parsed json schema, nil when the endpoint has no response schema
+func (r *EndpointResponseSchema) GetSchema() (map[string]interface{}, error) {
+	if r == nil || r.Schema == "" {
+		return nil, nil
+	}
+	var schema map[string]interface{}
+	if err := json.Unmarshal([]byte(r.Schema), &schema); err != nil {
+		return nil, err
+	}
+	return schema, nil
+}
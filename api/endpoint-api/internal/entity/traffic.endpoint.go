@@ -0,0 +1,53 @@
+package internal_entity
+
+import (
+	"cmp"
+	"hash/fnv"
+	"math/rand/v2"
+	"slices"
+	"strconv"
+
+	gorm_model "github.com/rapidaai/pkg/models/gorm"
+)
+
+// EndpointTrafficSplit is the weight of the traffic of the endpoint invoked on a provider model version
+type EndpointTrafficSplit struct {
+	gorm_model.Audited
+	gorm_model.Mutable
+	EndpointId              uint64 `json:"endpointId" gorm:"type:bigint;not null"`
+	EndpointProviderModelId uint64 `json:"endpointProviderModelId" gorm:"type:bigint;size:20;not null"`
+	Weight                  uint32 `json:"weight" gorm:"type:bigint;not null"`
+}
+
+// Variant returns the provider model version of the traffic split assigned to the key, the same key is always
+// assigned to the same version as long as the split does not change. Without a key the version is random.
+// It returns nil when the endpoint has no split so the version of the endpoint is invoked.
+func (e *Endpoint) Variant(key string) *uint64 {
+	// the points are assigned in the order of the version so the order of the splits does not move keys
+	splits := slices.SortedFunc(slices.Values(e.EndpointTrafficSplits), func(a, b *EndpointTrafficSplit) int {
+		return cmp.Compare(a.EndpointProviderModelId, b.EndpointProviderModelId)
+	})
+	var total uint64
+	for _, split := range splits {
+		total += uint64(split.Weight)
+	}
+	if total == 0 {
+		return nil
+	}
+
+	var point uint64
+	if key == "" {
+		point = rand.Uint64N(total)
+	} else {
+		h := fnv.New64a()
+		h.Write([]byte(strconv.FormatUint(e.Id, 10) + "::" + key))
+		point = h.Sum64() % total
+	}
+	for _, split := range splits {
+		if point < uint64(split.Weight) {
+			return &split.EndpointProviderModelId
+		}
+		point -= uint64(split.Weight)
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+package internal_entity
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestEndpointVariant(t *testing.T) {
+	endpoint := &Endpoint{}
+	if endpoint.Variant("user-1") != nil {
+		t.Error("endpoint without split should invoke its version")
+	}
+
+	endpoint.EndpointTrafficSplits = []*EndpointTrafficSplit{
+		{EndpointProviderModelId: 1, Weight: 90},
+		{EndpointProviderModelId: 2, Weight: 10},
+		{EndpointProviderModelId: 3, Weight: 0},
+	}
+	first := *endpoint.Variant("user-1")
+	for range 10 {
+		if got := *endpoint.Variant("user-1"); got != first {
+			t.Fatalf("Variant is not sticky, got %d want %d", got, first)
+		}
+	}
+
+	counts := map[uint64]int{}
+	for i := range 10000 {
+		counts[*endpoint.Variant(fmt.Sprintf("user-%d", i))]++
+	}
+	if counts[3] != 0 {
+		t.Errorf("version with zero weight got %d invokes", counts[3])
+	}
+	if counts[2] < 700 || counts[2] > 1300 {
+		t.Errorf("version with 10%% weight got %d of 10000 invokes", counts[2])
+	}
+}
+
+func TestEndpointVariantIgnoresSplitOrder(t *testing.T) {
+	splits := []*EndpointTrafficSplit{
+		{EndpointProviderModelId: 1, Weight: 50},
+		{EndpointProviderModelId: 2, Weight: 30},
+		{EndpointProviderModelId: 3, Weight: 20},
+	}
+	endpoint := &Endpoint{EndpointTrafficSplits: splits}
+	reversed := &Endpoint{EndpointTrafficSplits: []*EndpointTrafficSplit{splits[2], splits[1], splits[0]}}
+	for i := range 1000 {
+		key := fmt.Sprintf("user-%d", i)
+		if a, b := *endpoint.Variant(key), *reversed.Variant(key); a != b {
+			t.Fatalf("key %s assigned to %d and %d by the order of the splits", key, a, b)
+		}
+	}
+}
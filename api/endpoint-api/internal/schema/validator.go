@@ -0,0 +1,200 @@
+package internal_schema
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+// Parse returns the json object of the content, models which are not structured natively often wrap it
+// in a markdown code block or add text around it
+func Parse(content string) (map[string]interface{}, error) {
+	content = strings.TrimSpace(content)
+	if fenced, ok := strings.CutPrefix(content, "```"); ok {
+		// drop the language of the code block, ex: ```json
+		if idx := strings.IndexByte(fenced, '\n'); idx >= 0 {
+			fenced = fenced[idx+1:]
+		}
+		content = strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(fenced), "```"))
+	}
+	var output map[string]interface{}
+	if err := json.Unmarshal([]byte(content), &output); err == nil {
+		return output, nil
+	}
+	start, end := strings.IndexByte(content, '{'), strings.LastIndexByte(content, '}')
+	if start < 0 || end <= start {
+		return nil, errors.New("response is not a json object")
+	}
+	if err := json.Unmarshal([]byte(content[start:end+1]), &output); err != nil {
+		return nil, fmt.Errorf("response is not a valid json object: %w", err)
+	}
+	return output, nil
+}
+
+// Validate returns the violations of the value against the json schema, the value is valid when there are none.
+// It supports the keywords used for structured output: type, enum, const, properties, required,
+// additionalProperties, items, length, range, pattern, anyOf and allOf.
+func Validate(schema map[string]interface{}, value interface{}) []string {
+	return validate("$", schema, value)
+}
+
+func validate(path string, schema map[string]interface{}, value interface{}) []string {
+	if schema == nil {
+		return nil
+	}
+	if expected, ok := schema["type"]; ok && !matchType(expected, value) {
+		return []string{fmt.Sprintf("%s should be of type %v but got %s", path, expected, typeOf(value))}
+	}
+
+	violations := make([]string, 0)
+	if enum, ok := schema["enum"].([]interface{}); ok {
+		matched := false
+		for _, e := range enum {
+			if reflect.DeepEqual(e, value) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			violations = append(violations, fmt.Sprintf("%s should be one of %v", path, enum))
+		}
+	}
+	if cnst, ok := schema["const"]; ok && !reflect.DeepEqual(cnst, value) {
+		violations = append(violations, fmt.Sprintf("%s should be %v", path, cnst))
+	}
+
+	switch v := value.(type) {
+	case map[string]interface{}:
+		properties, _ := schema["properties"].(map[string]interface{})
+		if required, ok := schema["required"].([]interface{}); ok {
+			for _, r := range required {
+				if name, ok := r.(string); ok {
+					if _, present := v[name]; !present {
+						violations = append(violations, fmt.Sprintf("%s.%s is required", path, name))
+					}
+				}
+			}
+		}
+		for name, property := range v {
+			if ps, ok := properties[name].(map[string]interface{}); ok {
+				violations = append(violations, validate(path+"."+name, ps, property)...)
+				continue
+			}
+			switch additional := schema["additionalProperties"].(type) {
+			case bool:
+				if !additional {
+					violations = append(violations, fmt.Sprintf("%s.%s is not allowed", path, name))
+				}
+			case map[string]interface{}:
+				violations = append(violations, validate(path+"."+name, additional, property)...)
+			}
+		}
+	case []interface{}:
+		if items, ok := schema["items"].(map[string]interface{}); ok {
+			for i, item := range v {
+				violations = append(violations, validate(fmt.Sprintf("%s[%d]", path, i), items, item)...)
+			}
+		}
+		if n, ok := number(schema["minItems"]); ok && float64(len(v)) < n {
+			violations = append(violations, fmt.Sprintf("%s should have at least %v items", path, n))
+		}
+		if n, ok := number(schema["maxItems"]); ok && float64(len(v)) > n {
+			violations = append(violations, fmt.Sprintf("%s should have at most %v items", path, n))
+		}
+	case string:
+		length := float64(utf8.RuneCountInString(v))
+		if n, ok := number(schema["minLength"]); ok && length < n {
+			violations = append(violations, fmt.Sprintf("%s should have at least %v characters", path, n))
+		}
+		if n, ok := number(schema["maxLength"]); ok && length > n {
+			violations = append(violations, fmt.Sprintf("%s should have at most %v characters", path, n))
+		}
+		if pattern, ok := schema["pattern"].(string); ok {
+			if re, err := regexp.Compile(pattern); err == nil && !re.MatchString(v) {
+				violations = append(violations, fmt.Sprintf("%s should match %s", path, pattern))
+			}
+		}
+	case float64:
+		if n, ok := number(schema["minimum"]); ok && v < n {
+			violations = append(violations, fmt.Sprintf("%s should be at least %v", path, n))
+		}
+		if n, ok := number(schema["maximum"]); ok && v > n {
+			violations = append(violations, fmt.Sprintf("%s should be at most %v", path, n))
+		}
+		if n, ok := number(schema["exclusiveMinimum"]); ok && v <= n {
+			violations = append(violations, fmt.Sprintf("%s should be greater than %v", path, n))
+		}
+		if n, ok := number(schema["exclusiveMaximum"]); ok && v >= n {
+			violations = append(violations, fmt.Sprintf("%s should be less than %v", path, n))
+		}
+	}
+
+	if allOf, ok := schema["allOf"].([]interface{}); ok {
+		for _, s := range allOf {
+			if sub, ok := s.(map[string]interface{}); ok {
+				violations = append(violations, validate(path, sub, value)...)
+			}
+		}
+	}
+	if anyOf, ok := schema["anyOf"].([]interface{}); ok {
+		matched := false
+		for _, s := range anyOf {
+			if sub, ok := s.(map[string]interface{}); ok && len(validate(path, sub, value)) == 0 {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			violations = append(violations, fmt.Sprintf("%s should match any of the schemas", path))
+		}
+	}
+	return violations
+}
+
+// matchType tells if the value is of the type, or any of the types, of the schema
+func matchType(expected interface{}, value interface{}) bool {
+	switch t := expected.(type) {
+	case string:
+		actual := typeOf(value)
+		return actual == t || (t == "number" && actual == "integer")
+	case []interface{}:
+		for _, e := range t {
+			if matchType(e, value) {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+func typeOf(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case string:
+		return "string"
+	case float64:
+		if v == math.Trunc(v) && !math.IsInf(v, 0) {
+			return "integer"
+		}
+		return "number"
+	case []interface{}:
+		return "array"
+	case map[string]interface{}:
+		return "object"
+	}
+	return fmt.Sprintf("%T", value)
+}
+
+func number(value interface{}) (float64, bool) {
+	n, ok := value.(float64)
+	return n, ok
+}
@@ -0,0 +1,68 @@
+package internal_schema
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+const testSchema = `{
+	"type": "object",
+	"properties": {
+		"sentiment": {"type": "string", "enum": ["positive", "negative", "neutral"]},
+		"score": {"type": "number", "minimum": 0, "maximum": 1},
+		"topics": {"type": "array", "items": {"type": "string"}, "maxItems": 2},
+		"resolved": {"type": "boolean"}
+	},
+	"required": ["sentiment", "score"],
+	"additionalProperties": false
+}`
+
+func TestParse(t *testing.T) {
+	tests := map[string]string{
+		"plain":  `{"sentiment": "positive"}`,
+		"fenced": "```json\n{\"sentiment\": \"positive\"}\n```",
+		"text":   `Here is the analysis: {"sentiment": "positive"} hope it helps`,
+	}
+	for name, content := range tests {
+		output, err := Parse(content)
+		if err != nil {
+			t.Errorf("%s: unexpected error %v", name, err)
+			continue
+		}
+		if output["sentiment"] != "positive" {
+			t.Errorf("%s: sentiment = %v, want positive", name, output["sentiment"])
+		}
+	}
+	if _, err := Parse("no json here"); err == nil {
+		t.Error("expected error for content without json object")
+	}
+}
+
+func TestValidate(t *testing.T) {
+	var schema map[string]interface{}
+	if err := json.Unmarshal([]byte(testSchema), &schema); err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		value      string
+		violations int
+	}{
+		{`{"sentiment": "positive", "score": 0.8, "topics": ["billing"], "resolved": true}`, 0},
+		{`{"sentiment": "angry", "score": 0.8}`, 1},
+		{`{"sentiment": "positive"}`, 1},
+		{`{"sentiment": "positive", "score": 2}`, 1},
+		{`{"sentiment": "positive", "score": "high"}`, 1},
+		{`{"sentiment": "positive", "score": 0.5, "topics": ["a", "b", "c"]}`, 1},
+		{`{"sentiment": "positive", "score": 0.5, "topics": [1]}`, 1},
+		{`{"sentiment": "positive", "score": 0.5, "extra": 1}`, 1},
+	}
+	for _, tt := range tests {
+		var value map[string]interface{}
+		if err := json.Unmarshal([]byte(tt.value), &value); err != nil {
+			t.Fatal(err)
+		}
+		if got := Validate(schema, value); len(got) != tt.violations {
+			t.Errorf("Validate(%s) = %v, want %d violations", tt.value, got, tt.violations)
+		}
+	}
+}
@@ -0,0 +1,41 @@
+package internal_service
+
+import (
+	"context"
+
+	internal_gorm "github.com/rapidaai/api/endpoint-api/internal/entity"
+	"github.com/rapidaai/pkg/types"
+	protos "github.com/rapidaai/protos"
+)
+
+// CACHE_HIT_METRIC flags on the endpoint log if the response is served from the cache
+const CACHE_HIT_METRIC = "CACHE_HIT"
+
+// EndpointCacheLookup is the lookup of an invoke in the cache of the endpoint, response is nil when it is not cached
+type EndpointCacheLookup struct {
+	Key       string
+	Field     string
+	Embedding []float64
+
+	Response   *protos.ChatResponse
+	Similarity float64
+}
+
+type EndpointCacheService interface {
+	// Lookup returns the cached response of the rendered request, the standard cache matches the exact request
+	// and the sementic cache the prompts which are similar above the match threshold, the least recently
+	// used prompts are evicted from a full sementic cache
+	Lookup(ctx context.Context,
+		auth types.SimplePrinciple,
+		endpoint *internal_gorm.Endpoint,
+		request *protos.ChatRequest,
+	) (*EndpointCacheLookup, error)
+	// Store caches the response of the request for the expiry interval of the endpoint
+	Store(ctx context.Context,
+		endpoint *internal_gorm.Endpoint,
+		lookup *EndpointCacheLookup,
+		response *protos.ChatResponse,
+	) error
+	// Purge removes the cached responses of every version of the endpoint
+	Purge(ctx context.Context, endpointId uint64) (int64, error)
+}
@@ -0,0 +1,401 @@
+package internal_cache_service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rapidaai/api/endpoint-api/config"
+	internal_gorm "github.com/rapidaai/api/endpoint-api/internal/entity"
+	internal_service "github.com/rapidaai/api/endpoint-api/internal/service"
+	integration_client "github.com/rapidaai/pkg/clients/integration"
+	integration_client_builders "github.com/rapidaai/pkg/clients/integration/builders"
+	web_client "github.com/rapidaai/pkg/clients/web"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/connectors"
+	"github.com/rapidaai/pkg/types"
+	protos "github.com/rapidaai/protos"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// prompts of the sementic cache are compared one by one, the least recently used
+	// prompt is evicted when the cache grows over the size
+	sementicCacheSize = 256
+	// match threshold of the sementic cache when it is not configured
+	defaultMatchThreshold = 0.95
+	purgeBatch            = 500
+)
+
+const (
+	// caches the response and registers the key for purge, expired keys are dropped from the registry
+	standardStoreScript = `
+if tonumber(ARGV[2]) > 0 then
+	redis.call('SET', KEYS[2], ARGV[1], 'EX', ARGV[2])
+else
+	redis.call('SET', KEYS[2], ARGV[1])
+end
+redis.call('ZADD', KEYS[1], ARGV[3], KEYS[2])
+redis.call('ZREMRANGEBYSCORE', KEYS[1], '-inf', '(' .. ARGV[4])
+return 1`
+
+	// caches the embedding and the response of the prompt, marks it as most recently used and
+	// evicts the least recently used prompts over the size
+	sementicStoreScript = `
+redis.call('HSET', KEYS[2], ARGV[1], ARGV[2])
+redis.call('HSET', KEYS[3], ARGV[1], ARGV[3])
+redis.call('ZADD', KEYS[4], ARGV[4], ARGV[1])
+local excess = redis.call('ZCARD', KEYS[4]) - tonumber(ARGV[5])
+if excess > 0 then
+	local evicted = redis.call('ZPOPMIN', KEYS[4], excess)
+	for i = 1, #evicted, 2 do
+		redis.call('HDEL', KEYS[2], evicted[i])
+		redis.call('HDEL', KEYS[3], evicted[i])
+	end
+end
+for i = 2, 4 do
+	if tonumber(ARGV[6]) > 0 then
+		redis.call('EXPIRE', KEYS[i], ARGV[6])
+	end
+	redis.call('ZADD', KEYS[1], ARGV[7], KEYS[i])
+end
+redis.call('ZREMRANGEBYSCORE', KEYS[1], '-inf', '(' .. ARGV[8])
+return 1`
+
+	// removes the prompts from the sementic cache
+	sementicRemoveScript = `
+for i = 1, #ARGV do
+	redis.call('HDEL', KEYS[1], ARGV[i])
+	redis.call('HDEL', KEYS[2], ARGV[i])
+	redis.call('ZREM', KEYS[3], ARGV[i])
+end
+return #ARGV`
+)
+
+// keys of the sementic cache, embeddings are kept apart from the responses so the lookup
+// reads only the embeddings and the response of the match
+type sementicKeys struct {
+	embeddings string
+	responses  string
+	// prompts scored by their last use
+	index string
+}
+
+func sementicCacheKeys(key string) sementicKeys {
+	return sementicKeys{
+		embeddings: key + "::EMBEDDINGS",
+		responses:  key + "::RESPONSES",
+		index:      key + "::INDEX",
+	}
+}
+
+type endpointCacheService struct {
+	logger            commons.Logger
+	redis             connectors.RedisConnector
+	integrationClient integration_client.IntegrationServiceClient
+	inputBuilder      integration_client_builders.InputEmbeddingBuilder
+	vaultClient       web_client.VaultClient
+}
+
+func NewEndpointCacheService(config *config.EndpointConfig, logger commons.Logger, redis connectors.RedisConnector) internal_service.EndpointCacheService {
+	return &endpointCacheService{
+		logger:            logger,
+		redis:             redis,
+		integrationClient: integration_client.NewIntegrationServiceClientGRPC(&config.AppConfig, logger, redis),
+		inputBuilder:      integration_client_builders.NewEmbeddingInputBuilder(logger),
+		vaultClient:       web_client.NewVaultClientGRPC(&config.AppConfig, logger, redis),
+	}
+}
+
+// cachePrefix of the keys of the endpoint, the hash tag keeps them in one slot of a cluster
+// so the scripts and the purge can work on them together
+func cachePrefix(endpointId uint64) string {
+	return fmt.Sprintf("ENDPOINT::CACHE::{%d}::", endpointId)
+}
+
+// registry of the cache keys of the endpoint scored by their expiry, purge deletes the keys
+// from it as scan covers only one node of a cluster
+func cacheRegistry(endpointId uint64) string {
+	return cachePrefix(endpointId) + "KEYS"
+}
+
+func (cs *endpointCacheService) Lookup(ctx context.Context,
+	auth types.SimplePrinciple,
+	endpoint *internal_gorm.Endpoint,
+	request *protos.ChatRequest,
+) (*internal_service.EndpointCacheLookup, error) {
+	start := time.Now()
+	defer func() { cs.logger.Benchmark("endpointCacheService.Lookup", time.Since(start)) }()
+	providerModel := endpoint.EndpointProviderModel
+	prompt, err := hash(providerModel.ModelProviderName, &protos.ChatRequest{Conversations: request.GetConversations()})
+	if err != nil {
+		return nil, err
+	}
+	options, err := hash(providerModel.ModelProviderName, &protos.ChatRequest{
+		ModelParameters: request.GetModelParameters(),
+		ToolDefinitions: request.GetToolDefinitions(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	switch endpoint.EndpointCaching.CacheType {
+	case internal_gorm.STANDARD_CACHE:
+		lookup := &internal_service.EndpointCacheLookup{
+			Key: fmt.Sprintf("%s%d::%s::%s", cachePrefix(endpoint.Id), providerModel.Id, options, prompt),
+		}
+		// MGET does not fail for a missing key unlike GET
+		values, err := cs.redis.Cmd(ctx, "MGET", []string{lookup.Key}).ResultSlice()
+		if err != nil {
+			return nil, err
+		}
+		if cached, ok := values[0].(string); ok {
+			lookup.Response = &protos.ChatResponse{}
+			if err := proto.Unmarshal([]byte(cached), lookup.Response); err != nil {
+				return nil, err
+			}
+			lookup.Similarity = 1
+		}
+		return lookup, nil
+	case internal_gorm.SEMENTIC_CACHE:
+		lookup := &internal_service.EndpointCacheLookup{
+			Key:   fmt.Sprintf("%s%d::%s::SEMENTIC", cachePrefix(endpoint.Id), providerModel.Id, options),
+			Field: prompt,
+		}
+		lookup.Embedding, err = cs.embedding(ctx, auth, endpoint.EndpointCaching, request.GetConversations())
+		if err != nil {
+			return nil, err
+		}
+		keys := sementicCacheKeys(lookup.Key)
+		fields, err := cs.redis.Cmd(ctx, "ZREVRANGE", []string{keys.index, "0", strconv.Itoa(sementicCacheSize - 1)}).ResultStringSlice()
+		if err != nil {
+			return nil, err
+		}
+		if len(fields) == 0 {
+			return lookup, nil
+		}
+		values, err := cs.redis.Cmd(ctx, "HMGET", append([]string{keys.embeddings}, fields...)).ResultSlice()
+		if err != nil {
+			return nil, err
+		}
+
+		threshold := float64(endpoint.EndpointCaching.MatchThreshold)
+		if threshold <= 0 {
+			threshold = defaultMatchThreshold
+		}
+		expired := make([]string, 0)
+		matched := ""
+		for i, value := range values {
+			raw, ok := value.(string)
+			if !ok {
+				continue
+			}
+			embedding, expireAt, err := decodeEmbedding([]byte(raw))
+			if err != nil {
+				continue
+			}
+			if expireAt > 0 && expireAt < start.Unix() {
+				expired = append(expired, fields[i])
+				continue
+			}
+			if similarity := Similarity(lookup.Embedding, embedding); similarity >= threshold && similarity > lookup.Similarity {
+				lookup.Similarity, matched = similarity, fields[i]
+			}
+		}
+		if len(expired) > 0 {
+			cs.redis.Cmd(ctx, "EVAL", append([]string{sementicRemoveScript, "3", keys.embeddings, keys.responses, keys.index}, expired...))
+		}
+		if matched == "" {
+			return lookup, nil
+		}
+		responses, err := cs.redis.Cmd(ctx, "HMGET", []string{keys.responses, matched}).ResultSlice()
+		if err != nil {
+			return nil, err
+		}
+		cached, ok := responses[0].(string)
+		if !ok {
+			// evicted since the embeddings were read
+			lookup.Similarity = 0
+			return lookup, nil
+		}
+		lookup.Response = &protos.ChatResponse{}
+		if err := proto.Unmarshal([]byte(cached), lookup.Response); err != nil {
+			return nil, err
+		}
+		// XX only touches the prompt when it is not evicted meanwhile
+		cs.redis.Cmd(ctx, "ZADD", []string{keys.index, "XX", strconv.FormatInt(start.UnixMilli(), 10), matched})
+		return lookup, nil
+	default:
+		return nil, fmt.Errorf("illegal cache type %s for endpoint", endpoint.EndpointCaching.CacheType)
+	}
+}
+
+func (cs *endpointCacheService) Store(ctx context.Context,
+	endpoint *internal_gorm.Endpoint,
+	lookup *internal_service.EndpointCacheLookup,
+	response *protos.ChatResponse,
+) error {
+	start := time.Now()
+	defer func() { cs.logger.Benchmark("endpointCacheService.Store", time.Since(start)) }()
+	value, err := proto.Marshal(response)
+	if err != nil {
+		return err
+	}
+	expiry := endpoint.EndpointCaching.ExpiryInterval
+	// registry score of the keys, keys without expiry stay until purged
+	registryScore := "+inf"
+	var expireAt int64
+	if expiry > 0 {
+		expireAt = start.Add(time.Duration(expiry) * time.Second).Unix()
+		registryScore = strconv.FormatInt(expireAt, 10)
+	}
+	ttl := strconv.FormatUint(expiry, 10)
+	now := strconv.FormatInt(start.Unix(), 10)
+
+	if lookup.Field == "" {
+		return cs.redis.Cmd(ctx, "EVAL", []string{standardStoreScript, "2",
+			cacheRegistry(endpoint.Id), lookup.Key,
+			string(value), ttl, registryScore, now,
+		}).Error()
+	}
+
+	keys := sementicCacheKeys(lookup.Key)
+	return cs.redis.Cmd(ctx, "EVAL", []string{sementicStoreScript, "4",
+		cacheRegistry(endpoint.Id), keys.embeddings, keys.responses, keys.index,
+		lookup.Field,
+		string(encodeEmbedding(lookup.Embedding, expireAt)),
+		string(value),
+		strconv.FormatInt(start.UnixMilli(), 10),
+		strconv.Itoa(sementicCacheSize),
+		ttl, registryScore, now,
+	}).Error()
+}
+
+func (cs *endpointCacheService) Purge(ctx context.Context, endpointId uint64) (int64, error) {
+	start := time.Now()
+	defer func() { cs.logger.Benchmark("endpointCacheService.Purge", time.Since(start)) }()
+	registry := cacheRegistry(endpointId)
+	keys, err := cs.redis.Cmd(ctx, "ZRANGE", []string{registry, "0", "-1"}).ResultStringSlice()
+	if err != nil {
+		return 0, err
+	}
+	var purged int64
+	for batch := range slices.Chunk(keys, purgeBatch) {
+		res := cs.redis.Cmd(ctx, "DEL", batch)
+		if res.HasError() {
+			return purged, res.Error()
+		}
+		if deleted, ok := res.Result.(int64); ok {
+			purged += deleted
+		}
+		// keys stored while purging stay registered for the next purge
+		if res := cs.redis.Cmd(ctx, "ZREM", append([]string{registry}, batch...)); res.HasError() {
+			return purged, res.Error()
+		}
+	}
+	return purged, nil
+}
+
+// embedding of the rendered prompt with the embedding model of the cache configuration
+func (cs *endpointCacheService) embedding(ctx context.Context,
+	auth types.SimplePrinciple,
+	caching *internal_gorm.EndpointCaching,
+	conversations []*protos.Message,
+) ([]float64, error) {
+	if caching.EmbeddingModelProviderName == "" {
+		return nil, errors.New("embedding model is not configured for sementic cache")
+	}
+	credentialID, err := strconv.ParseUint(caching.EmbeddingModelOptions["rapida.credential_id"], 10, 64)
+	if err != nil {
+		return nil, errors.New("rapida.credential_id not found in embedding model options")
+	}
+	vlt, err := cs.vaultClient.GetCredential(ctx, auth, credentialID)
+	if err != nil {
+		return nil, err
+	}
+
+	var prompt strings.Builder
+	for _, message := range conversations {
+		prompt.WriteString(message.GetRole())
+		prompt.WriteString(": ")
+		prompt.WriteString(types.OnlyStringProtoContent(message.GetContents()))
+		prompt.WriteString("\n")
+	}
+	opts := make(map[string]interface{}, len(caching.EmbeddingModelOptions))
+	for k, v := range caching.EmbeddingModelOptions {
+		opts[k] = v
+	}
+	res, err := cs.integrationClient.Embedding(ctx,
+		auth,
+		caching.EmbeddingModelProviderName,
+		cs.inputBuilder.Embedding(
+			cs.inputBuilder.Credential(vlt.GetId(), vlt.GetValue()),
+			cs.inputBuilder.Options(opts, nil),
+			map[string]string{},
+			map[int32]string{0: prompt.String()},
+		))
+	if err != nil {
+		return nil, err
+	}
+	if !res.GetSuccess() || len(res.GetData()) == 0 {
+		return nil, fmt.Errorf("unable to embed the prompt %s", res.GetError().GetErrorMessage())
+	}
+	return res.GetData()[0].GetEmbedding(), nil
+}
+
+// hash of the request with the provider, marshalled deterministic so the same request has the same hash
+func hash(providerName string, request *protos.ChatRequest) (string, error) {
+	raw, err := proto.MarshalOptions{Deterministic: true}.Marshal(request)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(append([]byte(providerName+"::"), raw...))
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// encodeEmbedding packs the expiry of the prompt and its embedding as float32, a quarter
+// of its size in json which the lookup reads for every cached prompt
+func encodeEmbedding(embedding []float64, expireAt int64) []byte {
+	buf := make([]byte, 8+4*len(embedding))
+	binary.LittleEndian.PutUint64(buf, uint64(expireAt))
+	for i, v := range embedding {
+		binary.LittleEndian.PutUint32(buf[8+4*i:], math.Float32bits(float32(v)))
+	}
+	return buf
+}
+
+func decodeEmbedding(buf []byte) ([]float64, int64, error) {
+	if len(buf) < 8 || (len(buf)-8)%4 != 0 {
+		return nil, 0, errors.New("invalid cached embedding")
+	}
+	embedding := make([]float64, (len(buf)-8)/4)
+	for i := range embedding {
+		embedding[i] = float64(math.Float32frombits(binary.LittleEndian.Uint32(buf[8+4*i:])))
+	}
+	return embedding, int64(binary.LittleEndian.Uint64(buf)), nil
+}
+
+// Similarity returns the cosine similarity of the embeddings
+func Similarity(a, b []float64) float64 {
+	if len(a) == 0 || len(a) != len(b) {
+		return 0
+	}
+	var dot, normA, normB float64
+	for i := range a {
+		dot += a[i] * b[i]
+		normA += a[i] * a[i]
+		normB += b[i] * b[i]
+	}
+	if normA == 0 || normB == 0 {
+		return 0
+	}
+	return dot / (math.Sqrt(normA) * math.Sqrt(normB))
+}
@@ -0,0 +1,91 @@
+package internal_cache_service
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	protos "github.com/rapidaai/protos"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestSimilarity(t *testing.T) {
+	tests := []struct {
+		a, b []float64
+		want float64
+	}{
+		{[]float64{1, 0}, []float64{1, 0}, 1},
+		{[]float64{1, 0}, []float64{0, 1}, 0},
+		{[]float64{1, 1}, []float64{-1, -1}, -1},
+		{[]float64{1, 2}, []float64{1}, 0},
+		{[]float64{0, 0}, []float64{1, 1}, 0},
+	}
+	for _, tt := range tests {
+		if got := Similarity(tt.a, tt.b); math.Abs(got-tt.want) > 1e-9 {
+			t.Errorf("Similarity(%v, %v) = %v, want %v", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestHash(t *testing.T) {
+	parameters := func() map[string]*anypb.Any {
+		params := make(map[string]*anypb.Any)
+		for k, v := range map[string]interface{}{"model.name": "gpt-4o", "model.temperature": 0.2, "model.max_tokens": 200} {
+			value, _ := structpb.NewValue(v)
+			params[k], _ = anypb.New(value)
+		}
+		return params
+	}
+	a, err := hash("openai", &protos.ChatRequest{ModelParameters: parameters()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for range 10 {
+		if b, _ := hash("openai", &protos.ChatRequest{ModelParameters: parameters()}); a != b {
+			t.Fatalf("hash of the same request differs %s %s", a, b)
+		}
+	}
+	if b, _ := hash("anthropic", &protos.ChatRequest{ModelParameters: parameters()}); a == b {
+		t.Error("hash should differ by provider")
+	}
+}
+
+func TestEmbeddingEncoding(t *testing.T) {
+	embedding := []float64{0.25, -1, 0, 3.5}
+	buf := encodeEmbedding(embedding, 1700000000)
+	if len(buf) != 8+4*len(embedding) {
+		t.Fatalf("encoded size = %d, want %d", len(buf), 8+4*len(embedding))
+	}
+	decoded, expireAt, err := decodeEmbedding(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expireAt != 1700000000 {
+		t.Errorf("expireAt = %d, want 1700000000", expireAt)
+	}
+	if got := Similarity(embedding, decoded); math.Abs(got-1) > 1e-6 {
+		t.Errorf("decoded embedding differs %v %v", embedding, decoded)
+	}
+	if _, _, err := decodeEmbedding(buf[:9]); err == nil {
+		t.Error("expected error for truncated embedding")
+	}
+}
+
+func TestCacheKeysShareSlot(t *testing.T) {
+	// keys of the endpoint are used together by scripts and purge, in a cluster they must hash to one slot
+	tag := func(key string) string {
+		start := strings.Index(key, "{")
+		end := strings.Index(key[start+1:], "}")
+		if start < 0 || end <= 0 {
+			return key
+		}
+		return key[start+1 : start+1+end]
+	}
+	keys := sementicCacheKeys(cachePrefix(42) + "7::options::SEMENTIC")
+	for _, key := range []string{cacheRegistry(42), keys.embeddings, keys.responses, keys.index} {
+		if got := tag(key); got != "42" {
+			t.Errorf("hash tag of %s = %s, want 42", key, got)
+		}
+	}
+}
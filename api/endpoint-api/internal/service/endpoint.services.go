@@ -9,9 +9,11 @@ import (
 )
 
 type GetEndpointOption struct {
-	InjectTag     bool
-	InjectRetry   bool
-	InjectCaching bool
+	InjectTag            bool
+	InjectRetry          bool
+	InjectCaching        bool
+	InjectResponseSchema bool
+	InjectTrafficSplit   bool
 }
 
 func NewGetEndpointOption() *GetEndpointOption {
@@ -20,9 +22,11 @@ func NewGetEndpointOption() *GetEndpointOption {
 
 func NewDefaultGetEndpointOption() *GetEndpointOption {
 	return &GetEndpointOption{
-		InjectTag:     true,
-		InjectRetry:   true,
-		InjectCaching: true,
+		InjectTag:            true,
+		InjectRetry:          true,
+		InjectCaching:        true,
+		InjectResponseSchema: true,
+		InjectTrafficSplit:   true,
 	}
 }
 
@@ -73,6 +77,8 @@ type EndpointService interface {
 		caching internal_gorm.Cache,
 		expiryInterval uint64,
 		matchThreshold float32,
+		embeddingModelProviderName string,
+		embeddingModelOptions map[string]string,
 	) (*internal_gorm.EndpointCaching, error)
 
 	/**/
@@ -85,8 +91,28 @@ type EndpointService interface {
 		delaySeconds uint64,
 		exponentialBackoff bool,
 		retryables []string,
+		failoverEndpointProviderModelId uint64,
 	) (*internal_gorm.EndpointRetry, error)
 
+	// ConfigureEndpointResponseSchema configures the json schema of the response of the endpoint,
+	// an empty schema removes the structured output of the endpoint
+	ConfigureEndpointResponseSchema(ctx context.Context,
+		auth types.SimplePrinciple,
+		endpointId uint64,
+		name string,
+		schema string,
+		strict bool,
+		maxRepairAttempts uint64,
+	) (*internal_gorm.EndpointResponseSchema, error)
+
+	// ConfigureEndpointTrafficSplit replaces the traffic split of the endpoint with the weights of the
+	// provider model versions, an empty split routes the traffic to the version of the endpoint
+	ConfigureEndpointTrafficSplit(ctx context.Context,
+		auth types.SimplePrinciple,
+		endpointId uint64,
+		splits []*endpoint_grpc_api.EndpointTrafficSplit,
+	) ([]*internal_gorm.EndpointTrafficSplit, error)
+
 	//
 	CreateOrUpdateEndpointTag(ctx context.Context,
 		auth types.SimplePrinciple,
@@ -15,6 +15,7 @@ import (
 	type_enums "github.com/rapidaai/pkg/types/enums"
 	"github.com/rapidaai/pkg/utils"
 	endpoint_grpc_api "github.com/rapidaai/protos"
+	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 )
 
@@ -32,6 +33,11 @@ func NewEndpointService(cfg *config.EndpointConfig, logger commons.Logger, postg
 	}
 }
 
+// activeTrafficSplits preloads the active splits in the order of the version, the keys are assigned in that order
+func activeTrafficSplits(db *gorm.DB) *gorm.DB {
+	return db.Where("status = ?", type_enums.RECORD_ACTIVE.String()).Order("endpoint_provider_model_id")
+}
+
 func (eService *endpointService) Get(ctx context.Context,
 	auth types.SimplePrinciple,
 	endpointId uint64,
@@ -43,13 +49,19 @@ func (eService *endpointService) Get(ctx context.Context,
 
 	tx := db
 	if opts.InjectCaching {
-		tx.Preload("EndpointCaching")
+		tx = tx.Preload("EndpointCaching")
 	}
 	if opts.InjectRetry {
-		tx.Preload("EndpointRetry")
+		tx = tx.Preload("EndpointRetry")
 	}
 	if opts.InjectTag {
-		tx.Preload("EndpointTag")
+		tx = tx.Preload("EndpointTag")
+	}
+	if opts.InjectResponseSchema {
+		tx = tx.Preload("EndpointResponseSchema")
+	}
+	if opts.InjectTrafficSplit {
+		tx = tx.Preload("EndpointTrafficSplits", activeTrafficSplits)
 	}
 
 	if endpointProviderModelId != nil {
@@ -115,6 +127,8 @@ func (eService *endpointService) GetAll(ctx context.Context, auth types.SimplePr
 		Preload("EndpointTag").
 		Preload("EndpointRetry").
 		Preload("EndpointCaching").
+		Preload("EndpointResponseSchema").
+		Preload("EndpointTrafficSplits", activeTrafficSplits).
 		Preload("EndpointProviderModel").
 		Where("organization_id = ? AND project_id = ? AND status = ?", *auth.GetCurrentOrganizationId(), *auth.GetCurrentProjectId(), type_enums.RECORD_ACTIVE.String())
 	for _, ct := range criterias {
@@ -318,6 +332,7 @@ func (eService *endpointService) ConfigureEndpointRetry(ctx context.Context,
 	delaySeconds uint64,
 	exponentialBackoff bool,
 	retryables []string,
+	failoverEndpointProviderModelId uint64,
 ) (*internal_gorm.EndpointRetry, error) {
 	db := eService.postgres.DB(ctx)
 
@@ -348,11 +363,14 @@ func (eService *endpointService) ConfigureEndpointRetry(ctx context.Context,
 			UpdatedBy: *auth.GetUserId(),
 		},
 	}
+	if failoverEndpointProviderModelId > 0 {
+		retryEndpoint.FailoverEndpointProviderModelId = &failoverEndpointProviderModelId
+	}
 	tx = db.Clauses(clause.OnConflict{
 		Columns: []clause.Column{{Name: "endpoint_id"}, {Name: "retry_type"}},
 		DoUpdates: clause.AssignmentColumns([]string{
 			"max_attempts",
-			"delay_seconds", "exponential_backoff", "retryables"}),
+			"delay_seconds", "exponential_backoff", "retryables", "failover_endpoint_provider_model_id"}),
 	}).Create(&retryEndpoint)
 
 	if tx.Error != nil {
@@ -371,6 +389,8 @@ func (eService *endpointService) ConfigureEndpointCaching(ctx context.Context,
 	caching internal_gorm.Cache,
 	expiryInterval uint64,
 	matchThreshold float32,
+	embeddingModelProviderName string,
+	embeddingModelOptions map[string]string,
 ) (*internal_gorm.EndpointCaching, error) {
 	db := eService.postgres.DB(ctx)
 	cacheEnable := caching != internal_gorm.NEVER_CACHE
@@ -395,12 +415,16 @@ func (eService *endpointService) ConfigureEndpointCaching(ctx context.Context,
 		MatchThreshold: matchThreshold,
 		CreatedBy:      *auth.GetUserId(),
 		UpdatedBy:      *auth.GetUserId(),
+
+		EmbeddingModelProviderName: embeddingModelProviderName,
+		EmbeddingModelOptions:      embeddingModelOptions,
 	}
 	tx = db.Clauses(clause.OnConflict{
 		Columns: []clause.Column{{Name: "endpoint_id"}},
 		DoUpdates: clause.AssignmentColumns([]string{
 			"cache_type",
-			"expiry_interval", "match_threshold"}),
+			"expiry_interval", "match_threshold",
+			"embedding_model_provider_name", "embedding_model_options"}),
 	}).Create(&cachingEndpoint)
 
 	if tx.Error != nil {
@@ -410,6 +434,94 @@ func (eService *endpointService) ConfigureEndpointCaching(ctx context.Context,
 	return cachingEndpoint, nil
 }
 
+/*
+Configuring endpoint response schema
+*/
+func (eService *endpointService) ConfigureEndpointResponseSchema(ctx context.Context,
+	auth types.SimplePrinciple,
+	endpointId uint64,
+	name string,
+	schema string,
+	strict bool,
+	maxRepairAttempts uint64,
+) (*internal_gorm.EndpointResponseSchema, error) {
+	db := eService.postgres.DB(ctx)
+	responseSchema := &internal_gorm.EndpointResponseSchema{
+		EndpointId:        endpointId,
+		Name:              name,
+		Schema:            schema,
+		Strict:            strict,
+		MaxRepairAttempts: maxRepairAttempts,
+		Mutable: gorm_models.Mutable{
+			CreatedBy: *auth.GetUserId(),
+			UpdatedBy: *auth.GetUserId(),
+		},
+	}
+	tx := db.Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "endpoint_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{
+			"name", "schema", "strict", "max_repair_attempts", "updated_by"}),
+	}).Create(&responseSchema)
+
+	if tx.Error != nil {
+		eService.logger.Errorf("error while updating response schema configuration %v", tx.Error)
+		return nil, tx.Error
+	}
+	return responseSchema, nil
+}
+
+/*
+Configuring endpoint traffic split
+*/
+func (eService *endpointService) ConfigureEndpointTrafficSplit(ctx context.Context,
+	auth types.SimplePrinciple,
+	endpointId uint64,
+	splits []*endpoint_grpc_api.EndpointTrafficSplit,
+) ([]*internal_gorm.EndpointTrafficSplit, error) {
+	db := eService.postgres.DB(ctx)
+	trafficSplits := make([]*internal_gorm.EndpointTrafficSplit, 0, len(splits))
+	versions := make([]uint64, 0, len(splits))
+	for _, split := range splits {
+		trafficSplits = append(trafficSplits, &internal_gorm.EndpointTrafficSplit{
+			EndpointId:              endpointId,
+			EndpointProviderModelId: split.GetEndpointProviderModelId(),
+			Weight:                  split.GetWeight(),
+			Mutable: gorm_models.Mutable{
+				CreatedBy: *auth.GetUserId(),
+				UpdatedBy: *auth.GetUserId(),
+			},
+		})
+		versions = append(versions, split.GetEndpointProviderModelId())
+	}
+
+	err := db.Transaction(func(tx *gorm.DB) error {
+		if len(versions) > 0 {
+			var cnt int64
+			if err := tx.Model(&internal_gorm.EndpointProviderModel{}).
+				Where("endpoint_id = ? AND id IN ?", endpointId, versions).
+				Count(&cnt).Error; err != nil {
+				return err
+			}
+			if cnt != int64(len(versions)) {
+				return fmt.Errorf("traffic split has versions which are not of the endpoint %d", endpointId)
+			}
+		}
+		if err := tx.Where("endpoint_id = ?", endpointId).
+			Delete(&internal_gorm.EndpointTrafficSplit{}).Error; err != nil {
+			return err
+		}
+		if len(trafficSplits) == 0 {
+			return nil
+		}
+		return tx.Create(&trafficSplits).Error
+	})
+	if err != nil {
+		eService.logger.Errorf("error while updating traffic split configuration %v", err)
+		return nil, err
+	}
+	return trafficSplits, nil
+}
+
 func (eService *endpointService) CreateOrUpdateEndpointTag(ctx context.Context,
 	auth types.SimplePrinciple,
 	endpointId uint64,
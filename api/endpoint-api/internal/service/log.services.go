@@ -2,6 +2,7 @@ package internal_service
 
 import (
 	"context"
+	"time"
 
 	internal_gorm "github.com/rapidaai/api/endpoint-api/internal/entity"
 	"github.com/rapidaai/pkg/types"
@@ -33,4 +34,10 @@ type EndpointLogService interface {
 		criterias []*endpoint_grpc_api.Criteria, paginate *endpoint_grpc_api.Paginate) (int64, []*internal_gorm.EndpointLog, error)
 	GetEndpointLog(ctx context.Context, auth types.SimplePrinciple, logId, endpointId uint64) (*internal_gorm.EndpointLog, error)
 	GetAggregatedEndpointAnalytics(ctx context.Context, auth types.SimplePrinciple, endpointId uint64) *protos.AggregatedEndpointAnalytics
+	// GetEndpointVariantAnalytics aggregates the logs of the endpoint created since by the provider model version
+	// which was invoked, with the average of the feedback metrics attached to the logs. The latency leaves out
+	// the responses served from the cache.
+	GetEndpointVariantAnalytics(ctx context.Context, auth types.SimplePrinciple, endpointId uint64, from time.Time, feedbackMetrics []string) ([]*protos.EndpointVariantAnalytics, error)
+	// ApplyFeedback attaches the feedback of the client to the log of the invoke as metrics
+	ApplyFeedback(ctx context.Context, auth types.SimplePrinciple, logId uint64, feedbacks []*types.Metric) ([]*internal_gorm.EndpointLogMetric, error)
 }
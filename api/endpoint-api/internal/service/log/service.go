@@ -17,6 +17,7 @@ import (
 	"github.com/rapidaai/pkg/utils"
 	endpoint_grpc_api "github.com/rapidaai/protos"
 	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 )
 
@@ -57,6 +58,8 @@ func (els *endpointLogService) CreateEndpointLog(
 	tx := db.Clauses(clause.OnConflict{
 		Columns: []clause.Column{{Name: "id"}},
 		DoUpdates: clause.AssignmentColumns([]string{
+			"endpoint_id",
+			"endpoint_provider_model_id",
 			"updated_date"}),
 	}).Create(&endpointLog)
 	if tx.Error != nil {
@@ -435,3 +438,151 @@ func (els *endpointLogService) GetAggregatedEndpointAnalytics(ctx context.Contex
 	}
 
 }
+
+// numeric values of the metrics, the values are text and feedbacks may be labels
+const numericMetricPattern = `^\s*[-+]?([0-9]+\.?[0-9]*|\.[0-9]+)([eE][-+]?[0-9]+)?\s*$`
+
+// latency of the logs of a variant, responses served from the cache are left out of the latency
+type variantLatency struct {
+	EndpointProviderModelId uint64
+	Count                   uint64
+	AverageLatency          float64
+	P50Latency              float64
+	P99Latency              float64
+}
+
+// aggregate of a metric of the logs of a variant
+type variantMetric struct {
+	EndpointProviderModelId uint64
+	Name                    string
+	FailedCount             uint64
+	Count                   uint64
+	Total                   float64
+}
+
+func (els *endpointLogService) GetEndpointVariantAnalytics(ctx context.Context,
+	auth types.SimplePrinciple,
+	endpointId uint64,
+	from time.Time,
+	feedbackMetrics []string,
+) ([]*endpoint_grpc_api.EndpointVariantAnalytics, error) {
+	start := time.Now()
+	db := els.postgres.DB(ctx)
+	filter := func(tx *gorm.DB) *gorm.DB {
+		return tx.Where("endpoint_logs.organization_id = ? AND endpoint_logs.project_id = ? AND endpoint_logs.endpoint_id = ? AND endpoint_logs.created_date >= ? AND endpoint_logs.status = ?",
+			*auth.GetCurrentOrganizationId(), *auth.GetCurrentProjectId(), endpointId, from, type_enums.RECORD_COMPLETE.String())
+	}
+
+	logs := db.Model(&internal_gorm.EndpointLog{}).
+		Select(`endpoint_logs.endpoint_provider_model_id,
+			endpoint_logs.time_taken,
+			EXISTS (SELECT 1 FROM endpoint_log_metrics WHERE endpoint_log_metrics.endpoint_log_id = endpoint_logs.id AND endpoint_log_metrics.name = ? AND endpoint_log_metrics.value = ?) AS cached`,
+			internal_service.CACHE_HIT_METRIC, "true").
+		Scopes(filter)
+	var latencies []*variantLatency
+	tx := db.Table("(?) AS logs", logs).
+		Select(`endpoint_provider_model_id,
+			COUNT(*) AS count,
+			COALESCE(AVG(time_taken) FILTER (WHERE NOT cached), 0) AS average_latency,
+			COALESCE(percentile_cont(0.5) WITHIN GROUP (ORDER BY time_taken) FILTER (WHERE NOT cached), 0) AS p50_latency,
+			COALESCE(percentile_cont(0.99) WITHIN GROUP (ORDER BY time_taken) FILTER (WHERE NOT cached), 0) AS p99_latency`).
+		Group("endpoint_provider_model_id").
+		Order("endpoint_provider_model_id").
+		Scan(&latencies)
+	if tx.Error != nil {
+		els.logger.Errorf("unable to get the latency of endpoint %d %v", endpointId, tx.Error)
+		return nil, tx.Error
+	}
+
+	var metrics []*variantMetric
+	tx = db.Model(&internal_gorm.EndpointLogMetric{}).
+		Joins("JOIN endpoint_logs ON endpoint_logs.id = endpoint_log_metrics.endpoint_log_id").
+		Select(`endpoint_logs.endpoint_provider_model_id,
+			endpoint_log_metrics.name,
+			COUNT(DISTINCT endpoint_log_metrics.endpoint_log_id) FILTER (WHERE endpoint_log_metrics.value = ?) AS failed_count,
+			COUNT(*) FILTER (WHERE endpoint_log_metrics.value ~ ?) AS count,
+			COALESCE(SUM(CASE WHEN endpoint_log_metrics.value ~ ? THEN endpoint_log_metrics.value::double precision END), 0) AS total`,
+			type_enums.RECORD_FAILED.String(), numericMetricPattern, numericMetricPattern).
+		Scopes(filter).
+		Where("endpoint_log_metrics.name IN ?", append([]string{
+			type_enums.STATUS.String(),
+			type_enums.TOTAL_TOKEN.String(),
+			type_enums.INPUT_COST.String(),
+			type_enums.OUTPUT_COST.String(),
+		}, feedbackMetrics...)).
+		Group("endpoint_logs.endpoint_provider_model_id, endpoint_log_metrics.name").
+		Scan(&metrics)
+	if tx.Error != nil {
+		els.logger.Errorf("unable to get the metrics of endpoint %d %v", endpointId, tx.Error)
+		return nil, tx.Error
+	}
+	els.logger.Benchmark("EndpointLogService.GetEndpointVariantAnalytics", time.Since(start))
+	return variantAnalytics(latencies, metrics), nil
+}
+
+// variantAnalytics merges the latency and the metrics of the variants, ordered by the variant
+func variantAnalytics(latencies []*variantLatency, metrics []*variantMetric) []*endpoint_grpc_api.EndpointVariantAnalytics {
+	variants := make(map[uint64]*endpoint_grpc_api.EndpointVariantAnalytics, len(latencies))
+	out := make([]*endpoint_grpc_api.EndpointVariantAnalytics, 0, len(latencies))
+	for _, latency := range latencies {
+		variant := &endpoint_grpc_api.EndpointVariantAnalytics{
+			EndpointProviderModelId: latency.EndpointProviderModelId,
+			Count:                   latency.Count,
+			AverageLatency:          float32(latency.AverageLatency),
+			P50Latency:              float32(latency.P50Latency),
+			P99Latency:              float32(latency.P99Latency),
+			Feedbacks:               make(map[string]float32),
+			FeedbackCounts:          make(map[string]uint64),
+		}
+		variants[latency.EndpointProviderModelId] = variant
+		out = append(out, variant)
+	}
+	for _, metric := range metrics {
+		variant, ok := variants[metric.EndpointProviderModelId]
+		if !ok {
+			continue
+		}
+		switch type_enums.MetricName(metric.Name) {
+		case type_enums.STATUS:
+			// the status is of the provider, responses from the cache have none
+			variant.ErrorCount = metric.FailedCount
+		case type_enums.TOTAL_TOKEN:
+			variant.TotalToken += uint64(metric.Total)
+		case type_enums.INPUT_COST, type_enums.OUTPUT_COST:
+			variant.TotalCost += float32(metric.Total)
+		default:
+			if metric.Count > 0 {
+				variant.FeedbackCounts[metric.Name] = metric.Count
+				variant.Feedbacks[metric.Name] = float32(metric.Total / float64(metric.Count))
+			}
+		}
+	}
+	for _, variant := range out {
+		if variant.Count > 0 {
+			variant.ErrorRate = float32(variant.ErrorCount) / float32(variant.Count)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].EndpointProviderModelId < out[j].EndpointProviderModelId
+	})
+	return out
+}
+
+func (els *endpointLogService) ApplyFeedback(ctx context.Context,
+	auth types.SimplePrinciple,
+	logId uint64,
+	feedbacks []*types.Metric,
+) ([]*internal_gorm.EndpointLogMetric, error) {
+	db := els.postgres.DB(ctx)
+	var cnt int64
+	tx := db.Model(&internal_gorm.EndpointLog{}).
+		Where("id = ? AND organization_id = ? AND project_id = ?", logId, *auth.GetCurrentOrganizationId(), *auth.GetCurrentProjectId()).
+		Count(&cnt)
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+	if cnt == 0 {
+		return nil, fmt.Errorf("endpoint log %d not found", logId)
+	}
+	return els.ApplyMetrics(ctx, auth, logId, feedbacks)
+}
@@ -0,0 +1,42 @@
+package internal_log_service
+
+import (
+	"testing"
+
+	type_enums "github.com/rapidaai/pkg/types/enums"
+)
+
+func TestVariantAnalytics(t *testing.T) {
+	out := variantAnalytics([]*variantLatency{
+		{EndpointProviderModelId: 2, Count: 4, AverageLatency: 120, P50Latency: 100, P99Latency: 200},
+		{EndpointProviderModelId: 1, Count: 2, AverageLatency: 80, P50Latency: 80, P99Latency: 90},
+	}, []*variantMetric{
+		{EndpointProviderModelId: 2, Name: type_enums.STATUS.String(), FailedCount: 1},
+		{EndpointProviderModelId: 2, Name: type_enums.TOTAL_TOKEN.String(), Count: 3, Total: 300},
+		{EndpointProviderModelId: 2, Name: type_enums.INPUT_COST.String(), Count: 3, Total: 0.5},
+		{EndpointProviderModelId: 2, Name: type_enums.OUTPUT_COST.String(), Count: 3, Total: 0.25},
+		{EndpointProviderModelId: 2, Name: "rating", Count: 2, Total: 9},
+		{EndpointProviderModelId: 1, Name: "label", Count: 0},
+		// variant without logs in the window
+		{EndpointProviderModelId: 3, Name: "rating", Count: 1, Total: 5},
+	})
+	if len(out) != 2 || out[0].GetEndpointProviderModelId() != 1 || out[1].GetEndpointProviderModelId() != 2 {
+		t.Fatalf("variants = %v, want 1 and 2 in order", out)
+	}
+	v := out[1]
+	if v.GetErrorCount() != 1 || v.GetErrorRate() != 0.25 {
+		t.Errorf("errors = %d rate %v, want 1 rate 0.25", v.GetErrorCount(), v.GetErrorRate())
+	}
+	if v.GetTotalToken() != 300 || v.GetTotalCost() != 0.75 {
+		t.Errorf("tokens = %d cost %v, want 300 cost 0.75", v.GetTotalToken(), v.GetTotalCost())
+	}
+	if v.GetFeedbacks()["rating"] != 4.5 || v.GetFeedbackCounts()["rating"] != 2 {
+		t.Errorf("rating = %v of %d, want 4.5 of 2", v.GetFeedbacks()["rating"], v.GetFeedbackCounts()["rating"])
+	}
+	if v.GetP50Latency() != 100 || v.GetAverageLatency() != 120 {
+		t.Errorf("latency = p50 %v avg %v, want 100 and 120", v.GetP50Latency(), v.GetAverageLatency())
+	}
+	if _, ok := out[0].GetFeedbacks()["label"]; ok {
+		t.Error("feedback without numeric values should not be averaged")
+	}
+}
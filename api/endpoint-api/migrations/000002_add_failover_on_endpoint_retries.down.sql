@@ -0,0 +1 @@
+ALTER TABLE endpoint_retries DROP COLUMN IF EXISTS failover_endpoint_provider_model_id;
@@ -0,0 +1 @@
+ALTER TABLE endpoint_retries ADD COLUMN IF NOT EXISTS failover_endpoint_provider_model_id BIGINT;
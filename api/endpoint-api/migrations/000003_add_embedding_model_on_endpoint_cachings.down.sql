@@ -0,0 +1,2 @@
+ALTER TABLE endpoint_cachings DROP COLUMN IF EXISTS embedding_model_options;
+ALTER TABLE endpoint_cachings DROP COLUMN IF EXISTS embedding_model_provider_name;
@@ -0,0 +1,2 @@
+ALTER TABLE endpoint_cachings ADD COLUMN IF NOT EXISTS embedding_model_provider_name VARCHAR(200);
+ALTER TABLE endpoint_cachings ADD COLUMN IF NOT EXISTS embedding_model_options JSONB;
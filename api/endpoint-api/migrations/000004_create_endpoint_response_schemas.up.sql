@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS public.endpoint_response_schemas (
+    id bigint NOT NULL,
+    created_date timestamp without time zone DEFAULT now() NOT NULL,
+    updated_date timestamp without time zone,
+    endpoint_id bigint NOT NULL,
+    name character varying(200) NOT NULL,
+    schema text NOT NULL,
+    strict boolean DEFAULT false NOT NULL,
+    max_repair_attempts bigint DEFAULT 0 NOT NULL,
+    created_by bigint NOT NULL,
+    updated_by bigint,
+    status character varying(50) DEFAULT 'ACTIVE'::character varying NOT NULL,
+    CONSTRAINT endpoint_response_schemas_pkey PRIMARY KEY (id)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_endpoint_response_schemas ON public.endpoint_response_schemas USING btree (endpoint_id);
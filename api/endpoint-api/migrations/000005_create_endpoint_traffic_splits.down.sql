@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_endpoint_logs_endpoint_provider_model;
+DROP TABLE IF EXISTS endpoint_traffic_splits CASCADE;
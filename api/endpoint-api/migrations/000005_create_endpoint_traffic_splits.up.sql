@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS public.endpoint_traffic_splits (
+    id bigint NOT NULL,
+    created_date timestamp without time zone DEFAULT now() NOT NULL,
+    updated_date timestamp without time zone,
+    endpoint_id bigint NOT NULL,
+    endpoint_provider_model_id bigint NOT NULL,
+    weight bigint DEFAULT 0 NOT NULL,
+    created_by bigint NOT NULL,
+    updated_by bigint,
+    status character varying(50) DEFAULT 'ACTIVE'::character varying NOT NULL,
+    CONSTRAINT endpoint_traffic_splits_pkey PRIMARY KEY (id)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_endpoint_traffic_splits ON public.endpoint_traffic_splits USING btree (endpoint_id, endpoint_provider_model_id);
+
+CREATE INDEX IF NOT EXISTS idx_endpoint_logs_endpoint_provider_model ON public.endpoint_logs USING btree (endpoint_id, endpoint_provider_model_id, created_date);
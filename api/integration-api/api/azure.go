@@ -85,6 +85,7 @@ func (dgGRPC *azureIntegrationGRPCApi) VerifyCredential(c context.Context, irReq
 	}, nil
 }
 
-func (*azureIntegrationGRPCApi) GetModeration(context.Context, *integration_api.GetModerationRequest) (*integration_api.GetModerationResponse, error) {
-	panic("unimplemented")
+// GetModeration implements protos.AzureServiceServer.
+func (azGRPC *azureIntegrationGRPCApi) GetModeration(c context.Context, irRequest *integration_api.GetModerationRequest) (*integration_api.GetModerationResponse, error) {
+	return azGRPC.integrationApi.Moderation(c, irRequest, "AZURE-FOUNDRY", internal_azure_callers.NewModerationsCaller(azGRPC.logger, irRequest.GetCredential()))
 }
@@ -0,0 +1,74 @@
+// Rapida – Open Source Voice AI Orchestration Platform
+// Copyright (C) 2023-2025 Prashant Srivastav <prashant@rapida.ai>
+// Licensed under a modified GPL-2.0. See the LICENSE file for details.
+package integration_api
+
+import (
+	"context"
+
+	config "github.com/rapidaai/api/integration-api/config"
+	internal_callers "github.com/rapidaai/api/integration-api/internal/caller"
+	internal_bedrock_callers "github.com/rapidaai/api/integration-api/internal/caller/bedrock"
+	commons "github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/connectors"
+	integration_api "github.com/rapidaai/protos"
+)
+
+type bedrockIntegrationApi struct {
+	integrationApi
+}
+
+type bedrockIntegrationGRPCApi struct {
+	bedrockIntegrationApi
+}
+
+func NewBedrockGRPC(config *config.IntegrationConfig, logger commons.Logger, postgres connectors.PostgresConnector) integration_api.BedrockServiceServer {
+	return &bedrockIntegrationGRPCApi{
+		bedrockIntegrationApi{
+			integrationApi: NewInegrationApi(config, logger, postgres),
+		},
+	}
+}
+
+// Embedding implements protos.BedrockServiceServer.
+func (brGRPC *bedrockIntegrationGRPCApi) Embedding(c context.Context, irRequest *integration_api.EmbeddingRequest) (*integration_api.EmbeddingResponse, error) {
+	return brGRPC.integrationApi.Embedding(c, irRequest, "AWS-BEDROCK", internal_bedrock_callers.NewEmbeddingCaller(brGRPC.logger, irRequest.GetCredential()))
+}
+
+// Chat implements protos.BedrockServiceServer.
+func (brGRPC *bedrockIntegrationGRPCApi) Chat(c context.Context, irRequest *integration_api.ChatRequest) (*integration_api.ChatResponse, error) {
+	return brGRPC.integrationApi.Chat(c, irRequest, "AWS-BEDROCK", internal_bedrock_callers.NewLargeLanguageCaller(brGRPC.logger, irRequest.GetCredential()))
+}
+
+// StreamChat implements protos.BedrockServiceServer.
+func (brGRPC *bedrockIntegrationGRPCApi) StreamChat(irRequest *integration_api.ChatRequest, stream integration_api.BedrockService_StreamChatServer) error {
+	return brGRPC.integrationApi.StreamChat(
+		irRequest,
+		stream.Context(),
+		"AWS-BEDROCK",
+		internal_bedrock_callers.NewLargeLanguageCaller(brGRPC.logger, irRequest.GetCredential()),
+		stream.Send,
+	)
+}
+
+// VerifyCredential implements protos.BedrockServiceServer.
+func (brGRPC *bedrockIntegrationGRPCApi) VerifyCredential(c context.Context, irRequest *integration_api.VerifyCredentialRequest) (*integration_api.VerifyCredentialResponse, error) {
+	bedrockCaller := internal_bedrock_callers.NewVerifyCredentialCaller(brGRPC.logger, irRequest.GetCredential())
+	st, err := bedrockCaller.CredentialVerifier(
+		c,
+		&internal_callers.CredentialVerifierOptions{},
+	)
+	if err != nil {
+		brGRPC.logger.Errorf("verify credential response with error %v", err)
+		return &integration_api.VerifyCredentialResponse{
+			Code:         401,
+			Success:      false,
+			ErrorMessage: err.Error(),
+		}, nil
+	}
+	return &integration_api.VerifyCredentialResponse{
+		Code:     200,
+		Success:  true,
+		Response: st,
+	}, nil
+}
@@ -67,11 +67,31 @@ func (huggingf *huggingfaceIntegrationGRPCApi) Chat(c context.Context, irRequest
 		c, irRequest,
 
 		"HUGGINGFACE",
-		internal_huggingface_callers.NewLargeLanguageCaller(huggingf.logger, irRequest.GetCredential()),
+		huggingf.llmCaller(irRequest),
 	)
 
 }
 
+func (huggingf *huggingfaceIntegrationGRPCApi) StreamChat(irRequest *integration_api.ChatRequest, stream integration_api.HuggingfaceService_StreamChatServer) error {
+	return huggingf.integrationApi.StreamChat(
+		irRequest,
+		stream.Context(),
+		"HUGGINGFACE",
+		huggingf.llmCaller(irRequest),
+		stream.Send,
+	)
+}
+
+// llmCaller returns caller with native tool calling of text generation inference,
+// models served without tool support are given tools through prompt
+func (huggingf *huggingfaceIntegrationGRPCApi) llmCaller(irRequest *integration_api.ChatRequest) internal_callers.LargeLanguageCaller {
+	caller := internal_huggingface_callers.NewLargeLanguageCaller(huggingf.logger, irRequest.GetCredential())
+	if internal_callers.IsPromptToolCalling(irRequest.GetModelParameters()) {
+		return internal_callers.NewPromptToolCaller(huggingf.logger, caller)
+	}
+	return caller
+}
+
 func (huggingfaceGRPC *huggingfaceIntegrationGRPCApi) VerifyCredential(c context.Context, irRequest *integration_api.VerifyCredentialRequest) (*integration_api.VerifyCredentialResponse, error) {
 	antCaller := internal_huggingface_callers.NewVerifyCredentialCaller(huggingfaceGRPC.logger, irRequest.Credential)
 	st, err := antCaller.CredentialVerifier(
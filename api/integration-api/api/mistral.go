@@ -27,9 +27,24 @@ type mistralIntegrationGRPCApi struct {
 	mistralIntegrationApi
 }
 
+// llmCaller returns native tool calling caller of mistral, unless model parameters ask for prompt based tool calling
+func (mistral *mistralIntegrationGRPCApi) llmCaller(irRequest *integration_api.ChatRequest) internal_callers.LargeLanguageCaller {
+	caller := internal_mistral_callers.NewLargeLanguageCaller(mistral.logger, irRequest.GetCredential())
+	if internal_callers.IsPromptToolCalling(irRequest.GetModelParameters()) {
+		return internal_callers.NewPromptToolCaller(mistral.logger, caller)
+	}
+	return caller
+}
+
 // StreamChat implements protos.MistralServiceServer.
-func (*mistralIntegrationGRPCApi) StreamChat(*integration_api.ChatRequest, integration_api.MistralService_StreamChatServer) error {
-	panic("unimplemented")
+func (mistral *mistralIntegrationGRPCApi) StreamChat(irRequest *integration_api.ChatRequest, stream integration_api.MistralService_StreamChatServer) error {
+	return mistral.integrationApi.StreamChat(
+		irRequest,
+		stream.Context(),
+		"MISTRAL",
+		mistral.llmCaller(irRequest),
+		stream.Send,
+	)
 }
 
 // Embedding implements protos.mistralServiceServer.
@@ -63,7 +78,7 @@ func (mistralRPC *mistralIntegrationRPCApi) Chat(c *gin.Context) {
 
 // all grpc handler
 func (mistral *mistralIntegrationGRPCApi) Chat(c context.Context, irRequest *integration_api.ChatRequest) (*integration_api.ChatResponse, error) {
-	return mistral.integrationApi.Chat(c, irRequest, "MISTRAL", internal_mistral_callers.NewLargeLanguageCaller(mistral.logger, irRequest.GetCredential()))
+	return mistral.integrationApi.Chat(c, irRequest, "MISTRAL", mistral.llmCaller(irRequest))
 
 }
 
@@ -0,0 +1,71 @@
+// Rapida – Open Source Voice AI Orchestration Platform
+// Copyright (C) 2023-2025 Prashant Srivastav <prashant@rapida.ai>
+// Licensed under a modified GPL-2.0. See the LICENSE file for details.
+package integration_api
+
+import (
+	"context"
+	"errors"
+
+	internal_callers "github.com/rapidaai/api/integration-api/internal/caller"
+	"github.com/rapidaai/pkg/exceptions"
+	"github.com/rapidaai/pkg/types"
+	"github.com/rapidaai/pkg/utils"
+	integration_api "github.com/rapidaai/protos"
+)
+
+// Moderation classifies the content with moderation model of provider
+func (iApi *integrationApi) Moderation(c context.Context,
+	irRequest *integration_api.GetModerationRequest,
+	tag string,
+	caller internal_callers.ModerationsCaller,
+) (*integration_api.GetModerationResponse, error) {
+	iApi.logger.Infof("request for moderation %s", tag)
+	iAuth, isAuthenticated := types.GetSimplePrincipleGRPC(c)
+	if !isAuthenticated || !iAuth.HasProject() {
+		iApi.logger.Errorf("unauthenticated request for moderation")
+		return exceptions.APIAuthenticationError[integration_api.GetModerationResponse]()
+	}
+	requestId := iApi.RequestId()
+	if irRequest.AdditionalData == nil {
+		irRequest.AdditionalData = map[string]string{}
+	}
+
+	irRequest.AdditionalData["provider_name"] = tag
+	if model, ok := irRequest.ModelParameters["model.name"]; ok {
+		if mdl, err := utils.AnyToString(model); err == nil {
+			irRequest.AdditionalData["model_name"] = mdl
+		}
+	}
+
+	if source, ok := utils.GetClientSource(c); ok {
+		irRequest.AdditionalData["source"] = source.Get()
+	}
+
+	content := irRequest.GetContent()
+	moderations, metrics, err := caller.GetModeration(
+		c,
+		&types.Content{
+			ContentType:   content.GetContentType(),
+			ContentFormat: content.GetContentFormat(),
+			Content:       content.GetContent(),
+		},
+		internal_callers.NewModerationOptions(
+			requestId,
+			irRequest,
+			iApi.PreHook(c, iAuth, irRequest, requestId, tag),
+			iApi.PostHook(c, iAuth, irRequest, requestId, tag),
+		),
+	)
+	if err == nil {
+		return &integration_api.GetModerationResponse{
+			Code:      200,
+			Success:   true,
+			RequestId: requestId,
+			Data:      moderations,
+			Metrics:   metrics.ToProto(),
+		}, nil
+	}
+	iApi.logger.Errorf("unable to moderate the content %v", err)
+	return utils.Error[integration_api.GetModerationResponse](errors.New("illegal request while processing moderation"), "Unable to moderate the content, please try again")
+}
@@ -92,6 +92,6 @@ func (dgGRPC *openaiIntegrationApi) VerifyCredential(c context.Context, irReques
 }
 
 // GetModeration implements protos.OpenAiServiceServer.
-func (*openaiIntegrationGRPCApi) GetModeration(context.Context, *integration_api.GetModerationRequest) (*integration_api.GetModerationResponse, error) {
-	panic("unimplemented")
+func (oiGRPC *openaiIntegrationGRPCApi) GetModeration(c context.Context, irRequest *integration_api.GetModerationRequest) (*integration_api.GetModerationResponse, error) {
+	return oiGRPC.integrationApi.Moderation(c, irRequest, "OPENAI", internal_openai_callers.NewModerationsCaller(oiGRPC.logger, irRequest.GetCredential()))
 }
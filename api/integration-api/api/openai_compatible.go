@@ -0,0 +1,92 @@
+// Rapida – Open Source Voice AI Orchestration Platform
+// Copyright (C) 2023-2025 Prashant Srivastav <prashant@rapida.ai>
+// Licensed under a modified GPL-2.0. See the LICENSE file for details.
+package integration_api
+
+import (
+	"context"
+
+	config "github.com/rapidaai/api/integration-api/config"
+	internal_callers "github.com/rapidaai/api/integration-api/internal/caller"
+	internal_openai_callers "github.com/rapidaai/api/integration-api/internal/caller/openai"
+	commons "github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/connectors"
+	integration_api "github.com/rapidaai/protos"
+	"google.golang.org/grpc"
+)
+
+// openaiCompatibleIntegrationGRPCApi serves every provider exposing openai compatible api,
+// providers only differ by tag and the default base url
+type openaiCompatibleIntegrationGRPCApi struct {
+	integrationApi
+	tag     string
+	baseURL string
+}
+
+func newOpenAiCompatibleGRPC(config *config.IntegrationConfig, logger commons.Logger, postgres connectors.PostgresConnector, tag, baseURL string) *openaiCompatibleIntegrationGRPCApi {
+	return &openaiCompatibleIntegrationGRPCApi{
+		integrationApi: NewInegrationApi(config, logger, postgres),
+		tag:            tag,
+		baseURL:        baseURL,
+	}
+}
+
+func NewTogetherAiGRPC(config *config.IntegrationConfig, logger commons.Logger, postgres connectors.PostgresConnector) integration_api.TogetherAiServiceServer {
+	return newOpenAiCompatibleGRPC(config, logger, postgres, "TOGETHER-AI", internal_openai_callers.TOGETHER_AI_URL)
+}
+
+func NewDeepInfraGRPC(config *config.IntegrationConfig, logger commons.Logger, postgres connectors.PostgresConnector) integration_api.DeepInfraServiceServer {
+	return newOpenAiCompatibleGRPC(config, logger, postgres, "DEEP-INFRA", internal_openai_callers.DEEP_INFRA_URL)
+}
+
+func NewGroqGRPC(config *config.IntegrationConfig, logger commons.Logger, postgres connectors.PostgresConnector) integration_api.GroqServiceServer {
+	return newOpenAiCompatibleGRPC(config, logger, postgres, "GROQ", internal_openai_callers.GROQ_URL)
+}
+
+func NewFireworksGRPC(config *config.IntegrationConfig, logger commons.Logger, postgres connectors.PostgresConnector) integration_api.FireworksServiceServer {
+	return newOpenAiCompatibleGRPC(config, logger, postgres, "FIREWORKS", internal_openai_callers.FIREWORKS_URL)
+}
+
+// NewOpenAiCompatibleGRPC serves self hosted servers like vllm and ollama, url is given with credential
+func NewOpenAiCompatibleGRPC(config *config.IntegrationConfig, logger commons.Logger, postgres connectors.PostgresConnector) integration_api.OpenAiCompatibleServiceServer {
+	return newOpenAiCompatibleGRPC(config, logger, postgres, "OPENAI-COMPATIBLE", "")
+}
+
+func (ocGRPC *openaiCompatibleIntegrationGRPCApi) Embedding(c context.Context, irRequest *integration_api.EmbeddingRequest) (*integration_api.EmbeddingResponse, error) {
+	return ocGRPC.integrationApi.Embedding(c, irRequest, ocGRPC.tag, internal_openai_callers.NewCompatibleEmbeddingCaller(ocGRPC.logger, irRequest.GetCredential(), ocGRPC.baseURL))
+}
+
+func (ocGRPC *openaiCompatibleIntegrationGRPCApi) Chat(c context.Context, irRequest *integration_api.ChatRequest) (*integration_api.ChatResponse, error) {
+	return ocGRPC.integrationApi.Chat(c, irRequest, ocGRPC.tag, internal_openai_callers.NewCompatibleLargeLanguageCaller(ocGRPC.logger, irRequest.GetCredential(), ocGRPC.baseURL))
+}
+
+func (ocGRPC *openaiCompatibleIntegrationGRPCApi) StreamChat(irRequest *integration_api.ChatRequest, stream grpc.ServerStreamingServer[integration_api.ChatResponse]) error {
+	return ocGRPC.integrationApi.StreamChat(
+		irRequest,
+		stream.Context(),
+		ocGRPC.tag,
+		internal_openai_callers.NewCompatibleLargeLanguageCaller(ocGRPC.logger, irRequest.GetCredential(), ocGRPC.baseURL),
+		stream.Send,
+	)
+}
+
+func (ocGRPC *openaiCompatibleIntegrationGRPCApi) VerifyCredential(c context.Context, irRequest *integration_api.VerifyCredentialRequest) (*integration_api.VerifyCredentialResponse, error) {
+	verifier := internal_openai_callers.NewCompatibleVerifyCredentialCaller(ocGRPC.logger, irRequest.GetCredential(), ocGRPC.baseURL)
+	st, err := verifier.CredentialVerifier(
+		c,
+		&internal_callers.CredentialVerifierOptions{},
+	)
+	if err != nil {
+		ocGRPC.logger.Errorf("verify credential response with error %v", err)
+		return &integration_api.VerifyCredentialResponse{
+			Code:         401,
+			Success:      false,
+			ErrorMessage: err.Error(),
+		}, nil
+	}
+	return &integration_api.VerifyCredentialResponse{
+		Code:     200,
+		Success:  true,
+		Response: st,
+	}, nil
+}
@@ -28,8 +28,14 @@ type replicateIntegrationGRPCApi struct {
 }
 
 // StreamChat implements protos.ReplicateServiceServer.
-func (*replicateIntegrationGRPCApi) StreamChat(*integration_api.ChatRequest, integration_api.ReplicateService_StreamChatServer) error {
-	panic("unimplemented")
+func (replicateGRPC *replicateIntegrationGRPCApi) StreamChat(irRequest *integration_api.ChatRequest, stream integration_api.ReplicateService_StreamChatServer) error {
+	return replicateGRPC.integrationApi.StreamChat(
+		irRequest,
+		stream.Context(),
+		"REPLICATE",
+		internal_replicate_callers.NewLargeLanguageCaller(replicateGRPC.logger, irRequest.GetCredential()),
+		stream.Send,
+	)
 }
 
 func NewReplicateRPC(config *config.IntegrationConfig, logger commons.Logger, postgres connectors.PostgresConnector) *replicateIntegrationRPCApi {
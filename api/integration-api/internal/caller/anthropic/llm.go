@@ -131,6 +131,7 @@ func (llc *largeLanguageCaller) StreamChatCompletion(
 	params := llc.GetMessageNewParams(options)
 	params.Messages = messages
 	params.System = instruction
+	structured := structuredOutput(params)
 
 	client, err := llc.GetClient()
 	if err != nil {
@@ -175,6 +176,14 @@ func (llc *largeLanguageCaller) StreamChatCompletion(
 			llc.logger.Debugf("ContentBlockStartEvent %+v", event.JSON)
 			switch event.ContentBlock.Type {
 			case "tool_use":
+				if structured != "" && event.ContentBlock.Name == structured {
+					currentContent = &types.Content{
+						ContentType:   commons.TEXT_CONTENT.String(),
+						ContentFormat: commons.TEXT_CONTENT_FORMAT_RAW.String(),
+						Content:       []byte(""),
+					}
+					continue
+				}
 				isToolCall = true
 				currentToolCall = &types.ToolCall{
 					Id:   utils.Ptr(event.ContentBlock.ID),
@@ -213,6 +222,19 @@ func (llc *largeLanguageCaller) StreamChatCompletion(
 			case "input_json_delta":
 				if currentToolCall != nil {
 					currentToolCall.Function.MergeArguments(utils.Ptr(event.Delta.PartialJSON))
+					continue
+				}
+				// input of the structured output is streamed as the content
+				if content := event.Delta.PartialJSON; content != "" && currentContent != nil {
+					currentContent.Content = append(currentContent.Content, []byte(content)...)
+					onStream(types.Message{
+						Contents: []*types.Content{{
+							ContentType:   commons.TEXT_CONTENT.String(),
+							ContentFormat: commons.TEXT_CONTENT_FORMAT_RAW.String(),
+							Content:       []byte(content),
+						}},
+						Role: "assistant",
+					})
 				}
 			}
 
@@ -307,7 +329,47 @@ func (llc *largeLanguageCaller) GetMessageNewParams(opts *internal_callers.ChatC
 			if topP, err := utils.AnyToFloat64(value); err == nil {
 				options.TopP = anthropic.Float(topP)
 			}
-
+		case "model.response_format":
+			// anthropic has no response format, the schema is forced as the input of a tool
+			// and the input of the tool use is returned as the content
+			if format, err := utils.AnyToJSON(value); err == nil && format["type"] == "json_schema" {
+				if schemaData, ok := format["json_schema"].(map[string]interface{}); ok {
+					name, _ := schemaData["name"].(string)
+					schema, _ := schemaData["schema"].(map[string]interface{})
+					if name == "" || schema == nil {
+						continue
+					}
+					inputSchema := anthropic.ToolInputSchemaParam{
+						Properties:  schema["properties"],
+						ExtraFields: map[string]any{},
+					}
+					for k, v := range schema {
+						switch k {
+						case "type", "properties":
+						case "required":
+							if required, ok := v.([]interface{}); ok {
+								for _, r := range required {
+									if rs, ok := r.(string); ok {
+										inputSchema.Required = append(inputSchema.Required, rs)
+									}
+								}
+							}
+						default:
+							inputSchema.ExtraFields[k] = v
+						}
+					}
+					options.Tools = append(options.Tools, anthropic.ToolUnionParam{
+						OfTool: &anthropic.ToolParam{
+							Name:        name,
+							Description: anthropic.String("Respond with the output matching the schema"),
+							InputSchema: inputSchema,
+						},
+					})
+					options.ToolChoice = anthropic.ToolChoiceUnionParam{
+						OfTool: &anthropic.ToolChoiceToolParam{Name: name},
+					}
+				}
+			}
 		}
 
 	}
@@ -345,7 +407,7 @@ func (llc *largeLanguageCaller) GetChatCompletion(
 		return nil, metrics.Build(), err
 	}
 
-	internalMessage := llc.convertAnthropicMessageToInternal(*resp)
+	internalMessage := llc.convertAnthropicMessageToInternal(*resp, structuredOutput(params))
 	metrics.OnAddMetrics(llc.UsageMetrics(resp.Usage)...)
 	options.AIOptions.PostHook(map[string]interface{}{
 		"result": resp,
@@ -354,7 +416,15 @@ func (llc *largeLanguageCaller) GetChatCompletion(
 	return &internalMessage, metrics.Build(), nil
 }
 
-func (llc *largeLanguageCaller) convertAnthropicMessageToInternal(message anthropic.Message) types.Message {
+// structuredOutput returns the name of the tool forced for the response format, empty when there is none
+func structuredOutput(params anthropic.MessageNewParams) string {
+	if params.ToolChoice.OfTool == nil {
+		return ""
+	}
+	return params.ToolChoice.OfTool.Name
+}
+
+func (llc *largeLanguageCaller) convertAnthropicMessageToInternal(message anthropic.Message, structured string) types.Message {
 	internalMessage := types.Message{
 		Contents:  make([]*types.Content, 0),
 		ToolCalls: make([]*types.ToolCall, 0),
@@ -368,6 +438,14 @@ func (llc *largeLanguageCaller) convertAnthropicMessageToInternal(message anthro
 				Content:       []byte(c.Text),
 			})
 		case anthropic.ToolUseBlock:
+			if structured != "" && c.Name == structured {
+				internalMessage.Contents = append(internalMessage.Contents, &types.Content{
+					ContentType:   commons.TEXT_CONTENT.String(),
+					ContentFormat: commons.TEXT_CONTENT_FORMAT_RAW.String(),
+					Content:       []byte(c.JSON.Input.Raw()),
+				})
+				continue
+			}
 			internalMessage.ToolCalls = append(internalMessage.ToolCalls, &types.ToolCall{
 				Id:   utils.Ptr(c.ID),
 				Type: utils.Ptr("function"),
@@ -1,46 +1,110 @@
 package internal_azure_callers
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"net/http"
+	"strings"
 	"time"
 
 	internal_callers "github.com/rapidaai/api/integration-api/internal/caller"
+	internal_caller_metrics "github.com/rapidaai/api/integration-api/internal/caller/metrics"
 	"github.com/rapidaai/pkg/commons"
 	"github.com/rapidaai/pkg/types"
-	type_enums "github.com/rapidaai/pkg/types/enums"
+	"github.com/rapidaai/pkg/utils"
 	integration_api "github.com/rapidaai/protos"
 )
 
+const (
+	contentSafetyPath        = "/contentsafety/text:analyze?api-version=2024-09-01"
+	defaultSeverityThreshold = 2
+)
+
+// ModerationsCaller uses azure ai content safety, endpoint of credential is the content safety resource
 type ModerationsCaller struct {
 	AzureAi
+	httpClient *http.Client
 }
 
 func NewModerationsCaller(logger commons.Logger, credential *integration_api.Credential) internal_callers.ModerationsCaller {
 	return &ModerationsCaller{
-		AzureAi: azure(logger, credential),
+		AzureAi:    azure(logger, credential),
+		httpClient: &http.Client{Timeout: 30 * time.Second},
 	}
 }
 
+type contentSafetyResponse struct {
+	CategoriesAnalysis []struct {
+		Category string `json:"category"`
+		Severity int64  `json:"severity"`
+	} `json:"categoriesAnalysis"`
+}
+
+// GetModeration gives severity of every harm category, category is flagged when severity reaches model.severity_threshold
 func (stc *ModerationsCaller) GetModeration(ctx context.Context,
-	content *types.Content, options *internal_callers.ModerationOptions) (*types.Content, types.Metrics, error) {
-	//
-	// Working with chat complition with vision
-	//
-	start := time.Now()
-	// client, err := stc.GetClient()
-	timeMetric := &types.Metric{
-		Name:        type_enums.TIME_TAKEN.String(),
-		Value:       fmt.Sprintf("%d", int64(time.Since(start))),
-		Description: "Time taken to serve the llm request",
-	}
-	// if err != nil {
-	// 	return nil, types.Metrics{timeMetric}, err
-	// }
-	// Will need moderation for chat in future
-
-	return &types.Content{
-		ContentType:   "text",
-		ContentFormat: "raw",
-	}, types.Metrics{timeMetric}, nil
+	content *types.Content, options *internal_callers.ModerationOptions) ([]*integration_api.Moderation, types.Metrics, error) {
+	metrics := internal_caller_metrics.NewMetricBuilder(options.RequestId)
+	metrics.OnStart()
+
+	credentials := stc.credential()
+	key, ok := credentials[AZ_SUBSCRIPTION_KEY].(string)
+	if !ok {
+		return nil, metrics.OnFailure().Build(), errors.New("unable to resolve the credential")
+	}
+	endpoint, ok := credentials[AZ_ENDPOINT_KEY].(string)
+	if !ok || endpoint == "" {
+		return nil, metrics.OnFailure().Build(), errors.New("endpoint of content safety resource is required")
+	}
+	threshold := int64(defaultSeverityThreshold)
+	if v, ok := options.ModelParameter["model.severity_threshold"]; ok {
+		if th, err := utils.AnyToInt64(v); err == nil {
+			threshold = th
+		}
+	}
+
+	input := map[string]interface{}{"text": content.GetString()}
+	options.AIOptions.PreHook(map[string]interface{}{"input": input})
+	body, _ := json.Marshal(input)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, strings.TrimRight(endpoint, "/")+contentSafetyPath, bytes.NewReader(body))
+	if err != nil {
+		return nil, metrics.OnFailure().Build(), err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Ocp-Apim-Subscription-Key", key)
+
+	resp, err := stc.httpClient.Do(req)
+	if err == nil && resp.StatusCode >= 300 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		resp.Body.Close()
+		err = fmt.Errorf("content safety responded with status %d: %s", resp.StatusCode, strings.TrimSpace(string(msg)))
+	}
+	if err != nil {
+		options.AIOptions.PostHook(map[string]interface{}{
+			"error": err,
+		}, metrics.OnFailure().Build())
+		return nil, metrics.Build(), err
+	}
+	defer resp.Body.Close()
+
+	result := &contentSafetyResponse{}
+	if err := json.NewDecoder(resp.Body).Decode(result); err != nil {
+		return nil, metrics.OnFailure().Build(), err
+	}
+	metrics.OnSuccess()
+	moderations := make([]*integration_api.Moderation, 0, len(result.CategoriesAnalysis))
+	for _, category := range result.CategoriesAnalysis {
+		moderations = append(moderations, &integration_api.Moderation{
+			Name:    strings.ToLower(category.Category),
+			Value:   fmt.Sprintf("%d", category.Severity),
+			Flagged: category.Severity >= threshold,
+		})
+	}
+	options.AIOptions.PostHook(map[string]interface{}{
+		"result": result,
+	}, metrics.Build())
+	return moderations, metrics.Build(), nil
 }
@@ -0,0 +1,111 @@
+// Rapida – Open Source Voice AI Orchestration Platform
+// Copyright (C) 2023-2025 Prashant Srivastav <prashant@rapida.ai>
+// Licensed under a modified GPL-2.0. See the LICENSE file for details.
+package internal_bedrock_callers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsConfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/bedrockruntime"
+	bedrock_types "github.com/aws/aws-sdk-go-v2/service/bedrockruntime/types"
+	internal_callers "github.com/rapidaai/api/integration-api/internal/caller"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/types"
+	type_enums "github.com/rapidaai/pkg/types/enums"
+	integration_api "github.com/rapidaai/protos"
+)
+
+type Bedrock struct {
+	logger     commons.Logger
+	credential internal_callers.CredentialResolver
+}
+
+var (
+	ACCESS_KEY_ID     = "access_key_id"
+	SECRET_ACCESS_KEY = "secret_access_key"
+	SESSION_TOKEN     = "session_token"
+	REGION            = "region"
+	// optional endpoint of bedrock runtime, vpc endpoint or proxy
+	API_URL = "url"
+)
+
+func bedrock(logger commons.Logger, credential *integration_api.Credential) Bedrock {
+	_credential := credential.GetValue().AsMap()
+	return Bedrock{
+		logger: logger,
+		credential: func() map[string]interface{} {
+			return _credential
+		},
+	}
+}
+
+// GetConfig resolves aws config from static credential of the user
+func (br *Bedrock) GetConfig(ctx context.Context) (aws.Config, error) {
+	credentials_ := br.credential()
+	accessKeyId, ok := credentials_[ACCESS_KEY_ID].(string)
+	if !ok {
+		br.logger.Errorf("Unable to get client for user, access key id is missing")
+		return aws.Config{}, errors.New("unable to resolve the credential")
+	}
+	secretAccessKey, ok := credentials_[SECRET_ACCESS_KEY].(string)
+	if !ok {
+		br.logger.Errorf("Unable to get client for user, secret access key is missing")
+		return aws.Config{}, errors.New("unable to resolve the credential")
+	}
+	region, ok := credentials_[REGION].(string)
+	if !ok || region == "" {
+		br.logger.Errorf("Unable to get client for user, region is missing")
+		return aws.Config{}, errors.New("unable to resolve the region from credential")
+	}
+	sessionToken, _ := credentials_[SESSION_TOKEN].(string)
+	return awsConfig.LoadDefaultConfig(ctx,
+		awsConfig.WithRegion(region),
+		awsConfig.WithCredentialsProvider(credentials.NewStaticCredentialsProvider(
+			accessKeyId,
+			secretAccessKey,
+			sessionToken,
+		)),
+	)
+}
+
+func (br *Bedrock) GetClient(ctx context.Context) (*bedrockruntime.Client, error) {
+	cfg, err := br.GetConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return bedrockruntime.NewFromConfig(cfg, func(o *bedrockruntime.Options) {
+		if url, ok := br.credential()[API_URL].(string); ok && url != "" {
+			o.BaseEndpoint = aws.String(url)
+		}
+	}), nil
+}
+
+func (br *Bedrock) UsageMetrics(usages *bedrock_types.TokenUsage) types.Metrics {
+	metrics := make(types.Metrics, 0)
+	if usages == nil {
+		return metrics
+	}
+	metrics = append(metrics, &types.Metric{
+		Name:        type_enums.OUTPUT_TOKEN.String(),
+		Value:       fmt.Sprintf("%d", aws.ToInt32(usages.OutputTokens)),
+		Description: "Output token",
+	})
+
+	metrics = append(metrics, &types.Metric{
+		Name:        type_enums.INPUT_TOKEN.String(),
+		Value:       fmt.Sprintf("%d", aws.ToInt32(usages.InputTokens)),
+		Description: "Input token",
+	})
+
+	metrics = append(metrics, &types.Metric{
+		Name:        type_enums.TOTAL_TOKEN.String(),
+		Value:       fmt.Sprintf("%d", aws.ToInt32(usages.TotalTokens)),
+		Description: "Total Token",
+	})
+	return metrics
+}
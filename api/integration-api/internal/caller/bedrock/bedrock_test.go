@@ -0,0 +1,154 @@
+package internal_bedrock_callers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	internal_callers "github.com/rapidaai/api/integration-api/internal/caller"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/types"
+	"github.com/rapidaai/protos"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func testCaller(t *testing.T, handler http.HandlerFunc) (commons.Logger, *protos.Credential) {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	logger := commons.NewApplicationLoggerWithOptions(commons.EnableFile(false), commons.Level("error"))
+	require.NoError(t, logger.InitLogger())
+	value, err := structpb.NewStruct(map[string]interface{}{
+		"access_key_id":     "AKIDEXAMPLE",
+		"secret_access_key": "secret",
+		"region":            "us-east-1",
+		"url":               server.URL,
+	})
+	require.NoError(t, err)
+	return logger, &protos.Credential{Id: 1, Value: value}
+}
+
+func testOptions(t *testing.T, values map[string]interface{}) internal_callers.AIOptions {
+	parameters := make(map[string]*anypb.Any)
+	for k, v := range values {
+		value, err := structpb.NewValue(v)
+		require.NoError(t, err)
+		parameters[k], err = anypb.New(value)
+		require.NoError(t, err)
+	}
+	return internal_callers.AIOptions{
+		RequestId:      1,
+		PreHook:        func(rst map[string]interface{}) {},
+		PostHook:       func(rst map[string]interface{}, metrics types.Metrics) {},
+		ModelParameter: parameters,
+	}
+}
+
+func textMessage(role, text string) *protos.Message {
+	return &protos.Message{
+		Role: role,
+		Contents: []*protos.Content{{
+			ContentType:   commons.TEXT_CONTENT.String(),
+			ContentFormat: commons.TEXT_CONTENT_FORMAT_RAW.String(),
+			Content:       []byte(text),
+		}},
+	}
+}
+
+func TestConverseWithToolUse(t *testing.T) {
+	var request map[string]interface{}
+	var path string
+	logger, credential := testCaller(t, func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		require.True(t, strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256"))
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{
+			"output": {"message": {"role": "assistant", "content": [
+				{"text": "Let me check."},
+				{"toolUse": {"toolUseId": "tooluse_1", "name": "get_weather", "input": {"city": "Paris"}}}
+			]}},
+			"stopReason": "tool_use",
+			"usage": {"inputTokens": 20, "outputTokens": 10, "totalTokens": 30},
+			"metrics": {"latencyMs": 100}
+		}`))
+	})
+
+	toolResult := &protos.Message{Role: "tool", Contents: []*protos.Content{{ContentType: "tooluse_0", Content: []byte(`{"time":"10:00"}`)}}}
+	history := []*protos.Message{
+		textMessage("system", "you are helpful"),
+		textMessage("user", "what time is it?"),
+		{Role: "assistant", ToolCalls: []*protos.ToolCall{{Id: "tooluse_0", Type: "function", Function: &protos.FunctionCall{Name: "get_time", Arguments: "{}"}}}},
+		toolResult,
+		textMessage("user", "and the weather in paris?"),
+	}
+	caller := NewLargeLanguageCaller(logger, credential)
+	message, metrics, err := caller.GetChatCompletion(context.Background(), history, &internal_callers.ChatCompletionOptions{
+		AIOptions: testOptions(t, map[string]interface{}{"model.name": "anthropic.claude-3-haiku", "model.max_tokens": 256, "model.tool_choice": "auto"}),
+		ToolDefinitions: []*internal_callers.ToolDefinition{{
+			Type: "function",
+			Function: &internal_callers.FunctionDefinition{
+				Name:        "get_weather",
+				Description: "weather of city",
+				Parameters: &internal_callers.FunctionParameter{
+					Type:       "object",
+					Required:   []string{"city"},
+					Properties: map[string]internal_callers.FunctionParameterProperty{"city": {Type: "string"}},
+				},
+			},
+		}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "/model/anthropic.claude-3-haiku/converse", path)
+	assert.Equal(t, "Let me check.", message.String())
+	require.Len(t, message.ToolCalls, 1)
+	assert.Equal(t, "tooluse_1", *message.ToolCalls[0].Id)
+	assert.Equal(t, "get_weather", *message.ToolCalls[0].Function.Name)
+	assert.JSONEq(t, `{"city":"Paris"}`, *message.ToolCalls[0].Function.Arguments)
+	assert.NotEmpty(t, metrics)
+
+	// tool result and next user message are merged in single user turn
+	messages := request["messages"].([]interface{})
+	require.Len(t, messages, 3)
+	assert.Equal(t, []interface{}{"user", "assistant", "user"}, []interface{}{
+		messages[0].(map[string]interface{})["role"],
+		messages[1].(map[string]interface{})["role"],
+		messages[2].(map[string]interface{})["role"],
+	})
+	assert.Len(t, messages[2].(map[string]interface{})["content"], 2)
+	assert.Equal(t, "you are helpful", request["system"].([]interface{})[0].(map[string]interface{})["text"])
+	assert.Equal(t, float64(256), request["inferenceConfig"].(map[string]interface{})["maxTokens"])
+	toolConfig := request["toolConfig"].(map[string]interface{})
+	assert.Contains(t, toolConfig["toolChoice"], "auto")
+	assert.Len(t, toolConfig["tools"], 1)
+}
+
+func TestTitanEmbedding(t *testing.T) {
+	paths := make([]string, 0)
+	logger, credential := testCaller(t, func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		var body map[string]interface{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, float64(256), body["dimensions"])
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"embedding":           []float64{float64(len(body["inputText"].(string))), 0.1},
+			"inputTextTokenCount": 2,
+		})
+	})
+
+	embeddings, metrics, err := NewEmbeddingCaller(logger, credential).GetEmbedding(context.Background(), map[int32]string{0: "a", 1: "bbb"}, &internal_callers.EmbeddingOptions{
+		AIOptions: testOptions(t, map[string]interface{}{"model.dimensions": 256}),
+	})
+	require.NoError(t, err)
+	require.Len(t, embeddings, 2)
+	assert.Equal(t, []float64{1, 0.1}, embeddings[0].GetEmbedding())
+	assert.Equal(t, []float64{3, 0.1}, embeddings[1].GetEmbedding())
+	assert.Equal(t, []string{"/model/amazon.titan-embed-text-v2:0/invoke", "/model/amazon.titan-embed-text-v2:0/invoke"}, paths)
+	assert.NotEmpty(t, metrics)
+}
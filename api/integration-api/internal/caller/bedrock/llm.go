@@ -0,0 +1,408 @@
+// Rapida – Open Source Voice AI Orchestration Platform
+// Copyright (C) 2023-2025 Prashant Srivastav <prashant@rapida.ai>
+// Licensed under a modified GPL-2.0. See the LICENSE file for details.
+package internal_bedrock_callers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/bedrockruntime"
+	"github.com/aws/aws-sdk-go-v2/service/bedrockruntime/document"
+	bedrock_types "github.com/aws/aws-sdk-go-v2/service/bedrockruntime/types"
+	internal_callers "github.com/rapidaai/api/integration-api/internal/caller"
+	internal_caller_metrics "github.com/rapidaai/api/integration-api/internal/caller/metrics"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/types"
+	"github.com/rapidaai/pkg/utils"
+	protos "github.com/rapidaai/protos"
+)
+
+type largeLanguageCaller struct {
+	Bedrock
+}
+
+func NewLargeLanguageCaller(logger commons.Logger, credential *protos.Credential) internal_callers.LargeLanguageCaller {
+	return &largeLanguageCaller{
+		Bedrock: bedrock(logger, credential),
+	}
+}
+
+// ConverseInput builds converse request from model parameters and tool definitions,
+// the same input is used for converse and converse stream
+func (llc *largeLanguageCaller) ConverseInput(opts *internal_callers.ChatCompletionOptions) *bedrockruntime.ConverseInput {
+	input := &bedrockruntime.ConverseInput{}
+	inference := &bedrock_types.InferenceConfiguration{}
+	if len(opts.ToolDefinitions) > 0 {
+		tools := make([]bedrock_types.Tool, 0, len(opts.ToolDefinitions))
+		for _, tl := range opts.ToolDefinitions {
+			if tl.Type != "function" || tl.Function == nil {
+				continue
+			}
+			// bedrock requires input schema even when function does not take any argument
+			schema := map[string]interface{}{
+				"type":       "object",
+				"properties": map[string]interface{}{},
+			}
+			if tl.Function.Parameters != nil {
+				schema = tl.Function.Parameters.ToMap()
+			}
+			tools = append(tools, &bedrock_types.ToolMemberToolSpec{
+				Value: bedrock_types.ToolSpecification{
+					Name:        aws.String(tl.Function.Name),
+					Description: aws.String(tl.Function.Description),
+					InputSchema: &bedrock_types.ToolInputSchemaMemberJson{
+						Value: document.NewLazyDocument(schema),
+					},
+				},
+			})
+		}
+		if len(tools) > 0 {
+			input.ToolConfig = &bedrock_types.ToolConfiguration{Tools: tools}
+		}
+	}
+
+	for key, value := range opts.ModelParameter {
+		switch key {
+		case "model.name":
+			if modelName, err := utils.AnyToString(value); err == nil {
+				input.ModelId = aws.String(modelName)
+			}
+		case "model.max_tokens", "model.max_completion_tokens":
+			if maxTokens, err := utils.AnyToInt32(value); err == nil {
+				inference.MaxTokens = aws.Int32(maxTokens)
+			}
+		case "model.temperature":
+			if temp, err := utils.AnyToFloat32(value); err == nil {
+				inference.Temperature = aws.Float32(temp)
+			}
+		case "model.top_p":
+			if topP, err := utils.AnyToFloat32(value); err == nil {
+				inference.TopP = aws.Float32(topP)
+			}
+		case "model.stop":
+			if stopStr, err := utils.AnyToString(value); err == nil {
+				for _, stopper := range strings.Split(stopStr, ",") {
+					if strings.TrimSpace(stopper) != "" {
+						inference.StopSequences = append(inference.StopSequences, stopper)
+					}
+				}
+			}
+		case "model.tool_choice":
+			if choice, err := utils.AnyToString(value); err == nil && input.ToolConfig != nil {
+				switch choice {
+				case "auto":
+					input.ToolConfig.ToolChoice = &bedrock_types.ToolChoiceMemberAuto{}
+				case "required":
+					input.ToolConfig.ToolChoice = &bedrock_types.ToolChoiceMemberAny{}
+				case "none":
+					// converse does not support none, tools are not sent at all
+					input.ToolConfig = nil
+				}
+			}
+		}
+	}
+	input.InferenceConfig = inference
+	return input
+}
+
+// BuildHistory converts messages to converse messages, system messages are given separately.
+// Converse expects alternate user and assistant turn so consecutive messages of same role are merged,
+// tool results are sent as user message.
+func (llc *largeLanguageCaller) BuildHistory(allMessages []*protos.Message) ([]bedrock_types.SystemContentBlock, []bedrock_types.Message) {
+	system := make([]bedrock_types.SystemContentBlock, 0)
+	messages := make([]bedrock_types.Message, 0)
+	appendMessage := func(role bedrock_types.ConversationRole, blocks []bedrock_types.ContentBlock) {
+		if len(blocks) == 0 {
+			return
+		}
+		if len(messages) > 0 && messages[len(messages)-1].Role == role {
+			messages[len(messages)-1].Content = append(messages[len(messages)-1].Content, blocks...)
+			return
+		}
+		messages = append(messages, bedrock_types.Message{Role: role, Content: blocks})
+	}
+
+	for _, msg := range allMessages {
+		switch msg.GetRole() {
+		case "system":
+			for _, c := range msg.GetContents() {
+				if c.GetContentType() == commons.TEXT_CONTENT.String() && strings.TrimSpace(string(c.GetContent())) != "" {
+					system = append(system, &bedrock_types.SystemContentBlockMemberText{Value: string(c.GetContent())})
+				}
+			}
+		case "user":
+			blocks := make([]bedrock_types.ContentBlock, 0)
+			for _, c := range msg.GetContents() {
+				switch c.GetContentType() {
+				case commons.TEXT_CONTENT.String():
+					// converse rejects blank text block
+					if strings.TrimSpace(string(c.GetContent())) != "" {
+						blocks = append(blocks, &bedrock_types.ContentBlockMemberText{Value: string(c.GetContent())})
+					}
+				default:
+					llc.logger.Warnf("Unsupported content type for bedrock: %s", c.GetContentType())
+				}
+			}
+			appendMessage(bedrock_types.ConversationRoleUser, blocks)
+		case "assistant":
+			blocks := make([]bedrock_types.ContentBlock, 0)
+			if txt := types.OnlyStringProtoContent(msg.GetContents()); strings.TrimSpace(txt) != "" {
+				blocks = append(blocks, &bedrock_types.ContentBlockMemberText{Value: txt})
+			}
+			for _, tc := range msg.GetToolCalls() {
+				var arguments map[string]interface{}
+				if err := json.Unmarshal([]byte(tc.GetFunction().GetArguments()), &arguments); err != nil {
+					arguments = map[string]interface{}{}
+				}
+				blocks = append(blocks, &bedrock_types.ContentBlockMemberToolUse{
+					Value: bedrock_types.ToolUseBlock{
+						ToolUseId: aws.String(tc.GetId()),
+						Name:      aws.String(tc.GetFunction().GetName()),
+						Input:     document.NewLazyDocument(arguments),
+					},
+				})
+			}
+			appendMessage(bedrock_types.ConversationRoleAssistant, blocks)
+		case "tool":
+			blocks := make([]bedrock_types.ContentBlock, 0)
+			for _, c := range msg.GetContents() {
+				// content type of tool message holds the id of tool call
+				blocks = append(blocks, &bedrock_types.ContentBlockMemberToolResult{
+					Value: bedrock_types.ToolResultBlock{
+						ToolUseId: aws.String(c.GetContentType()),
+						Content: []bedrock_types.ToolResultContentBlock{
+							&bedrock_types.ToolResultContentBlockMemberText{Value: string(c.GetContent())},
+						},
+					},
+				})
+			}
+			appendMessage(bedrock_types.ConversationRoleUser, blocks)
+		}
+	}
+	return system, messages
+}
+
+func (llc *largeLanguageCaller) GetChatCompletion(
+	ctx context.Context,
+	allMessages []*protos.Message,
+	options *internal_callers.ChatCompletionOptions,
+) (*types.Message, types.Metrics, error) {
+	metrics := internal_caller_metrics.NewMetricBuilder(options.RequestId)
+	metrics.OnStart()
+
+	client, err := llc.GetClient(ctx)
+	if err != nil {
+		llc.logger.Errorf("chat complition unable to get client for bedrock %v", err)
+		options.AIOptions.PostHook(map[string]interface{}{
+			"error": err,
+		}, metrics.OnFailure().Build())
+		return nil, metrics.OnFailure().Build(), err
+	}
+
+	input := llc.ConverseInput(options)
+	input.System, input.Messages = llc.BuildHistory(allMessages)
+	options.AIOptions.PreHook(utils.ToJson(input))
+
+	resp, err := client.Converse(ctx, input)
+	if err != nil {
+		llc.logger.Errorf("chat complition failed to get response from bedrock %v", err)
+		options.AIOptions.PostHook(map[string]interface{}{
+			"error":  err,
+			"result": resp,
+		}, metrics.OnFailure().Build())
+		return nil, metrics.Build(), err
+	}
+
+	output, ok := resp.Output.(*bedrock_types.ConverseOutputMemberMessage)
+	if !ok {
+		err := errors.New("illegal response from bedrock, message is missing")
+		options.AIOptions.PostHook(map[string]interface{}{
+			"error":  err,
+			"result": resp,
+		}, metrics.OnFailure().Build())
+		return nil, metrics.Build(), err
+	}
+
+	message := llc.toMessage(output.Value)
+	metrics.OnAddMetrics(llc.UsageMetrics(resp.Usage)...)
+	options.AIOptions.PostHook(map[string]interface{}{
+		"result": resp,
+	}, metrics.OnSuccess().Build())
+	return &message, metrics.Build(), nil
+}
+
+func (llc *largeLanguageCaller) toMessage(msg bedrock_types.Message) types.Message {
+	message := types.Message{
+		Role:      "assistant",
+		Contents:  make([]*types.Content, 0),
+		ToolCalls: make([]*types.ToolCall, 0),
+	}
+	for _, block := range msg.Content {
+		switch c := block.(type) {
+		case *bedrock_types.ContentBlockMemberText:
+			message.Contents = append(message.Contents, &types.Content{
+				ContentType:   commons.TEXT_CONTENT.String(),
+				ContentFormat: commons.TEXT_CONTENT_FORMAT_RAW.String(),
+				Content:       []byte(c.Value),
+			})
+		case *bedrock_types.ContentBlockMemberToolUse:
+			arguments := "{}"
+			if c.Value.Input != nil {
+				if raw, err := c.Value.Input.MarshalSmithyDocument(); err == nil {
+					arguments = string(raw)
+				}
+			}
+			message.ToolCalls = append(message.ToolCalls, &types.ToolCall{
+				Id:   c.Value.ToolUseId,
+				Type: utils.Ptr("function"),
+				Function: &types.FunctionCall{
+					Name:      c.Value.Name,
+					Arguments: utils.Ptr(arguments),
+				},
+			})
+		}
+	}
+	return message
+}
+
+func (llc *largeLanguageCaller) StreamChatCompletion(
+	ctx context.Context,
+	allMessages []*protos.Message,
+	options *internal_callers.ChatCompletionOptions,
+	onStream func(types.Message) error,
+	onMetrics func(*types.Message, types.Metrics) error,
+	onError func(err error),
+) error {
+	metrics := internal_caller_metrics.NewMetricBuilder(options.RequestId)
+	metrics.OnStart()
+
+	client, err := llc.GetClient(ctx)
+	if err != nil {
+		llc.logger.Errorf("chat completion unable to get client for bedrock: %v", err)
+		onError(err)
+		onMetrics(nil, metrics.OnFailure().Build())
+		return err
+	}
+
+	input := llc.ConverseInput(options)
+	system, messages := llc.BuildHistory(allMessages)
+	streamInput := &bedrockruntime.ConverseStreamInput{
+		ModelId:         input.ModelId,
+		InferenceConfig: input.InferenceConfig,
+		ToolConfig:      input.ToolConfig,
+		System:          system,
+		Messages:        messages,
+	}
+	options.AIOptions.PreHook(utils.ToJson(streamInput))
+
+	resp, err := client.ConverseStream(ctx, streamInput)
+	if err != nil {
+		llc.logger.Errorf("Failed to get converse stream: %v", err)
+		options.AIOptions.PostHook(map[string]interface{}{
+			"error": err,
+		}, metrics.OnFailure().Build())
+		onMetrics(nil, metrics.OnFailure().Build())
+		onError(err)
+		return err
+	}
+	stream := resp.GetStream()
+	defer stream.Close()
+
+	completeMessage := types.Message{
+		Role:      "assistant",
+		Contents:  make([]*types.Content, 0),
+		ToolCalls: make([]*types.ToolCall, 0),
+	}
+	// content blocks are indexed, text and tool use can be interleaved
+	contents := map[int32]*types.Content{}
+	toolCalls := map[int32]*types.ToolCall{}
+	var stopReason bedrock_types.StopReason
+
+	for event := range stream.Events() {
+		switch ev := event.(type) {
+		case *bedrock_types.ConverseStreamOutputMemberContentBlockStart:
+			if start, ok := ev.Value.Start.(*bedrock_types.ContentBlockStartMemberToolUse); ok {
+				toolCall := &types.ToolCall{
+					Id:   start.Value.ToolUseId,
+					Type: utils.Ptr("function"),
+					Function: &types.FunctionCall{
+						Name:      start.Value.Name,
+						Arguments: utils.Ptr(""),
+					},
+				}
+				toolCalls[aws.ToInt32(ev.Value.ContentBlockIndex)] = toolCall
+				completeMessage.ToolCalls = append(completeMessage.ToolCalls, toolCall)
+			}
+
+		case *bedrock_types.ConverseStreamOutputMemberContentBlockDelta:
+			index := aws.ToInt32(ev.Value.ContentBlockIndex)
+			switch delta := ev.Value.Delta.(type) {
+			case *bedrock_types.ContentBlockDeltaMemberText:
+				if delta.Value == "" {
+					continue
+				}
+				content, ok := contents[index]
+				if !ok {
+					content = &types.Content{
+						ContentType:   commons.TEXT_CONTENT.String(),
+						ContentFormat: commons.TEXT_CONTENT_FORMAT_RAW.String(),
+						Content:       []byte{},
+					}
+					contents[index] = content
+					completeMessage.Contents = append(completeMessage.Contents, content)
+				}
+				content.Content = append(content.Content, []byte(delta.Value)...)
+				if err := onStream(types.Message{
+					Role: "assistant",
+					Contents: []*types.Content{{
+						ContentType:   commons.TEXT_CONTENT.String(),
+						ContentFormat: commons.TEXT_CONTENT_FORMAT_RAW.String(),
+						Content:       []byte(delta.Value),
+					}},
+				}); err != nil {
+					llc.logger.Errorf("Error sending stream data: %v", err)
+					return err
+				}
+			case *bedrock_types.ContentBlockDeltaMemberToolUse:
+				if toolCall, ok := toolCalls[index]; ok {
+					toolCall.Function.MergeArguments(delta.Value.Input)
+				}
+			}
+
+		case *bedrock_types.ConverseStreamOutputMemberMessageStop:
+			stopReason = ev.Value.StopReason
+
+		case *bedrock_types.ConverseStreamOutputMemberMetadata:
+			// metadata is the last event of stream and carries the usage
+			metrics.OnAddMetrics(llc.UsageMetrics(ev.Value.Usage)...)
+		}
+	}
+
+	if err := stream.Err(); err != nil {
+		llc.logger.Errorf("Stream error: %v", err)
+		options.AIOptions.PostHook(map[string]interface{}{
+			"error":  err,
+			"result": utils.ToJson(completeMessage),
+		}, metrics.OnFailure().Build())
+		onMetrics(nil, metrics.Build())
+		onError(err)
+		return err
+	}
+
+	for _, toolCall := range completeMessage.ToolCalls {
+		// tool without any argument does not send any delta
+		if toolCall.Function.Arguments == nil || *toolCall.Function.Arguments == "" {
+			toolCall.Function.Arguments = utils.Ptr("{}")
+		}
+	}
+	options.AIOptions.PostHook(map[string]interface{}{
+		"result":      utils.ToJson(completeMessage),
+		"stop_reason": stopReason,
+	}, metrics.OnSuccess().Build())
+	onMetrics(&completeMessage, metrics.Build())
+	return nil
+}
@@ -0,0 +1,199 @@
+// Rapida – Open Source Voice AI Orchestration Platform
+// Copyright (C) 2023-2025 Prashant Srivastav <prashant@rapida.ai>
+// Licensed under a modified GPL-2.0. See the LICENSE file for details.
+package internal_bedrock_callers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/bedrockruntime"
+	internal_callers "github.com/rapidaai/api/integration-api/internal/caller"
+	internal_caller_metrics "github.com/rapidaai/api/integration-api/internal/caller/metrics"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/types"
+	type_enums "github.com/rapidaai/pkg/types/enums"
+	"github.com/rapidaai/pkg/utils"
+	integration_api "github.com/rapidaai/protos"
+)
+
+var (
+	DEFAULT_EMBEDDING_MODEL = "amazon.titan-embed-text-v2:0"
+	COHERE_MODEL_PREFIX     = "cohere."
+)
+
+type embeddingCaller struct {
+	Bedrock
+}
+
+func NewEmbeddingCaller(logger commons.Logger, credential *integration_api.Credential) internal_callers.EmbeddingCaller {
+	return &embeddingCaller{
+		Bedrock: bedrock(logger, credential),
+	}
+}
+
+type titanEmbeddingResponse struct {
+	Embedding           []float64 `json:"embedding"`
+	InputTextTokenCount int       `json:"inputTextTokenCount"`
+}
+
+type cohereEmbeddingResponse struct {
+	Embeddings [][]float64 `json:"embeddings"`
+}
+
+// GetEmbeddingParams returns model id and the parameters which are sent with every request
+func (ec *embeddingCaller) GetEmbeddingParams(opts *internal_callers.EmbeddingOptions) (string, map[string]interface{}) {
+	model := DEFAULT_EMBEDDING_MODEL
+	params := map[string]interface{}{}
+	for key, value := range opts.ModelParameter {
+		switch key {
+		case "model.name":
+			if modelName, err := utils.AnyToString(value); err == nil && modelName != "" {
+				model = modelName
+			}
+		case "model.dimensions":
+			if dimensions, err := utils.AnyToInt64(value); err == nil {
+				params["dimensions"] = dimensions
+			}
+		case "model.normalize":
+			if normalize, err := utils.AnyToBool(value); err == nil {
+				params["normalize"] = normalize
+			}
+		case "model.input_type":
+			if inputType, err := utils.AnyToString(value); err == nil {
+				params["input_type"] = inputType
+			}
+		case "model.truncate":
+			if truncate, err := utils.AnyToString(value); err == nil {
+				params["truncate"] = truncate
+			}
+		}
+	}
+	return model, params
+}
+
+// GetEmbedding embeds the content with titan or cohere models of bedrock,
+// cohere takes the whole batch while titan takes single text per request
+func (ec *embeddingCaller) GetEmbedding(ctx context.Context,
+	content map[int32]string,
+	options *internal_callers.EmbeddingOptions) ([]*integration_api.Embedding, types.Metrics, error) {
+	metrics := internal_caller_metrics.NewMetricBuilder(options.RequestId)
+	metrics.OnStart()
+
+	client, err := ec.GetClient(ctx)
+	if err != nil {
+		return nil, metrics.OnFailure().Build(), err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, time.Minute)
+	defer cancel()
+
+	input := make([]string, len(content))
+	for k, v := range content {
+		input[k] = v
+	}
+	model, params := ec.GetEmbeddingParams(options)
+	options.AIOptions.PreHook(map[string]interface{}{"input": input, "model": model, "parameters": params})
+
+	var output []*integration_api.Embedding
+	inputTokens := 0
+	if strings.HasPrefix(model, COHERE_MODEL_PREFIX) {
+		output, err = ec.cohereEmbedding(ctx, client, model, params, input)
+	} else {
+		output, inputTokens, err = ec.titanEmbedding(ctx, client, model, params, input)
+	}
+	if err != nil {
+		options.AIOptions.PostHook(map[string]interface{}{
+			"error": err,
+		}, metrics.OnFailure().Build())
+		return nil, metrics.Build(), err
+	}
+
+	metrics.OnSuccess()
+	if inputTokens > 0 {
+		metrics.OnAddMetrics(&types.Metric{
+			Name:        type_enums.INPUT_TOKEN.String(),
+			Value:       fmt.Sprintf("%d", inputTokens),
+			Description: "Input token",
+		}, &types.Metric{
+			Name:        type_enums.TOTAL_TOKEN.String(),
+			Value:       fmt.Sprintf("%d", inputTokens),
+			Description: "Total Token",
+		})
+	}
+	options.AIOptions.PostHook(map[string]interface{}{
+		"result": output,
+	}, metrics.Build())
+	return output, metrics.Build(), nil
+}
+
+func (ec *embeddingCaller) invoke(ctx context.Context, client *bedrockruntime.Client, model string, body map[string]interface{}, out interface{}) error {
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	resp, err := client.InvokeModel(ctx, &bedrockruntime.InvokeModelInput{
+		ModelId:     aws.String(model),
+		ContentType: aws.String("application/json"),
+		Accept:      aws.String("application/json"),
+		Body:        payload,
+	})
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(resp.Body, out)
+}
+
+func (ec *embeddingCaller) titanEmbedding(ctx context.Context, client *bedrockruntime.Client, model string, params map[string]interface{}, input []string) ([]*integration_api.Embedding, int, error) {
+	output := make([]*integration_api.Embedding, len(input))
+	tokens := 0
+	for idx, text := range input {
+		body := map[string]interface{}{"inputText": text}
+		for _, k := range []string{"dimensions", "normalize"} {
+			if v, ok := params[k]; ok {
+				body[k] = v
+			}
+		}
+		var resp titanEmbeddingResponse
+		if err := ec.invoke(ctx, client, model, body, &resp); err != nil {
+			return nil, 0, err
+		}
+		tokens += resp.InputTextTokenCount
+		output[idx] = &integration_api.Embedding{
+			Index:     int32(idx),
+			Embedding: resp.Embedding,
+		}
+	}
+	return output, tokens, nil
+}
+
+func (ec *embeddingCaller) cohereEmbedding(ctx context.Context, client *bedrockruntime.Client, model string, params map[string]interface{}, input []string) ([]*integration_api.Embedding, error) {
+	body := map[string]interface{}{
+		"texts":      input,
+		"input_type": "search_document",
+	}
+	for _, k := range []string{"input_type", "truncate"} {
+		if v, ok := params[k]; ok {
+			body[k] = v
+		}
+	}
+	var resp cohereEmbeddingResponse
+	if err := ec.invoke(ctx, client, model, body, &resp); err != nil {
+		return nil, err
+	}
+	if len(resp.Embeddings) != len(input) {
+		return nil, fmt.Errorf("illegal response from bedrock, expected %d embeddings got %d", len(input), len(resp.Embeddings))
+	}
+	output := make([]*integration_api.Embedding, len(resp.Embeddings))
+	for idx, embedding := range resp.Embeddings {
+		output[idx] = &integration_api.Embedding{
+			Index:     int32(idx),
+			Embedding: embedding,
+		}
+	}
+	return output, nil
+}
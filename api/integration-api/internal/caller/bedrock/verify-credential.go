@@ -0,0 +1,43 @@
+// Rapida – Open Source Voice AI Orchestration Platform
+// Copyright (C) 2023-2025 Prashant Srivastav <prashant@rapida.ai>
+// Licensed under a modified GPL-2.0. See the LICENSE file for details.
+package internal_bedrock_callers
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	internal_callers "github.com/rapidaai/api/integration-api/internal/caller"
+	"github.com/rapidaai/pkg/commons"
+	integration_api "github.com/rapidaai/protos"
+)
+
+type verifyCredentialCaller struct {
+	Bedrock
+}
+
+func NewVerifyCredentialCaller(logger commons.Logger, credential *integration_api.Credential) internal_callers.Verifier {
+	return &verifyCredentialCaller{
+		Bedrock: bedrock(logger, credential),
+	}
+}
+
+// CredentialVerifier verifies the access keys with caller identity, bedrock runtime
+// does not have any call which does not require a model
+func (stc *verifyCredentialCaller) CredentialVerifier(
+	ctx context.Context,
+	options *internal_callers.CredentialVerifierOptions) (*string, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Minute)
+	defer cancel()
+
+	cfg, err := stc.GetConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	identity, err := sts.NewFromConfig(cfg).GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
+	if err != nil {
+		return nil, err
+	}
+	return identity.Arn, nil
+}
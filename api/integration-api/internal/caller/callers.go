@@ -95,10 +95,10 @@ type EmbeddingCaller interface {
 }
 
 // ModerationsCaller is an interface for content moderation tasks.
-// - GetModeration: Processes content moderation for given content and options, returning moderated content and metrics.
+// - GetModeration: Processes content moderation for given content and options, returning verdict of every category and metrics.
 type ModerationsCaller interface {
 	GetModeration(ctx context.Context,
-		content *types.Content, options *ModerationOptions) (*types.Content, types.Metrics, error)
+		content *types.Content, options *ModerationOptions) ([]*protos.Moderation, types.Metrics, error)
 }
 
 // RerankingCaller is an interface for reranking models.
@@ -63,6 +63,7 @@ type Huggingface struct {
 
 var (
 	DEFUALT_URL = "https://api-inference.huggingface.co"
+	ROUTER_URL  = "https://router.huggingface.co/v1"
 	AUTH_URL    = "https://huggingface.co"
 	API_URL     = "url"
 	TIMEOUT     = 5 * time.Minute
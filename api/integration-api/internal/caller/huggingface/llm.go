@@ -5,13 +5,16 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	internal_callers "github.com/rapidaai/api/integration-api/internal/caller"
 	internal_caller_metrics "github.com/rapidaai/api/integration-api/internal/caller/metrics"
+	internal_openai_callers "github.com/rapidaai/api/integration-api/internal/caller/openai"
 	"github.com/rapidaai/pkg/commons"
 	"github.com/rapidaai/pkg/types"
 	integration_api "github.com/rapidaai/protos"
 	protos "github.com/rapidaai/protos"
+	"google.golang.org/protobuf/types/known/structpb"
 )
 
 type largeLanguageCaller struct {
@@ -21,127 +24,62 @@ type largeLanguageCaller struct {
 func NewLargeLanguageCaller(logger commons.Logger, credential *integration_api.Credential) internal_callers.LargeLanguageCaller {
 	return &largeLanguageCaller{
 		Huggingface: huggingface(logger,
-			DEFUALT_URL, credential),
+			ROUTER_URL, credential),
 	}
 }
 
+// chatCaller returns caller of messages api, text generation inference and inference providers of huggingface
+// serve openai compatible chat completions with native tool calling. url in credential points to
+// dedicated inference endpoint or self hosted tgi, otherwise request goes through router of huggingface.
+func (llc *largeLanguageCaller) chatCaller() (internal_callers.LargeLanguageCaller, error) {
+	credentials := llc.credential()
+	baseURL := llc.endpoint
+	if endpoint, ok := credentials[API_URL].(string); ok && strings.TrimSpace(endpoint) != "" {
+		baseURL = strings.TrimSuffix(strings.TrimSpace(endpoint), "/")
+		if !strings.HasSuffix(baseURL, "/v1") {
+			baseURL = baseURL + "/v1"
+		}
+	}
+	value, err := structpb.NewStruct(map[string]interface{}{
+		API_KEY: credentials[API_KEY],
+		API_URL: baseURL,
+	})
+	if err != nil {
+		llc.logger.Errorf("unable to build credential for huggingface messages api %v", err)
+		return nil, err
+	}
+	return internal_openai_callers.NewCompatibleLargeLanguageCaller(llc.logger, &protos.Credential{Value: value}, baseURL), nil
+}
+
 // StreamChatCompletion implements internal_callers.LargeLanguageCaller.
-func (*largeLanguageCaller) StreamChatCompletion(
+func (llc *largeLanguageCaller) StreamChatCompletion(
 	ctx context.Context,
-	// providerModel string,
 	allMessages []*protos.Message,
 	options *internal_callers.ChatCompletionOptions,
 	onStream func(types.Message) error,
 	onMetrics func(*types.Message, types.Metrics) error,
 	onError func(err error),
 ) error {
-	panic("unimplemented")
+	caller, err := llc.chatCaller()
+	if err != nil {
+		onMetrics(nil, internal_caller_metrics.NewMetricBuilder(options.RequestId).OnFailure().Build())
+		onError(err)
+		return err
+	}
+	return caller.StreamChatCompletion(ctx, allMessages, options, onStream, onMetrics, onError)
 }
 
 func (llc *largeLanguageCaller) GetChatCompletion(
 	ctx context.Context,
-	// providerModel string,
 	allMessages []*protos.Message,
 	options *internal_callers.ChatCompletionOptions,
 ) (*types.Message, types.Metrics, error) {
-	llc.logger.Debugf("getting chat completion from google llc")
-	//
-	// Working with chat complition with vision
-	//
-	metrics := internal_caller_metrics.NewMetricBuilder(options.RequestId)
-	metrics.OnStart()
-	requestBody := map[string]interface{}{
-		// "model":      providerModel,
-		"max_tokens": 1024,
-	}
-
-	msg := make([]map[string]interface{}, 0)
-	var lastRole string
-
-	for _, cntn := range allMessages {
-		if len(cntn.GetContents()) == 0 {
-			// there might be problem in initiator
-			continue
-		}
-		currentRole := cntn.GetRole()
-		if currentRole == "user" || currentRole == "system" {
-			if lastRole == "user" {
-				// Skip this message to ensure alternation
-				continue
-			}
-			contents := make([]map[string]interface{}, 0)
-			for _, c := range cntn.Contents {
-				if c.GetContentType() == commons.TEXT_CONTENT.String() {
-					contents = append(contents, map[string]interface{}{
-						"type": "text",
-						"text": string(c.GetContent()),
-					})
-				}
-			}
-			// txt := cntn.Contents[0].GetContent()
-			msg = append(msg, map[string]interface{}{
-				"role":    "user",
-				"content": contents,
-			})
-			lastRole = "user"
-		}
-
-		if currentRole == "assistant" {
-			if lastRole == "assistant" {
-				// Skip this message to ensure alternation
-				continue
-			}
-			txt := cntn.Contents[0].GetContent()
-			msg = append(msg, map[string]interface{}{
-				"role":    "assistant",
-				"content": string(txt),
-			})
-			lastRole = "assistant"
-		}
-	}
-
-	requestBody["messages"] = msg
-	headers := map[string]string{}
-	options.AIOptions.PreHook(requestBody)
-	res, err := llc.Call(ctx, fmt.Sprintf("models/%s"), // providerModel,
-		"POST", headers, requestBody)
-
-	//
+	llc.logger.Debugf("getting chat completion from huggingface llc")
+	caller, err := llc.chatCaller()
 	if err != nil {
-		llc.logger.Errorf("getting error for chat complition %v", err)
-		options.AIOptions.PostHook(map[string]interface{}{
-			"result": res,
-			"error":  err,
-		}, metrics.OnFailure().Build())
-		return nil, metrics.Build(), err
-	}
-	metrics.OnSuccess()
-	var resp HuggingfaceInferenceResponse
-	if err := json.Unmarshal([]byte(*res), &resp); err != nil {
-		llc.logger.Errorf("error while parsing chat complition response %v", err)
-		options.AIOptions.PostHook(map[string]interface{}{
-			"result": res,
-			"error":  err,
-		}, metrics.Build())
-		return nil, metrics.Build(), err
+		return nil, internal_caller_metrics.NewMetricBuilder(options.RequestId).OnFailure().Build(), err
 	}
-
-	//
-	//
-	output := make([]*types.Content, 0)
-	// metrics.OnAddMetrics(llc.UsageMetrics(resp.Usage)...)
-
-	output = append(output, &types.Content{
-		ContentType:   commons.TEXT_CONTENT.String(),
-		ContentFormat: commons.TEXT_CONTENT_FORMAT_RAW.String(),
-		Content:       []byte(resp.Text),
-	})
-	options.AIOptions.PostHook(map[string]interface{}{
-		"result": res,
-	}, metrics.Build())
-	return &types.Message{
-		Contents: output,
-	}, metrics.Build(), nil
+	return caller.GetChatCompletion(ctx, allMessages, options)
 }
 
 func (llc *largeLanguageCaller) GetCompletion(
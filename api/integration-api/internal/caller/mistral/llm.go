@@ -1,14 +1,18 @@
 package internal_mistral_callers
 
 import (
+	"bufio"
 	"context"
 	"encoding/json"
 	"errors"
+	"sort"
+	"strings"
 
 	internal_callers "github.com/rapidaai/api/integration-api/internal/caller"
 	internal_caller_metrics "github.com/rapidaai/api/integration-api/internal/caller/metrics"
 	"github.com/rapidaai/pkg/commons"
 	"github.com/rapidaai/pkg/types"
+	"github.com/rapidaai/pkg/utils"
 	integration_api "github.com/rapidaai/protos"
 	protos "github.com/rapidaai/protos"
 )
@@ -23,77 +27,291 @@ func NewLargeLanguageCaller(logger commons.Logger, credential *integration_api.C
 	}
 }
 
+// ChatCompletionRequest builds request body from model parameters and tool definitions
+func (llc *largeLanguageCaller) ChatCompletionRequest(opts *internal_callers.ChatCompletionOptions) map[string]interface{} {
+	requestBody := map[string]interface{}{}
+	if len(opts.ToolDefinitions) > 0 {
+		tools := make([]map[string]interface{}, 0, len(opts.ToolDefinitions))
+		for _, tl := range opts.ToolDefinitions {
+			if tl.Type != "function" || tl.Function == nil {
+				continue
+			}
+			function := map[string]interface{}{
+				"name":        tl.Function.Name,
+				"description": tl.Function.Description,
+			}
+			if tl.Function.Parameters != nil {
+				function["parameters"] = tl.Function.Parameters.ToMap()
+			}
+			tools = append(tools, map[string]interface{}{
+				"type":     "function",
+				"function": function,
+			})
+		}
+		if len(tools) > 0 {
+			requestBody["tools"] = tools
+		}
+	}
+
+	for key, value := range opts.ModelParameter {
+		switch key {
+		case "model.name":
+			if modelName, err := utils.AnyToString(value); err == nil {
+				requestBody["model"] = modelName
+			}
+		case "model.max_tokens", "model.max_completion_tokens":
+			if maxTokens, err := utils.AnyToInt64(value); err == nil {
+				requestBody["max_tokens"] = maxTokens
+			}
+		case "model.temperature":
+			if temp, err := utils.AnyToFloat64(value); err == nil {
+				requestBody["temperature"] = temp
+			}
+		case "model.top_p":
+			if topP, err := utils.AnyToFloat64(value); err == nil {
+				requestBody["top_p"] = topP
+			}
+		case "model.seed", "model.random_seed":
+			if seed, err := utils.AnyToInt64(value); err == nil {
+				requestBody["random_seed"] = seed
+			}
+		case "model.stop":
+			if stopStr, err := utils.AnyToString(value); err == nil {
+				stop := make([]string, 0)
+				for _, stopper := range strings.Split(stopStr, ",") {
+					if strings.TrimSpace(stopper) != "" {
+						stop = append(stop, stopper)
+					}
+				}
+				if len(stop) > 0 {
+					requestBody["stop"] = stop
+				}
+			}
+		case "model.tool_choice":
+			if choice, err := utils.AnyToString(value); err == nil && requestBody["tools"] != nil {
+				// mistral calls forcing a tool as any
+				if choice == "required" {
+					choice = "any"
+				}
+				requestBody["tool_choice"] = choice
+			}
+		case "model.parallel_tool_calls":
+			if parallel, err := utils.AnyToBool(value); err == nil && requestBody["tools"] != nil {
+				requestBody["parallel_tool_calls"] = parallel
+			}
+		}
+	}
+	return requestBody
+}
+
+// BuildHistory converts messages to mistral chat messages including tool calls of assistant and tool results
+func (llc *largeLanguageCaller) BuildHistory(allMessages []*protos.Message) []map[string]interface{} {
+	msg := make([]map[string]interface{}, 0)
+	for _, cntn := range allMessages {
+		switch cntn.GetRole() {
+		case "system", "user":
+			txt := types.OnlyStringProtoContent(cntn.GetContents())
+			if strings.TrimSpace(txt) == "" {
+				// there might be problem in initiator
+				continue
+			}
+			msg = append(msg, map[string]interface{}{
+				"role":    cntn.GetRole(),
+				"content": txt,
+			})
+		case "assistant":
+			assistant := map[string]interface{}{
+				"role":    "assistant",
+				"content": types.OnlyStringProtoContent(cntn.GetContents()),
+			}
+			if len(cntn.GetToolCalls()) > 0 {
+				toolCalls := make([]map[string]interface{}, 0, len(cntn.GetToolCalls()))
+				for _, tc := range cntn.GetToolCalls() {
+					toolCalls = append(toolCalls, map[string]interface{}{
+						"id":   tc.GetId(),
+						"type": "function",
+						"function": map[string]interface{}{
+							"name":      tc.GetFunction().GetName(),
+							"arguments": tc.GetFunction().GetArguments(),
+						},
+					})
+				}
+				assistant["tool_calls"] = toolCalls
+			} else if strings.TrimSpace(assistant["content"].(string)) == "" {
+				continue
+			}
+			msg = append(msg, assistant)
+		case "tool":
+			// content type of tool message holds the id of tool call
+			for _, c := range cntn.GetContents() {
+				msg = append(msg, map[string]interface{}{
+					"role":         "tool",
+					"tool_call_id": c.GetContentType(),
+					"content":      string(c.GetContent()),
+				})
+			}
+		}
+	}
+	return msg
+}
+
+func (llc *largeLanguageCaller) toMessage(message MistralMessage) *types.Message {
+	output := &types.Message{
+		Role:     "assistant",
+		Contents: make([]*types.Content, 0),
+	}
+	if message.Content != "" {
+		output.Contents = append(output.Contents, &types.Content{
+			ContentType:   commons.TEXT_CONTENT.String(),
+			ContentFormat: commons.TEXT_CONTENT_FORMAT_RAW.String(),
+			Content:       []byte(message.Content),
+		})
+	}
+	for _, tc := range message.ToolCalls {
+		output.ToolCalls = append(output.ToolCalls, &types.ToolCall{
+			Id:   utils.Ptr(tc.ID),
+			Type: utils.Ptr("function"),
+			Function: &types.FunctionCall{
+				Name:      utils.Ptr(tc.Function.Name),
+				Arguments: utils.Ptr(tc.GetArguments()),
+			},
+		})
+	}
+	return output
+}
+
 // StreamChatCompletion implements internal_callers.LargeLanguageCaller.
-func (*largeLanguageCaller) StreamChatCompletion(
+func (llc *largeLanguageCaller) StreamChatCompletion(
 	ctx context.Context,
-	// providerModel string,
 	allMessages []*protos.Message,
 	options *internal_callers.ChatCompletionOptions,
 	onStream func(types.Message) error,
 	onMetrics func(*types.Message, types.Metrics) error,
 	onError func(err error),
 ) error {
-	panic("unimplemented")
-}
-
-func (llc *largeLanguageCaller) GetChatCompletion(
-	ctx context.Context,
-	// providerModel string,
-	allMessages []*protos.Message,
-	options *internal_callers.ChatCompletionOptions,
-) (*types.Message, types.Metrics, error) {
-	llc.logger.Debugf("getting chat completion from google llc")
-	//
-	// Working with chat complition with vision
-	//
 	metrics := internal_caller_metrics.NewMetricBuilder(options.RequestId)
 	metrics.OnStart()
-	requestBody := map[string]interface{}{
-		// "model": providerModel,
-	}
 
-	msg := make([]map[string]string, 0)
-	var lastRole string
+	requestBody := llc.ChatCompletionRequest(options)
+	requestBody["messages"] = llc.BuildHistory(allMessages)
+	requestBody["stream"] = true
+	options.AIOptions.PreHook(requestBody)
 
-	for _, cntn := range allMessages {
-		if len(cntn.GetContents()) == 0 {
-			// there might be problem in initiator
+	body, err := llc.Stream(ctx, "v1/chat/completions", "POST", map[string]string{}, requestBody)
+	if err != nil {
+		llc.logger.Errorf("getting error for chat complition stream %v", err)
+		options.AIOptions.PostHook(map[string]interface{}{
+			"error": err,
+		}, metrics.OnFailure().Build())
+		onMetrics(nil, metrics.Build())
+		onError(err)
+		return err
+	}
+	defer body.Close()
+
+	var content strings.Builder
+	var usage *MistralUsage
+	toolCalls := make(map[int]*types.ToolCall)
+	scanner := bufio.NewScanner(body)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if !strings.HasPrefix(line, "data:") {
 			continue
 		}
-
-		currentRole := cntn.GetRole()
-		if currentRole == "user" || currentRole == "system" {
-			if lastRole == "user" {
-				// Skip this message to ensure alternation
+		data := strings.TrimSpace(strings.TrimPrefix(line, "data:"))
+		if data == "[DONE]" {
+			break
+		}
+		var chunk MistralStreamResponse
+		if err := json.Unmarshal([]byte(data), &chunk); err != nil {
+			llc.logger.Errorf("error while parsing chat complition stream chunk %v", err)
+			continue
+		}
+		if chunk.Usage != nil {
+			usage = chunk.Usage
+		}
+		for _, choice := range chunk.Choices {
+			for _, tc := range choice.Delta.ToolCalls {
+				call, ok := toolCalls[tc.Index]
+				if !ok {
+					call = &types.ToolCall{Type: utils.Ptr("function"), Function: &types.FunctionCall{}}
+					toolCalls[tc.Index] = call
+				}
+				if tc.ID != "" {
+					call.Id = utils.Ptr(tc.ID)
+				}
+				if tc.Function.Name != "" {
+					call.Function.MergeName(utils.Ptr(tc.Function.Name))
+				}
+				if len(tc.Function.Arguments) > 0 {
+					call.Function.MergeArguments(utils.Ptr(tc.GetArguments()))
+				}
+			}
+			if choice.Delta.Content == "" {
 				continue
 			}
-
-			txt := cntn.Contents[0].GetContent()
-			msg = append(msg, map[string]string{
-				"role":    "user",
-				"content": string(txt),
-			})
-			lastRole = "user"
+			content.WriteString(choice.Delta.Content)
+			if err := onStream(types.Message{
+				Role: "assistant",
+				Contents: []*types.Content{{
+					ContentType:   commons.TEXT_CONTENT.String(),
+					ContentFormat: commons.TEXT_CONTENT_FORMAT_RAW.String(),
+					Content:       []byte(choice.Delta.Content),
+				}},
+			}); err != nil {
+				llc.logger.Errorf("Error sending stream data: %v", err)
+				return err
+			}
 		}
+	}
 
-		if currentRole == "assistant" {
-			if lastRole == "assistant" {
-				// Skip this message to ensure alternation
-				continue
-			}
-			txt := cntn.Contents[0].GetContent()
-			msg = append(msg, map[string]string{
-				"role":    "assistant",
-				"content": string(txt),
-			})
-			lastRole = "assistant"
+	if err := scanner.Err(); err != nil {
+		llc.logger.Errorf("chat completions stream failed: %v", err)
+		options.AIOptions.PostHook(map[string]interface{}{
+			"error": err,
+		}, metrics.OnFailure().Build())
+		onMetrics(nil, metrics.Build())
+		onError(err)
+		return err
+	}
+
+	completeMsg := llc.toMessage(MistralMessage{Content: content.String()})
+	indexes := make([]int, 0, len(toolCalls))
+	for idx := range toolCalls {
+		indexes = append(indexes, idx)
+	}
+	sort.Ints(indexes)
+	for _, idx := range indexes {
+		if toolCalls[idx].Function.Arguments == nil {
+			toolCalls[idx].Function.Arguments = utils.Ptr("{}")
 		}
+		completeMsg.ToolCalls = append(completeMsg.ToolCalls, toolCalls[idx])
 	}
 
-	requestBody["messages"] = msg
+	metrics.OnSuccess()
+	metrics.OnAddMetrics(llc.UsageMetrics(usage)...)
+	options.AIOptions.PostHook(map[string]interface{}{
+		"result": completeMsg,
+	}, metrics.Build())
+	onMetrics(completeMsg, metrics.Build())
+	return nil
+}
+
+func (llc *largeLanguageCaller) GetChatCompletion(
+	ctx context.Context,
+	allMessages []*protos.Message,
+	options *internal_callers.ChatCompletionOptions,
+) (*types.Message, types.Metrics, error) {
+	llc.logger.Debugf("getting chat completion from mistral llc")
+	metrics := internal_caller_metrics.NewMetricBuilder(options.RequestId)
+	metrics.OnStart()
+
+	requestBody := llc.ChatCompletionRequest(options)
+	requestBody["messages"] = llc.BuildHistory(allMessages)
 	headers := map[string]string{}
 	options.AIOptions.PreHook(requestBody)
-	res, err := llc.Call(ctx, "chat/completions", "POST", headers, requestBody)
+	res, err := llc.Call(ctx, "v1/chat/completions", "POST", headers, requestBody)
 
 	//
 	if err != nil {
@@ -116,25 +334,14 @@ func (llc *largeLanguageCaller) GetChatCompletion(
 		return nil, metrics.Build(), err
 	}
 
-	//
-	//
-	output := make([]*types.Content, len(resp.Choices))
 	metrics.OnAddMetrics(llc.UsageMetrics(resp.Usage)...)
-
-	for idx, choice := range resp.Choices {
-		output[idx] = &types.Content{
-			ContentType:   commons.TEXT_CONTENT.String(),
-			ContentFormat: commons.TEXT_CONTENT_FORMAT_RAW.String(),
-			Content:       []byte(choice.Message.Content),
-		}
-
-	}
 	options.AIOptions.PostHook(map[string]interface{}{
 		"result": res,
 	}, metrics.Build())
-	return &types.Message{
-		Contents: output,
-	}, metrics.Build(), nil
+	if len(resp.Choices) == 0 {
+		return nil, metrics.Build(), errors.New("empty response from mistral")
+	}
+	return llc.toMessage(resp.Choices[0].Message), metrics.Build(), nil
 }
 
 func (llc *largeLanguageCaller) GetCompletion(
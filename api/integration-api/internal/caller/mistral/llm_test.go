@@ -0,0 +1,155 @@
+package internal_mistral_callers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	internal_callers "github.com/rapidaai/api/integration-api/internal/caller"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/types"
+	"github.com/rapidaai/protos"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func testCaller(t *testing.T, handler http.HandlerFunc) internal_callers.LargeLanguageCaller {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	url := API_URL
+	API_URL = server.URL
+	t.Cleanup(func() { API_URL = url })
+
+	logger := commons.NewApplicationLoggerWithOptions(commons.EnableFile(false), commons.Level("error"))
+	require.NoError(t, logger.InitLogger())
+	value, err := structpb.NewStruct(map[string]interface{}{"key": "test-key"})
+	require.NoError(t, err)
+	return NewLargeLanguageCaller(logger, &protos.Credential{Id: 1, Value: value})
+}
+
+func testOptions(t *testing.T, values map[string]interface{}) *internal_callers.ChatCompletionOptions {
+	parameters := make(map[string]*anypb.Any)
+	for k, v := range values {
+		value, err := structpb.NewValue(v)
+		require.NoError(t, err)
+		parameters[k], err = anypb.New(value)
+		require.NoError(t, err)
+	}
+	return &internal_callers.ChatCompletionOptions{
+		AIOptions: internal_callers.AIOptions{
+			RequestId:      1,
+			PreHook:        func(rst map[string]interface{}) {},
+			PostHook:       func(rst map[string]interface{}, metrics types.Metrics) {},
+			ModelParameter: parameters,
+		},
+		ToolDefinitions: []*internal_callers.ToolDefinition{{
+			Type: "function",
+			Function: &internal_callers.FunctionDefinition{
+				Name:        "get_weather",
+				Description: "weather of city",
+				Parameters: &internal_callers.FunctionParameter{
+					Type:       "object",
+					Required:   []string{"city"},
+					Properties: map[string]internal_callers.FunctionParameterProperty{"city": {Type: "string"}},
+				},
+			},
+		}},
+	}
+}
+
+func testHistory() []*protos.Message {
+	text := func(role, txt string) *protos.Message {
+		return &protos.Message{Role: role, Contents: []*protos.Content{{
+			ContentType:   commons.TEXT_CONTENT.String(),
+			ContentFormat: commons.TEXT_CONTENT_FORMAT_RAW.String(),
+			Content:       []byte(txt),
+		}}}
+	}
+	return []*protos.Message{
+		text("system", "you are helpful"),
+		text("user", "what time is it?"),
+		{Role: "assistant", ToolCalls: []*protos.ToolCall{{Id: "abc123xyz", Type: "function", Function: &protos.FunctionCall{Name: "get_time", Arguments: "{}"}}}},
+		{Role: "tool", Contents: []*protos.Content{{ContentType: "abc123xyz", Content: []byte(`{"time":"10:00"}`)}}},
+		text("user", "and the weather in paris?"),
+	}
+}
+
+func TestChatCompletionWithTools(t *testing.T) {
+	var request map[string]interface{}
+	caller := testCaller(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/chat/completions", r.URL.Path)
+		assert.Equal(t, "Bearer test-key", r.Header.Get("Authorization"))
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id":"c1","object":"chat.completion","model":"mistral-large-latest","choices":[{"index":0,"finish_reason":"tool_calls","message":{"role":"assistant","content":"","tool_calls":[{"id":"D681PevKs","type":"function","function":{"name":"get_weather","arguments":"{\"city\": \"Paris\"}"}}]}}],"usage":{"prompt_tokens":30,"completion_tokens":10,"total_tokens":40}}`))
+	})
+
+	message, metrics, err := caller.GetChatCompletion(context.Background(), testHistory(), testOptions(t, map[string]interface{}{
+		"model.name":        "mistral-large-latest",
+		"model.tool_choice": "required",
+		"model.temperature": 0.3,
+	}))
+	require.NoError(t, err)
+	assert.NotEmpty(t, metrics)
+	require.Len(t, message.ToolCalls, 1)
+	assert.Equal(t, "D681PevKs", *message.ToolCalls[0].Id)
+	assert.Equal(t, "get_weather", *message.ToolCalls[0].Function.Name)
+	assert.JSONEq(t, `{"city":"Paris"}`, *message.ToolCalls[0].Function.Arguments)
+
+	assert.Equal(t, "mistral-large-latest", request["model"])
+	assert.Equal(t, "any", request["tool_choice"])
+	assert.Equal(t, 0.3, request["temperature"])
+	assert.Len(t, request["tools"], 1)
+	messages := request["messages"].([]interface{})
+	require.Len(t, messages, 5)
+	assistant := messages[2].(map[string]interface{})
+	assert.Equal(t, "get_time", assistant["tool_calls"].([]interface{})[0].(map[string]interface{})["function"].(map[string]interface{})["name"])
+	tool := messages[3].(map[string]interface{})
+	assert.Equal(t, "tool", tool["role"])
+	assert.Equal(t, "abc123xyz", tool["tool_call_id"])
+}
+
+func TestStreamChatCompletionWithTools(t *testing.T) {
+	caller := testCaller(t, func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, true, body["stream"])
+		w.Header().Set("Content-Type", "text/event-stream")
+		chunks := []string{
+			`{"id":"c2","model":"m","choices":[{"index":0,"delta":{"role":"assistant","content":"Checking"},"finish_reason":null}]}`,
+			`{"id":"c2","model":"m","choices":[{"index":0,"delta":{"content":" now."},"finish_reason":null}]}`,
+			`{"id":"c2","model":"m","choices":[{"index":0,"delta":{"tool_calls":[{"id":"Q1w2E3r4T","index":0,"function":{"name":"get_weather","arguments":"{\"city\": \"Paris\"}"}}]},"finish_reason":"tool_calls"}],"usage":{"prompt_tokens":30,"completion_tokens":10,"total_tokens":40}}`,
+		}
+		for _, chunk := range chunks {
+			fmt.Fprintf(w, "data: %s\n\n", chunk)
+		}
+		fmt.Fprint(w, "data: [DONE]\n\n")
+	})
+
+	deltas := make([]string, 0)
+	var completed *types.Message
+	err := caller.StreamChatCompletion(context.Background(), testHistory(), testOptions(t, map[string]interface{}{"model.name": "mistral-small-latest"}),
+		func(msg types.Message) error {
+			deltas = append(deltas, msg.String())
+			return nil
+		},
+		func(msg *types.Message, metrics types.Metrics) error {
+			completed = msg
+			assert.NotEmpty(t, metrics)
+			return nil
+		},
+		func(err error) { t.Fatalf("unexpected stream error %v", err) },
+	)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Checking", " now."}, deltas)
+	require.NotNil(t, completed)
+	assert.Equal(t, "Checking now.", completed.String())
+	require.Len(t, completed.ToolCalls, 1)
+	assert.Equal(t, "Q1w2E3r4T", *completed.ToolCalls[0].Id)
+	assert.JSONEq(t, `{"city":"Paris"}`, *completed.ToolCalls[0].Function.Arguments)
+}
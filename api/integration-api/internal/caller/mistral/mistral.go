@@ -49,6 +49,34 @@ type MistralEmbeddingResponse struct {
 		Index     int       `json:"index"`
 	} `json:"data"`
 }
+
+type MistralToolCall struct {
+	ID       string `json:"id"`
+	Type     string `json:"type"`
+	Index    int    `json:"index"`
+	Function struct {
+		Name string `json:"name"`
+		// arguments are json encoded string, few models give json object instead
+		Arguments json.RawMessage `json:"arguments"`
+	} `json:"function"`
+}
+
+// GetArguments returns arguments of tool call as json string
+func (tc *MistralToolCall) GetArguments() string {
+	var arguments string
+	if err := json.Unmarshal(tc.Function.Arguments, &arguments); err == nil {
+		return arguments
+	}
+	return string(tc.Function.Arguments)
+}
+
+type MistralMessage struct {
+	Content   string            `json:"content"`
+	ToolCalls []MistralToolCall `json:"tool_calls"`
+	Prefix    bool              `json:"prefix"`
+	Role      string            `json:"role"`
+}
+
 type MistralMessageResponse struct {
 	ID      string        `json:"id"`
 	Object  string        `json:"object"`
@@ -56,21 +84,21 @@ type MistralMessageResponse struct {
 	Created int64         `json:"created"`
 	Usage   *MistralUsage `json:"usage"`
 	Choices []struct {
-		Index   int `json:"index"`
-		Message struct {
-			Content   string `json:"content"`
-			ToolCalls []struct {
-				ID       string `json:"id"`
-				Type     string `json:"type"`
-				Function struct {
-					Name      string   `json:"name"`
-					Arguments struct{} `json:"arguments"`
-				} `json:"function"`
-			} `json:"tool_calls"`
-			Prefix bool   `json:"prefix"`
-			Role   string `json:"role"`
-		} `json:"message"`
-		FinishReason string `json:"finish_reason"`
+		Index        int            `json:"index"`
+		Message      MistralMessage `json:"message"`
+		FinishReason string         `json:"finish_reason"`
+	} `json:"choices"`
+}
+
+// MistralStreamResponse is a chunk of server sent events of chat completion with stream
+type MistralStreamResponse struct {
+	ID      string        `json:"id"`
+	Model   string        `json:"model"`
+	Usage   *MistralUsage `json:"usage"`
+	Choices []struct {
+		Index        int            `json:"index"`
+		Delta        MistralMessage `json:"delta"`
+		FinishReason *string        `json:"finish_reason"`
 	} `json:"choices"`
 }
 
@@ -107,6 +135,43 @@ func (AICaller *Mistral) Do(req *http.Request) (*http.Response, error) {
 }
 
 func (mistralC *Mistral) Call(ctx context.Context, endpoint, method string, headers map[string]string, payload map[string]interface{}) (*string, error) {
+	req, err := mistralC.newRequest(ctx, endpoint, method, headers, payload)
+	if err != nil {
+		return nil, err
+	}
+	return mistralC.do(req)
+}
+
+// Stream makes the request and returns body of successful response to read server sent events,
+// caller must close the body
+func (mistralC *Mistral) Stream(ctx context.Context, endpoint, method string, headers map[string]string, payload map[string]interface{}) (io.ReadCloser, error) {
+	req, err := mistralC.newRequest(ctx, endpoint, method, headers, payload)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("Accept", "text/event-stream")
+	// timeout of client would cut long streams, context of request controls the cancellation
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		mistralC.logger.Errorf("unable to complete stream request for mistral with error %v", err)
+		return nil, err
+	}
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return resp.Body, nil
+	}
+
+	var apiErr MistralError
+	if err := mistralC.Unmarshal(resp, &apiErr); err != nil {
+		mistralC.logger.Errorf("unable to unmarshal error response from mistral with error %v", err)
+		return nil, MistralError{StatusCode: resp.StatusCode}
+	}
+	if apiErr.StatusCode == 0 {
+		apiErr.StatusCode = resp.StatusCode
+	}
+	return nil, &apiErr
+}
+
+func (mistralC *Mistral) newRequest(ctx context.Context, endpoint, method string, headers map[string]string, payload map[string]interface{}) (*http.Request, error) {
 	credentials := mistralC.credential()
 	cx, ok := credentials[API_KEY]
 	if !ok {
@@ -136,8 +201,7 @@ func (mistralC *Mistral) Call(ctx context.Context, endpoint, method string, head
 
 	req.Header.Add("Content-Type", "application/json")
 	req.Header.Add(API_KEY_HEADER_KEY, fmt.Sprintf("Bearer %s", cx.(string)))
-	return mistralC.do(req)
-
+	return req, nil
 }
 
 func (mistralC *Mistral) do(req *http.Request) (*string, error) {
@@ -0,0 +1,76 @@
+package internal_openai_callers
+
+import (
+	"errors"
+
+	"github.com/openai/openai-go"
+	"github.com/openai/openai-go/option"
+	internal_callers "github.com/rapidaai/api/integration-api/internal/caller"
+	"github.com/rapidaai/pkg/commons"
+	integration_api "github.com/rapidaai/protos"
+)
+
+// base url of providers serving openai compatible api, url in credential takes precedence
+// so the same caller works for self hosted server like vllm and ollama
+var (
+	TOGETHER_AI_URL = "https://api.together.xyz/v1"
+	DEEP_INFRA_URL  = "https://api.deepinfra.com/v1/openai"
+	GROQ_URL        = "https://api.groq.com/openai/v1"
+	FIREWORKS_URL   = "https://api.fireworks.ai/inference/v1"
+)
+
+func openAICompatible(logger commons.Logger, credential *integration_api.Credential, baseURL string) OpenAI {
+	oai := openAI(logger, credential)
+	oai.compatible = true
+	oai.baseURL = baseURL
+	return oai
+}
+
+// IsCompatible tells that the caller is talking to openai compatible provider instead of openai
+func (openAI *OpenAI) IsCompatible() bool {
+	return openAI.compatible
+}
+
+// getCompatibleClient builds client for base url, key is optional as self hosted servers
+// are mostly running without any authentication
+func (openAI *OpenAI) getCompatibleClient(credentials map[string]interface{}) (*openai.Client, error) {
+	baseURL := openAI.baseURL
+	if url, ok := credentials[API_URL].(string); ok && url != "" {
+		baseURL = url
+	}
+	if baseURL == "" {
+		openAI.logger.Errorf("Unable to get client for openai compatible provider, url is missing")
+		return nil, errors.New("unable to resolve the url of provider from credential")
+	}
+	opts := []option.RequestOption{option.WithBaseURL(baseURL)}
+	if key, ok := credentials[API_KEY].(string); ok && key != "" {
+		opts = append(opts, option.WithAPIKey(key))
+	} else {
+		// never send the key of openai picked from environment to other provider
+		opts = append(opts, option.WithAPIKey(""), option.WithHeaderDel("authorization"))
+	}
+	opts = append(opts, option.WithHeaderDel("OpenAI-Organization"), option.WithHeaderDel("OpenAI-Project"))
+	clt := openai.NewClient(opts...)
+	return &clt, nil
+}
+
+// NewCompatibleLargeLanguageCaller returns chat caller for any provider serving openai compatible api at base url
+func NewCompatibleLargeLanguageCaller(logger commons.Logger, credential *integration_api.Credential, baseURL string) internal_callers.LargeLanguageCaller {
+	return &largeLanguageCaller{
+		OpenAI: openAICompatible(logger, credential, baseURL),
+	}
+}
+
+// NewCompatibleEmbeddingCaller returns embedding caller for any provider serving openai compatible api at base url
+func NewCompatibleEmbeddingCaller(logger commons.Logger, credential *integration_api.Credential, baseURL string) internal_callers.EmbeddingCaller {
+	return &embeddingCaller{
+		OpenAI: openAICompatible(logger, credential, baseURL),
+	}
+}
+
+// NewCompatibleVerifyCredentialCaller returns verifier for any provider serving openai compatible api at base url
+func NewCompatibleVerifyCredentialCaller(logger commons.Logger, credential *integration_api.Credential, baseURL string) internal_callers.Verifier {
+	return &verifyCredentialCaller{
+		OpenAI: openAICompatible(logger, credential, baseURL),
+	}
+}
@@ -0,0 +1,255 @@
+package internal_openai_callers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	internal_callers "github.com/rapidaai/api/integration-api/internal/caller"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/types"
+	"github.com/rapidaai/protos"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// compatibleServer emulates the wire format of openai chat completions, embeddings and models api
+func compatibleServer(t *testing.T, key string) (*httptest.Server, *[]map[string]interface{}) {
+	requests := make([]map[string]interface{}, 0)
+	mux := http.NewServeMux()
+	authorized := func(w http.ResponseWriter, r *http.Request) bool {
+		expected := ""
+		if key != "" {
+			expected = "Bearer " + key
+		}
+		if r.Header.Get("Authorization") != expected {
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte(`{"error":{"message":"invalid api key"}}`))
+			return false
+		}
+		return true
+	}
+	decode := func(r *http.Request) map[string]interface{} {
+		var body map[string]interface{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		requests = append(requests, body)
+		return body
+	}
+
+	mux.HandleFunc("/v1/chat/completions", func(w http.ResponseWriter, r *http.Request) {
+		if !authorized(w, r) {
+			return
+		}
+		body := decode(r)
+		if stream, _ := body["stream"].(bool); stream {
+			w.Header().Set("Content-Type", "text/event-stream")
+			chunks := []string{
+				`{"id":"c1","object":"chat.completion.chunk","model":"m","choices":[{"index":0,"delta":{"role":"assistant","content":"Hello"}}]}`,
+				`{"id":"c1","object":"chat.completion.chunk","model":"m","choices":[{"index":0,"delta":{"content":" there"}}]}`,
+				`{"id":"c1","object":"chat.completion.chunk","model":"m","choices":[{"index":0,"delta":{},"finish_reason":"stop"}],"usage":{"prompt_tokens":5,"completion_tokens":2,"total_tokens":7}}`,
+			}
+			for _, chunk := range chunks {
+				fmt.Fprintf(w, "data: %s\n\n", chunk)
+			}
+			fmt.Fprint(w, "data: [DONE]\n\n")
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if _, ok := body["tools"]; ok {
+			w.Write([]byte(`{"id":"c2","object":"chat.completion","model":"m","choices":[{"index":0,"finish_reason":"tool_calls","message":{"role":"assistant","content":null,"tool_calls":[{"id":"call_1","type":"function","function":{"name":"get_weather","arguments":"{\"city\":\"Paris\"}"}}]}}],"usage":{"prompt_tokens":12,"completion_tokens":8,"total_tokens":20}}`))
+			return
+		}
+		w.Write([]byte(`{"id":"c3","object":"chat.completion","model":"m","choices":[{"index":0,"finish_reason":"stop","message":{"role":"assistant","content":"Hello there"}}],"usage":{"prompt_tokens":5,"completion_tokens":2,"total_tokens":7}}`))
+	})
+
+	mux.HandleFunc("/v1/embeddings", func(w http.ResponseWriter, r *http.Request) {
+		if !authorized(w, r) {
+			return
+		}
+		body := decode(r)
+		input, _ := body["input"].([]interface{})
+		data := make([]map[string]interface{}, 0)
+		// reversed order, the caller must keep the index of input
+		for i := len(input) - 1; i >= 0; i-- {
+			data = append(data, map[string]interface{}{"object": "embedding", "index": i, "embedding": []float64{float64(i), 0.5}})
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"object": "list",
+			"model":  body["model"],
+			"data":   data,
+			"usage":  map[string]interface{}{"prompt_tokens": 4, "total_tokens": 4},
+		})
+	})
+
+	mux.HandleFunc("/v1/models", func(w http.ResponseWriter, r *http.Request) {
+		if !authorized(w, r) {
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"object":"list","data":[{"id":"llama3","object":"model","created":0,"owned_by":"me"}]}`))
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server, &requests
+}
+
+func testLogger(t *testing.T) commons.Logger {
+	logger := commons.NewApplicationLoggerWithOptions(commons.EnableFile(false), commons.Level("error"))
+	require.NoError(t, logger.InitLogger())
+	return logger
+}
+
+func testCredential(t *testing.T, values map[string]interface{}) *protos.Credential {
+	value, err := structpb.NewStruct(values)
+	require.NoError(t, err)
+	return &protos.Credential{Id: 1, Value: value}
+}
+
+func testParameters(t *testing.T, values map[string]interface{}) map[string]*anypb.Any {
+	parameters := make(map[string]*anypb.Any)
+	for k, v := range values {
+		value, err := structpb.NewValue(v)
+		require.NoError(t, err)
+		parameters[k], err = anypb.New(value)
+		require.NoError(t, err)
+	}
+	return parameters
+}
+
+func testAIOptions(t *testing.T, parameters map[string]interface{}) internal_callers.AIOptions {
+	return internal_callers.AIOptions{
+		RequestId:      1,
+		PreHook:        func(rst map[string]interface{}) {},
+		PostHook:       func(rst map[string]interface{}, metrics types.Metrics) {},
+		ModelParameter: testParameters(t, parameters),
+	}
+}
+
+func userMessage(text string) *protos.Message {
+	return &protos.Message{
+		Role: "user",
+		Contents: []*protos.Content{{
+			ContentType:   commons.TEXT_CONTENT.String(),
+			ContentFormat: commons.TEXT_CONTENT_FORMAT_RAW.String(),
+			Content:       []byte(text),
+		}},
+	}
+}
+
+func TestCompatibleChatCompletion(t *testing.T) {
+	server, requests := compatibleServer(t, "test-key")
+	caller := NewCompatibleLargeLanguageCaller(testLogger(t), testCredential(t, map[string]interface{}{"key": "test-key"}), server.URL+"/v1")
+
+	message, metrics, err := caller.GetChatCompletion(context.Background(), []*protos.Message{userMessage("hi")}, &internal_callers.ChatCompletionOptions{
+		AIOptions: testAIOptions(t, map[string]interface{}{"model.name": "meta-llama/Llama-3-8b", "model.temperature": 0.2}),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "Hello there", message.String())
+	assert.NotEmpty(t, metrics)
+	require.Len(t, *requests, 1)
+	assert.Equal(t, "meta-llama/Llama-3-8b", (*requests)[0]["model"])
+	assert.Equal(t, 0.2, (*requests)[0]["temperature"])
+}
+
+func TestCompatibleChatCompletionWithTools(t *testing.T) {
+	server, requests := compatibleServer(t, "test-key")
+	caller := NewCompatibleLargeLanguageCaller(testLogger(t), testCredential(t, map[string]interface{}{"key": "test-key"}), server.URL+"/v1")
+
+	message, _, err := caller.GetChatCompletion(context.Background(), []*protos.Message{userMessage("weather in paris?")}, &internal_callers.ChatCompletionOptions{
+		AIOptions: testAIOptions(t, map[string]interface{}{"model.name": "llama3"}),
+		ToolDefinitions: []*internal_callers.ToolDefinition{{
+			Type: "function",
+			Function: &internal_callers.FunctionDefinition{
+				Name:        "get_weather",
+				Description: "weather of city",
+				Parameters: &internal_callers.FunctionParameter{
+					Type:       "object",
+					Required:   []string{"city"},
+					Properties: map[string]internal_callers.FunctionParameterProperty{"city": {Type: "string"}},
+				},
+			},
+		}},
+	})
+	require.NoError(t, err)
+	require.Len(t, message.ToolCalls, 1)
+	assert.Equal(t, "call_1", *message.ToolCalls[0].Id)
+	assert.Equal(t, "get_weather", *message.ToolCalls[0].Function.Name)
+	assert.JSONEq(t, `{"city":"Paris"}`, *message.ToolCalls[0].Function.Arguments)
+
+	tools, ok := (*requests)[0]["tools"].([]interface{})
+	require.True(t, ok)
+	require.Len(t, tools, 1)
+	assert.Equal(t, "get_weather", tools[0].(map[string]interface{})["function"].(map[string]interface{})["name"])
+}
+
+func TestCompatibleStreamChatCompletion(t *testing.T) {
+	server, _ := compatibleServer(t, "test-key")
+	caller := NewCompatibleLargeLanguageCaller(testLogger(t), testCredential(t, map[string]interface{}{"key": "test-key"}), server.URL+"/v1")
+
+	deltas := make([]string, 0)
+	var completed *types.Message
+	var completedMetrics types.Metrics
+	err := caller.StreamChatCompletion(context.Background(), []*protos.Message{userMessage("hi")}, &internal_callers.ChatCompletionOptions{
+		AIOptions: testAIOptions(t, map[string]interface{}{"model.name": "llama3"}),
+	}, func(msg types.Message) error {
+		deltas = append(deltas, msg.String())
+		return nil
+	}, func(msg *types.Message, metrics types.Metrics) error {
+		completed, completedMetrics = msg, metrics
+		return nil
+	}, func(err error) {
+		t.Fatalf("unexpected stream error %v", err)
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Hello", " there"}, deltas)
+	require.NotNil(t, completed)
+	assert.Equal(t, "Hello there", completed.String())
+	assert.NotEmpty(t, completedMetrics)
+}
+
+func TestCompatibleEmbedding(t *testing.T) {
+	server, requests := compatibleServer(t, "test-key")
+	caller := NewCompatibleEmbeddingCaller(testLogger(t), testCredential(t, map[string]interface{}{"key": "test-key"}), server.URL+"/v1")
+
+	embeddings, _, err := caller.GetEmbedding(context.Background(), map[int32]string{0: "first", 1: "second"}, &internal_callers.EmbeddingOptions{
+		AIOptions: testAIOptions(t, map[string]interface{}{"model.name": "BAAI/bge-base-en-v1.5"}),
+	})
+	require.NoError(t, err)
+	require.Len(t, embeddings, 2)
+	for i, embedding := range embeddings {
+		assert.Equal(t, int32(i), embedding.GetIndex())
+		assert.Equal(t, []float64{float64(i), 0.5}, embedding.GetEmbedding())
+	}
+	assert.Equal(t, "BAAI/bge-base-en-v1.5", (*requests)[0]["model"])
+}
+
+func TestCompatibleCredential(t *testing.T) {
+	server, _ := compatibleServer(t, "test-key")
+	logger := testLogger(t)
+
+	// url of credential takes precedence over the default url of provider
+	verifier := NewCompatibleVerifyCredentialCaller(logger, testCredential(t, map[string]interface{}{"key": "test-key", "url": server.URL + "/v1"}), "http://127.0.0.1:1/v1")
+	_, err := verifier.CredentialVerifier(context.Background(), &internal_callers.CredentialVerifierOptions{})
+	assert.NoError(t, err)
+
+	verifier = NewCompatibleVerifyCredentialCaller(logger, testCredential(t, map[string]interface{}{"key": "wrong-key"}), server.URL+"/v1")
+	_, err = verifier.CredentialVerifier(context.Background(), &internal_callers.CredentialVerifierOptions{})
+	assert.Error(t, err)
+
+	// self hosted server without authentication
+	t.Setenv("OPENAI_API_KEY", "sk-openai")
+	keyless, _ := compatibleServer(t, "")
+	verifier = NewCompatibleVerifyCredentialCaller(logger, testCredential(t, map[string]interface{}{"url": keyless.URL + "/v1"}), "")
+	_, err = verifier.CredentialVerifier(context.Background(), &internal_callers.CredentialVerifierOptions{})
+	assert.NoError(t, err)
+
+	verifier = NewCompatibleVerifyCredentialCaller(logger, testCredential(t, map[string]interface{}{}), "")
+	_, err = verifier.CredentialVerifier(context.Background(), &internal_callers.CredentialVerifierOptions{})
+	assert.Error(t, err)
+}
@@ -360,6 +360,23 @@ func (llc *largeLanguageCaller) StreamChatCompletion(
 		}
 	}
 
+	if resp.Err() != nil {
+		llc.logger.Errorf("chat completions stream failed: %v", resp.Err())
+		options.AIOptions.PostHook(map[string]interface{}{
+			"result": utils.ToJson(accumulate),
+			"error":  resp.Err(),
+		}, metrics.OnFailure().Build())
+		onMetrics(nil, metrics.Build())
+		onError(resp.Err())
+		return resp.Err()
+	}
+
+	// some of the compatible servers close the stream without finishing chunk
+	metrics.OnAddMetrics(llc.GetComplitionUsages(accumulate.Usage)...)
+	options.AIOptions.PostHook(map[string]interface{}{
+		"result": utils.ToJson(accumulate),
+	}, metrics.OnSuccess().Build())
+	onMetrics(&completeMsg, metrics.Build())
 	return nil
 }
 
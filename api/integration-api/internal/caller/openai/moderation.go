@@ -2,13 +2,17 @@ package internal_openai_callers
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"sort"
 	"time"
 
+	"github.com/openai/openai-go"
 	internal_callers "github.com/rapidaai/api/integration-api/internal/caller"
+	internal_caller_metrics "github.com/rapidaai/api/integration-api/internal/caller/metrics"
 	"github.com/rapidaai/pkg/commons"
 	"github.com/rapidaai/pkg/types"
-	type_enums "github.com/rapidaai/pkg/types/enums"
+	"github.com/rapidaai/pkg/utils"
 	integration_api "github.com/rapidaai/protos"
 )
 
@@ -22,25 +26,72 @@ func NewModerationsCaller(logger commons.Logger, credential *integration_api.Cre
 	}
 }
 
+func (stc *ModerationsCaller) GetModerationNewParams(opts *internal_callers.ModerationOptions) openai.ModerationNewParams {
+	options := openai.ModerationNewParams{
+		Model: openai.ModerationModelOmniModerationLatest,
+	}
+	if v, ok := opts.ModelParameter["model.name"]; ok {
+		if modelName, err := utils.AnyToString(v); err == nil && modelName != "" {
+			options.Model = modelName
+		}
+	}
+	return options
+}
+
+// GetModeration gives verdict of every category of moderation model, value of moderation is the score of category
 func (stc *ModerationsCaller) GetModeration(ctx context.Context,
-	content *types.Content, options *internal_callers.ModerationOptions) (*types.Content, types.Metrics, error) {
-	//
-	// Working with chat complition with vision
-	//
-	start := time.Now()
-	// client, err := stc.GetClient()
-	timeMetric := &types.Metric{
-		Name:        type_enums.TIME_TAKEN.String(),
-		Value:       fmt.Sprintf("%d", int64(time.Since(start))),
-		Description: "Time taken to serve the llm request",
+	content *types.Content, options *internal_callers.ModerationOptions) ([]*integration_api.Moderation, types.Metrics, error) {
+	metrics := internal_caller_metrics.NewMetricBuilder(options.RequestId)
+	metrics.OnStart()
+
+	client, err := stc.GetClient()
+	if err != nil {
+		return nil, metrics.OnFailure().Build(), err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	opts := stc.GetModerationNewParams(options)
+	opts.Input = openai.ModerationNewParamsInputUnion{
+		OfString: openai.String(content.GetString()),
+	}
+	options.AIOptions.PreHook(map[string]interface{}{"input": opts})
+	resp, err := client.Moderations.New(ctx, opts)
+	if err != nil {
+		options.AIOptions.PostHook(map[string]interface{}{
+			"result": resp,
+			"error":  err,
+		}, metrics.OnFailure().Build())
+		return nil, metrics.Build(), err
+	}
+	metrics.OnSuccess()
+
+	moderations := make([]*integration_api.Moderation, 0)
+	for _, result := range resp.Results {
+		categories := map[string]bool{}
+		scores := map[string]float64{}
+		if err := json.Unmarshal([]byte(result.Categories.RawJSON()), &categories); err != nil {
+			stc.logger.Warnf("unable to read moderation categories %v", err)
+		}
+		if err := json.Unmarshal([]byte(result.CategoryScores.RawJSON()), &scores); err != nil {
+			stc.logger.Warnf("unable to read moderation category scores %v", err)
+		}
+		names := make([]string, 0, len(scores))
+		for name := range scores {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			moderations = append(moderations, &integration_api.Moderation{
+				Name:    name,
+				Value:   fmt.Sprintf("%f", scores[name]),
+				Flagged: categories[name],
+			})
+		}
 	}
-	// if err != nil {
-	// 	return nil, types.Metrics{timeMetric}, err
-	// }
-	// Will need moderation for chat in future
-
-	return &types.Content{
-		ContentType:   "text",
-		ContentFormat: "raw",
-	}, types.Metrics{timeMetric}, nil
+	options.AIOptions.PostHook(map[string]interface{}{
+		"result": resp,
+	}, metrics.Build())
+	return moderations, metrics.Build(), nil
 }
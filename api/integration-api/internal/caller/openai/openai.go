@@ -16,6 +16,10 @@ import (
 type OpenAI struct {
 	logger     commons.Logger
 	credential internal_callers.CredentialResolver
+
+	// openai compatible provider with the default base url
+	compatible bool
+	baseURL    string
 }
 
 var (
@@ -50,6 +54,9 @@ func openAI(logger commons.Logger, credential *integration_api.Credential) OpenA
 func (openAI *OpenAI) GetClient() (*openai.Client, error) {
 	openAI.logger.Debugf("Getting client for open ai")
 	credentials := openAI.credential()
+	if openAI.IsCompatible() {
+		return openAI.getCompatibleClient(credentials)
+	}
 	cx, ok := credentials[API_KEY]
 	if !ok {
 		openAI.logger.Errorf("Unable to get client for user")
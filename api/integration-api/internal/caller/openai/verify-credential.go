@@ -30,6 +30,12 @@ func (stc *verifyCredentialCaller) CredentialVerifier(
 
 	ctx, cancel := context.WithTimeout(ctx, time.Minute)
 	defer cancel()
+
+	// compatible providers serve different models, listing models is supported by all of them
+	if stc.IsCompatible() {
+		_, err = client.Models.List(ctx)
+		return nil, err
+	}
 	_, err = client.Chat.Completions.New(ctx, openai.ChatCompletionNewParams{
 		Messages: []openai.ChatCompletionMessageParamUnion{
 			openai.UserMessage("Test"),
@@ -2,6 +2,8 @@ package internal_replicate_callers
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"strings"
 	"time"
 
@@ -19,14 +21,113 @@ type largeLanguageCaller struct {
 	Replicate
 }
 
+// NewLargeLanguageCaller returns caller for language models hosted on replicate, models on replicate
+// take a plain prompt so tools are always given through prompt based tool calling
 func NewLargeLanguageCaller(logger commons.Logger, credential *integration_api.Credential) internal_callers.LargeLanguageCaller {
-	return &largeLanguageCaller{
+	return internal_callers.NewPromptToolCaller(logger, &largeLanguageCaller{
 		Replicate: replicate(logger, credential),
+	})
+}
+
+// PredictionInput builds input of prediction, conversation is flattened to prompt and system
+// message is given as system prompt which most of the language models on replicate accept
+func (llc *largeLanguageCaller) PredictionInput(allMessages []*protos.Message, options *internal_callers.ChatCompletionOptions) (string, replicate_go.PredictionInput) {
+	input := replicate_go.PredictionInput{}
+	var model string
+	for key, value := range options.ModelParameter {
+		switch key {
+		case "model.name":
+			if modelName, err := utils.AnyToString(value); err == nil {
+				model = modelName
+			}
+		case "model.max_tokens", "model.max_completion_tokens":
+			if maxTokens, err := utils.AnyToInt(value); err == nil {
+				input["max_tokens"] = maxTokens
+				input["max_new_tokens"] = maxTokens
+			}
+		case "model.temperature":
+			if temp, err := utils.AnyToFloat64(value); err == nil {
+				input["temperature"] = temp
+			}
+		case "model.top_p":
+			if topP, err := utils.AnyToFloat64(value); err == nil {
+				input["top_p"] = topP
+			}
+		case "model.top_k":
+			if topK, err := utils.AnyToInt(value); err == nil {
+				input["top_k"] = topK
+			}
+		case "model.stop":
+			if stop, err := utils.AnyToString(value); err == nil {
+				input["stop_sequences"] = stop
+			}
+		case "model.seed":
+			if seed, err := utils.AnyToInt(value); err == nil {
+				input["seed"] = seed
+			}
+		}
+	}
+
+	system := make([]string, 0)
+	conversation := make([]string, 0)
+	for _, msg := range allMessages {
+		txt := strings.TrimSpace(types.OnlyStringProtoContent(msg.GetContents()))
+		if txt == "" {
+			continue
+		}
+		switch msg.GetRole() {
+		case "system":
+			system = append(system, txt)
+		case "assistant":
+			conversation = append(conversation, fmt.Sprintf("Assistant: %s", txt))
+		default:
+			conversation = append(conversation, fmt.Sprintf("User: %s", txt))
+		}
+	}
+	if len(system) > 0 {
+		input["system_prompt"] = strings.Join(system, "\n\n")
+	}
+	// single user message is given as it is, conversation needs turn of assistant at the end
+	if len(conversation) == 1 && strings.HasPrefix(conversation[0], "User: ") {
+		input["prompt"] = strings.TrimPrefix(conversation[0], "User: ")
+	} else {
+		input["prompt"] = strings.Join(append(conversation, "Assistant:"), "\n\n")
+	}
+	return model, input
+}
+
+// createPrediction creates prediction on model deployment or on the version when model is given as owner/name:version
+func (llc *largeLanguageCaller) createPrediction(ctx context.Context, client *replicate_go.Client, model string, input replicate_go.PredictionInput, stream bool) (*replicate_go.Prediction, error) {
+	identifier, err := replicate_go.ParseIdentifier(model)
+	if err != nil {
+		return nil, err
+	}
+	if identifier.Version != nil {
+		return client.CreatePrediction(ctx, *identifier.Version, input, nil, stream)
+	}
+	return client.CreatePredictionWithModel(ctx, identifier.Owner, identifier.Name, input, nil, stream)
+}
+
+// outputText joins output of language model, output is list of tokens or a single string
+func (llc *largeLanguageCaller) outputText(output replicate_go.PredictionOutput) (string, error) {
+	switch v := output.(type) {
+	case string:
+		return v, nil
+	case []string:
+		return strings.Join(v, ""), nil
+	case []interface{}:
+		var builder strings.Builder
+		for _, token := range v {
+			builder.WriteString(fmt.Sprintf("%v", token))
+		}
+		return builder.String(), nil
+	default:
+		return "", errors.New("output of replicate prediction is not text")
 	}
 }
 
 // StreamChatCompletion implements internal_callers.LargeLanguageCaller.
-func (*largeLanguageCaller) StreamChatCompletion(
+func (llc *largeLanguageCaller) StreamChatCompletion(
 	ctx context.Context,
 	allMessages []*protos.Message,
 	options *internal_callers.ChatCompletionOptions,
@@ -34,7 +135,94 @@ func (*largeLanguageCaller) StreamChatCompletion(
 	onMetrics func(*types.Message, types.Metrics) error,
 	onError func(err error),
 ) error {
-	panic("unimplemented")
+	metrics := internal_caller_metrics.NewMetricBuilder(options.RequestId)
+	metrics.OnStart()
+
+	client, err := llc.GetClient()
+	if err != nil {
+		llc.logger.Errorf("chat complition unable to get client for replicate %v", err)
+		onMetrics(nil, metrics.OnFailure().Build())
+		onError(err)
+		return err
+	}
+
+	model, input := llc.PredictionInput(allMessages, options)
+	options.AIOptions.PreHook(utils.ToJson(input))
+	prediction, err := llc.createPrediction(ctx, client, model, input, true)
+	if err != nil {
+		llc.logger.Errorf("unable to create replicate prediction %v", err)
+		options.AIOptions.PostHook(map[string]interface{}{
+			"error":  err,
+			"result": prediction,
+		}, metrics.OnFailure().Build())
+		onMetrics(nil, metrics.Build())
+		onError(err)
+		return err
+	}
+
+	var content strings.Builder
+	events, errs := client.StreamPrediction(ctx, prediction)
+	for done := false; !done; {
+		select {
+		case event, ok := <-events:
+			if !ok {
+				done = true
+				continue
+			}
+			switch event.Type {
+			case replicate_go.SSETypeOutput:
+				content.WriteString(event.Data)
+				if err := onStream(types.Message{
+					Role: "assistant",
+					Contents: []*types.Content{{
+						ContentType:   commons.TEXT_CONTENT.String(),
+						ContentFormat: commons.TEXT_CONTENT_FORMAT_RAW.String(),
+						Content:       []byte(event.Data),
+					}},
+				}); err != nil {
+					llc.logger.Errorf("Error sending stream data: %v", err)
+					return err
+				}
+			case replicate_go.SSETypeError:
+				err = fmt.Errorf("replicate prediction failed %s", event.Data)
+				done = true
+			case replicate_go.SSETypeDone:
+				done = true
+			}
+		case err = <-errs:
+			done = true
+		}
+	}
+
+	if err != nil {
+		llc.logger.Errorf("replicate prediction stream failed %v", err)
+		options.AIOptions.PostHook(map[string]interface{}{
+			"error":  err,
+			"result": prediction,
+		}, metrics.OnFailure().Build())
+		onMetrics(nil, metrics.Build())
+		onError(err)
+		return err
+	}
+
+	// metrics are available on prediction once it has completed
+	if completed, err := client.GetPrediction(ctx, prediction.ID); err == nil {
+		metrics.OnAddMetrics(llc.UsageMetrics(completed.Metrics)...)
+	}
+	metrics.OnSuccess()
+	completeMsg := &types.Message{
+		Role: "assistant",
+		Contents: []*types.Content{{
+			ContentType:   commons.TEXT_CONTENT.String(),
+			ContentFormat: commons.TEXT_CONTENT_FORMAT_RAW.String(),
+			Content:       []byte(content.String()),
+		}},
+	}
+	options.AIOptions.PostHook(map[string]interface{}{
+		"result": completeMsg,
+	}, metrics.Build())
+	onMetrics(completeMsg, metrics.Build())
+	return nil
 }
 
 func (llc *largeLanguageCaller) GetChatCompletion(
@@ -47,23 +235,17 @@ func (llc *largeLanguageCaller) GetChatCompletion(
 
 	client, err := llc.GetClient()
 	if err != nil {
-		llc.logger.Errorf("complition unable to get client for cohere %v", err)
+		llc.logger.Errorf("complition unable to get client for replicate %v", err)
 		return nil, metrics.OnFailure().Build(), err
 	}
 
-	input := replicate_go.PredictionInput{}
-
+	model, input := llc.PredictionInput(allMessages, options)
 	options.AIOptions.PreHook(utils.ToJson(input))
 	// single minute timeout and cancellable by the client as context will get cancel
 	ctx, cancel := context.WithTimeout(ctx, time.Minute)
 	defer cancel()
 
-	prediction, err := client.CreatePrediction(ctx,
-		// *options.Version,
-		"",
-		input,
-		nil,
-		false)
+	prediction, err := llc.createPrediction(ctx, client, model, input, false)
 	if err != nil {
 		metrics.OnFailure()
 		llc.logger.Errorf("unable to create replicate prediction %v", err)
@@ -74,6 +256,9 @@ func (llc *largeLanguageCaller) GetChatCompletion(
 		return nil, metrics.Build(), err
 	}
 	err = client.Wait(ctx, prediction) // Wait for the prediction to finish
+	if err == nil && prediction.Error != nil {
+		err = fmt.Errorf("replicate prediction failed %v", prediction.Error)
+	}
 	if err != nil {
 		metrics.OnFailure()
 		llc.logger.Errorf("after waiting prediction failed to response %v", err)
@@ -86,8 +271,8 @@ func (llc *largeLanguageCaller) GetChatCompletion(
 
 	// all the usages into the metrics
 	metrics.OnAddMetrics(llc.UsageMetrics(prediction.Metrics)...)
-	v, ok := prediction.Output.([]string)
-	if !ok {
+	v, err := llc.outputText(prediction.Output)
+	if err != nil {
 		metrics.OnFailure()
 		llc.logger.Errorf("response is not string %v", err)
 		options.AIOptions.PostHook(map[string]interface{}{
@@ -98,17 +283,16 @@ func (llc *largeLanguageCaller) GetChatCompletion(
 	}
 	metrics.OnSuccess()
 
-	// options.AIOptions.PreHook(llc.toString(response))
 	options.AIOptions.PostHook(map[string]interface{}{
 		"result": prediction,
 	}, metrics.Build())
 
 	return &types.Message{
-		Role: "chatbot",
+		Role: "assistant",
 		Contents: []*types.Content{{
 			ContentType:   commons.TEXT_CONTENT.String(),
 			ContentFormat: commons.TEXT_CONTENT_FORMAT_RAW.String(),
-			Content:       []byte(strings.Join(v, "")),
+			Content:       []byte(v),
 		}},
 	}, metrics.Build(), nil
 }
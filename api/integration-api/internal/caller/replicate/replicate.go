@@ -47,47 +47,58 @@ func (replicate *Replicate) GetClient() (*replicate_go.Client, error) {
 func (replicate *Replicate) UsageMetrics(usages *replicate_go.PredictionMetrics) types.Metrics {
 
 	metrics := make(types.Metrics, 0)
-	if usages != nil {
-
+	if usages == nil {
+		return metrics
+	}
+	// metrics are reported by the model, not every model gives all of them
+	if usages.PredictTime != nil {
 		metrics = append(metrics, &types.Metric{
 			Name:        type_enums.PROVIDER_GENERATE_TIME.String(),
 			Value:       fmt.Sprintf("%f", *usages.PredictTime),
 			Description: "Time taken to generate by provider",
 		})
+	}
 
+	if usages.TotalTime != nil {
 		metrics = append(metrics, &types.Metric{
 			Name:        type_enums.PROVIDER_TOTAL_TIME.String(),
 			Value:       fmt.Sprintf("%f", *usages.TotalTime),
 			Description: "Total time taken by provider",
 		})
+	}
 
+	if usages.TimeToFirstToken != nil {
 		metrics = append(metrics, &types.Metric{
 			Name:        type_enums.TIME_TO_FIRST_TOKEN.String(),
 			Value:       fmt.Sprintf("%f", *usages.TimeToFirstToken),
 			Description: "Time to First Token",
 		})
+	}
 
+	if usages.TokensPerSecond != nil {
 		metrics = append(metrics, &types.Metric{
 			Name:        type_enums.TOKEN_PRE_SECOND.String(),
 			Value:       fmt.Sprintf("%f", *usages.TokensPerSecond),
 			Description: "Token Per second",
 		})
+	}
 
+	if usages.InputTokenCount != nil && usages.OutputTokenCount != nil {
 		metrics = append(metrics, &types.Metric{
-			Name:        type_enums.OUTPUT_TOKEN.String(),
-			Value:       fmt.Sprintf("%f", *usages.InputTokenCount),
+			Name:        type_enums.INPUT_TOKEN.String(),
+			Value:       fmt.Sprintf("%d", *usages.InputTokenCount),
 			Description: "Input token",
 		})
 
 		metrics = append(metrics, &types.Metric{
-			Name:        type_enums.INPUT_TOKEN.String(),
-			Value:       fmt.Sprintf("%f", *usages.OutputTokenCount),
+			Name:        type_enums.OUTPUT_TOKEN.String(),
+			Value:       fmt.Sprintf("%d", *usages.OutputTokenCount),
 			Description: "Output Token",
 		})
 
 		metrics = append(metrics, &types.Metric{
 			Name:        type_enums.TOTAL_TOKEN.String(),
-			Value:       fmt.Sprintf("%f", *usages.InputTokenCount+*usages.OutputTokenCount),
+			Value:       fmt.Sprintf("%d", *usages.InputTokenCount+*usages.OutputTokenCount),
 			Description: "Total Token",
 		})
 	}
@@ -0,0 +1,289 @@
+// Rapida – Open Source Voice AI Orchestration Platform
+// Copyright (C) 2023-2025 Prashant Srivastav <prashant@rapida.ai>
+// Licensed under a modified GPL-2.0. See the LICENSE file for details.
+package internal_callers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/types"
+	"github.com/rapidaai/pkg/utils"
+	"github.com/rapidaai/protos"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// TOOL_CALLING_PARAMETER selects how tools are given to the model,
+// "native" (default) uses tool api of provider and "prompt" describes tools in system prompt
+const TOOL_CALLING_PARAMETER = "model.tool_calling"
+
+// IsPromptToolCalling tells whether model parameters ask for prompt based tool calling
+func IsPromptToolCalling(parameters map[string]*anypb.Any) bool {
+	value, ok := parameters[TOOL_CALLING_PARAMETER]
+	if !ok {
+		return false
+	}
+	mode, err := utils.AnyToString(value)
+	return err == nil && strings.EqualFold(mode, "prompt")
+}
+
+// promptToolCall is the json shape model is asked to reply with when it wants to call tools
+type promptToolCall struct {
+	Id        string          `json:"id,omitempty"`
+	Name      string          `json:"name"`
+	Arguments json.RawMessage `json:"arguments,omitempty"`
+}
+
+type promptToolReply struct {
+	ToolCalls []promptToolCall `json:"tool_calls"`
+}
+
+// promptToolCaller gives tool calling to models without native support,
+// tools are described in system prompt and json reply of model is parsed back to tool calls
+type promptToolCaller struct {
+	logger commons.Logger
+	caller LargeLanguageCaller
+}
+
+func NewPromptToolCaller(logger commons.Logger, caller LargeLanguageCaller) LargeLanguageCaller {
+	return &promptToolCaller{
+		logger: logger,
+		caller: caller,
+	}
+}
+
+// ToolPrompt renders instruction describing available tools and the expected reply format
+func ToolPrompt(tools []*ToolDefinition) string {
+	var builder strings.Builder
+	builder.WriteString("You have access to the following tools:\n")
+	for _, tl := range tools {
+		if tl.Type != "function" || tl.Function == nil {
+			continue
+		}
+		parameters := map[string]interface{}{"type": "object", "properties": map[string]interface{}{}}
+		if tl.Function.Parameters != nil {
+			parameters = tl.Function.Parameters.ToMap()
+		}
+		schema, _ := json.Marshal(parameters)
+		builder.WriteString(fmt.Sprintf("- %s: %s\n  parameters: %s\n", tl.Function.Name, tl.Function.Description, schema))
+	}
+	builder.WriteString("\nWhen a tool is needed, reply with only a json object and nothing else in the format ")
+	builder.WriteString(`{"tool_calls": [{"name": "<tool name>", "arguments": {<arguments matching parameters>}}]}`)
+	builder.WriteString(".\nWhen no tool is needed, reply to the user in plain text.")
+	return builder.String()
+}
+
+// ParseToolCalls extracts tool calls from reply of model, text outside of the json object is returned as it is.
+// Reply without valid tool call json is returned unchanged.
+func ParseToolCalls(reply string) (string, []*types.ToolCall) {
+	start := strings.Index(reply, "{")
+	end := strings.LastIndex(reply, "}")
+	if start < 0 || end < start {
+		return reply, nil
+	}
+
+	var parsed promptToolReply
+	if err := json.Unmarshal([]byte(reply[start:end+1]), &parsed); err != nil || len(parsed.ToolCalls) == 0 {
+		return reply, nil
+	}
+
+	toolCalls := make([]*types.ToolCall, 0, len(parsed.ToolCalls))
+	for _, call := range parsed.ToolCalls {
+		if call.Name == "" {
+			continue
+		}
+		id := call.Id
+		if id == "" {
+			id = fmt.Sprintf("call_%s", strings.ReplaceAll(uuid.NewString(), "-", "")[:24])
+		}
+		arguments := strings.TrimSpace(string(call.Arguments))
+		if arguments == "" || arguments == "null" {
+			arguments = "{}"
+		}
+		// few models give arguments as json encoded string
+		var encoded string
+		if err := json.Unmarshal(call.Arguments, &encoded); err == nil {
+			arguments = encoded
+		}
+		toolCalls = append(toolCalls, &types.ToolCall{
+			Id:   utils.Ptr(id),
+			Type: utils.Ptr("function"),
+			Function: &types.FunctionCall{
+				Name:      utils.Ptr(call.Name),
+				Arguments: utils.Ptr(arguments),
+			},
+		})
+	}
+	if len(toolCalls) == 0 {
+		return reply, nil
+	}
+
+	// strip markdown fence around json if model has added one
+	before := strings.TrimSuffix(strings.TrimSpace(reply[:start]), "```json")
+	before = strings.TrimSuffix(before, "```")
+	after := strings.TrimPrefix(strings.TrimSpace(reply[end+1:]), "```")
+	return strings.TrimSpace(before + " " + after), toolCalls
+}
+
+// BuildHistory moves tool definitions to system prompt and converts tool calls and tool results
+// of history to plain text so the model without tool support can follow the conversation.
+func (ptc *promptToolCaller) BuildHistory(allMessages []*protos.Message, tools []*ToolDefinition) []*protos.Message {
+	textMessage := func(role, text string) *protos.Message {
+		return &protos.Message{
+			Role: role,
+			Contents: []*protos.Content{{
+				ContentType:   commons.TEXT_CONTENT.String(),
+				ContentFormat: commons.TEXT_CONTENT_FORMAT_RAW.String(),
+				Content:       []byte(text),
+			}},
+		}
+	}
+
+	history := make([]*protos.Message, 0, len(allMessages)+1)
+	toolNames := make(map[string]string)
+	systemFound := false
+	for _, msg := range allMessages {
+		switch msg.GetRole() {
+		case "system":
+			if systemFound {
+				history = append(history, msg)
+				continue
+			}
+			systemFound = true
+			history = append(history, textMessage("system", strings.TrimSpace(types.OnlyStringProtoContent(msg.GetContents())+"\n\n"+ToolPrompt(tools))))
+		case "assistant":
+			if len(msg.GetToolCalls()) == 0 {
+				history = append(history, msg)
+				continue
+			}
+			reply := promptToolReply{ToolCalls: make([]promptToolCall, 0, len(msg.GetToolCalls()))}
+			for _, tc := range msg.GetToolCalls() {
+				toolNames[tc.GetId()] = tc.GetFunction().GetName()
+				arguments := json.RawMessage(tc.GetFunction().GetArguments())
+				if !json.Valid(arguments) {
+					arguments = json.RawMessage("{}")
+				}
+				reply.ToolCalls = append(reply.ToolCalls, promptToolCall{Id: tc.GetId(), Name: tc.GetFunction().GetName(), Arguments: arguments})
+			}
+			encoded, _ := json.Marshal(reply)
+			history = append(history, textMessage("assistant", strings.TrimSpace(types.OnlyStringProtoContent(msg.GetContents())+"\n"+string(encoded))))
+		case "tool":
+			// content type of tool message holds the id of tool call
+			results := make([]string, 0, len(msg.GetContents()))
+			for _, c := range msg.GetContents() {
+				results = append(results, fmt.Sprintf("Result of tool %s (%s): %s", toolNames[c.GetContentType()], c.GetContentType(), c.GetContent()))
+			}
+			history = append(history, textMessage("user", strings.Join(results, "\n")))
+		default:
+			history = append(history, msg)
+		}
+	}
+	if !systemFound {
+		history = append([]*protos.Message{textMessage("system", ToolPrompt(tools))}, history...)
+	}
+	return history
+}
+
+func (ptc *promptToolCaller) prepare(allMessages []*protos.Message, options *ChatCompletionOptions) ([]*protos.Message, *ChatCompletionOptions) {
+	if len(options.ToolDefinitions) == 0 {
+		return allMessages, options
+	}
+	return ptc.BuildHistory(allMessages, options.ToolDefinitions), &ChatCompletionOptions{AIOptions: options.AIOptions}
+}
+
+// toolMessage replaces text of message with the tool calls found in it
+func (ptc *promptToolCaller) toolMessage(msg *types.Message) bool {
+	text, toolCalls := ParseToolCalls(msg.String())
+	if len(toolCalls) == 0 {
+		return false
+	}
+	msg.ToolCalls = append(msg.ToolCalls, toolCalls...)
+	msg.Contents = make([]*types.Content, 0)
+	if text != "" {
+		msg.Contents = append(msg.Contents, &types.Content{
+			ContentType:   commons.TEXT_CONTENT.String(),
+			ContentFormat: commons.TEXT_CONTENT_FORMAT_RAW.String(),
+			Content:       []byte(text),
+		})
+	}
+	return true
+}
+
+func (ptc *promptToolCaller) GetChatCompletion(
+	ctx context.Context,
+	allMessages []*protos.Message,
+	options *ChatCompletionOptions,
+) (*types.Message, types.Metrics, error) {
+	history, opts := ptc.prepare(allMessages, options)
+	msg, metrics, err := ptc.caller.GetChatCompletion(ctx, history, opts)
+	if err != nil || msg == nil || len(options.ToolDefinitions) == 0 {
+		return msg, metrics, err
+	}
+	ptc.toolMessage(msg)
+	return msg, metrics, nil
+}
+
+// StreamChatCompletion holds back the deltas once reply starts like json, as it can be a tool call
+// which must not reach the user, plain text reply is streamed as it comes.
+func (ptc *promptToolCaller) StreamChatCompletion(
+	ctx context.Context,
+	allMessages []*protos.Message,
+	options *ChatCompletionOptions,
+	onStream func(types.Message) error,
+	onMetrics func(*types.Message, types.Metrics) error,
+	onError func(err error),
+) error {
+	history, opts := ptc.prepare(allMessages, options)
+	if len(options.ToolDefinitions) == 0 {
+		return ptc.caller.StreamChatCompletion(ctx, history, opts, onStream, onMetrics, onError)
+	}
+
+	var buffered strings.Builder
+	holding, decided := false, false
+	textDelta := func(text string) types.Message {
+		return types.Message{
+			Role: "assistant",
+			Contents: []*types.Content{{
+				ContentType:   commons.TEXT_CONTENT.String(),
+				ContentFormat: commons.TEXT_CONTENT_FORMAT_RAW.String(),
+				Content:       []byte(text),
+			}},
+		}
+	}
+
+	return ptc.caller.StreamChatCompletion(ctx, history, opts,
+		func(delta types.Message) error {
+			if decided && !holding {
+				return onStream(delta)
+			}
+			buffered.WriteString(delta.String())
+			if decided {
+				return nil
+			}
+			trimmed := strings.TrimSpace(buffered.String())
+			if trimmed == "" {
+				return nil
+			}
+			decided = true
+			holding = strings.HasPrefix(trimmed, "{") || strings.HasPrefix(trimmed, "`")
+			if holding {
+				return nil
+			}
+			return onStream(textDelta(buffered.String()))
+		},
+		func(msg *types.Message, metrics types.Metrics) error {
+			if msg != nil && !ptc.toolMessage(msg) && holding {
+				// held reply was not a tool call after all
+				if err := onStream(textDelta(buffered.String())); err != nil {
+					ptc.logger.Errorf("unable to send held reply of model %v", err)
+				}
+			}
+			return onMetrics(msg, metrics)
+		},
+		onError,
+	)
+}
@@ -0,0 +1,162 @@
+package internal_callers
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/types"
+	"github.com/rapidaai/protos"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// scriptedCaller replies with the given text and records the request it received
+type scriptedCaller struct {
+	reply    string
+	messages []*protos.Message
+	options  *ChatCompletionOptions
+}
+
+func (sc *scriptedCaller) textMessage(text string) *types.Message {
+	return &types.Message{
+		Role: "assistant",
+		Contents: []*types.Content{{
+			ContentType:   commons.TEXT_CONTENT.String(),
+			ContentFormat: commons.TEXT_CONTENT_FORMAT_RAW.String(),
+			Content:       []byte(text),
+		}},
+	}
+}
+
+func (sc *scriptedCaller) GetChatCompletion(ctx context.Context, allMessages []*protos.Message, options *ChatCompletionOptions) (*types.Message, types.Metrics, error) {
+	sc.messages, sc.options = allMessages, options
+	return sc.textMessage(sc.reply), types.Metrics{}, nil
+}
+
+func (sc *scriptedCaller) StreamChatCompletion(ctx context.Context, allMessages []*protos.Message, options *ChatCompletionOptions, onStream func(types.Message) error, onMetrics func(*types.Message, types.Metrics) error, onError func(err error)) error {
+	sc.messages, sc.options = allMessages, options
+	// stream reply in small chunks as providers do
+	for i := 0; i < len(sc.reply); i += 4 {
+		end := min(i+4, len(sc.reply))
+		if err := onStream(*sc.textMessage(sc.reply[i:end])); err != nil {
+			return err
+		}
+	}
+	return onMetrics(sc.textMessage(sc.reply), types.Metrics{})
+}
+
+func weatherTool() []*ToolDefinition {
+	return []*ToolDefinition{{
+		Type: "function",
+		Function: &FunctionDefinition{
+			Name:        "get_weather",
+			Description: "weather of city",
+			Parameters: &FunctionParameter{
+				Type:       "object",
+				Required:   []string{"city"},
+				Properties: map[string]FunctionParameterProperty{"city": {Type: "string"}},
+			},
+		},
+	}}
+}
+
+func testLogger(t *testing.T) commons.Logger {
+	logger := commons.NewApplicationLoggerWithOptions(commons.EnableFile(false), commons.Level("error"))
+	require.NoError(t, logger.InitLogger())
+	return logger
+}
+
+func TestParseToolCalls(t *testing.T) {
+	tests := []struct {
+		name      string
+		reply     string
+		text      string
+		calls     []string
+		arguments []string
+	}{
+		{"plain text", "It is sunny in Paris.", "It is sunny in Paris.", nil, nil},
+		{"tool call", `{"tool_calls": [{"name": "get_weather", "arguments": {"city": "Paris"}}]}`, "", []string{"get_weather"}, []string{`{"city": "Paris"}`}},
+		{"fenced with text", "Let me check.\n```json\n{\"tool_calls\": [{\"name\": \"get_weather\", \"arguments\": {\"city\": \"Paris\"}}]}\n```", "Let me check.", []string{"get_weather"}, []string{`{"city": "Paris"}`}},
+		{"encoded arguments", `{"tool_calls": [{"name": "end_call", "arguments": "{\"reason\":\"done\"}"}, {"name": "noop"}]}`, "", []string{"end_call", "noop"}, []string{`{"reason":"done"}`, `{}`}},
+		{"json without tool calls", `{"answer": 42}`, `{"answer": 42}`, nil, nil},
+		{"invalid json", `{"tool_calls": [`, `{"tool_calls": [`, nil, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			text, calls := ParseToolCalls(tt.reply)
+			assert.Equal(t, tt.text, text)
+			require.Len(t, calls, len(tt.calls))
+			for i, call := range calls {
+				assert.NotEmpty(t, *call.Id)
+				assert.Equal(t, tt.calls[i], *call.Function.Name)
+				assert.JSONEq(t, tt.arguments[i], *call.Function.Arguments)
+			}
+		})
+	}
+}
+
+func TestPromptToolCallerHistory(t *testing.T) {
+	inner := &scriptedCaller{reply: "It is sunny."}
+	caller := NewPromptToolCaller(testLogger(t), inner)
+	history := []*protos.Message{
+		{Role: "system", Contents: []*protos.Content{{ContentType: commons.TEXT_CONTENT.String(), ContentFormat: commons.TEXT_CONTENT_FORMAT_RAW.String(), Content: []byte("you are helpful")}}},
+		{Role: "user", Contents: []*protos.Content{{ContentType: commons.TEXT_CONTENT.String(), ContentFormat: commons.TEXT_CONTENT_FORMAT_RAW.String(), Content: []byte("weather in paris?")}}},
+		{Role: "assistant", ToolCalls: []*protos.ToolCall{{Id: "call_1", Type: "function", Function: &protos.FunctionCall{Name: "get_weather", Arguments: `{"city":"Paris"}`}}}},
+		{Role: "tool", Contents: []*protos.Content{{ContentType: "call_1", Content: []byte(`{"sky":"sunny"}`)}}},
+	}
+
+	message, _, err := caller.GetChatCompletion(context.Background(), history, &ChatCompletionOptions{ToolDefinitions: weatherTool()})
+	require.NoError(t, err)
+	assert.Equal(t, "It is sunny.", message.String())
+	assert.Empty(t, message.ToolCalls)
+
+	// tools are not sent natively, they are part of system prompt
+	assert.Empty(t, inner.options.ToolDefinitions)
+	require.Len(t, inner.messages, 4)
+	system := types.OnlyStringProtoContent(inner.messages[0].GetContents())
+	assert.True(t, strings.HasPrefix(system, "you are helpful"))
+	assert.Contains(t, system, "get_weather")
+	assert.Contains(t, types.OnlyStringProtoContent(inner.messages[2].GetContents()), `"tool_calls":[{"id":"call_1","name":"get_weather","arguments":{"city":"Paris"}}]`)
+	assert.Equal(t, "user", inner.messages[3].GetRole())
+	assert.Contains(t, types.OnlyStringProtoContent(inner.messages[3].GetContents()), `Result of tool get_weather (call_1): {"sky":"sunny"}`)
+}
+
+func TestPromptToolCallerStream(t *testing.T) {
+	stream := func(reply string) (string, *types.Message) {
+		caller := NewPromptToolCaller(testLogger(t), &scriptedCaller{reply: reply})
+		var streamed strings.Builder
+		var completed *types.Message
+		err := caller.StreamChatCompletion(context.Background(), []*protos.Message{}, &ChatCompletionOptions{ToolDefinitions: weatherTool()},
+			func(msg types.Message) error {
+				streamed.WriteString(msg.String())
+				return nil
+			},
+			func(msg *types.Message, metrics types.Metrics) error {
+				completed = msg
+				return nil
+			},
+			func(err error) { t.Fatalf("unexpected error %v", err) },
+		)
+		require.NoError(t, err)
+		return streamed.String(), completed
+	}
+
+	// plain text reaches the user as it streams
+	streamed, completed := stream("It is sunny in Paris.")
+	assert.Equal(t, "It is sunny in Paris.", streamed)
+	assert.Empty(t, completed.ToolCalls)
+
+	// tool call json is held back and given as tool call
+	streamed, completed = stream(` {"tool_calls": [{"name": "get_weather", "arguments": {"city": "Paris"}}]}`)
+	assert.Empty(t, streamed)
+	require.Len(t, completed.ToolCalls, 1)
+	assert.Equal(t, "get_weather", *completed.ToolCalls[0].Function.Name)
+	assert.Empty(t, completed.String())
+
+	// held reply which is not a tool call is released at the end
+	streamed, completed = stream(`{"answer": 42}`)
+	assert.Equal(t, `{"answer": 42}`, streamed)
+	assert.Empty(t, completed.ToolCalls)
+}
@@ -24,6 +24,12 @@ func ProviderApiRoute(Cfg *config.IntegrationConfig, S *grpc.Server, Logger comm
 	protos.RegisterMistralServiceServer(S, integrationApi.NewMistralGRPC(Cfg, Logger, Postgres))
 	protos.RegisterReplicateServiceServer(S, integrationApi.NewReplicateGRPC(Cfg, Logger, Postgres))
 	protos.RegisterVertexAiServiceServer(S, integrationApi.NewVertexaiGRPC(Cfg, Logger, Postgres))
+	protos.RegisterBedrockServiceServer(S, integrationApi.NewBedrockGRPC(Cfg, Logger, Postgres))
+	protos.RegisterTogetherAiServiceServer(S, integrationApi.NewTogetherAiGRPC(Cfg, Logger, Postgres))
+	protos.RegisterDeepInfraServiceServer(S, integrationApi.NewDeepInfraGRPC(Cfg, Logger, Postgres))
+	protos.RegisterGroqServiceServer(S, integrationApi.NewGroqGRPC(Cfg, Logger, Postgres))
+	protos.RegisterFireworksServiceServer(S, integrationApi.NewFireworksGRPC(Cfg, Logger, Postgres))
+	protos.RegisterOpenAiCompatibleServiceServer(S, integrationApi.NewOpenAiCompatibleGRPC(Cfg, Logger, Postgres))
 }
 
 // audit logging api route
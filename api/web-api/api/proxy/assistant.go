@@ -377,6 +377,17 @@ func (assistantGRPCApi *webAssistantGRPCApi) GetAssistantWebhookLog(ctx context.
 	return assistantGRPCApi.assistantClient.GetAssistantWebhookLog(ctx, iAuth, iRequest)
 }
 
+// RedeliverAssistantWebhookLog implements protos.AssistantServiceServer.
+func (assistantGRPCApi *webAssistantGRPCApi) RedeliverAssistantWebhookLog(ctx context.Context, iRequest *protos.RedeliverAssistantWebhookLogRequest) (*protos.GetAssistantWebhookLogResponse, error) {
+	iAuth, isAuthenticated := types.GetAuthPrincipleGPRC(ctx)
+	if !isAuthenticated {
+		assistantGRPCApi.logger.Errorf("unauthenticated request to redeliver assistant webhook log")
+		return nil, errors.New("unauthenticated request")
+	}
+
+	return assistantGRPCApi.assistantClient.RedeliverAssistantWebhookLog(ctx, iAuth, iRequest)
+}
+
 // GetAssistantWebhook implements protos.AssistantServiceServer.
 func (assistantGRPCApi *webAssistantGRPCApi) GetAssistantWebhook(ctx context.Context, iRequest *protos.GetAssistantWebhookRequest) (*protos.GetAssistantWebhookResponse, error) {
 	iAuth, isAuthenticated := types.GetAuthPrincipleGPRC(ctx)
@@ -592,6 +603,126 @@ func (assistantGRPCApi *webAssistantGRPCApi) GetAssistantAnalysis(ctx context.Co
 	return assistantGRPCApi.assistantClient.GetAssistantAnalysis(ctx, iAuth, iRequest)
 }
 
+func (assistantGRPCApi *webAssistantGRPCApi) CreateAssistantLexicon(ctx context.Context, iRequest *protos.CreateAssistantLexiconRequest) (*protos.GetAssistantLexiconResponse, error) {
+	iAuth, isAuthenticated := types.GetAuthPrincipleGPRC(ctx)
+	if !isAuthenticated {
+		assistantGRPCApi.logger.Errorf("unauthenticated request to create assistant lexicon")
+		return nil, errors.New("unauthenticated request")
+	}
+	return assistantGRPCApi.assistantClient.CreateAssistantLexicon(ctx, iAuth, iRequest)
+
+}
+
+func (assistantGRPCApi *webAssistantGRPCApi) UpdateAssistantLexicon(ctx context.Context, iRequest *protos.UpdateAssistantLexiconRequest) (*protos.GetAssistantLexiconResponse, error) {
+	iAuth, isAuthenticated := types.GetAuthPrincipleGPRC(ctx)
+	if !isAuthenticated {
+		assistantGRPCApi.logger.Errorf("unauthenticated request to Update assistant lexicon")
+		return nil, errors.New("unauthenticated request")
+	}
+	return assistantGRPCApi.assistantClient.UpdateAssistantLexicon(ctx, iAuth, iRequest)
+
+}
+
+func (assistantGRPCApi *webAssistantGRPCApi) DeleteAssistantLexicon(ctx context.Context, iRequest *protos.DeleteAssistantLexiconRequest) (*protos.GetAssistantLexiconResponse, error) {
+	iAuth, isAuthenticated := types.GetAuthPrincipleGPRC(ctx)
+	if !isAuthenticated {
+		assistantGRPCApi.logger.Errorf("unauthenticated request to Delete assistant lexicon")
+		return nil, errors.New("unauthenticated request")
+	}
+	return assistantGRPCApi.assistantClient.DeleteAssistantLexicon(ctx, iAuth, iRequest)
+
+}
+
+func (assistantGRPCApi *webAssistantGRPCApi) GetAllAssistantLexicon(ctx context.Context, iRequest *protos.GetAllAssistantLexiconRequest) (*protos.GetAllAssistantLexiconResponse, error) {
+	iAuth, isAuthenticated := types.GetAuthPrincipleGPRC(ctx)
+	if !isAuthenticated {
+		assistantGRPCApi.logger.Errorf("unauthenticated request to GetAllAssistantLexicon")
+		return nil, errors.New("unauthenticated request")
+	}
+
+	page, tls, err := assistantGRPCApi.assistantClient.GetAllAssistantLexicon(ctx, iAuth, iRequest.GetAssistantId(), iRequest.GetCriterias(), iRequest.GetPaginate())
+	if err != nil {
+		return utils.Error[protos.GetAllAssistantLexiconResponse](
+			err,
+			"Unable to get all the assistant lexicons, please try again later.",
+		)
+	}
+
+	return utils.PaginatedSuccess[protos.GetAllAssistantLexiconResponse, []*protos.AssistantLexicon](
+		page.GetTotalItem(), page.GetCurrentPage(),
+		tls)
+
+}
+
+func (assistantGRPCApi *webAssistantGRPCApi) GetAssistantLexicon(ctx context.Context, iRequest *protos.GetAssistantLexiconRequest) (*protos.GetAssistantLexiconResponse, error) {
+	iAuth, isAuthenticated := types.GetAuthPrincipleGPRC(ctx)
+	if !isAuthenticated {
+		assistantGRPCApi.logger.Errorf("unauthenticated request to GetAssistantLexicon")
+		return nil, errors.New("unauthenticated request")
+	}
+	return assistantGRPCApi.assistantClient.GetAssistantLexicon(ctx, iAuth, iRequest)
+}
+
+func (assistantGRPCApi *webAssistantGRPCApi) CreateAssistantEvaluator(ctx context.Context, iRequest *protos.CreateAssistantEvaluatorRequest) (*protos.GetAssistantEvaluatorResponse, error) {
+	iAuth, isAuthenticated := types.GetAuthPrincipleGPRC(ctx)
+	if !isAuthenticated {
+		assistantGRPCApi.logger.Errorf("unauthenticated request to create assistant evaluator")
+		return nil, errors.New("unauthenticated request")
+	}
+	return assistantGRPCApi.assistantClient.CreateAssistantEvaluator(ctx, iAuth, iRequest)
+
+}
+
+func (assistantGRPCApi *webAssistantGRPCApi) UpdateAssistantEvaluator(ctx context.Context, iRequest *protos.UpdateAssistantEvaluatorRequest) (*protos.GetAssistantEvaluatorResponse, error) {
+	iAuth, isAuthenticated := types.GetAuthPrincipleGPRC(ctx)
+	if !isAuthenticated {
+		assistantGRPCApi.logger.Errorf("unauthenticated request to Update assistant evaluator")
+		return nil, errors.New("unauthenticated request")
+	}
+	return assistantGRPCApi.assistantClient.UpdateAssistantEvaluator(ctx, iAuth, iRequest)
+
+}
+
+func (assistantGRPCApi *webAssistantGRPCApi) DeleteAssistantEvaluator(ctx context.Context, iRequest *protos.DeleteAssistantEvaluatorRequest) (*protos.GetAssistantEvaluatorResponse, error) {
+	iAuth, isAuthenticated := types.GetAuthPrincipleGPRC(ctx)
+	if !isAuthenticated {
+		assistantGRPCApi.logger.Errorf("unauthenticated request to Delete assistant evaluator")
+		return nil, errors.New("unauthenticated request")
+	}
+	return assistantGRPCApi.assistantClient.DeleteAssistantEvaluator(ctx, iAuth, iRequest)
+
+}
+
+func (assistantGRPCApi *webAssistantGRPCApi) GetAllAssistantEvaluator(ctx context.Context, iRequest *protos.GetAllAssistantEvaluatorRequest) (*protos.GetAllAssistantEvaluatorResponse, error) {
+	iAuth, isAuthenticated := types.GetAuthPrincipleGPRC(ctx)
+	if !isAuthenticated {
+		assistantGRPCApi.logger.Errorf("unauthenticated request to GetAllAssistantEvaluator")
+		return nil, errors.New("unauthenticated request")
+	}
+
+	page, tls, err := assistantGRPCApi.assistantClient.GetAllAssistantEvaluator(ctx, iAuth, iRequest.GetAssistantId(), iRequest.GetCriterias(), iRequest.GetPaginate())
+	if err != nil {
+		return utils.Error[protos.GetAllAssistantEvaluatorResponse](
+			err,
+			"Unable to get all the assistant evaluators, please try again later.",
+		)
+	}
+
+	return utils.PaginatedSuccess[protos.GetAllAssistantEvaluatorResponse, []*protos.AssistantEvaluator](
+		page.GetTotalItem(), page.GetCurrentPage(),
+		tls)
+
+}
+
+func (assistantGRPCApi *webAssistantGRPCApi) GetAssistantEvaluator(ctx context.Context, iRequest *protos.GetAssistantEvaluatorRequest) (*protos.GetAssistantEvaluatorResponse, error) {
+	iAuth, isAuthenticated := types.GetAuthPrincipleGPRC(ctx)
+	if !isAuthenticated {
+		assistantGRPCApi.logger.Errorf("unauthenticated request to GetAssistantEvaluator")
+		return nil, errors.New("unauthenticated request")
+	}
+	return assistantGRPCApi.assistantClient.GetAssistantEvaluator(ctx, iAuth, iRequest)
+}
+
 func (assistantGRPCApi *webAssistantGRPCApi) GetAssistantToolLog(ctx context.Context, iRequest *protos.GetAssistantToolLogRequest) (*protos.GetAssistantToolLogResponse, error) {
 	iAuth, isAuthenticated := types.GetAuthPrincipleGPRC(ctx)
 	if !isAuthenticated {
@@ -618,3 +749,84 @@ func (assistantGRPCApi *webAssistantGRPCApi) GetAllAssistantTelemetry(ctx contex
 	}
 	return assistantGRPCApi.assistantClient.GetAllAssistantTelemetry(ctx, iAuth, iRequest)
 }
+
+func (assistantGRPCApi *webAssistantGRPCApi) GetAssistantTurnLatency(ctx context.Context, iRequest *protos.GetAssistantTurnLatencyRequest) (*protos.GetAssistantTurnLatencyResponse, error) {
+	iAuth, isAuthenticated := types.GetAuthPrincipleGPRC(ctx)
+	if !isAuthenticated {
+		assistantGRPCApi.logger.Errorf("unauthenticated request to GetAssistantTurnLatency")
+		return nil, errors.New("unauthenticated request")
+	}
+	return assistantGRPCApi.assistantClient.GetAssistantTurnLatency(ctx, iAuth, iRequest)
+}
+
+func (assistantGRPCApi *webAssistantGRPCApi) GetAllAssistantConversationEvaluation(ctx context.Context, iRequest *protos.GetAllAssistantConversationEvaluationRequest) (*protos.GetAllAssistantConversationEvaluationResponse, error) {
+	iAuth, isAuthenticated := types.GetAuthPrincipleGPRC(ctx)
+	if !isAuthenticated {
+		assistantGRPCApi.logger.Errorf("unauthenticated request to GetAllAssistantConversationEvaluation")
+		return nil, errors.New("unauthenticated request")
+	}
+	return assistantGRPCApi.assistantClient.GetAllAssistantConversationEvaluation(ctx, iAuth, iRequest)
+}
+
+func (assistantGRPCApi *webAssistantGRPCApi) GetAssistantEvaluationSummary(ctx context.Context, iRequest *protos.GetAssistantEvaluationSummaryRequest) (*protos.GetAssistantEvaluationSummaryResponse, error) {
+	iAuth, isAuthenticated := types.GetAuthPrincipleGPRC(ctx)
+	if !isAuthenticated {
+		assistantGRPCApi.logger.Errorf("unauthenticated request to GetAssistantEvaluationSummary")
+		return nil, errors.New("unauthenticated request")
+	}
+	return assistantGRPCApi.assistantClient.GetAssistantEvaluationSummary(ctx, iAuth, iRequest)
+}
+
+func (assistantGRPCApi *webAssistantGRPCApi) CreateAssistantCampaign(ctx context.Context, iRequest *protos.CreateAssistantCampaignRequest) (*protos.GetAssistantCampaignResponse, error) {
+	iAuth, isAuthenticated := types.GetAuthPrincipleGPRC(ctx)
+	if !isAuthenticated {
+		assistantGRPCApi.logger.Errorf("unauthenticated request to CreateAssistantCampaign")
+		return nil, errors.New("unauthenticated request")
+	}
+	return assistantGRPCApi.assistantClient.CreateAssistantCampaign(ctx, iAuth, iRequest)
+}
+
+func (assistantGRPCApi *webAssistantGRPCApi) GetAssistantCampaign(ctx context.Context, iRequest *protos.GetAssistantCampaignRequest) (*protos.GetAssistantCampaignResponse, error) {
+	iAuth, isAuthenticated := types.GetAuthPrincipleGPRC(ctx)
+	if !isAuthenticated {
+		assistantGRPCApi.logger.Errorf("unauthenticated request to GetAssistantCampaign")
+		return nil, errors.New("unauthenticated request")
+	}
+	return assistantGRPCApi.assistantClient.GetAssistantCampaign(ctx, iAuth, iRequest)
+}
+
+func (assistantGRPCApi *webAssistantGRPCApi) GetAllAssistantCampaign(ctx context.Context, iRequest *protos.GetAllAssistantCampaignRequest) (*protos.GetAllAssistantCampaignResponse, error) {
+	iAuth, isAuthenticated := types.GetAuthPrincipleGPRC(ctx)
+	if !isAuthenticated {
+		assistantGRPCApi.logger.Errorf("unauthenticated request to GetAllAssistantCampaign")
+		return nil, errors.New("unauthenticated request")
+	}
+	return assistantGRPCApi.assistantClient.GetAllAssistantCampaign(ctx, iAuth, iRequest)
+}
+
+func (assistantGRPCApi *webAssistantGRPCApi) UpdateAssistantCampaignState(ctx context.Context, iRequest *protos.UpdateAssistantCampaignStateRequest) (*protos.GetAssistantCampaignResponse, error) {
+	iAuth, isAuthenticated := types.GetAuthPrincipleGPRC(ctx)
+	if !isAuthenticated {
+		assistantGRPCApi.logger.Errorf("unauthenticated request to UpdateAssistantCampaignState")
+		return nil, errors.New("unauthenticated request")
+	}
+	return assistantGRPCApi.assistantClient.UpdateAssistantCampaignState(ctx, iAuth, iRequest)
+}
+
+func (assistantGRPCApi *webAssistantGRPCApi) DeleteAssistantCampaign(ctx context.Context, iRequest *protos.DeleteAssistantCampaignRequest) (*protos.GetAssistantCampaignResponse, error) {
+	iAuth, isAuthenticated := types.GetAuthPrincipleGPRC(ctx)
+	if !isAuthenticated {
+		assistantGRPCApi.logger.Errorf("unauthenticated request to DeleteAssistantCampaign")
+		return nil, errors.New("unauthenticated request")
+	}
+	return assistantGRPCApi.assistantClient.DeleteAssistantCampaign(ctx, iAuth, iRequest)
+}
+
+func (assistantGRPCApi *webAssistantGRPCApi) GetAllAssistantCampaignContact(ctx context.Context, iRequest *protos.GetAllAssistantCampaignContactRequest) (*protos.GetAllAssistantCampaignContactResponse, error) {
+	iAuth, isAuthenticated := types.GetAuthPrincipleGPRC(ctx)
+	if !isAuthenticated {
+		assistantGRPCApi.logger.Errorf("unauthenticated request to GetAllAssistantCampaignContact")
+		return nil, errors.New("unauthenticated request")
+	}
+	return assistantGRPCApi.assistantClient.GetAllAssistantCampaignContact(ctx, iAuth, iRequest)
+}
@@ -161,6 +161,17 @@ func (endpointGRPCApi *webEndpointGRPCApi) CreateEndpointRetryConfiguration(ctx
 	return endpointGRPCApi.endpointClient.CreateEndpointRetryConfiguration(ctx, iAuth, iRequest)
 }
 
+// CreateEndpointResponseSchemaConfiguration implements protos.EndpointServiceServer.
+func (endpointGRPCApi *webEndpointGRPCApi) CreateEndpointResponseSchemaConfiguration(ctx context.Context, iRequest *protos.CreateEndpointResponseSchemaConfigurationRequest) (*protos.CreateEndpointResponseSchemaConfigurationResponse, error) {
+	endpointGRPCApi.logger.Debugf("Create endpoint response schema configuration request %v, %v", iRequest, ctx)
+	iAuth, isAuthenticated := types.GetAuthPrincipleGPRC(ctx)
+	if !isAuthenticated {
+		endpointGRPCApi.logger.Errorf("unauthenticated request to create endpoint response schema configuration")
+		return nil, errors.New("unauthenticated request")
+	}
+	return endpointGRPCApi.endpointClient.CreateEndpointResponseSchemaConfiguration(ctx, iAuth, iRequest)
+}
+
 // CreateEndpointTag implements protos.EndpointServiceServer.
 func (endpointGRPCApi *webEndpointGRPCApi) CreateEndpointTag(ctx context.Context, iRequest *protos.CreateEndpointTagRequest) (*protos.GetEndpointResponse, error) {
 	endpointGRPCApi.logger.Debugf("Create endpoint provider model request %v, %v", iRequest, ctx)
@@ -192,6 +203,36 @@ func (endpointGRPCApi *webEndpointGRPCApi) ForkEndpoint(ctx context.Context, iRe
 	return endpointGRPCApi.endpointClient.ForkEndpoint(ctx, iAuth, iRequest)
 }
 
+// PurgeEndpointCache implements protos.EndpointServiceServer.
+func (endpointGRPCApi *webEndpointGRPCApi) PurgeEndpointCache(ctx context.Context, iRequest *protos.PurgeEndpointCacheRequest) (*protos.BaseResponse, error) {
+	iAuth, isAuthenticated := types.GetAuthPrincipleGPRC(ctx)
+	if !isAuthenticated {
+		endpointGRPCApi.logger.Errorf("unauthenticated request to purge endpoint cache")
+		return nil, errors.New("unauthenticated request")
+	}
+	return endpointGRPCApi.endpointClient.PurgeEndpointCache(ctx, iAuth, iRequest)
+}
+
+// CreateEndpointTrafficSplit implements protos.EndpointServiceServer.
+func (endpointGRPCApi *webEndpointGRPCApi) CreateEndpointTrafficSplit(ctx context.Context, iRequest *protos.CreateEndpointTrafficSplitRequest) (*protos.CreateEndpointTrafficSplitResponse, error) {
+	iAuth, isAuthenticated := types.GetAuthPrincipleGPRC(ctx)
+	if !isAuthenticated {
+		endpointGRPCApi.logger.Errorf("unauthenticated request to create endpoint traffic split")
+		return nil, errors.New("unauthenticated request")
+	}
+	return endpointGRPCApi.endpointClient.CreateEndpointTrafficSplit(ctx, iAuth, iRequest)
+}
+
+// GetEndpointExperiment implements protos.EndpointServiceServer.
+func (endpointGRPCApi *webEndpointGRPCApi) GetEndpointExperiment(ctx context.Context, iRequest *protos.GetEndpointExperimentRequest) (*protos.GetEndpointExperimentResponse, error) {
+	iAuth, isAuthenticated := types.GetSimplePrincipleGRPC(ctx)
+	if !isAuthenticated {
+		endpointGRPCApi.logger.Errorf("unauthenticated request to get endpoint experiment")
+		return nil, errors.New("unauthenticated request")
+	}
+	return endpointGRPCApi.endpointClient.GetEndpointExperiment(ctx, iAuth, iRequest)
+}
+
 func (endpoint *webEndpointGRPCApi) GetEndpointLog(c context.Context, iRequest *protos.GetEndpointLogRequest) (*protos.GetEndpointLogResponse, error) {
 	endpoint.logger.Debugf("GetEndpoint from grpc with requestPayload %v, %v", iRequest, c)
 	iAuth, isAuthenticated := types.GetSimplePrincipleGRPC(c)
@@ -3,6 +3,7 @@ package web_proxy_api
 import (
 	"context"
 	"errors"
+	"io"
 
 	endpoint_client "github.com/rapidaai/pkg/clients/endpoint"
 	protos "github.com/rapidaai/protos"
@@ -41,8 +42,13 @@ func (*webInvokeGRPCApi) Probe(context.Context, *protos.ProbeRequest) (*protos.P
 }
 
 // Update implements protos.DeploymentServer.
-func (*webInvokeGRPCApi) Update(context.Context, *protos.UpdateRequest) (*protos.UpdateResponse, error) {
-	panic("unimplemented")
+func (endpointGRPCApi *webInvokeGRPCApi) Update(ctx context.Context, iRequest *protos.UpdateRequest) (*protos.UpdateResponse, error) {
+	iAuth, isAuthenticated := types.GetSimplePrincipleGRPC(ctx)
+	if !isAuthenticated {
+		endpointGRPCApi.logger.Errorf("unauthenticated request to update invoke")
+		return nil, errors.New("unauthenticated request")
+	}
+	return endpointGRPCApi.deployServiceClient.Update(ctx, iAuth, iRequest)
 }
 
 func (endpointGRPCApi *webInvokeGRPCApi) Invoke(ctx context.Context, iRequest *protos.InvokeRequest) (*protos.InvokeResponse, error) {
@@ -54,3 +60,27 @@ func (endpointGRPCApi *webInvokeGRPCApi) Invoke(ctx context.Context, iRequest *p
 	}
 	return endpointGRPCApi.deployServiceClient.Invoke(ctx, iAuth, iRequest)
 }
+
+func (endpointGRPCApi *webInvokeGRPCApi) InvokeStream(iRequest *protos.InvokeRequest, stream protos.Deployment_InvokeStreamServer) error {
+	iAuth, isAuthenticated := types.GetSimplePrincipleGRPC(stream.Context())
+	if !isAuthenticated {
+		endpointGRPCApi.logger.Errorf("unauthenticated request to invoke stream")
+		return errors.New("unauthenticated request")
+	}
+	res, err := endpointGRPCApi.deployServiceClient.InvokeStream(stream.Context(), iAuth, iRequest)
+	if err != nil {
+		return err
+	}
+	for {
+		out, err := res.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := stream.Send(out); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,13 @@
+#!/bin/bash
+# downloads livekit's turn detector model and tokenizer used by the livekit_eos end of speech.
+# usage: ./bin/livekit-turn-detector.sh [output directory] [revision]
+set -euo pipefail
+
+OUT_DIR="${1:-./api/assistant-api/internal/end_of_speech/livekit/models}"
+REVISION="${2:-v1.2.2-en}"
+BASE_URL="https://huggingface.co/livekit/turn-detector/resolve/${REVISION}"
+
+mkdir -p "${OUT_DIR}"
+curl -fsSL "${BASE_URL}/onnx/model_q8.onnx" -o "${OUT_DIR}/livekit_turn_detector_q8.onnx"
+curl -fsSL "${BASE_URL}/tokenizer.json" -o "${OUT_DIR}/tokenizer.json"
+echo "livekit turn detector ${REVISION} downloaded to ${OUT_DIR}"
@@ -126,6 +126,9 @@ func main() {
 
 	// all router add all handlers which required to resolve the service request
 	appRunner.AllRouters()
+
+	// background workers
+	appRunner.AllWorkers(ctx)
 	listener, err := net.Listen("tcp", fmt.Sprintf("%s:%d", appRunner.Cfg.Host, appRunner.Cfg.Port))
 	if err != nil {
 		log.Fatalf("Failed to create connection tcp %v", err)
@@ -293,6 +296,18 @@ func (g *AppRunner) AllRouters() {
 
 }
 
+// all background workers
+func (g *AppRunner) AllWorkers(ctx context.Context) {
+	// workers are closed before the connectors they depend on, spans of the
+	// calls they end are flushed after them
+	g.Closeable = append([]func(context.Context) error{
+		router.AssistantWebhookWorker(ctx, g.Cfg, g.Logger, g.Postgres, g.Redis),
+		router.AssistantCampaignWorker(ctx, g.Cfg, g.Logger, g.Postgres, g.Redis, g.Opensearch),
+		router.AssistantWhatsappWorker(ctx, g.Cfg, g.Logger, g.Postgres, g.Redis, g.Opensearch),
+		router.AssistantTraceExporter(),
+	}, g.Closeable...)
+}
+
 // all middleware
 func (g *AppRunner) AllMiddlewares() {
 	g.RecoveryMiddleware()
@@ -20,6 +20,10 @@ import (
 
 type DeploymentServiceClient interface {
 	Invoke(ctx context.Context, auth types.SimplePrinciple, iRequest *endpoint_api.InvokeRequest) (*endpoint_api.InvokeResponse, error)
+	// InvokeStream streams the content deltas of the endpoint, the last response carries the complete content with the metrics
+	InvokeStream(ctx context.Context, auth types.SimplePrinciple, iRequest *endpoint_api.InvokeRequest) (endpoint_api.Deployment_InvokeStreamClient, error)
+	// Update attaches the feedback to the log of the invoke
+	Update(ctx context.Context, auth types.SimplePrinciple, iRequest *endpoint_api.UpdateRequest) (*endpoint_api.UpdateResponse, error)
 }
 
 type deploymentServiceClient struct {
@@ -62,3 +66,22 @@ func (dsc *deploymentServiceClient) Invoke(ctx context.Context, auth types.Simpl
 
 	return res, nil
 }
+
+func (dsc *deploymentServiceClient) InvokeStream(ctx context.Context, auth types.SimplePrinciple, iRequest *endpoint_api.InvokeRequest) (endpoint_api.Deployment_InvokeStreamClient, error) {
+	dsc.logger.Debugf("invoke stream api for endpoint")
+	res, err := dsc.deploymentClient.InvokeStream(dsc.WithAuth(ctx, auth), iRequest)
+	if err != nil {
+		dsc.logger.Errorf("error while calling invoke stream endpoint %v", err)
+		return nil, err
+	}
+	return res, nil
+}
+
+func (dsc *deploymentServiceClient) Update(ctx context.Context, auth types.SimplePrinciple, iRequest *endpoint_api.UpdateRequest) (*endpoint_api.UpdateResponse, error) {
+	res, err := dsc.deploymentClient.Update(dsc.WithAuth(ctx, auth), iRequest)
+	if err != nil {
+		dsc.logger.Errorf("error while calling update endpoint %v", err)
+		return nil, err
+	}
+	return res, nil
+}
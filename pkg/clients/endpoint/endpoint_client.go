@@ -27,7 +27,11 @@ type EndpointServiceClient interface {
 	CreateEndpointProviderModel(c context.Context, auth types.SimplePrinciple, endpointRequest *endpoint_api.CreateEndpointProviderModelRequest) (*endpoint_api.CreateEndpointProviderModelResponse, error)
 	CreateEndpointCacheConfiguration(c context.Context, auth types.SimplePrinciple, endpointRequest *endpoint_api.CreateEndpointCacheConfigurationRequest) (*endpoint_api.CreateEndpointCacheConfigurationResponse, error)
 	CreateEndpointRetryConfiguration(c context.Context, auth types.SimplePrinciple, endpointRequest *endpoint_api.CreateEndpointRetryConfigurationRequest) (*endpoint_api.CreateEndpointRetryConfigurationResponse, error)
+	CreateEndpointResponseSchemaConfiguration(c context.Context, auth types.SimplePrinciple, endpointRequest *endpoint_api.CreateEndpointResponseSchemaConfigurationRequest) (*endpoint_api.CreateEndpointResponseSchemaConfigurationResponse, error)
 	ForkEndpoint(c context.Context, auth types.SimplePrinciple, endpointRequest *endpoint_api.ForkEndpointRequest) (*endpoint_api.BaseResponse, error)
+	PurgeEndpointCache(c context.Context, auth types.SimplePrinciple, endpointRequest *endpoint_api.PurgeEndpointCacheRequest) (*endpoint_api.BaseResponse, error)
+	CreateEndpointTrafficSplit(c context.Context, auth types.SimplePrinciple, endpointRequest *endpoint_api.CreateEndpointTrafficSplitRequest) (*endpoint_api.CreateEndpointTrafficSplitResponse, error)
+	GetEndpointExperiment(c context.Context, auth types.SimplePrinciple, endpointRequest *endpoint_api.GetEndpointExperimentRequest) (*endpoint_api.GetEndpointExperimentResponse, error)
 	CreateEndpointTag(c context.Context, auth types.SimplePrinciple, endpointRequest *endpoint_api.CreateEndpointTagRequest) (*endpoint_api.GetEndpointResponse, error)
 	UpdateEndpointDetail(c context.Context, auth types.SimplePrinciple, endpointRequest *endpoint_api.UpdateEndpointDetailRequest) (*endpoint_api.GetEndpointResponse, error)
 
@@ -152,6 +156,30 @@ func (client *endpointServiceClient) CreateEndpointRetryConfiguration(c context.
 	}
 	return res, nil
 }
+func (client *endpointServiceClient) CreateEndpointResponseSchemaConfiguration(c context.Context, auth types.SimplePrinciple, endpointRequest *endpoint_api.CreateEndpointResponseSchemaConfigurationRequest) (*endpoint_api.CreateEndpointResponseSchemaConfigurationResponse, error) {
+	res, err := client.endpointClient.CreateEndpointResponseSchemaConfiguration(client.WithAuth(c, auth), endpointRequest)
+	if err != nil {
+		client.logger.Errorf("error while calling CreateEndpointResponseSchemaConfiguration %v", err)
+		return nil, err
+	}
+	return res, nil
+}
+func (client *endpointServiceClient) CreateEndpointTrafficSplit(c context.Context, auth types.SimplePrinciple, endpointRequest *endpoint_api.CreateEndpointTrafficSplitRequest) (*endpoint_api.CreateEndpointTrafficSplitResponse, error) {
+	res, err := client.endpointClient.CreateEndpointTrafficSplit(client.WithAuth(c, auth), endpointRequest)
+	if err != nil {
+		client.logger.Errorf("error while calling CreateEndpointTrafficSplit %v", err)
+		return nil, err
+	}
+	return res, nil
+}
+func (client *endpointServiceClient) GetEndpointExperiment(c context.Context, auth types.SimplePrinciple, endpointRequest *endpoint_api.GetEndpointExperimentRequest) (*endpoint_api.GetEndpointExperimentResponse, error) {
+	res, err := client.endpointClient.GetEndpointExperiment(client.WithAuth(c, auth), endpointRequest)
+	if err != nil {
+		client.logger.Errorf("error while calling GetEndpointExperiment %v", err)
+		return nil, err
+	}
+	return res, nil
+}
 func (client *endpointServiceClient) CreateEndpointTag(c context.Context, auth types.SimplePrinciple, endpointRequest *endpoint_api.CreateEndpointTagRequest) (*endpoint_api.GetEndpointResponse, error) {
 	res, err := client.endpointClient.CreateEndpointTag(client.WithAuth(c, auth), endpointRequest)
 	if err != nil {
@@ -177,6 +205,15 @@ func (client *endpointServiceClient) ForkEndpoint(c context.Context, auth types.
 	return res, nil
 }
 
+func (client *endpointServiceClient) PurgeEndpointCache(c context.Context, auth types.SimplePrinciple, endpointRequest *endpoint_api.PurgeEndpointCacheRequest) (*endpoint_api.BaseResponse, error) {
+	res, err := client.endpointClient.PurgeEndpointCache(client.WithAuth(c, auth), endpointRequest)
+	if err != nil {
+		client.logger.Errorf("error while calling to PurgeEndpointCache %v", err)
+		return nil, err
+	}
+	return res, nil
+}
+
 func (client *endpointServiceClient) GetAllEndpointLog(c context.Context, auth types.SimplePrinciple, endpointId uint64, criterias []*endpoint_api.Criteria, paginate *endpoint_api.Paginate) (*endpoint_api.Paginated, []*endpoint_api.EndpointLog, error) {
 	res, err := client.endpointClient.GetAllEndpointLog(client.WithAuth(c, auth), &endpoint_api.GetAllEndpointLogRequest{
 		EndpointId: endpointId,
@@ -31,6 +31,7 @@ type IntegrationServiceClient interface {
 	Embedding(ctx context.Context, auth types.SimplePrinciple, providerName string, in *protos.EmbeddingRequest) (*protos.EmbeddingResponse, error)
 	Reranking(ctx context.Context, auth types.SimplePrinciple, providerName string, in *protos.RerankingRequest) (*protos.RerankingResponse, error)
 	VerifyCredential(ctx context.Context, auth types.SimplePrinciple, providerName string, in *protos.Credential) (*protos.VerifyCredentialResponse, error)
+	GetModeration(ctx context.Context, auth types.SimplePrinciple, providerName string, in *protos.GetModerationRequest) (*protos.GetModerationResponse, error)
 }
 
 type integrationServiceClient struct {
@@ -51,6 +52,10 @@ type integrationServiceClient struct {
 	deepInfraCLient   protos.DeepInfraServiceClient
 	huggingfaceClient protos.HuggingfaceServiceClient
 	awsbedrockClient  protos.BedrockServiceClient
+	groqClient        protos.GroqServiceClient
+	fireworksClient   protos.FireworksServiceClient
+	// self hosted servers like vllm and ollama
+	openAiCompatibleClient protos.OpenAiCompatibleServiceClient
 }
 
 func NewIntegrationServiceClientGRPC(config *config.AppConfig, logger commons.Logger, redis connectors.RedisConnector) IntegrationServiceClient {
@@ -78,6 +83,10 @@ func NewIntegrationServiceClientGRPC(config *config.AppConfig, logger commons.Lo
 		azureAiClient:     protos.NewAzureServiceClient(lightConnection),
 		huggingfaceClient: protos.NewHuggingfaceServiceClient(lightConnection),
 		awsbedrockClient:  protos.NewBedrockServiceClient(lightConnection),
+		groqClient:        protos.NewGroqServiceClient(lightConnection),
+		fireworksClient:   protos.NewFireworksServiceClient(lightConnection),
+
+		openAiCompatibleClient: protos.NewOpenAiCompatibleServiceClient(lightConnection),
 	}
 }
 
@@ -93,12 +102,20 @@ func (client *integrationServiceClient) Embedding(c context.Context,
 		return client.openAiClient.Embedding(client.WithAuth(c, auth), request)
 	case "voyageai":
 		return client.voyageAiClient.Embedding(client.WithAuth(c, auth), request)
-	case "bedrock":
+	case "bedrock", "aws-bedrock":
 		return client.bedrockClient.Embedding(client.WithAuth(c, auth), request)
 	case "azure-foundry":
 		return client.azureAiClient.Embedding(client.WithAuth(c, auth), request)
 	case "gemini":
 		return client.geminiClient.Embedding(client.WithAuth(c, auth), request)
+	case "togetherai":
+		return client.togetherAiClient.Embedding(client.WithAuth(c, auth), request)
+	case "deepinfra":
+		return client.deepInfraCLient.Embedding(client.WithAuth(c, auth), request)
+	case "fireworks":
+		return client.fireworksClient.Embedding(client.WithAuth(c, auth), request)
+	case "vllm", "ollama", "openai-compatible":
+		return client.openAiCompatibleClient.Embedding(client.WithAuth(c, auth), request)
 	// case "mistral":
 	// return client.mistralClient.Embedding(client.WithAuth(c, auth), request)
 	default:
@@ -118,6 +135,20 @@ func (client *integrationServiceClient) Reranking(c context.Context,
 	}
 }
 
+func (client *integrationServiceClient) GetModeration(c context.Context,
+	auth types.SimplePrinciple,
+	providerName string,
+	request *protos.GetModerationRequest) (*protos.GetModerationResponse, error) {
+	switch providerName := strings.ToLower(providerName); providerName {
+	case "openai":
+		return client.openAiClient.GetModeration(client.WithAuth(c, auth), request)
+	case "azure-foundry":
+		return client.azureAiClient.GetModeration(client.WithAuth(c, auth), request)
+	default:
+		return nil, errors.New("illegal provider for moderation request")
+	}
+}
+
 func (client *integrationServiceClient) Chat(c context.Context,
 	auth types.SimplePrinciple,
 	providerName string,
@@ -133,8 +164,18 @@ func (client *integrationServiceClient) Chat(c context.Context,
 		return client.geminiClient.Chat(client.WithAuth(c, auth), request)
 	case "mistral":
 		return client.mistralClient.Chat(client.WithAuth(c, auth), request)
+	case "huggingface":
+		return client.huggingfaceClient.Chat(client.WithAuth(c, auth), request)
 	case "togetherai":
 		return client.togetherAiClient.Chat(client.WithAuth(c, auth), request)
+	case "deepinfra":
+		return client.deepInfraCLient.Chat(client.WithAuth(c, auth), request)
+	case "groq":
+		return client.groqClient.Chat(client.WithAuth(c, auth), request)
+	case "fireworks":
+		return client.fireworksClient.Chat(client.WithAuth(c, auth), request)
+	case "vllm", "ollama", "openai-compatible":
+		return client.openAiCompatibleClient.Chat(client.WithAuth(c, auth), request)
 	case "openai":
 		return client.openAiClient.Chat(client.WithAuth(c, auth), request)
 	case "aws-bedrock":
@@ -163,6 +204,24 @@ func (client *integrationServiceClient) StreamChat(c context.Context, auth types
 		return client.azureAiClient.StreamChat(client.WithAuth(c, auth), request)
 	case "vertexai":
 		return client.vertexaiClient.StreamChat(client.WithAuth(c, auth), request)
+	case "aws-bedrock":
+		return client.bedrockClient.StreamChat(client.WithAuth(c, auth), request)
+	case "togetherai":
+		return client.togetherAiClient.StreamChat(client.WithAuth(c, auth), request)
+	case "deepinfra":
+		return client.deepInfraCLient.StreamChat(client.WithAuth(c, auth), request)
+	case "groq":
+		return client.groqClient.StreamChat(client.WithAuth(c, auth), request)
+	case "fireworks":
+		return client.fireworksClient.StreamChat(client.WithAuth(c, auth), request)
+	case "vllm", "ollama", "openai-compatible":
+		return client.openAiCompatibleClient.StreamChat(client.WithAuth(c, auth), request)
+	case "mistral":
+		return client.mistralClient.StreamChat(client.WithAuth(c, auth), request)
+	case "huggingface":
+		return client.huggingfaceClient.StreamChat(client.WithAuth(c, auth), request)
+	case "replicate":
+		return client.replicateClient.StreamChat(client.WithAuth(c, auth), request)
 	default:
 		return nil, errors.New("illegal provider for chat request")
 	}
@@ -199,6 +258,16 @@ func (client *integrationServiceClient) VerifyCredential(c context.Context,
 		return client.awsbedrockClient.VerifyCredential(client.WithAuth(c, auth), request)
 	case "azure-foundry":
 		return client.azureAiClient.VerifyCredential(client.WithAuth(c, auth), request)
+	case "togetherai":
+		return client.togetherAiClient.VerifyCredential(client.WithAuth(c, auth), request)
+	case "deepinfra":
+		return client.deepInfraCLient.VerifyCredential(client.WithAuth(c, auth), request)
+	case "groq":
+		return client.groqClient.VerifyCredential(client.WithAuth(c, auth), request)
+	case "fireworks":
+		return client.fireworksClient.VerifyCredential(client.WithAuth(c, auth), request)
+	case "vllm", "ollama", "openai-compatible":
+		return client.openAiCompatibleClient.VerifyCredential(client.WithAuth(c, auth), request)
 	default:
 		return nil, errors.New("illegal provider for chat request")
 	}
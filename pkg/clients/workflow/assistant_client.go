@@ -63,6 +63,7 @@ type AssistantServiceClient interface {
 	//
 	GetAssistantWebhookLog(ctx context.Context, auth types.SimplePrinciple, req *protos.GetAssistantWebhookLogRequest) (*protos.GetAssistantWebhookLogResponse, error)
 	GetAllAssistantWebhookLog(ctx context.Context, auth types.SimplePrinciple, projectId uint64, criterias []*protos.Criteria, paginate *protos.Paginate, ordering *protos.Ordering) (*protos.Paginated, []*protos.AssistantWebhookLog, error)
+	RedeliverAssistantWebhookLog(ctx context.Context, auth types.SimplePrinciple, req *protos.RedeliverAssistantWebhookLogRequest) (*protos.GetAssistantWebhookLogResponse, error)
 
 	//
 	GetAllAssistantWebhook(c context.Context, auth types.SimplePrinciple, assistantId uint64, criterias []*protos.Criteria, paginate *protos.Paginate) (*protos.Paginated, []*protos.AssistantWebhook, error)
@@ -78,6 +79,20 @@ type AssistantServiceClient interface {
 	UpdateAssistantAnalysis(c context.Context, auth types.SimplePrinciple, iRequest *protos.UpdateAssistantAnalysisRequest) (*protos.GetAssistantAnalysisResponse, error)
 	DeleteAssistantAnalysis(c context.Context, auth types.SimplePrinciple, iRequest *protos.DeleteAssistantAnalysisRequest) (*protos.GetAssistantAnalysisResponse, error)
 
+	//
+	GetAllAssistantLexicon(c context.Context, auth types.SimplePrinciple, assistantId uint64, criterias []*protos.Criteria, paginate *protos.Paginate) (*protos.Paginated, []*protos.AssistantLexicon, error)
+	GetAssistantLexicon(c context.Context, auth types.SimplePrinciple, iRequest *protos.GetAssistantLexiconRequest) (*protos.GetAssistantLexiconResponse, error)
+	CreateAssistantLexicon(c context.Context, auth types.SimplePrinciple, iRequest *protos.CreateAssistantLexiconRequest) (*protos.GetAssistantLexiconResponse, error)
+	UpdateAssistantLexicon(c context.Context, auth types.SimplePrinciple, iRequest *protos.UpdateAssistantLexiconRequest) (*protos.GetAssistantLexiconResponse, error)
+	DeleteAssistantLexicon(c context.Context, auth types.SimplePrinciple, iRequest *protos.DeleteAssistantLexiconRequest) (*protos.GetAssistantLexiconResponse, error)
+
+	//
+	GetAllAssistantEvaluator(c context.Context, auth types.SimplePrinciple, assistantId uint64, criterias []*protos.Criteria, paginate *protos.Paginate) (*protos.Paginated, []*protos.AssistantEvaluator, error)
+	GetAssistantEvaluator(c context.Context, auth types.SimplePrinciple, iRequest *protos.GetAssistantEvaluatorRequest) (*protos.GetAssistantEvaluatorResponse, error)
+	CreateAssistantEvaluator(c context.Context, auth types.SimplePrinciple, iRequest *protos.CreateAssistantEvaluatorRequest) (*protos.GetAssistantEvaluatorResponse, error)
+	UpdateAssistantEvaluator(c context.Context, auth types.SimplePrinciple, iRequest *protos.UpdateAssistantEvaluatorRequest) (*protos.GetAssistantEvaluatorResponse, error)
+	DeleteAssistantEvaluator(c context.Context, auth types.SimplePrinciple, iRequest *protos.DeleteAssistantEvaluatorRequest) (*protos.GetAssistantEvaluatorResponse, error)
+
 	//
 	GetAllAssistantTool(c context.Context, auth types.SimplePrinciple, assistantId uint64, criterias []*protos.Criteria, paginate *protos.Paginate) (*protos.Paginated, []*protos.AssistantTool, error)
 	GetAssistantTool(c context.Context, auth types.SimplePrinciple, iRequest *protos.GetAssistantToolRequest) (*protos.GetAssistantToolResponse, error)
@@ -95,6 +110,15 @@ type AssistantServiceClient interface {
 	GetAssistantToolLog(ctx context.Context, auth types.SimplePrinciple, in *protos.GetAssistantToolLogRequest) (*protos.GetAssistantToolLogResponse, error)
 	GetAllAssistantToolLog(ctx context.Context, auth types.SimplePrinciple, in *protos.GetAllAssistantToolLogRequest) (*protos.GetAllAssistantToolLogResponse, error)
 	GetAllAssistantTelemetry(ctx context.Context, auth types.SimplePrinciple, in *protos.GetAllAssistantTelemetryRequest) (*protos.GetAllAssistantTelemetryResponse, error)
+	GetAssistantTurnLatency(ctx context.Context, auth types.SimplePrinciple, in *protos.GetAssistantTurnLatencyRequest) (*protos.GetAssistantTurnLatencyResponse, error)
+	GetAllAssistantConversationEvaluation(ctx context.Context, auth types.SimplePrinciple, in *protos.GetAllAssistantConversationEvaluationRequest) (*protos.GetAllAssistantConversationEvaluationResponse, error)
+	GetAssistantEvaluationSummary(ctx context.Context, auth types.SimplePrinciple, in *protos.GetAssistantEvaluationSummaryRequest) (*protos.GetAssistantEvaluationSummaryResponse, error)
+	CreateAssistantCampaign(ctx context.Context, auth types.SimplePrinciple, in *protos.CreateAssistantCampaignRequest) (*protos.GetAssistantCampaignResponse, error)
+	GetAssistantCampaign(ctx context.Context, auth types.SimplePrinciple, in *protos.GetAssistantCampaignRequest) (*protos.GetAssistantCampaignResponse, error)
+	GetAllAssistantCampaign(ctx context.Context, auth types.SimplePrinciple, in *protos.GetAllAssistantCampaignRequest) (*protos.GetAllAssistantCampaignResponse, error)
+	UpdateAssistantCampaignState(ctx context.Context, auth types.SimplePrinciple, in *protos.UpdateAssistantCampaignStateRequest) (*protos.GetAssistantCampaignResponse, error)
+	DeleteAssistantCampaign(ctx context.Context, auth types.SimplePrinciple, in *protos.DeleteAssistantCampaignRequest) (*protos.GetAssistantCampaignResponse, error)
+	GetAllAssistantCampaignContact(ctx context.Context, auth types.SimplePrinciple, in *protos.GetAllAssistantCampaignContactRequest) (*protos.GetAllAssistantCampaignContactResponse, error)
 }
 
 type assistantServiceClient struct {
@@ -510,6 +534,22 @@ func (client *assistantServiceClient) GetAssistantWebhookLog(c context.Context,
 	return res, nil
 }
 
+func (client *assistantServiceClient) RedeliverAssistantWebhookLog(c context.Context,
+	auth types.SimplePrinciple, iRequest *protos.RedeliverAssistantWebhookLogRequest) (*protos.GetAssistantWebhookLogResponse, error) {
+	start := time.Now()
+	res, err := client.assistantClient.RedeliverAssistantWebhookLog(client.WithAuth(c, auth), iRequest)
+	if err != nil {
+		client.logger.Benchmark("Benchmarking: assistantClient.RedeliverAssistantWebhookLog", time.Since(start))
+		client.logger.Errorf("error while calling RedeliverAssistantWebhookLog %v", err)
+		return nil, err
+	}
+	if !res.GetSuccess() {
+		client.logger.Errorf("error while calling to get RedeliverAssistantWebhookLog %v", err)
+	}
+	client.logger.Benchmark("Benchmarking: assistantClient.RedeliverAssistantWebhookLog", time.Since(start))
+	return res, nil
+}
+
 func (client *assistantServiceClient) GetAssistantWebhook(c context.Context,
 	auth types.SimplePrinciple, iRequest *protos.GetAssistantWebhookRequest) (*protos.GetAssistantWebhookResponse, error) {
 	start := time.Now()
@@ -670,6 +710,168 @@ func (client *assistantServiceClient) UpdateAssistantAnalysis(c context.Context,
 	return res, nil
 }
 
+func (client *assistantServiceClient) GetAllAssistantLexicon(ctx context.Context, auth types.SimplePrinciple, assistantId uint64, criterias []*protos.Criteria, paginate *protos.Paginate) (*protos.Paginated, []*protos.AssistantLexicon, error) {
+	start := time.Now()
+	res, err := client.assistantClient.GetAllAssistantLexicon(client.WithAuth(ctx, auth), &protos.GetAllAssistantLexiconRequest{
+		Paginate:    paginate,
+		AssistantId: assistantId,
+		Criterias:   criterias,
+	})
+	if err != nil {
+		client.logger.Benchmark("Benchmarking: assistantClient.GetAllAssistantLexicon", time.Since(start))
+		client.logger.Errorf("error while calling to get all assistant lexicon %v", err)
+		return nil, nil, err
+	}
+	if !res.GetSuccess() {
+		client.logger.Errorf("error while calling to get all assistant lexicon %v", err)
+	}
+
+	client.logger.Benchmark("Benchmarking: assistantClient.GetAllAssistantLexicon", time.Since(start))
+	return res.GetPaginated(), res.GetData(), nil
+}
+
+func (client *assistantServiceClient) GetAssistantLexicon(c context.Context,
+	auth types.SimplePrinciple, iRequest *protos.GetAssistantLexiconRequest) (*protos.GetAssistantLexiconResponse, error) {
+	start := time.Now()
+	res, err := client.assistantClient.GetAssistantLexicon(client.WithAuth(c, auth), iRequest)
+	if err != nil {
+		client.logger.Benchmark("Benchmarking: assistantClient.GetAssistantLexicon", time.Since(start))
+		client.logger.Errorf("error while calling GetAssistantLexicon %v", err)
+		return nil, err
+	}
+	if !res.GetSuccess() {
+		client.logger.Errorf("error while calling to get GetAssistantLexicon %v", err)
+	}
+	client.logger.Benchmark("Benchmarking: assistantClient.GetAssistantLexicon", time.Since(start))
+	return res, nil
+}
+
+func (client *assistantServiceClient) CreateAssistantLexicon(c context.Context, auth types.SimplePrinciple, iRequest *protos.CreateAssistantLexiconRequest) (*protos.GetAssistantLexiconResponse, error) {
+	start := time.Now()
+	res, err := client.assistantClient.CreateAssistantLexicon(client.WithAuth(c, auth), iRequest)
+	if err != nil {
+		client.logger.Benchmark("Benchmarking: assistantClient.CreateAssistantLexicon", time.Since(start))
+		client.logger.Errorf("error while calling CreateAssistantLexicon %v", err)
+		return nil, err
+	}
+	if !res.GetSuccess() {
+		client.logger.Errorf("error while calling to get lexicon %v", err)
+	}
+	client.logger.Benchmark("Benchmarking: assistantClient.CreateAssistantLexicon", time.Since(start))
+	return res, nil
+}
+
+func (client *assistantServiceClient) DeleteAssistantLexicon(c context.Context, auth types.SimplePrinciple, iRequest *protos.DeleteAssistantLexiconRequest) (*protos.GetAssistantLexiconResponse, error) {
+	start := time.Now()
+	res, err := client.assistantClient.DeleteAssistantLexicon(client.WithAuth(c, auth), iRequest)
+	if err != nil {
+		client.logger.Benchmark("Benchmarking: assistantClient.DeleteAssistantLexicon", time.Since(start))
+		client.logger.Errorf("error while calling DeleteAssistantLexicon %v", err)
+		return nil, err
+	}
+	if !res.GetSuccess() {
+		client.logger.Errorf("error while calling to get lexicon %v", err)
+	}
+	client.logger.Benchmark("Benchmarking: assistantClient.DeleteAssistantLexicon", time.Since(start))
+	return res, nil
+}
+
+func (client *assistantServiceClient) UpdateAssistantLexicon(c context.Context, auth types.SimplePrinciple, iRequest *protos.UpdateAssistantLexiconRequest) (*protos.GetAssistantLexiconResponse, error) {
+	start := time.Now()
+	res, err := client.assistantClient.UpdateAssistantLexicon(client.WithAuth(c, auth), iRequest)
+	if err != nil {
+		client.logger.Benchmark("Benchmarking: assistantClient.UpdateAssistantLexicon", time.Since(start))
+		client.logger.Errorf("error while calling UpdateAssistantLexicon %v", err)
+		return nil, err
+	}
+	if !res.GetSuccess() {
+		client.logger.Errorf("error while calling to get lexicon %v", err)
+	}
+	client.logger.Benchmark("Benchmarking: assistantClient.UpdateAssistantLexicon", time.Since(start))
+	return res, nil
+}
+
+func (client *assistantServiceClient) GetAllAssistantEvaluator(ctx context.Context, auth types.SimplePrinciple, assistantId uint64, criterias []*protos.Criteria, paginate *protos.Paginate) (*protos.Paginated, []*protos.AssistantEvaluator, error) {
+	start := time.Now()
+	res, err := client.assistantClient.GetAllAssistantEvaluator(client.WithAuth(ctx, auth), &protos.GetAllAssistantEvaluatorRequest{
+		Paginate:    paginate,
+		AssistantId: assistantId,
+		Criterias:   criterias,
+	})
+	if err != nil {
+		client.logger.Benchmark("Benchmarking: assistantClient.GetAllAssistantEvaluator", time.Since(start))
+		client.logger.Errorf("error while calling to get all assistant evaluator %v", err)
+		return nil, nil, err
+	}
+	if !res.GetSuccess() {
+		client.logger.Errorf("error while calling to get all assistant evaluator %v", err)
+	}
+
+	client.logger.Benchmark("Benchmarking: assistantClient.GetAllAssistantEvaluator", time.Since(start))
+	return res.GetPaginated(), res.GetData(), nil
+}
+
+func (client *assistantServiceClient) GetAssistantEvaluator(c context.Context,
+	auth types.SimplePrinciple, iRequest *protos.GetAssistantEvaluatorRequest) (*protos.GetAssistantEvaluatorResponse, error) {
+	start := time.Now()
+	res, err := client.assistantClient.GetAssistantEvaluator(client.WithAuth(c, auth), iRequest)
+	if err != nil {
+		client.logger.Benchmark("Benchmarking: assistantClient.GetAssistantEvaluator", time.Since(start))
+		client.logger.Errorf("error while calling GetAssistantEvaluator %v", err)
+		return nil, err
+	}
+	if !res.GetSuccess() {
+		client.logger.Errorf("error while calling to get GetAssistantEvaluator %v", err)
+	}
+	client.logger.Benchmark("Benchmarking: assistantClient.GetAssistantEvaluator", time.Since(start))
+	return res, nil
+}
+
+func (client *assistantServiceClient) CreateAssistantEvaluator(c context.Context, auth types.SimplePrinciple, iRequest *protos.CreateAssistantEvaluatorRequest) (*protos.GetAssistantEvaluatorResponse, error) {
+	start := time.Now()
+	res, err := client.assistantClient.CreateAssistantEvaluator(client.WithAuth(c, auth), iRequest)
+	if err != nil {
+		client.logger.Benchmark("Benchmarking: assistantClient.CreateAssistantEvaluator", time.Since(start))
+		client.logger.Errorf("error while calling CreateAssistantEvaluator %v", err)
+		return nil, err
+	}
+	if !res.GetSuccess() {
+		client.logger.Errorf("error while calling to get evaluator %v", err)
+	}
+	client.logger.Benchmark("Benchmarking: assistantClient.CreateAssistantEvaluator", time.Since(start))
+	return res, nil
+}
+
+func (client *assistantServiceClient) DeleteAssistantEvaluator(c context.Context, auth types.SimplePrinciple, iRequest *protos.DeleteAssistantEvaluatorRequest) (*protos.GetAssistantEvaluatorResponse, error) {
+	start := time.Now()
+	res, err := client.assistantClient.DeleteAssistantEvaluator(client.WithAuth(c, auth), iRequest)
+	if err != nil {
+		client.logger.Benchmark("Benchmarking: assistantClient.DeleteAssistantEvaluator", time.Since(start))
+		client.logger.Errorf("error while calling DeleteAssistantEvaluator %v", err)
+		return nil, err
+	}
+	if !res.GetSuccess() {
+		client.logger.Errorf("error while calling to get evaluator %v", err)
+	}
+	client.logger.Benchmark("Benchmarking: assistantClient.DeleteAssistantEvaluator", time.Since(start))
+	return res, nil
+}
+
+func (client *assistantServiceClient) UpdateAssistantEvaluator(c context.Context, auth types.SimplePrinciple, iRequest *protos.UpdateAssistantEvaluatorRequest) (*protos.GetAssistantEvaluatorResponse, error) {
+	start := time.Now()
+	res, err := client.assistantClient.UpdateAssistantEvaluator(client.WithAuth(c, auth), iRequest)
+	if err != nil {
+		client.logger.Benchmark("Benchmarking: assistantClient.UpdateAssistantEvaluator", time.Since(start))
+		client.logger.Errorf("error while calling UpdateAssistantEvaluator %v", err)
+		return nil, err
+	}
+	if !res.GetSuccess() {
+		client.logger.Errorf("error while calling to get evaluator %v", err)
+	}
+	client.logger.Benchmark("Benchmarking: assistantClient.UpdateAssistantEvaluator", time.Since(start))
+	return res, nil
+}
+
 func (client *assistantServiceClient) GetAllAssistantTool(c context.Context, auth types.SimplePrinciple, assistantId uint64, criterias []*protos.Criteria, paginate *protos.Paginate) (*protos.Paginated, []*protos.AssistantTool, error) {
 	start := time.Now()
 	res, err := client.assistantClient.GetAllAssistantTool(client.WithAuth(c, auth), &protos.GetAllAssistantToolRequest{
@@ -878,3 +1080,138 @@ func (client *assistantServiceClient) GetAllAssistantTelemetry(ctx context.Conte
 	client.logger.Benchmark("Benchmarking: assistantClient.GetAllAssistantTelemetry", time.Since(start))
 	return res, nil
 }
+
+func (client *assistantServiceClient) GetAssistantTurnLatency(ctx context.Context, auth types.SimplePrinciple, in *protos.GetAssistantTurnLatencyRequest) (*protos.GetAssistantTurnLatencyResponse, error) {
+	start := time.Now()
+	res, err := client.assistantClient.GetAssistantTurnLatency(client.WithAuth(ctx, auth), in)
+	if err != nil {
+		client.logger.Benchmark("Benchmarking: assistantClient.GetAssistantTurnLatency", time.Since(start))
+		client.logger.Errorf("error while calling GetAssistantTurnLatency %v", err)
+		return nil, err
+	}
+	if !res.GetSuccess() {
+		client.logger.Errorf("error while calling to get turn latency %v", res.GetError())
+	}
+	client.logger.Benchmark("Benchmarking: assistantClient.GetAssistantTurnLatency", time.Since(start))
+	return res, nil
+}
+
+func (client *assistantServiceClient) GetAllAssistantConversationEvaluation(ctx context.Context, auth types.SimplePrinciple, in *protos.GetAllAssistantConversationEvaluationRequest) (*protos.GetAllAssistantConversationEvaluationResponse, error) {
+	start := time.Now()
+	res, err := client.assistantClient.GetAllAssistantConversationEvaluation(client.WithAuth(ctx, auth), in)
+	if err != nil {
+		client.logger.Benchmark("Benchmarking: assistantClient.GetAllAssistantConversationEvaluation", time.Since(start))
+		client.logger.Errorf("error while calling GetAllAssistantConversationEvaluation %v", err)
+		return nil, err
+	}
+	if !res.GetSuccess() {
+		client.logger.Errorf("error while calling to get conversation evaluations %v", res.GetError())
+	}
+	client.logger.Benchmark("Benchmarking: assistantClient.GetAllAssistantConversationEvaluation", time.Since(start))
+	return res, nil
+}
+
+func (client *assistantServiceClient) GetAssistantEvaluationSummary(ctx context.Context, auth types.SimplePrinciple, in *protos.GetAssistantEvaluationSummaryRequest) (*protos.GetAssistantEvaluationSummaryResponse, error) {
+	start := time.Now()
+	res, err := client.assistantClient.GetAssistantEvaluationSummary(client.WithAuth(ctx, auth), in)
+	if err != nil {
+		client.logger.Benchmark("Benchmarking: assistantClient.GetAssistantEvaluationSummary", time.Since(start))
+		client.logger.Errorf("error while calling GetAssistantEvaluationSummary %v", err)
+		return nil, err
+	}
+	if !res.GetSuccess() {
+		client.logger.Errorf("error while calling to get evaluation summary %v", res.GetError())
+	}
+	client.logger.Benchmark("Benchmarking: assistantClient.GetAssistantEvaluationSummary", time.Since(start))
+	return res, nil
+}
+
+func (client *assistantServiceClient) CreateAssistantCampaign(ctx context.Context, auth types.SimplePrinciple, in *protos.CreateAssistantCampaignRequest) (*protos.GetAssistantCampaignResponse, error) {
+	start := time.Now()
+	res, err := client.assistantClient.CreateAssistantCampaign(client.WithAuth(ctx, auth), in)
+	if err != nil {
+		client.logger.Benchmark("Benchmarking: assistantClient.CreateAssistantCampaign", time.Since(start))
+		client.logger.Errorf("error while calling CreateAssistantCampaign %v", err)
+		return nil, err
+	}
+	if !res.GetSuccess() {
+		client.logger.Errorf("error while calling to create campaign %v", res.GetError())
+	}
+	client.logger.Benchmark("Benchmarking: assistantClient.CreateAssistantCampaign", time.Since(start))
+	return res, nil
+}
+
+func (client *assistantServiceClient) GetAssistantCampaign(ctx context.Context, auth types.SimplePrinciple, in *protos.GetAssistantCampaignRequest) (*protos.GetAssistantCampaignResponse, error) {
+	start := time.Now()
+	res, err := client.assistantClient.GetAssistantCampaign(client.WithAuth(ctx, auth), in)
+	if err != nil {
+		client.logger.Benchmark("Benchmarking: assistantClient.GetAssistantCampaign", time.Since(start))
+		client.logger.Errorf("error while calling GetAssistantCampaign %v", err)
+		return nil, err
+	}
+	if !res.GetSuccess() {
+		client.logger.Errorf("error while calling to get campaign %v", res.GetError())
+	}
+	client.logger.Benchmark("Benchmarking: assistantClient.GetAssistantCampaign", time.Since(start))
+	return res, nil
+}
+
+func (client *assistantServiceClient) GetAllAssistantCampaign(ctx context.Context, auth types.SimplePrinciple, in *protos.GetAllAssistantCampaignRequest) (*protos.GetAllAssistantCampaignResponse, error) {
+	start := time.Now()
+	res, err := client.assistantClient.GetAllAssistantCampaign(client.WithAuth(ctx, auth), in)
+	if err != nil {
+		client.logger.Benchmark("Benchmarking: assistantClient.GetAllAssistantCampaign", time.Since(start))
+		client.logger.Errorf("error while calling GetAllAssistantCampaign %v", err)
+		return nil, err
+	}
+	if !res.GetSuccess() {
+		client.logger.Errorf("error while calling to get campaigns %v", res.GetError())
+	}
+	client.logger.Benchmark("Benchmarking: assistantClient.GetAllAssistantCampaign", time.Since(start))
+	return res, nil
+}
+
+func (client *assistantServiceClient) UpdateAssistantCampaignState(ctx context.Context, auth types.SimplePrinciple, in *protos.UpdateAssistantCampaignStateRequest) (*protos.GetAssistantCampaignResponse, error) {
+	start := time.Now()
+	res, err := client.assistantClient.UpdateAssistantCampaignState(client.WithAuth(ctx, auth), in)
+	if err != nil {
+		client.logger.Benchmark("Benchmarking: assistantClient.UpdateAssistantCampaignState", time.Since(start))
+		client.logger.Errorf("error while calling UpdateAssistantCampaignState %v", err)
+		return nil, err
+	}
+	if !res.GetSuccess() {
+		client.logger.Errorf("error while calling to update campaign state %v", res.GetError())
+	}
+	client.logger.Benchmark("Benchmarking: assistantClient.UpdateAssistantCampaignState", time.Since(start))
+	return res, nil
+}
+
+func (client *assistantServiceClient) DeleteAssistantCampaign(ctx context.Context, auth types.SimplePrinciple, in *protos.DeleteAssistantCampaignRequest) (*protos.GetAssistantCampaignResponse, error) {
+	start := time.Now()
+	res, err := client.assistantClient.DeleteAssistantCampaign(client.WithAuth(ctx, auth), in)
+	if err != nil {
+		client.logger.Benchmark("Benchmarking: assistantClient.DeleteAssistantCampaign", time.Since(start))
+		client.logger.Errorf("error while calling DeleteAssistantCampaign %v", err)
+		return nil, err
+	}
+	if !res.GetSuccess() {
+		client.logger.Errorf("error while calling to delete campaign %v", res.GetError())
+	}
+	client.logger.Benchmark("Benchmarking: assistantClient.DeleteAssistantCampaign", time.Since(start))
+	return res, nil
+}
+
+func (client *assistantServiceClient) GetAllAssistantCampaignContact(ctx context.Context, auth types.SimplePrinciple, in *protos.GetAllAssistantCampaignContactRequest) (*protos.GetAllAssistantCampaignContactResponse, error) {
+	start := time.Now()
+	res, err := client.assistantClient.GetAllAssistantCampaignContact(client.WithAuth(ctx, auth), in)
+	if err != nil {
+		client.logger.Benchmark("Benchmarking: assistantClient.GetAllAssistantCampaignContact", time.Since(start))
+		client.logger.Errorf("error while calling GetAllAssistantCampaignContact %v", err)
+		return nil, err
+	}
+	if !res.GetSuccess() {
+		client.logger.Errorf("error while calling to get campaign contacts %v", res.GetError())
+	}
+	client.logger.Benchmark("Benchmarking: assistantClient.GetAllAssistantCampaignContact", time.Since(start))
+	return res, nil
+}
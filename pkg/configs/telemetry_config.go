@@ -0,0 +1,76 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package configs
+
+import (
+	"strings"
+	"time"
+)
+
+type TelemetryExporterType string
+
+const (
+	OPENSEARCH_EXPORTER TelemetryExporterType = "opensearch"
+	OTLP_EXPORTER       TelemetryExporterType = "otlp"
+	LOGGING_EXPORTER    TelemetryExporterType = "logging"
+)
+
+type OtlpProtocol string
+
+const (
+	OTLP_GRPC OtlpProtocol = "grpc"
+	OTLP_HTTP OtlpProtocol = "http"
+)
+
+type TelemetryConfig struct {
+	// comma separated exporters, opensearch backs the telemetry listing
+	Exporters []string   `mapstructure:"exporters"`
+	Otlp      OtlpConfig `mapstructure:"otlp"`
+}
+
+type OtlpConfig struct {
+	Protocol    string `mapstructure:"protocol"`
+	Endpoint    string `mapstructure:"endpoint"`
+	URLPath     string `mapstructure:"url_path"`
+	Insecure    bool   `mapstructure:"insecure"`
+	ServiceName string `mapstructure:"service_name"`
+	// comma separated key=value pairs sent with every export request
+	Headers string `mapstructure:"headers"`
+
+	BatchSize    int           `mapstructure:"batch_size"`
+	BatchTimeout time.Duration `mapstructure:"batch_timeout"`
+	MaxQueueSize int           `mapstructure:"max_queue_size"`
+}
+
+func (cfg *TelemetryConfig) Enabled(exporter TelemetryExporterType) bool {
+	for _, e := range cfg.Exporters {
+		for _, v := range strings.Split(e, ",") {
+			if strings.EqualFold(strings.TrimSpace(v), string(exporter)) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (cfg *OtlpConfig) GetProtocol() OtlpProtocol {
+	if strings.EqualFold(cfg.Protocol, string(OTLP_HTTP)) {
+		return OTLP_HTTP
+	}
+	return OTLP_GRPC
+}
+
+func (cfg *OtlpConfig) GetHeaders() map[string]string {
+	headers := make(map[string]string)
+	for _, pair := range strings.Split(cfg.Headers, ",") {
+		k, v, ok := strings.Cut(pair, "=")
+		if !ok || strings.TrimSpace(k) == "" {
+			continue
+		}
+		headers[strings.TrimSpace(k)] = strings.TrimSpace(v)
+	}
+	return headers
+}
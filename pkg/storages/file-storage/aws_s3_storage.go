@@ -6,7 +6,9 @@ import (
 	"fmt"
 	"io"
 	"path/filepath"
+	"sort"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -151,3 +153,92 @@ func (aws *awsFileStorage) GetUrl(ctx context.Context, key string) storages.Stor
 		StorageType:  configs.S3,
 	}
 }
+
+type awsUpload struct {
+	storage  *awsFileStorage
+	client   *s3.S3
+	key      string
+	uploadId *string
+	mu       sync.Mutex
+	parts    map[int]*s3.CompletedPart
+}
+
+// CreateUpload implements storages.MultipartStorage.
+func (storage *awsFileStorage) CreateUpload(ctx context.Context, key string) (storages.Upload, error) {
+	aws_session, err := aws_session.NewSessionWithOptions(storage.options)
+	if err != nil {
+		storage.logger.Errorf("unable to create aws s3 session to upload the document %v", err)
+		return nil, err
+	}
+	s3Client := s3.New(aws_session)
+	out, err := s3Client.CreateMultipartUploadWithContext(ctx, &s3.CreateMultipartUploadInput{
+		Bucket:      aws.String(storage.config.StoragePathPrefix),
+		Key:         aws.String(key),
+		ContentType: aws.String(storage.contentType(key)),
+	})
+	if err != nil {
+		storage.logger.Errorf("unable to create multipart upload to S3: %v", err)
+		return nil, err
+	}
+	return &awsUpload{
+		storage:  storage,
+		client:   s3Client,
+		key:      key,
+		uploadId: out.UploadId,
+		parts:    make(map[int]*s3.CompletedPart),
+	}, nil
+}
+
+// WritePart implements storages.Upload.
+func (upload *awsUpload) WritePart(ctx context.Context, number int, content []byte) error {
+	out, err := upload.client.UploadPartWithContext(ctx, &s3.UploadPartInput{
+		Bucket:     aws.String(upload.storage.config.StoragePathPrefix),
+		Key:        aws.String(upload.key),
+		UploadId:   upload.uploadId,
+		PartNumber: aws.Int64(int64(number)),
+		Body:       bytes.NewReader(content),
+	})
+	if err != nil {
+		upload.storage.logger.Errorf("unable to upload part %d to S3: %v", number, err)
+		return err
+	}
+	upload.mu.Lock()
+	defer upload.mu.Unlock()
+	upload.parts[number] = &s3.CompletedPart{ETag: out.ETag, PartNumber: aws.Int64(int64(number))}
+	return nil
+}
+
+// Complete implements storages.Upload.
+func (upload *awsUpload) Complete(ctx context.Context) storages.StorageOutput {
+	completePath := fmt.Sprintf("s3://%s/%s", upload.storage.config.StoragePathPrefix, upload.key)
+	upload.mu.Lock()
+	parts := make([]*s3.CompletedPart, 0, len(upload.parts))
+	for _, part := range upload.parts {
+		parts = append(parts, part)
+	}
+	upload.mu.Unlock()
+	sort.Slice(parts, func(i, j int) bool {
+		return *parts[i].PartNumber < *parts[j].PartNumber
+	})
+	_, err := upload.client.CompleteMultipartUploadWithContext(ctx, &s3.CompleteMultipartUploadInput{
+		Bucket:          aws.String(upload.storage.config.StoragePathPrefix),
+		Key:             aws.String(upload.key),
+		UploadId:        upload.uploadId,
+		MultipartUpload: &s3.CompletedMultipartUpload{Parts: parts},
+	})
+	if err != nil {
+		upload.storage.logger.Errorf("unable to complete multipart upload to S3: %v", err)
+		return storages.StorageOutput{CompletePath: completePath, Error: err, StorageType: configs.S3}
+	}
+	return storages.StorageOutput{CompletePath: completePath, StorageType: configs.S3}
+}
+
+// Abort implements storages.Upload.
+func (upload *awsUpload) Abort(ctx context.Context) error {
+	_, err := upload.client.AbortMultipartUploadWithContext(ctx, &s3.AbortMultipartUploadInput{
+		Bucket:   aws.String(upload.storage.config.StoragePathPrefix),
+		Key:      aws.String(upload.key),
+		UploadId: upload.uploadId,
+	})
+	return err
+}
@@ -2,9 +2,12 @@ package storage_files
 
 import (
 	"context"
+	"fmt"
 	"os"
 	"path"
 	"path/filepath"
+	"sort"
+	"sync"
 
 	"github.com/rapidaai/pkg/commons"
 	"github.com/rapidaai/pkg/configs"
@@ -82,3 +85,73 @@ func (lfs *localFileStorage) GetUrl(ctx context.Context, key string) storages.St
 		StorageType:  configs.LOCAL,
 	}
 }
+
+type localUpload struct {
+	storage *localFileStorage
+	key     string
+	mu      sync.Mutex
+	parts   map[int]string
+}
+
+// CreateUpload implements storages.MultipartStorage, parts are kept next to the file until completed
+func (lfs *localFileStorage) CreateUpload(ctx context.Context, key string) (storages.Upload, error) {
+	if err := os.MkdirAll(filepath.Dir(path.Join(lfs.config.StoragePathPrefix, key)), 0755); err != nil {
+		lfs.logger.Errorf("unable to create complete path, err %v", err)
+		return nil, err
+	}
+	return &localUpload{storage: lfs, key: key, parts: make(map[int]string)}, nil
+}
+
+// WritePart implements storages.Upload.
+func (upload *localUpload) WritePart(ctx context.Context, number int, content []byte) error {
+	partPath := fmt.Sprintf("%s.part%d", path.Join(upload.storage.config.StoragePathPrefix, upload.key), number)
+	if err := os.WriteFile(partPath, content, 0644); err != nil {
+		upload.storage.logger.Errorf("unable to store part %d to local path, err %v", number, err)
+		return err
+	}
+	upload.mu.Lock()
+	defer upload.mu.Unlock()
+	upload.parts[number] = partPath
+	return nil
+}
+
+// Complete implements storages.Upload.
+func (upload *localUpload) Complete(ctx context.Context) storages.StorageOutput {
+	completePath := path.Join(upload.storage.config.StoragePathPrefix, upload.key)
+	upload.mu.Lock()
+	defer upload.mu.Unlock()
+	numbers := make([]int, 0, len(upload.parts))
+	for number := range upload.parts {
+		numbers = append(numbers, number)
+	}
+	sort.Ints(numbers)
+	file, err := os.Create(completePath)
+	if err != nil {
+		upload.storage.logger.Errorf("unable to store a file to local path, err %v", err)
+		return storages.StorageOutput{CompletePath: completePath, StorageType: configs.LOCAL, Error: err}
+	}
+	defer file.Close()
+	for _, number := range numbers {
+		content, err := os.ReadFile(upload.parts[number])
+		if err == nil {
+			_, err = file.Write(content)
+		}
+		if err != nil {
+			upload.storage.logger.Errorf("unable to join part %d to local path, err %v", number, err)
+			return storages.StorageOutput{CompletePath: completePath, StorageType: configs.LOCAL, Error: err}
+		}
+		os.Remove(upload.parts[number])
+	}
+	return storages.StorageOutput{CompletePath: completePath, StorageType: configs.LOCAL}
+}
+
+// Abort implements storages.Upload.
+func (upload *localUpload) Abort(ctx context.Context) error {
+	upload.mu.Lock()
+	defer upload.mu.Unlock()
+	for _, partPath := range upload.parts {
+		os.Remove(partPath)
+	}
+	upload.parts = make(map[int]string)
+	return nil
+}
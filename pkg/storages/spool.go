@@ -0,0 +1,179 @@
+package storages
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"sync"
+)
+
+// MinPartSize is the smallest part object storages take in a multipart upload, except the last part
+const MinPartSize int64 = 5 << 20
+
+// Spool spills an object to a temporary file while it is written, so a long object
+// never sits in memory, and uploads the finished parts of it when the storage takes parts.
+//
+// Writers go back to the start of the object to patch their header once they are done
+// (wav and flac), so the first part is uploaded only when the spool is completed, every
+// other part is final once it is full.
+type Spool struct {
+	mu       sync.Mutex
+	flushMu  sync.Mutex
+	storage  Storage
+	key      string
+	partSize int64
+	file     *os.File
+	upload   Upload
+
+	// parts uploaded after the first one
+	parts int
+}
+
+// NewSpool creates the spool of the object, parts smaller than MinPartSize are raised to it
+func NewSpool(ctx context.Context, storage Storage, key string, partSize int64) (*Spool, error) {
+	if partSize < MinPartSize {
+		partSize = MinPartSize
+	}
+	file, err := os.CreateTemp("", "spool-*")
+	if err != nil {
+		return nil, err
+	}
+	spool := &Spool{storage: storage, key: key, partSize: partSize, file: file}
+	if multipart, ok := storage.(MultipartStorage); ok {
+		upload, err := multipart.CreateUpload(ctx, key)
+		if err != nil {
+			// the object is stored at once when completed
+			return spool, nil
+		}
+		spool.upload = upload
+	}
+	return spool, nil
+}
+
+// Key of the object in storage
+func (s *Spool) Key() string {
+	return s.key
+}
+
+// Write implements io.Writer.
+func (s *Spool) Write(p []byte) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.file == nil {
+		return 0, os.ErrClosed
+	}
+	return s.file.Write(p)
+}
+
+// Seek implements io.Seeker.
+func (s *Spool) Seek(offset int64, whence int) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.file == nil {
+		return 0, os.ErrClosed
+	}
+	return s.file.Seek(offset, whence)
+}
+
+// Size of the object written so far
+func (s *Spool) Size() (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.file == nil {
+		return 0, os.ErrClosed
+	}
+	info, err := s.file.Stat()
+	if err != nil {
+		return 0, err
+	}
+	return info.Size(), nil
+}
+
+// Flush uploads the parts which are full, writes can go on while parts are uploaded
+func (s *Spool) Flush(ctx context.Context) error {
+	s.flushMu.Lock()
+	defer s.flushMu.Unlock()
+	if s.upload == nil {
+		return nil
+	}
+	size, err := s.Size()
+	if err != nil {
+		return err
+	}
+	for {
+		// part n covers [(n-1)*partSize, n*partSize), the first part is left for complete
+		number := s.parts + 2
+		end := int64(number) * s.partSize
+		if end > size {
+			return nil
+		}
+		if err := s.writePart(ctx, number, end-s.partSize, end); err != nil {
+			return err
+		}
+		s.parts++
+	}
+}
+
+func (s *Spool) writePart(ctx context.Context, number int, from, to int64) error {
+	content := make([]byte, to-from)
+	if _, err := s.file.ReadAt(content, from); err != nil && !errors.Is(err, io.EOF) {
+		return err
+	}
+	return s.upload.WritePart(ctx, number, content)
+}
+
+// Complete uploads the rest of the object with its first part and completes it in storage,
+// the temporary file is removed
+func (s *Spool) Complete(ctx context.Context) StorageOutput {
+	defer s.Discard(ctx)
+	if err := s.Flush(ctx); err != nil {
+		return StorageOutput{Error: err}
+	}
+	s.flushMu.Lock()
+	defer s.flushMu.Unlock()
+	size, err := s.Size()
+	if err != nil {
+		return StorageOutput{Error: err}
+	}
+	if s.upload == nil {
+		content := make([]byte, size)
+		if _, err := s.file.ReadAt(content, 0); err != nil && !errors.Is(err, io.EOF) {
+			return StorageOutput{Error: err}
+		}
+		return s.storage.Store(ctx, s.key, content)
+	}
+	if tail := int64(s.parts+1) * s.partSize; s.parts > 0 && tail < size {
+		if err := s.writePart(ctx, s.parts+2, tail, size); err != nil {
+			return StorageOutput{Error: err}
+		}
+	}
+	first := s.partSize
+	if s.parts == 0 {
+		// object within a part is uploaded whole as its only part
+		first = size
+	}
+	if err := s.writePart(ctx, 1, 0, first); err != nil {
+		return StorageOutput{Error: err}
+	}
+	output := s.upload.Complete(ctx)
+	s.upload = nil
+	return output
+}
+
+// Discard aborts the upload and removes the temporary file, nothing is kept in storage
+func (s *Spool) Discard(ctx context.Context) {
+	s.flushMu.Lock()
+	defer s.flushMu.Unlock()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.upload != nil {
+		s.upload.Abort(ctx)
+		s.upload = nil
+	}
+	if s.file != nil {
+		s.file.Close()
+		os.Remove(s.file.Name())
+		s.file = nil
+	}
+}
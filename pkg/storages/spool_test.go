@@ -0,0 +1,130 @@
+package storages
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type memoryUpload struct {
+	storage   *memoryStorage
+	key       string
+	parts     map[int][]byte
+	completed bool
+	aborted   bool
+}
+
+func (u *memoryUpload) WritePart(ctx context.Context, number int, content []byte) error {
+	u.parts[number] = append([]byte(nil), content...)
+	return nil
+}
+
+func (u *memoryUpload) Complete(ctx context.Context) StorageOutput {
+	numbers := make([]int, 0, len(u.parts))
+	for number := range u.parts {
+		numbers = append(numbers, number)
+	}
+	sort.Ints(numbers)
+	var object []byte
+	for _, number := range numbers {
+		object = append(object, u.parts[number]...)
+	}
+	u.completed = true
+	return u.storage.Store(ctx, u.key, object)
+}
+
+func (u *memoryUpload) Abort(ctx context.Context) error {
+	u.aborted = true
+	return nil
+}
+
+type memoryStorage struct {
+	objects map[string][]byte
+	uploads []*memoryUpload
+}
+
+func (m *memoryStorage) Name() string { return "memory" }
+
+func (m *memoryStorage) Store(ctx context.Context, key string, content []byte) StorageOutput {
+	m.objects[key] = content
+	return StorageOutput{CompletePath: key}
+}
+
+func (m *memoryStorage) Get(ctx context.Context, key string) GetStorageOutput {
+	return GetStorageOutput{Data: m.objects[key]}
+}
+
+func (m *memoryStorage) GetUrl(ctx context.Context, key string) StorageOutput {
+	return StorageOutput{CompletePath: key}
+}
+
+type memoryMultipartStorage struct {
+	*memoryStorage
+}
+
+func (m memoryMultipartStorage) CreateUpload(ctx context.Context, key string) (Upload, error) {
+	upload := &memoryUpload{storage: m.memoryStorage, key: key, parts: map[int][]byte{}}
+	m.uploads = append(m.uploads, upload)
+	return upload, nil
+}
+
+// writeObject writes the object in small writes, flushing after each, and patches its header at the end
+func writeObject(t *testing.T, spool *Spool, size int) []byte {
+	object := make([]byte, size)
+	for i := range object {
+		object[i] = byte(i % 251)
+	}
+	for from := 0; from < size; from += 1 << 20 {
+		to := min(from+1<<20, size)
+		_, err := spool.Write(object[from:to])
+		require.NoError(t, err)
+		require.NoError(t, spool.Flush(context.Background()))
+	}
+	_, err := spool.Seek(0, io.SeekStart)
+	require.NoError(t, err)
+	_, err = spool.Write([]byte("HEAD"))
+	require.NoError(t, err)
+	copy(object, "HEAD")
+	_, err = spool.Seek(0, io.SeekEnd)
+	require.NoError(t, err)
+	return object
+}
+
+func TestSpoolMultipart(t *testing.T) {
+	for _, size := range []int{10, int(MinPartSize) + 7, 2 * int(MinPartSize), 3*int(MinPartSize) + 123} {
+		storage := memoryMultipartStorage{&memoryStorage{objects: map[string][]byte{}}}
+		spool, err := NewSpool(context.Background(), storage, "recording.wav", 0)
+		require.NoError(t, err)
+		object := writeObject(t, spool, size)
+
+		output := spool.Complete(context.Background())
+		require.NoError(t, output.Error)
+		assert.True(t, bytes.Equal(object, storage.objects["recording.wav"]), "object of size %d", size)
+
+		upload := storage.uploads[0]
+		assert.True(t, upload.completed)
+		for number, part := range upload.parts {
+			// every part but the last is at least the minimum part size
+			if number < len(upload.parts) {
+				assert.GreaterOrEqual(t, int64(len(part)), MinPartSize, "part %d of size %d", number, size)
+			}
+		}
+	}
+}
+
+func TestSpoolWithoutMultipart(t *testing.T) {
+	storage := &memoryStorage{objects: map[string][]byte{}}
+	spool, err := NewSpool(context.Background(), storage, "recording.flac", 0)
+	require.NoError(t, err)
+	object := writeObject(t, spool, 3<<20)
+	require.NoError(t, spool.Complete(context.Background()).Error)
+	assert.True(t, bytes.Equal(object, storage.objects["recording.flac"]))
+
+	_, err = spool.Write([]byte("late"))
+	assert.Error(t, err)
+}
@@ -23,3 +23,17 @@ type Storage interface {
 	Get(ctx context.Context, key string) GetStorageOutput
 	GetUrl(ctx context.Context, key string) StorageOutput
 }
+
+// Upload is an object taken by the storage in parts, parts are numbered from one
+// and a part can be written again until the upload is completed
+type Upload interface {
+	WritePart(ctx context.Context, number int, content []byte) error
+	Complete(ctx context.Context) StorageOutput
+	Abort(ctx context.Context) error
+}
+
+// MultipartStorage is a storage which takes an object in parts while it is still written
+type MultipartStorage interface {
+	Storage
+	CreateUpload(ctx context.Context, key string) (Upload, error)
+}
@@ -19,3 +19,8 @@ func (mm ModelMetadata) String() string {
 type TokenCalculator interface {
 	Token(in []*types.Message, out *types.Message) []*types.Metric
 }
+
+// TokenCounter counts the prompt tokens the messages take in the context window of the model
+type TokenCounter interface {
+	Count(messages ...*types.Message) int
+}
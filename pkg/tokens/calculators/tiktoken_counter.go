@@ -0,0 +1,58 @@
+package token_tiktoken_calculators
+
+import (
+	"sync"
+
+	"github.com/pkoukk/tiktoken-go"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/tokens"
+	"github.com/rapidaai/pkg/types"
+)
+
+// every message follows <|start|>{role/name}\n{content}<|end|>\n
+const tokensPerMessage = 3
+
+type tikTokenCounter struct {
+	logger   commons.Logger
+	model    string
+	once     sync.Once
+	encoding *tiktoken.Tiktoken
+}
+
+// NewTikTokenCounter counts tokens with the encoding of the model, models which are not known to
+// tiktoken are counted with cl100k_base which is close enough to keep the history in the window.
+func NewTikTokenCounter(logger commons.Logger, providerModel string) tokens.TokenCounter {
+	return &tikTokenCounter{
+		logger: logger,
+		model:  providerModel,
+	}
+}
+
+func (ttc *tikTokenCounter) Count(messages ...*types.Message) int {
+	ttc.once.Do(func() {
+		encoding, err := tiktoken.EncodingForModel(ttc.model)
+		if err != nil {
+			encoding, err = tiktoken.GetEncoding(tiktoken.MODEL_CL100K_BASE)
+		}
+		if err != nil {
+			ttc.logger.Warnf("unable to load token encoding for %s, estimating token count: %v", ttc.model, err)
+			return
+		}
+		ttc.encoding = encoding
+	})
+	count := 0
+	for _, message := range messages {
+		count += tokensPerMessage
+		count += ttc.count(message.GetRole())
+		count += ttc.count(types.OnlyStringContent(message.GetContents()))
+	}
+	return count
+}
+
+func (ttc *tikTokenCounter) count(text string) int {
+	if ttc.encoding == nil {
+		// roughly four characters a token for english text
+		return (len(text) + 3) / 4
+	}
+	return len(ttc.encoding.Encode(text, nil, nil))
+}
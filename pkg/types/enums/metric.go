@@ -18,6 +18,11 @@ var (
 	TIME_TO_FIRST_TOKEN    MetricName = "TIME_TO_FIRST_TOKEN"
 	PROVIDER_TOTAL_TIME    MetricName = "PROVIDER_TOTAL_TIME"
 	PROVIDER_GENERATE_TIME MetricName = "PROVIDER_GENERATE_TIME"
+	// voice to voice latency of a turn
+	END_OF_SPEECH_DELAY     MetricName = "END_OF_SPEECH_DELAY"
+	LLM_TIME_TO_FIRST_TOKEN MetricName = "LLM_TIME_TO_FIRST_TOKEN"
+	TTS_TIME_TO_FIRST_BYTE  MetricName = "TTS_TIME_TO_FIRST_BYTE"
+	MOUTH_TO_EAR_LATENCY    MetricName = "MOUTH_TO_EAR_LATENCY"
 )
 
 func (m *MetricName) String() string {
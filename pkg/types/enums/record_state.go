@@ -27,6 +27,8 @@ const (
 	RECORD_INACTIVE    RecordState = "INACTIVE"
 	RECORD_ARCHIEVE    RecordState = "ARCHIEVE"
 	RECORD_FAILED      RecordState = "FAILED"
+	// failed after exhausting all the retries, requires manual action
+	RECORD_DEAD_LETTER RecordState = "DEAD_LETTER"
 )
 
 func (m RecordState) String() string {
@@ -35,6 +35,7 @@ const (
 	AssistantListenConnectStage       RapidaStage = "talk.assistant.listen.connect"
 	AssistantSpeakConnectStage        RapidaStage = "talk.assistant.speak.connect"
 	AssistantListeningStage           RapidaStage = "talk.assistant.listen.listening"
+	AssistantListenFailoverStage      RapidaStage = "talk.assistant.listen.failover"
 	AssistantUtteranceStage           RapidaStage = "talk.assistant.utterance"
 	AssistantInterruptStage           RapidaStage = "talk.assistant.interrupt"
 	AssistantAgentConnectStage        RapidaStage = "talk.assistant.agent.connect"
@@ -43,6 +44,7 @@ const (
 	AssistantAgentTextGenerationStage RapidaStage = "talk.assistant.agent.text-generation"
 	AssistantTranscribeStage          RapidaStage = "talk.assistant.speak.transcribe"
 	AssistantSpeakingStage            RapidaStage = "talk.assistant.speak.speaking"
+	AssistantSpeakFailoverStage       RapidaStage = "talk.assistant.speak.failover"
 	AssistantNotifyStage              RapidaStage = "talk.assistant.notify"
 	AssistantDisconnectStage          RapidaStage = "talk.assistant.disconnect"
 )
@@ -19,45 +19,65 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	AssistantService_GetAssistant_FullMethodName                = "/assistant_api.AssistantService/GetAssistant"
-	AssistantService_GetAllAssistant_FullMethodName             = "/assistant_api.AssistantService/GetAllAssistant"
-	AssistantService_CreateAssistant_FullMethodName             = "/assistant_api.AssistantService/CreateAssistant"
-	AssistantService_DeleteAssistant_FullMethodName             = "/assistant_api.AssistantService/DeleteAssistant"
-	AssistantService_GetAllAssistantProvider_FullMethodName     = "/assistant_api.AssistantService/GetAllAssistantProvider"
-	AssistantService_CreateAssistantProvider_FullMethodName     = "/assistant_api.AssistantService/CreateAssistantProvider"
-	AssistantService_CreateAssistantTag_FullMethodName          = "/assistant_api.AssistantService/CreateAssistantTag"
-	AssistantService_UpdateAssistantVersion_FullMethodName      = "/assistant_api.AssistantService/UpdateAssistantVersion"
-	AssistantService_UpdateAssistantDetail_FullMethodName       = "/assistant_api.AssistantService/UpdateAssistantDetail"
-	AssistantService_GetAllAssistantMessage_FullMethodName      = "/assistant_api.AssistantService/GetAllAssistantMessage"
-	AssistantService_GetAllConversationMessage_FullMethodName   = "/assistant_api.AssistantService/GetAllConversationMessage"
-	AssistantService_GetAllMessage_FullMethodName               = "/assistant_api.AssistantService/GetAllMessage"
-	AssistantService_GetAllAssistantTelemetry_FullMethodName    = "/assistant_api.AssistantService/GetAllAssistantTelemetry"
-	AssistantService_GetAllAssistantConversation_FullMethodName = "/assistant_api.AssistantService/GetAllAssistantConversation"
-	AssistantService_GetAssistantConversation_FullMethodName    = "/assistant_api.AssistantService/GetAssistantConversation"
-	AssistantService_GetAssistantWebhookLog_FullMethodName      = "/assistant_api.AssistantService/GetAssistantWebhookLog"
-	AssistantService_GetAllAssistantWebhookLog_FullMethodName   = "/assistant_api.AssistantService/GetAllAssistantWebhookLog"
-	AssistantService_GetAllAssistantWebhook_FullMethodName      = "/assistant_api.AssistantService/GetAllAssistantWebhook"
-	AssistantService_GetAssistantWebhook_FullMethodName         = "/assistant_api.AssistantService/GetAssistantWebhook"
-	AssistantService_CreateAssistantWebhook_FullMethodName      = "/assistant_api.AssistantService/CreateAssistantWebhook"
-	AssistantService_UpdateAssistantWebhook_FullMethodName      = "/assistant_api.AssistantService/UpdateAssistantWebhook"
-	AssistantService_DeleteAssistantWebhook_FullMethodName      = "/assistant_api.AssistantService/DeleteAssistantWebhook"
-	AssistantService_GetAssistantToolLog_FullMethodName         = "/assistant_api.AssistantService/GetAssistantToolLog"
-	AssistantService_GetAllAssistantToolLog_FullMethodName      = "/assistant_api.AssistantService/GetAllAssistantToolLog"
-	AssistantService_GetAssistantAnalysis_FullMethodName        = "/assistant_api.AssistantService/GetAssistantAnalysis"
-	AssistantService_UpdateAssistantAnalysis_FullMethodName     = "/assistant_api.AssistantService/UpdateAssistantAnalysis"
-	AssistantService_CreateAssistantAnalysis_FullMethodName     = "/assistant_api.AssistantService/CreateAssistantAnalysis"
-	AssistantService_DeleteAssistantAnalysis_FullMethodName     = "/assistant_api.AssistantService/DeleteAssistantAnalysis"
-	AssistantService_GetAllAssistantAnalysis_FullMethodName     = "/assistant_api.AssistantService/GetAllAssistantAnalysis"
-	AssistantService_CreateAssistantTool_FullMethodName         = "/assistant_api.AssistantService/CreateAssistantTool"
-	AssistantService_GetAssistantTool_FullMethodName            = "/assistant_api.AssistantService/GetAssistantTool"
-	AssistantService_GetAllAssistantTool_FullMethodName         = "/assistant_api.AssistantService/GetAllAssistantTool"
-	AssistantService_DeleteAssistantTool_FullMethodName         = "/assistant_api.AssistantService/DeleteAssistantTool"
-	AssistantService_UpdateAssistantTool_FullMethodName         = "/assistant_api.AssistantService/UpdateAssistantTool"
-	AssistantService_CreateAssistantKnowledge_FullMethodName    = "/assistant_api.AssistantService/CreateAssistantKnowledge"
-	AssistantService_GetAssistantKnowledge_FullMethodName       = "/assistant_api.AssistantService/GetAssistantKnowledge"
-	AssistantService_GetAllAssistantKnowledge_FullMethodName    = "/assistant_api.AssistantService/GetAllAssistantKnowledge"
-	AssistantService_DeleteAssistantKnowledge_FullMethodName    = "/assistant_api.AssistantService/DeleteAssistantKnowledge"
-	AssistantService_UpdateAssistantKnowledge_FullMethodName    = "/assistant_api.AssistantService/UpdateAssistantKnowledge"
+	AssistantService_GetAssistant_FullMethodName                          = "/assistant_api.AssistantService/GetAssistant"
+	AssistantService_GetAllAssistant_FullMethodName                       = "/assistant_api.AssistantService/GetAllAssistant"
+	AssistantService_CreateAssistant_FullMethodName                       = "/assistant_api.AssistantService/CreateAssistant"
+	AssistantService_DeleteAssistant_FullMethodName                       = "/assistant_api.AssistantService/DeleteAssistant"
+	AssistantService_GetAllAssistantProvider_FullMethodName               = "/assistant_api.AssistantService/GetAllAssistantProvider"
+	AssistantService_CreateAssistantProvider_FullMethodName               = "/assistant_api.AssistantService/CreateAssistantProvider"
+	AssistantService_CreateAssistantTag_FullMethodName                    = "/assistant_api.AssistantService/CreateAssistantTag"
+	AssistantService_UpdateAssistantVersion_FullMethodName                = "/assistant_api.AssistantService/UpdateAssistantVersion"
+	AssistantService_UpdateAssistantDetail_FullMethodName                 = "/assistant_api.AssistantService/UpdateAssistantDetail"
+	AssistantService_GetAllAssistantMessage_FullMethodName                = "/assistant_api.AssistantService/GetAllAssistantMessage"
+	AssistantService_GetAllConversationMessage_FullMethodName             = "/assistant_api.AssistantService/GetAllConversationMessage"
+	AssistantService_GetAllMessage_FullMethodName                         = "/assistant_api.AssistantService/GetAllMessage"
+	AssistantService_GetAllAssistantTelemetry_FullMethodName              = "/assistant_api.AssistantService/GetAllAssistantTelemetry"
+	AssistantService_GetAllAssistantConversation_FullMethodName           = "/assistant_api.AssistantService/GetAllAssistantConversation"
+	AssistantService_GetAssistantConversation_FullMethodName              = "/assistant_api.AssistantService/GetAssistantConversation"
+	AssistantService_GetAssistantWebhookLog_FullMethodName                = "/assistant_api.AssistantService/GetAssistantWebhookLog"
+	AssistantService_GetAllAssistantWebhookLog_FullMethodName             = "/assistant_api.AssistantService/GetAllAssistantWebhookLog"
+	AssistantService_GetAllAssistantWebhook_FullMethodName                = "/assistant_api.AssistantService/GetAllAssistantWebhook"
+	AssistantService_GetAssistantWebhook_FullMethodName                   = "/assistant_api.AssistantService/GetAssistantWebhook"
+	AssistantService_CreateAssistantWebhook_FullMethodName                = "/assistant_api.AssistantService/CreateAssistantWebhook"
+	AssistantService_UpdateAssistantWebhook_FullMethodName                = "/assistant_api.AssistantService/UpdateAssistantWebhook"
+	AssistantService_DeleteAssistantWebhook_FullMethodName                = "/assistant_api.AssistantService/DeleteAssistantWebhook"
+	AssistantService_GetAssistantToolLog_FullMethodName                   = "/assistant_api.AssistantService/GetAssistantToolLog"
+	AssistantService_GetAllAssistantToolLog_FullMethodName                = "/assistant_api.AssistantService/GetAllAssistantToolLog"
+	AssistantService_GetAssistantAnalysis_FullMethodName                  = "/assistant_api.AssistantService/GetAssistantAnalysis"
+	AssistantService_UpdateAssistantAnalysis_FullMethodName               = "/assistant_api.AssistantService/UpdateAssistantAnalysis"
+	AssistantService_CreateAssistantAnalysis_FullMethodName               = "/assistant_api.AssistantService/CreateAssistantAnalysis"
+	AssistantService_DeleteAssistantAnalysis_FullMethodName               = "/assistant_api.AssistantService/DeleteAssistantAnalysis"
+	AssistantService_GetAllAssistantAnalysis_FullMethodName               = "/assistant_api.AssistantService/GetAllAssistantAnalysis"
+	AssistantService_CreateAssistantTool_FullMethodName                   = "/assistant_api.AssistantService/CreateAssistantTool"
+	AssistantService_GetAssistantTool_FullMethodName                      = "/assistant_api.AssistantService/GetAssistantTool"
+	AssistantService_GetAllAssistantTool_FullMethodName                   = "/assistant_api.AssistantService/GetAllAssistantTool"
+	AssistantService_DeleteAssistantTool_FullMethodName                   = "/assistant_api.AssistantService/DeleteAssistantTool"
+	AssistantService_UpdateAssistantTool_FullMethodName                   = "/assistant_api.AssistantService/UpdateAssistantTool"
+	AssistantService_CreateAssistantKnowledge_FullMethodName              = "/assistant_api.AssistantService/CreateAssistantKnowledge"
+	AssistantService_GetAssistantKnowledge_FullMethodName                 = "/assistant_api.AssistantService/GetAssistantKnowledge"
+	AssistantService_GetAllAssistantKnowledge_FullMethodName              = "/assistant_api.AssistantService/GetAllAssistantKnowledge"
+	AssistantService_DeleteAssistantKnowledge_FullMethodName              = "/assistant_api.AssistantService/DeleteAssistantKnowledge"
+	AssistantService_UpdateAssistantKnowledge_FullMethodName              = "/assistant_api.AssistantService/UpdateAssistantKnowledge"
+	AssistantService_GetAssistantTurnLatency_FullMethodName               = "/assistant_api.AssistantService/GetAssistantTurnLatency"
+	AssistantService_RedeliverAssistantWebhookLog_FullMethodName          = "/assistant_api.AssistantService/RedeliverAssistantWebhookLog"
+	AssistantService_CreateAssistantLexicon_FullMethodName                = "/assistant_api.AssistantService/CreateAssistantLexicon"
+	AssistantService_GetAssistantLexicon_FullMethodName                   = "/assistant_api.AssistantService/GetAssistantLexicon"
+	AssistantService_GetAllAssistantLexicon_FullMethodName                = "/assistant_api.AssistantService/GetAllAssistantLexicon"
+	AssistantService_UpdateAssistantLexicon_FullMethodName                = "/assistant_api.AssistantService/UpdateAssistantLexicon"
+	AssistantService_DeleteAssistantLexicon_FullMethodName                = "/assistant_api.AssistantService/DeleteAssistantLexicon"
+	AssistantService_CreateAssistantEvaluator_FullMethodName              = "/assistant_api.AssistantService/CreateAssistantEvaluator"
+	AssistantService_GetAssistantEvaluator_FullMethodName                 = "/assistant_api.AssistantService/GetAssistantEvaluator"
+	AssistantService_GetAllAssistantEvaluator_FullMethodName              = "/assistant_api.AssistantService/GetAllAssistantEvaluator"
+	AssistantService_UpdateAssistantEvaluator_FullMethodName              = "/assistant_api.AssistantService/UpdateAssistantEvaluator"
+	AssistantService_DeleteAssistantEvaluator_FullMethodName              = "/assistant_api.AssistantService/DeleteAssistantEvaluator"
+	AssistantService_GetAllAssistantConversationEvaluation_FullMethodName = "/assistant_api.AssistantService/GetAllAssistantConversationEvaluation"
+	AssistantService_GetAssistantEvaluationSummary_FullMethodName         = "/assistant_api.AssistantService/GetAssistantEvaluationSummary"
+	AssistantService_CreateAssistantCampaign_FullMethodName               = "/assistant_api.AssistantService/CreateAssistantCampaign"
+	AssistantService_GetAssistantCampaign_FullMethodName                  = "/assistant_api.AssistantService/GetAssistantCampaign"
+	AssistantService_GetAllAssistantCampaign_FullMethodName               = "/assistant_api.AssistantService/GetAllAssistantCampaign"
+	AssistantService_UpdateAssistantCampaignState_FullMethodName          = "/assistant_api.AssistantService/UpdateAssistantCampaignState"
+	AssistantService_DeleteAssistantCampaign_FullMethodName               = "/assistant_api.AssistantService/DeleteAssistantCampaign"
+	AssistantService_GetAllAssistantCampaignContact_FullMethodName        = "/assistant_api.AssistantService/GetAllAssistantCampaignContact"
 )
 
 // AssistantServiceClient is the client API for AssistantService service.
@@ -108,6 +128,28 @@ type AssistantServiceClient interface {
 	GetAllAssistantKnowledge(ctx context.Context, in *GetAllAssistantKnowledgeRequest, opts ...grpc.CallOption) (*GetAllAssistantKnowledgeResponse, error)
 	DeleteAssistantKnowledge(ctx context.Context, in *DeleteAssistantKnowledgeRequest, opts ...grpc.CallOption) (*GetAssistantKnowledgeResponse, error)
 	UpdateAssistantKnowledge(ctx context.Context, in *UpdateAssistantKnowledgeRequest, opts ...grpc.CallOption) (*GetAssistantKnowledgeResponse, error)
+	GetAssistantTurnLatency(ctx context.Context, in *GetAssistantTurnLatencyRequest, opts ...grpc.CallOption) (*GetAssistantTurnLatencyResponse, error)
+	RedeliverAssistantWebhookLog(ctx context.Context, in *RedeliverAssistantWebhookLogRequest, opts ...grpc.CallOption) (*GetAssistantWebhookLogResponse, error)
+	// pronunciation lexicon
+	CreateAssistantLexicon(ctx context.Context, in *CreateAssistantLexiconRequest, opts ...grpc.CallOption) (*GetAssistantLexiconResponse, error)
+	GetAssistantLexicon(ctx context.Context, in *GetAssistantLexiconRequest, opts ...grpc.CallOption) (*GetAssistantLexiconResponse, error)
+	GetAllAssistantLexicon(ctx context.Context, in *GetAllAssistantLexiconRequest, opts ...grpc.CallOption) (*GetAllAssistantLexiconResponse, error)
+	UpdateAssistantLexicon(ctx context.Context, in *UpdateAssistantLexiconRequest, opts ...grpc.CallOption) (*GetAssistantLexiconResponse, error)
+	DeleteAssistantLexicon(ctx context.Context, in *DeleteAssistantLexiconRequest, opts ...grpc.CallOption) (*GetAssistantLexiconResponse, error)
+	// conversation evaluators
+	CreateAssistantEvaluator(ctx context.Context, in *CreateAssistantEvaluatorRequest, opts ...grpc.CallOption) (*GetAssistantEvaluatorResponse, error)
+	GetAssistantEvaluator(ctx context.Context, in *GetAssistantEvaluatorRequest, opts ...grpc.CallOption) (*GetAssistantEvaluatorResponse, error)
+	GetAllAssistantEvaluator(ctx context.Context, in *GetAllAssistantEvaluatorRequest, opts ...grpc.CallOption) (*GetAllAssistantEvaluatorResponse, error)
+	UpdateAssistantEvaluator(ctx context.Context, in *UpdateAssistantEvaluatorRequest, opts ...grpc.CallOption) (*GetAssistantEvaluatorResponse, error)
+	DeleteAssistantEvaluator(ctx context.Context, in *DeleteAssistantEvaluatorRequest, opts ...grpc.CallOption) (*GetAssistantEvaluatorResponse, error)
+	GetAllAssistantConversationEvaluation(ctx context.Context, in *GetAllAssistantConversationEvaluationRequest, opts ...grpc.CallOption) (*GetAllAssistantConversationEvaluationResponse, error)
+	GetAssistantEvaluationSummary(ctx context.Context, in *GetAssistantEvaluationSummaryRequest, opts ...grpc.CallOption) (*GetAssistantEvaluationSummaryResponse, error)
+	CreateAssistantCampaign(ctx context.Context, in *CreateAssistantCampaignRequest, opts ...grpc.CallOption) (*GetAssistantCampaignResponse, error)
+	GetAssistantCampaign(ctx context.Context, in *GetAssistantCampaignRequest, opts ...grpc.CallOption) (*GetAssistantCampaignResponse, error)
+	GetAllAssistantCampaign(ctx context.Context, in *GetAllAssistantCampaignRequest, opts ...grpc.CallOption) (*GetAllAssistantCampaignResponse, error)
+	UpdateAssistantCampaignState(ctx context.Context, in *UpdateAssistantCampaignStateRequest, opts ...grpc.CallOption) (*GetAssistantCampaignResponse, error)
+	DeleteAssistantCampaign(ctx context.Context, in *DeleteAssistantCampaignRequest, opts ...grpc.CallOption) (*GetAssistantCampaignResponse, error)
+	GetAllAssistantCampaignContact(ctx context.Context, in *GetAllAssistantCampaignContactRequest, opts ...grpc.CallOption) (*GetAllAssistantCampaignContactResponse, error)
 }
 
 type assistantServiceClient struct {
@@ -508,6 +550,206 @@ func (c *assistantServiceClient) UpdateAssistantKnowledge(ctx context.Context, i
 	return out, nil
 }
 
+func (c *assistantServiceClient) GetAssistantTurnLatency(ctx context.Context, in *GetAssistantTurnLatencyRequest, opts ...grpc.CallOption) (*GetAssistantTurnLatencyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAssistantTurnLatencyResponse)
+	err := c.cc.Invoke(ctx, AssistantService_GetAssistantTurnLatency_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assistantServiceClient) RedeliverAssistantWebhookLog(ctx context.Context, in *RedeliverAssistantWebhookLogRequest, opts ...grpc.CallOption) (*GetAssistantWebhookLogResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAssistantWebhookLogResponse)
+	err := c.cc.Invoke(ctx, AssistantService_RedeliverAssistantWebhookLog_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assistantServiceClient) CreateAssistantLexicon(ctx context.Context, in *CreateAssistantLexiconRequest, opts ...grpc.CallOption) (*GetAssistantLexiconResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAssistantLexiconResponse)
+	err := c.cc.Invoke(ctx, AssistantService_CreateAssistantLexicon_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assistantServiceClient) GetAssistantLexicon(ctx context.Context, in *GetAssistantLexiconRequest, opts ...grpc.CallOption) (*GetAssistantLexiconResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAssistantLexiconResponse)
+	err := c.cc.Invoke(ctx, AssistantService_GetAssistantLexicon_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assistantServiceClient) GetAllAssistantLexicon(ctx context.Context, in *GetAllAssistantLexiconRequest, opts ...grpc.CallOption) (*GetAllAssistantLexiconResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAllAssistantLexiconResponse)
+	err := c.cc.Invoke(ctx, AssistantService_GetAllAssistantLexicon_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assistantServiceClient) UpdateAssistantLexicon(ctx context.Context, in *UpdateAssistantLexiconRequest, opts ...grpc.CallOption) (*GetAssistantLexiconResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAssistantLexiconResponse)
+	err := c.cc.Invoke(ctx, AssistantService_UpdateAssistantLexicon_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assistantServiceClient) DeleteAssistantLexicon(ctx context.Context, in *DeleteAssistantLexiconRequest, opts ...grpc.CallOption) (*GetAssistantLexiconResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAssistantLexiconResponse)
+	err := c.cc.Invoke(ctx, AssistantService_DeleteAssistantLexicon_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assistantServiceClient) CreateAssistantEvaluator(ctx context.Context, in *CreateAssistantEvaluatorRequest, opts ...grpc.CallOption) (*GetAssistantEvaluatorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAssistantEvaluatorResponse)
+	err := c.cc.Invoke(ctx, AssistantService_CreateAssistantEvaluator_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assistantServiceClient) GetAssistantEvaluator(ctx context.Context, in *GetAssistantEvaluatorRequest, opts ...grpc.CallOption) (*GetAssistantEvaluatorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAssistantEvaluatorResponse)
+	err := c.cc.Invoke(ctx, AssistantService_GetAssistantEvaluator_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assistantServiceClient) GetAllAssistantEvaluator(ctx context.Context, in *GetAllAssistantEvaluatorRequest, opts ...grpc.CallOption) (*GetAllAssistantEvaluatorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAllAssistantEvaluatorResponse)
+	err := c.cc.Invoke(ctx, AssistantService_GetAllAssistantEvaluator_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assistantServiceClient) UpdateAssistantEvaluator(ctx context.Context, in *UpdateAssistantEvaluatorRequest, opts ...grpc.CallOption) (*GetAssistantEvaluatorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAssistantEvaluatorResponse)
+	err := c.cc.Invoke(ctx, AssistantService_UpdateAssistantEvaluator_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assistantServiceClient) DeleteAssistantEvaluator(ctx context.Context, in *DeleteAssistantEvaluatorRequest, opts ...grpc.CallOption) (*GetAssistantEvaluatorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAssistantEvaluatorResponse)
+	err := c.cc.Invoke(ctx, AssistantService_DeleteAssistantEvaluator_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assistantServiceClient) GetAllAssistantConversationEvaluation(ctx context.Context, in *GetAllAssistantConversationEvaluationRequest, opts ...grpc.CallOption) (*GetAllAssistantConversationEvaluationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAllAssistantConversationEvaluationResponse)
+	err := c.cc.Invoke(ctx, AssistantService_GetAllAssistantConversationEvaluation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assistantServiceClient) GetAssistantEvaluationSummary(ctx context.Context, in *GetAssistantEvaluationSummaryRequest, opts ...grpc.CallOption) (*GetAssistantEvaluationSummaryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAssistantEvaluationSummaryResponse)
+	err := c.cc.Invoke(ctx, AssistantService_GetAssistantEvaluationSummary_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assistantServiceClient) CreateAssistantCampaign(ctx context.Context, in *CreateAssistantCampaignRequest, opts ...grpc.CallOption) (*GetAssistantCampaignResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAssistantCampaignResponse)
+	err := c.cc.Invoke(ctx, AssistantService_CreateAssistantCampaign_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assistantServiceClient) GetAssistantCampaign(ctx context.Context, in *GetAssistantCampaignRequest, opts ...grpc.CallOption) (*GetAssistantCampaignResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAssistantCampaignResponse)
+	err := c.cc.Invoke(ctx, AssistantService_GetAssistantCampaign_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assistantServiceClient) GetAllAssistantCampaign(ctx context.Context, in *GetAllAssistantCampaignRequest, opts ...grpc.CallOption) (*GetAllAssistantCampaignResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAllAssistantCampaignResponse)
+	err := c.cc.Invoke(ctx, AssistantService_GetAllAssistantCampaign_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assistantServiceClient) UpdateAssistantCampaignState(ctx context.Context, in *UpdateAssistantCampaignStateRequest, opts ...grpc.CallOption) (*GetAssistantCampaignResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAssistantCampaignResponse)
+	err := c.cc.Invoke(ctx, AssistantService_UpdateAssistantCampaignState_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assistantServiceClient) DeleteAssistantCampaign(ctx context.Context, in *DeleteAssistantCampaignRequest, opts ...grpc.CallOption) (*GetAssistantCampaignResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAssistantCampaignResponse)
+	err := c.cc.Invoke(ctx, AssistantService_DeleteAssistantCampaign_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assistantServiceClient) GetAllAssistantCampaignContact(ctx context.Context, in *GetAllAssistantCampaignContactRequest, opts ...grpc.CallOption) (*GetAllAssistantCampaignContactResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAllAssistantCampaignContactResponse)
+	err := c.cc.Invoke(ctx, AssistantService_GetAllAssistantCampaignContact_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AssistantServiceServer is the server API for AssistantService service.
 // All implementations should embed UnimplementedAssistantServiceServer
 // for forward compatibility.
@@ -556,6 +798,28 @@ type AssistantServiceServer interface {
 	GetAllAssistantKnowledge(context.Context, *GetAllAssistantKnowledgeRequest) (*GetAllAssistantKnowledgeResponse, error)
 	DeleteAssistantKnowledge(context.Context, *DeleteAssistantKnowledgeRequest) (*GetAssistantKnowledgeResponse, error)
 	UpdateAssistantKnowledge(context.Context, *UpdateAssistantKnowledgeRequest) (*GetAssistantKnowledgeResponse, error)
+	GetAssistantTurnLatency(context.Context, *GetAssistantTurnLatencyRequest) (*GetAssistantTurnLatencyResponse, error)
+	RedeliverAssistantWebhookLog(context.Context, *RedeliverAssistantWebhookLogRequest) (*GetAssistantWebhookLogResponse, error)
+	// pronunciation lexicon
+	CreateAssistantLexicon(context.Context, *CreateAssistantLexiconRequest) (*GetAssistantLexiconResponse, error)
+	GetAssistantLexicon(context.Context, *GetAssistantLexiconRequest) (*GetAssistantLexiconResponse, error)
+	GetAllAssistantLexicon(context.Context, *GetAllAssistantLexiconRequest) (*GetAllAssistantLexiconResponse, error)
+	UpdateAssistantLexicon(context.Context, *UpdateAssistantLexiconRequest) (*GetAssistantLexiconResponse, error)
+	DeleteAssistantLexicon(context.Context, *DeleteAssistantLexiconRequest) (*GetAssistantLexiconResponse, error)
+	// conversation evaluators
+	CreateAssistantEvaluator(context.Context, *CreateAssistantEvaluatorRequest) (*GetAssistantEvaluatorResponse, error)
+	GetAssistantEvaluator(context.Context, *GetAssistantEvaluatorRequest) (*GetAssistantEvaluatorResponse, error)
+	GetAllAssistantEvaluator(context.Context, *GetAllAssistantEvaluatorRequest) (*GetAllAssistantEvaluatorResponse, error)
+	UpdateAssistantEvaluator(context.Context, *UpdateAssistantEvaluatorRequest) (*GetAssistantEvaluatorResponse, error)
+	DeleteAssistantEvaluator(context.Context, *DeleteAssistantEvaluatorRequest) (*GetAssistantEvaluatorResponse, error)
+	GetAllAssistantConversationEvaluation(context.Context, *GetAllAssistantConversationEvaluationRequest) (*GetAllAssistantConversationEvaluationResponse, error)
+	GetAssistantEvaluationSummary(context.Context, *GetAssistantEvaluationSummaryRequest) (*GetAssistantEvaluationSummaryResponse, error)
+	CreateAssistantCampaign(context.Context, *CreateAssistantCampaignRequest) (*GetAssistantCampaignResponse, error)
+	GetAssistantCampaign(context.Context, *GetAssistantCampaignRequest) (*GetAssistantCampaignResponse, error)
+	GetAllAssistantCampaign(context.Context, *GetAllAssistantCampaignRequest) (*GetAllAssistantCampaignResponse, error)
+	UpdateAssistantCampaignState(context.Context, *UpdateAssistantCampaignStateRequest) (*GetAssistantCampaignResponse, error)
+	DeleteAssistantCampaign(context.Context, *DeleteAssistantCampaignRequest) (*GetAssistantCampaignResponse, error)
+	GetAllAssistantCampaignContact(context.Context, *GetAllAssistantCampaignContactRequest) (*GetAllAssistantCampaignContactResponse, error)
 }
 
 // UnimplementedAssistantServiceServer should be embedded to have
@@ -682,6 +946,66 @@ func (UnimplementedAssistantServiceServer) DeleteAssistantKnowledge(context.Cont
 func (UnimplementedAssistantServiceServer) UpdateAssistantKnowledge(context.Context, *UpdateAssistantKnowledgeRequest) (*GetAssistantKnowledgeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateAssistantKnowledge not implemented")
 }
+func (UnimplementedAssistantServiceServer) GetAssistantTurnLatency(context.Context, *GetAssistantTurnLatencyRequest) (*GetAssistantTurnLatencyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAssistantTurnLatency not implemented")
+}
+func (UnimplementedAssistantServiceServer) RedeliverAssistantWebhookLog(context.Context, *RedeliverAssistantWebhookLogRequest) (*GetAssistantWebhookLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedeliverAssistantWebhookLog not implemented")
+}
+func (UnimplementedAssistantServiceServer) CreateAssistantLexicon(context.Context, *CreateAssistantLexiconRequest) (*GetAssistantLexiconResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAssistantLexicon not implemented")
+}
+func (UnimplementedAssistantServiceServer) GetAssistantLexicon(context.Context, *GetAssistantLexiconRequest) (*GetAssistantLexiconResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAssistantLexicon not implemented")
+}
+func (UnimplementedAssistantServiceServer) GetAllAssistantLexicon(context.Context, *GetAllAssistantLexiconRequest) (*GetAllAssistantLexiconResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllAssistantLexicon not implemented")
+}
+func (UnimplementedAssistantServiceServer) UpdateAssistantLexicon(context.Context, *UpdateAssistantLexiconRequest) (*GetAssistantLexiconResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAssistantLexicon not implemented")
+}
+func (UnimplementedAssistantServiceServer) DeleteAssistantLexicon(context.Context, *DeleteAssistantLexiconRequest) (*GetAssistantLexiconResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAssistantLexicon not implemented")
+}
+func (UnimplementedAssistantServiceServer) CreateAssistantEvaluator(context.Context, *CreateAssistantEvaluatorRequest) (*GetAssistantEvaluatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAssistantEvaluator not implemented")
+}
+func (UnimplementedAssistantServiceServer) GetAssistantEvaluator(context.Context, *GetAssistantEvaluatorRequest) (*GetAssistantEvaluatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAssistantEvaluator not implemented")
+}
+func (UnimplementedAssistantServiceServer) GetAllAssistantEvaluator(context.Context, *GetAllAssistantEvaluatorRequest) (*GetAllAssistantEvaluatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllAssistantEvaluator not implemented")
+}
+func (UnimplementedAssistantServiceServer) UpdateAssistantEvaluator(context.Context, *UpdateAssistantEvaluatorRequest) (*GetAssistantEvaluatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAssistantEvaluator not implemented")
+}
+func (UnimplementedAssistantServiceServer) DeleteAssistantEvaluator(context.Context, *DeleteAssistantEvaluatorRequest) (*GetAssistantEvaluatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAssistantEvaluator not implemented")
+}
+func (UnimplementedAssistantServiceServer) GetAllAssistantConversationEvaluation(context.Context, *GetAllAssistantConversationEvaluationRequest) (*GetAllAssistantConversationEvaluationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllAssistantConversationEvaluation not implemented")
+}
+func (UnimplementedAssistantServiceServer) GetAssistantEvaluationSummary(context.Context, *GetAssistantEvaluationSummaryRequest) (*GetAssistantEvaluationSummaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAssistantEvaluationSummary not implemented")
+}
+func (UnimplementedAssistantServiceServer) CreateAssistantCampaign(context.Context, *CreateAssistantCampaignRequest) (*GetAssistantCampaignResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAssistantCampaign not implemented")
+}
+func (UnimplementedAssistantServiceServer) GetAssistantCampaign(context.Context, *GetAssistantCampaignRequest) (*GetAssistantCampaignResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAssistantCampaign not implemented")
+}
+func (UnimplementedAssistantServiceServer) GetAllAssistantCampaign(context.Context, *GetAllAssistantCampaignRequest) (*GetAllAssistantCampaignResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllAssistantCampaign not implemented")
+}
+func (UnimplementedAssistantServiceServer) UpdateAssistantCampaignState(context.Context, *UpdateAssistantCampaignStateRequest) (*GetAssistantCampaignResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAssistantCampaignState not implemented")
+}
+func (UnimplementedAssistantServiceServer) DeleteAssistantCampaign(context.Context, *DeleteAssistantCampaignRequest) (*GetAssistantCampaignResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAssistantCampaign not implemented")
+}
+func (UnimplementedAssistantServiceServer) GetAllAssistantCampaignContact(context.Context, *GetAllAssistantCampaignContactRequest) (*GetAllAssistantCampaignContactResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllAssistantCampaignContact not implemented")
+}
 func (UnimplementedAssistantServiceServer) testEmbeddedByValue() {}
 
 // UnsafeAssistantServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -1404,6 +1728,366 @@ func _AssistantService_UpdateAssistantKnowledge_Handler(srv interface{}, ctx con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AssistantService_GetAssistantTurnLatency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAssistantTurnLatencyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssistantServiceServer).GetAssistantTurnLatency(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssistantService_GetAssistantTurnLatency_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssistantServiceServer).GetAssistantTurnLatency(ctx, req.(*GetAssistantTurnLatencyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssistantService_RedeliverAssistantWebhookLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RedeliverAssistantWebhookLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssistantServiceServer).RedeliverAssistantWebhookLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssistantService_RedeliverAssistantWebhookLog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssistantServiceServer).RedeliverAssistantWebhookLog(ctx, req.(*RedeliverAssistantWebhookLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssistantService_CreateAssistantLexicon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAssistantLexiconRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssistantServiceServer).CreateAssistantLexicon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssistantService_CreateAssistantLexicon_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssistantServiceServer).CreateAssistantLexicon(ctx, req.(*CreateAssistantLexiconRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssistantService_GetAssistantLexicon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAssistantLexiconRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssistantServiceServer).GetAssistantLexicon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssistantService_GetAssistantLexicon_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssistantServiceServer).GetAssistantLexicon(ctx, req.(*GetAssistantLexiconRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssistantService_GetAllAssistantLexicon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllAssistantLexiconRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssistantServiceServer).GetAllAssistantLexicon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssistantService_GetAllAssistantLexicon_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssistantServiceServer).GetAllAssistantLexicon(ctx, req.(*GetAllAssistantLexiconRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssistantService_UpdateAssistantLexicon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAssistantLexiconRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssistantServiceServer).UpdateAssistantLexicon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssistantService_UpdateAssistantLexicon_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssistantServiceServer).UpdateAssistantLexicon(ctx, req.(*UpdateAssistantLexiconRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssistantService_DeleteAssistantLexicon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAssistantLexiconRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssistantServiceServer).DeleteAssistantLexicon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssistantService_DeleteAssistantLexicon_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssistantServiceServer).DeleteAssistantLexicon(ctx, req.(*DeleteAssistantLexiconRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssistantService_CreateAssistantEvaluator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAssistantEvaluatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssistantServiceServer).CreateAssistantEvaluator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssistantService_CreateAssistantEvaluator_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssistantServiceServer).CreateAssistantEvaluator(ctx, req.(*CreateAssistantEvaluatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssistantService_GetAssistantEvaluator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAssistantEvaluatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssistantServiceServer).GetAssistantEvaluator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssistantService_GetAssistantEvaluator_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssistantServiceServer).GetAssistantEvaluator(ctx, req.(*GetAssistantEvaluatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssistantService_GetAllAssistantEvaluator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllAssistantEvaluatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssistantServiceServer).GetAllAssistantEvaluator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssistantService_GetAllAssistantEvaluator_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssistantServiceServer).GetAllAssistantEvaluator(ctx, req.(*GetAllAssistantEvaluatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssistantService_UpdateAssistantEvaluator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAssistantEvaluatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssistantServiceServer).UpdateAssistantEvaluator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssistantService_UpdateAssistantEvaluator_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssistantServiceServer).UpdateAssistantEvaluator(ctx, req.(*UpdateAssistantEvaluatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssistantService_DeleteAssistantEvaluator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAssistantEvaluatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssistantServiceServer).DeleteAssistantEvaluator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssistantService_DeleteAssistantEvaluator_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssistantServiceServer).DeleteAssistantEvaluator(ctx, req.(*DeleteAssistantEvaluatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssistantService_GetAllAssistantConversationEvaluation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllAssistantConversationEvaluationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssistantServiceServer).GetAllAssistantConversationEvaluation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssistantService_GetAllAssistantConversationEvaluation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssistantServiceServer).GetAllAssistantConversationEvaluation(ctx, req.(*GetAllAssistantConversationEvaluationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssistantService_GetAssistantEvaluationSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAssistantEvaluationSummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssistantServiceServer).GetAssistantEvaluationSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssistantService_GetAssistantEvaluationSummary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssistantServiceServer).GetAssistantEvaluationSummary(ctx, req.(*GetAssistantEvaluationSummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssistantService_CreateAssistantCampaign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAssistantCampaignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssistantServiceServer).CreateAssistantCampaign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssistantService_CreateAssistantCampaign_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssistantServiceServer).CreateAssistantCampaign(ctx, req.(*CreateAssistantCampaignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssistantService_GetAssistantCampaign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAssistantCampaignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssistantServiceServer).GetAssistantCampaign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssistantService_GetAssistantCampaign_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssistantServiceServer).GetAssistantCampaign(ctx, req.(*GetAssistantCampaignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssistantService_GetAllAssistantCampaign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllAssistantCampaignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssistantServiceServer).GetAllAssistantCampaign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssistantService_GetAllAssistantCampaign_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssistantServiceServer).GetAllAssistantCampaign(ctx, req.(*GetAllAssistantCampaignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssistantService_UpdateAssistantCampaignState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAssistantCampaignStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssistantServiceServer).UpdateAssistantCampaignState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssistantService_UpdateAssistantCampaignState_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssistantServiceServer).UpdateAssistantCampaignState(ctx, req.(*UpdateAssistantCampaignStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssistantService_DeleteAssistantCampaign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAssistantCampaignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssistantServiceServer).DeleteAssistantCampaign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssistantService_DeleteAssistantCampaign_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssistantServiceServer).DeleteAssistantCampaign(ctx, req.(*DeleteAssistantCampaignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssistantService_GetAllAssistantCampaignContact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllAssistantCampaignContactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssistantServiceServer).GetAllAssistantCampaignContact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssistantService_GetAllAssistantCampaignContact_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssistantServiceServer).GetAllAssistantCampaignContact(ctx, req.(*GetAllAssistantCampaignContactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AssistantService_ServiceDesc is the grpc.ServiceDesc for AssistantService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1567,6 +2251,86 @@ var AssistantService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateAssistantKnowledge",
 			Handler:    _AssistantService_UpdateAssistantKnowledge_Handler,
 		},
+		{
+			MethodName: "GetAssistantTurnLatency",
+			Handler:    _AssistantService_GetAssistantTurnLatency_Handler,
+		},
+		{
+			MethodName: "RedeliverAssistantWebhookLog",
+			Handler:    _AssistantService_RedeliverAssistantWebhookLog_Handler,
+		},
+		{
+			MethodName: "CreateAssistantLexicon",
+			Handler:    _AssistantService_CreateAssistantLexicon_Handler,
+		},
+		{
+			MethodName: "GetAssistantLexicon",
+			Handler:    _AssistantService_GetAssistantLexicon_Handler,
+		},
+		{
+			MethodName: "GetAllAssistantLexicon",
+			Handler:    _AssistantService_GetAllAssistantLexicon_Handler,
+		},
+		{
+			MethodName: "UpdateAssistantLexicon",
+			Handler:    _AssistantService_UpdateAssistantLexicon_Handler,
+		},
+		{
+			MethodName: "DeleteAssistantLexicon",
+			Handler:    _AssistantService_DeleteAssistantLexicon_Handler,
+		},
+		{
+			MethodName: "CreateAssistantEvaluator",
+			Handler:    _AssistantService_CreateAssistantEvaluator_Handler,
+		},
+		{
+			MethodName: "GetAssistantEvaluator",
+			Handler:    _AssistantService_GetAssistantEvaluator_Handler,
+		},
+		{
+			MethodName: "GetAllAssistantEvaluator",
+			Handler:    _AssistantService_GetAllAssistantEvaluator_Handler,
+		},
+		{
+			MethodName: "UpdateAssistantEvaluator",
+			Handler:    _AssistantService_UpdateAssistantEvaluator_Handler,
+		},
+		{
+			MethodName: "DeleteAssistantEvaluator",
+			Handler:    _AssistantService_DeleteAssistantEvaluator_Handler,
+		},
+		{
+			MethodName: "GetAllAssistantConversationEvaluation",
+			Handler:    _AssistantService_GetAllAssistantConversationEvaluation_Handler,
+		},
+		{
+			MethodName: "GetAssistantEvaluationSummary",
+			Handler:    _AssistantService_GetAssistantEvaluationSummary_Handler,
+		},
+		{
+			MethodName: "CreateAssistantCampaign",
+			Handler:    _AssistantService_CreateAssistantCampaign_Handler,
+		},
+		{
+			MethodName: "GetAssistantCampaign",
+			Handler:    _AssistantService_GetAssistantCampaign_Handler,
+		},
+		{
+			MethodName: "GetAllAssistantCampaign",
+			Handler:    _AssistantService_GetAllAssistantCampaign_Handler,
+		},
+		{
+			MethodName: "UpdateAssistantCampaignState",
+			Handler:    _AssistantService_UpdateAssistantCampaignState_Handler,
+		},
+		{
+			MethodName: "DeleteAssistantCampaign",
+			Handler:    _AssistantService_DeleteAssistantCampaign_Handler,
+		},
+		{
+			MethodName: "GetAllAssistantCampaignContact",
+			Handler:    _AssistantService_GetAllAssistantCampaignContact_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "assistant-api.proto",
@@ -0,0 +1,1742 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        v3.20.3
+// source: assistant-campaign.proto
+
+package protos
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	anypb "google.golang.org/protobuf/types/known/anypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// bulk outbound calls of the assistant paced by concurrency and calls per second
+type AssistantCampaign struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id               uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name             string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	AssistantId      uint64 `protobuf:"varint,3,opt,name=assistantId,proto3" json:"assistantId,omitempty"`
+	AssistantVersion string `protobuf:"bytes,4,opt,name=assistantVersion,proto3" json:"assistantVersion,omitempty"`
+	FromNumber       string `protobuf:"bytes,5,opt,name=fromNumber,proto3" json:"fromNumber,omitempty"`
+	// RUNNING, PAUSED, COMPLETED or CANCELLED
+	State          string  `protobuf:"bytes,6,opt,name=state,proto3" json:"state,omitempty"`
+	Concurrency    uint32  `protobuf:"varint,7,opt,name=concurrency,proto3" json:"concurrency,omitempty"`
+	CallsPerSecond float64 `protobuf:"fixed64,8,opt,name=callsPerSecond,proto3" json:"callsPerSecond,omitempty"`
+	// calling hours as HH:MM in the timezone of the contact or the campaign
+	Timezone         string                 `protobuf:"bytes,9,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	CallingHourStart string                 `protobuf:"bytes,10,opt,name=callingHourStart,proto3" json:"callingHourStart,omitempty"`
+	CallingHourEnd   string                 `protobuf:"bytes,11,opt,name=callingHourEnd,proto3" json:"callingHourEnd,omitempty"`
+	StartDate        *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=startDate,proto3" json:"startDate,omitempty"`
+	MaxAttempts      uint32                 `protobuf:"varint,13,opt,name=maxAttempts,proto3" json:"maxAttempts,omitempty"`
+	RetryDelaySecond uint32                 `protobuf:"varint,14,opt,name=retryDelaySecond,proto3" json:"retryDelaySecond,omitempty"`
+	// busy, no-answer or failed
+	RetryOutcomes []string                   `protobuf:"bytes,15,rep,name=retryOutcomes,proto3" json:"retryOutcomes,omitempty"`
+	Progress      *AssistantCampaignProgress `protobuf:"bytes,16,opt,name=progress,proto3" json:"progress,omitempty"`
+	Status        string                     `protobuf:"bytes,20,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedBy     uint64                     `protobuf:"varint,21,opt,name=createdBy,proto3" json:"createdBy,omitempty"`
+	UpdatedBy     uint64                     `protobuf:"varint,22,opt,name=updatedBy,proto3" json:"updatedBy,omitempty"`
+	CreatedDate   *timestamppb.Timestamp     `protobuf:"bytes,23,opt,name=createdDate,proto3" json:"createdDate,omitempty"`
+	UpdatedDate   *timestamppb.Timestamp     `protobuf:"bytes,24,opt,name=updatedDate,proto3" json:"updatedDate,omitempty"`
+}
+
+func (x *AssistantCampaign) Reset() {
+	*x = AssistantCampaign{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_assistant_campaign_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AssistantCampaign) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssistantCampaign) ProtoMessage() {}
+
+func (x *AssistantCampaign) ProtoReflect() protoreflect.Message {
+	mi := &file_assistant_campaign_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssistantCampaign.ProtoReflect.Descriptor instead.
+func (*AssistantCampaign) Descriptor() ([]byte, []int) {
+	return file_assistant_campaign_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AssistantCampaign) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AssistantCampaign) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AssistantCampaign) GetAssistantId() uint64 {
+	if x != nil {
+		return x.AssistantId
+	}
+	return 0
+}
+
+func (x *AssistantCampaign) GetAssistantVersion() string {
+	if x != nil {
+		return x.AssistantVersion
+	}
+	return ""
+}
+
+func (x *AssistantCampaign) GetFromNumber() string {
+	if x != nil {
+		return x.FromNumber
+	}
+	return ""
+}
+
+func (x *AssistantCampaign) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *AssistantCampaign) GetConcurrency() uint32 {
+	if x != nil {
+		return x.Concurrency
+	}
+	return 0
+}
+
+func (x *AssistantCampaign) GetCallsPerSecond() float64 {
+	if x != nil {
+		return x.CallsPerSecond
+	}
+	return 0
+}
+
+func (x *AssistantCampaign) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *AssistantCampaign) GetCallingHourStart() string {
+	if x != nil {
+		return x.CallingHourStart
+	}
+	return ""
+}
+
+func (x *AssistantCampaign) GetCallingHourEnd() string {
+	if x != nil {
+		return x.CallingHourEnd
+	}
+	return ""
+}
+
+func (x *AssistantCampaign) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *AssistantCampaign) GetMaxAttempts() uint32 {
+	if x != nil {
+		return x.MaxAttempts
+	}
+	return 0
+}
+
+func (x *AssistantCampaign) GetRetryDelaySecond() uint32 {
+	if x != nil {
+		return x.RetryDelaySecond
+	}
+	return 0
+}
+
+func (x *AssistantCampaign) GetRetryOutcomes() []string {
+	if x != nil {
+		return x.RetryOutcomes
+	}
+	return nil
+}
+
+func (x *AssistantCampaign) GetProgress() *AssistantCampaignProgress {
+	if x != nil {
+		return x.Progress
+	}
+	return nil
+}
+
+func (x *AssistantCampaign) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *AssistantCampaign) GetCreatedBy() uint64 {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return 0
+}
+
+func (x *AssistantCampaign) GetUpdatedBy() uint64 {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return 0
+}
+
+func (x *AssistantCampaign) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+func (x *AssistantCampaign) GetUpdatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedDate
+	}
+	return nil
+}
+
+type AssistantCampaignProgress struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total     uint64 `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Pending   uint64 `protobuf:"varint,2,opt,name=pending,proto3" json:"pending,omitempty"`
+	Dialing   uint64 `protobuf:"varint,3,opt,name=dialing,proto3" json:"dialing,omitempty"`
+	Completed uint64 `protobuf:"varint,4,opt,name=completed,proto3" json:"completed,omitempty"`
+	Failed    uint64 `protobuf:"varint,5,opt,name=failed,proto3" json:"failed,omitempty"`
+	Cancelled uint64 `protobuf:"varint,6,opt,name=cancelled,proto3" json:"cancelled,omitempty"`
+}
+
+func (x *AssistantCampaignProgress) Reset() {
+	*x = AssistantCampaignProgress{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_assistant_campaign_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AssistantCampaignProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssistantCampaignProgress) ProtoMessage() {}
+
+func (x *AssistantCampaignProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_assistant_campaign_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssistantCampaignProgress.ProtoReflect.Descriptor instead.
+func (*AssistantCampaignProgress) Descriptor() ([]byte, []int) {
+	return file_assistant_campaign_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AssistantCampaignProgress) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *AssistantCampaignProgress) GetPending() uint64 {
+	if x != nil {
+		return x.Pending
+	}
+	return 0
+}
+
+func (x *AssistantCampaignProgress) GetDialing() uint64 {
+	if x != nil {
+		return x.Dialing
+	}
+	return 0
+}
+
+func (x *AssistantCampaignProgress) GetCompleted() uint64 {
+	if x != nil {
+		return x.Completed
+	}
+	return 0
+}
+
+func (x *AssistantCampaignProgress) GetFailed() uint64 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *AssistantCampaignProgress) GetCancelled() uint64 {
+	if x != nil {
+		return x.Cancelled
+	}
+	return 0
+}
+
+// contact of the campaign with the outcome of its last attempt
+type AssistantCampaignContact struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                  uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	AssistantCampaignId uint64 `protobuf:"varint,2,opt,name=assistantCampaignId,proto3" json:"assistantCampaignId,omitempty"`
+	ToNumber            string `protobuf:"bytes,3,opt,name=toNumber,proto3" json:"toNumber,omitempty"`
+	Timezone            string `protobuf:"bytes,4,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	// PENDING, DIALING, COMPLETED, FAILED or CANCELLED
+	State                   string                 `protobuf:"bytes,5,opt,name=state,proto3" json:"state,omitempty"`
+	Attempts                uint32                 `protobuf:"varint,6,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	NextAttemptDate         *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=nextAttemptDate,proto3" json:"nextAttemptDate,omitempty"`
+	AssistantConversationId uint64                 `protobuf:"varint,8,opt,name=assistantConversationId,proto3" json:"assistantConversationId,omitempty"`
+	// completed, busy, no-answer or failed
+	LastOutcome string                 `protobuf:"bytes,9,opt,name=lastOutcome,proto3" json:"lastOutcome,omitempty"`
+	LastError   string                 `protobuf:"bytes,10,opt,name=lastError,proto3" json:"lastError,omitempty"`
+	CreatedDate *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=createdDate,proto3" json:"createdDate,omitempty"`
+	UpdatedDate *timestamppb.Timestamp `protobuf:"bytes,18,opt,name=updatedDate,proto3" json:"updatedDate,omitempty"`
+}
+
+func (x *AssistantCampaignContact) Reset() {
+	*x = AssistantCampaignContact{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_assistant_campaign_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AssistantCampaignContact) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssistantCampaignContact) ProtoMessage() {}
+
+func (x *AssistantCampaignContact) ProtoReflect() protoreflect.Message {
+	mi := &file_assistant_campaign_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssistantCampaignContact.ProtoReflect.Descriptor instead.
+func (*AssistantCampaignContact) Descriptor() ([]byte, []int) {
+	return file_assistant_campaign_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AssistantCampaignContact) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AssistantCampaignContact) GetAssistantCampaignId() uint64 {
+	if x != nil {
+		return x.AssistantCampaignId
+	}
+	return 0
+}
+
+func (x *AssistantCampaignContact) GetToNumber() string {
+	if x != nil {
+		return x.ToNumber
+	}
+	return ""
+}
+
+func (x *AssistantCampaignContact) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *AssistantCampaignContact) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *AssistantCampaignContact) GetAttempts() uint32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *AssistantCampaignContact) GetNextAttemptDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextAttemptDate
+	}
+	return nil
+}
+
+func (x *AssistantCampaignContact) GetAssistantConversationId() uint64 {
+	if x != nil {
+		return x.AssistantConversationId
+	}
+	return 0
+}
+
+func (x *AssistantCampaignContact) GetLastOutcome() string {
+	if x != nil {
+		return x.LastOutcome
+	}
+	return ""
+}
+
+func (x *AssistantCampaignContact) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *AssistantCampaignContact) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+func (x *AssistantCampaignContact) GetUpdatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedDate
+	}
+	return nil
+}
+
+type CreateAssistantCampaignContact struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ToNumber string                `protobuf:"bytes,1,opt,name=toNumber,proto3" json:"toNumber,omitempty"`
+	Metadata map[string]*anypb.Any `protobuf:"bytes,2,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Args     map[string]*anypb.Any `protobuf:"bytes,3,rep,name=args,proto3" json:"args,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Options  map[string]*anypb.Any `protobuf:"bytes,4,rep,name=options,proto3" json:"options,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// overrides the timezone of the campaign for the calling hours
+	Timezone string `protobuf:"bytes,5,opt,name=timezone,proto3" json:"timezone,omitempty"`
+}
+
+func (x *CreateAssistantCampaignContact) Reset() {
+	*x = CreateAssistantCampaignContact{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_assistant_campaign_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateAssistantCampaignContact) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAssistantCampaignContact) ProtoMessage() {}
+
+func (x *CreateAssistantCampaignContact) ProtoReflect() protoreflect.Message {
+	mi := &file_assistant_campaign_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAssistantCampaignContact.ProtoReflect.Descriptor instead.
+func (*CreateAssistantCampaignContact) Descriptor() ([]byte, []int) {
+	return file_assistant_campaign_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateAssistantCampaignContact) GetToNumber() string {
+	if x != nil {
+		return x.ToNumber
+	}
+	return ""
+}
+
+func (x *CreateAssistantCampaignContact) GetMetadata() map[string]*anypb.Any {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *CreateAssistantCampaignContact) GetArgs() map[string]*anypb.Any {
+	if x != nil {
+		return x.Args
+	}
+	return nil
+}
+
+func (x *CreateAssistantCampaignContact) GetOptions() map[string]*anypb.Any {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *CreateAssistantCampaignContact) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+type CreateAssistantCampaignRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name             string                            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Assistant        *AssistantDefinition              `protobuf:"bytes,2,opt,name=assistant,proto3" json:"assistant,omitempty"`
+	FromNumber       string                            `protobuf:"bytes,3,opt,name=fromNumber,proto3" json:"fromNumber,omitempty"`
+	Concurrency      uint32                            `protobuf:"varint,4,opt,name=concurrency,proto3" json:"concurrency,omitempty"`
+	CallsPerSecond   float64                           `protobuf:"fixed64,5,opt,name=callsPerSecond,proto3" json:"callsPerSecond,omitempty"`
+	Timezone         string                            `protobuf:"bytes,6,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	CallingHourStart string                            `protobuf:"bytes,7,opt,name=callingHourStart,proto3" json:"callingHourStart,omitempty"`
+	CallingHourEnd   string                            `protobuf:"bytes,8,opt,name=callingHourEnd,proto3" json:"callingHourEnd,omitempty"`
+	StartDate        *timestamppb.Timestamp            `protobuf:"bytes,9,opt,name=startDate,proto3" json:"startDate,omitempty"`
+	MaxAttempts      uint32                            `protobuf:"varint,10,opt,name=maxAttempts,proto3" json:"maxAttempts,omitempty"`
+	RetryDelaySecond uint32                            `protobuf:"varint,11,opt,name=retryDelaySecond,proto3" json:"retryDelaySecond,omitempty"`
+	RetryOutcomes    []string                          `protobuf:"bytes,12,rep,name=retryOutcomes,proto3" json:"retryOutcomes,omitempty"`
+	Contacts         []*CreateAssistantCampaignContact `protobuf:"bytes,13,rep,name=contacts,proto3" json:"contacts,omitempty"`
+}
+
+func (x *CreateAssistantCampaignRequest) Reset() {
+	*x = CreateAssistantCampaignRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_assistant_campaign_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateAssistantCampaignRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAssistantCampaignRequest) ProtoMessage() {}
+
+func (x *CreateAssistantCampaignRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_assistant_campaign_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAssistantCampaignRequest.ProtoReflect.Descriptor instead.
+func (*CreateAssistantCampaignRequest) Descriptor() ([]byte, []int) {
+	return file_assistant_campaign_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateAssistantCampaignRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateAssistantCampaignRequest) GetAssistant() *AssistantDefinition {
+	if x != nil {
+		return x.Assistant
+	}
+	return nil
+}
+
+func (x *CreateAssistantCampaignRequest) GetFromNumber() string {
+	if x != nil {
+		return x.FromNumber
+	}
+	return ""
+}
+
+func (x *CreateAssistantCampaignRequest) GetConcurrency() uint32 {
+	if x != nil {
+		return x.Concurrency
+	}
+	return 0
+}
+
+func (x *CreateAssistantCampaignRequest) GetCallsPerSecond() float64 {
+	if x != nil {
+		return x.CallsPerSecond
+	}
+	return 0
+}
+
+func (x *CreateAssistantCampaignRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *CreateAssistantCampaignRequest) GetCallingHourStart() string {
+	if x != nil {
+		return x.CallingHourStart
+	}
+	return ""
+}
+
+func (x *CreateAssistantCampaignRequest) GetCallingHourEnd() string {
+	if x != nil {
+		return x.CallingHourEnd
+	}
+	return ""
+}
+
+func (x *CreateAssistantCampaignRequest) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *CreateAssistantCampaignRequest) GetMaxAttempts() uint32 {
+	if x != nil {
+		return x.MaxAttempts
+	}
+	return 0
+}
+
+func (x *CreateAssistantCampaignRequest) GetRetryDelaySecond() uint32 {
+	if x != nil {
+		return x.RetryDelaySecond
+	}
+	return 0
+}
+
+func (x *CreateAssistantCampaignRequest) GetRetryOutcomes() []string {
+	if x != nil {
+		return x.RetryOutcomes
+	}
+	return nil
+}
+
+func (x *CreateAssistantCampaignRequest) GetContacts() []*CreateAssistantCampaignContact {
+	if x != nil {
+		return x.Contacts
+	}
+	return nil
+}
+
+type GetAssistantCampaignRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	AssistantId uint64 `protobuf:"varint,2,opt,name=assistantId,proto3" json:"assistantId,omitempty"`
+}
+
+func (x *GetAssistantCampaignRequest) Reset() {
+	*x = GetAssistantCampaignRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_assistant_campaign_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAssistantCampaignRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAssistantCampaignRequest) ProtoMessage() {}
+
+func (x *GetAssistantCampaignRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_assistant_campaign_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAssistantCampaignRequest.ProtoReflect.Descriptor instead.
+func (*GetAssistantCampaignRequest) Descriptor() ([]byte, []int) {
+	return file_assistant_campaign_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetAssistantCampaignRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GetAssistantCampaignRequest) GetAssistantId() uint64 {
+	if x != nil {
+		return x.AssistantId
+	}
+	return 0
+}
+
+// pause, resume or cancel the campaign
+type UpdateAssistantCampaignStateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	AssistantId uint64 `protobuf:"varint,2,opt,name=assistantId,proto3" json:"assistantId,omitempty"`
+	State       string `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+}
+
+func (x *UpdateAssistantCampaignStateRequest) Reset() {
+	*x = UpdateAssistantCampaignStateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_assistant_campaign_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateAssistantCampaignStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAssistantCampaignStateRequest) ProtoMessage() {}
+
+func (x *UpdateAssistantCampaignStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_assistant_campaign_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAssistantCampaignStateRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAssistantCampaignStateRequest) Descriptor() ([]byte, []int) {
+	return file_assistant_campaign_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UpdateAssistantCampaignStateRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateAssistantCampaignStateRequest) GetAssistantId() uint64 {
+	if x != nil {
+		return x.AssistantId
+	}
+	return 0
+}
+
+func (x *UpdateAssistantCampaignStateRequest) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+type DeleteAssistantCampaignRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	AssistantId uint64 `protobuf:"varint,2,opt,name=assistantId,proto3" json:"assistantId,omitempty"`
+}
+
+func (x *DeleteAssistantCampaignRequest) Reset() {
+	*x = DeleteAssistantCampaignRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_assistant_campaign_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteAssistantCampaignRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAssistantCampaignRequest) ProtoMessage() {}
+
+func (x *DeleteAssistantCampaignRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_assistant_campaign_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAssistantCampaignRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAssistantCampaignRequest) Descriptor() ([]byte, []int) {
+	return file_assistant_campaign_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DeleteAssistantCampaignRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DeleteAssistantCampaignRequest) GetAssistantId() uint64 {
+	if x != nil {
+		return x.AssistantId
+	}
+	return 0
+}
+
+type GetAssistantCampaignResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code    int32              `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Success bool               `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	Data    *AssistantCampaign `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Error   *Error             `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *GetAssistantCampaignResponse) Reset() {
+	*x = GetAssistantCampaignResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_assistant_campaign_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAssistantCampaignResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAssistantCampaignResponse) ProtoMessage() {}
+
+func (x *GetAssistantCampaignResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_assistant_campaign_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAssistantCampaignResponse.ProtoReflect.Descriptor instead.
+func (*GetAssistantCampaignResponse) Descriptor() ([]byte, []int) {
+	return file_assistant_campaign_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetAssistantCampaignResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *GetAssistantCampaignResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetAssistantCampaignResponse) GetData() *AssistantCampaign {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *GetAssistantCampaignResponse) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type GetAllAssistantCampaignRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AssistantId uint64      `protobuf:"varint,1,opt,name=assistantId,proto3" json:"assistantId,omitempty"`
+	Paginate    *Paginate   `protobuf:"bytes,2,opt,name=paginate,proto3" json:"paginate,omitempty"`
+	Criterias   []*Criteria `protobuf:"bytes,3,rep,name=criterias,proto3" json:"criterias,omitempty"`
+}
+
+func (x *GetAllAssistantCampaignRequest) Reset() {
+	*x = GetAllAssistantCampaignRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_assistant_campaign_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAllAssistantCampaignRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllAssistantCampaignRequest) ProtoMessage() {}
+
+func (x *GetAllAssistantCampaignRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_assistant_campaign_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllAssistantCampaignRequest.ProtoReflect.Descriptor instead.
+func (*GetAllAssistantCampaignRequest) Descriptor() ([]byte, []int) {
+	return file_assistant_campaign_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetAllAssistantCampaignRequest) GetAssistantId() uint64 {
+	if x != nil {
+		return x.AssistantId
+	}
+	return 0
+}
+
+func (x *GetAllAssistantCampaignRequest) GetPaginate() *Paginate {
+	if x != nil {
+		return x.Paginate
+	}
+	return nil
+}
+
+func (x *GetAllAssistantCampaignRequest) GetCriterias() []*Criteria {
+	if x != nil {
+		return x.Criterias
+	}
+	return nil
+}
+
+type GetAllAssistantCampaignResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code      int32                `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Success   bool                 `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	Data      []*AssistantCampaign `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`
+	Error     *Error               `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	Paginated *Paginated           `protobuf:"bytes,5,opt,name=paginated,proto3" json:"paginated,omitempty"`
+}
+
+func (x *GetAllAssistantCampaignResponse) Reset() {
+	*x = GetAllAssistantCampaignResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_assistant_campaign_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAllAssistantCampaignResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllAssistantCampaignResponse) ProtoMessage() {}
+
+func (x *GetAllAssistantCampaignResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_assistant_campaign_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllAssistantCampaignResponse.ProtoReflect.Descriptor instead.
+func (*GetAllAssistantCampaignResponse) Descriptor() ([]byte, []int) {
+	return file_assistant_campaign_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetAllAssistantCampaignResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *GetAllAssistantCampaignResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetAllAssistantCampaignResponse) GetData() []*AssistantCampaign {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *GetAllAssistantCampaignResponse) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+func (x *GetAllAssistantCampaignResponse) GetPaginated() *Paginated {
+	if x != nil {
+		return x.Paginated
+	}
+	return nil
+}
+
+type GetAllAssistantCampaignContactRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AssistantId         uint64      `protobuf:"varint,1,opt,name=assistantId,proto3" json:"assistantId,omitempty"`
+	AssistantCampaignId uint64      `protobuf:"varint,2,opt,name=assistantCampaignId,proto3" json:"assistantCampaignId,omitempty"`
+	Paginate            *Paginate   `protobuf:"bytes,3,opt,name=paginate,proto3" json:"paginate,omitempty"`
+	Criterias           []*Criteria `protobuf:"bytes,4,rep,name=criterias,proto3" json:"criterias,omitempty"`
+}
+
+func (x *GetAllAssistantCampaignContactRequest) Reset() {
+	*x = GetAllAssistantCampaignContactRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_assistant_campaign_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAllAssistantCampaignContactRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllAssistantCampaignContactRequest) ProtoMessage() {}
+
+func (x *GetAllAssistantCampaignContactRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_assistant_campaign_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllAssistantCampaignContactRequest.ProtoReflect.Descriptor instead.
+func (*GetAllAssistantCampaignContactRequest) Descriptor() ([]byte, []int) {
+	return file_assistant_campaign_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetAllAssistantCampaignContactRequest) GetAssistantId() uint64 {
+	if x != nil {
+		return x.AssistantId
+	}
+	return 0
+}
+
+func (x *GetAllAssistantCampaignContactRequest) GetAssistantCampaignId() uint64 {
+	if x != nil {
+		return x.AssistantCampaignId
+	}
+	return 0
+}
+
+func (x *GetAllAssistantCampaignContactRequest) GetPaginate() *Paginate {
+	if x != nil {
+		return x.Paginate
+	}
+	return nil
+}
+
+func (x *GetAllAssistantCampaignContactRequest) GetCriterias() []*Criteria {
+	if x != nil {
+		return x.Criterias
+	}
+	return nil
+}
+
+type GetAllAssistantCampaignContactResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code      int32                       `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Success   bool                        `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	Data      []*AssistantCampaignContact `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`
+	Error     *Error                      `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	Paginated *Paginated                  `protobuf:"bytes,5,opt,name=paginated,proto3" json:"paginated,omitempty"`
+}
+
+func (x *GetAllAssistantCampaignContactResponse) Reset() {
+	*x = GetAllAssistantCampaignContactResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_assistant_campaign_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAllAssistantCampaignContactResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllAssistantCampaignContactResponse) ProtoMessage() {}
+
+func (x *GetAllAssistantCampaignContactResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_assistant_campaign_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllAssistantCampaignContactResponse.ProtoReflect.Descriptor instead.
+func (*GetAllAssistantCampaignContactResponse) Descriptor() ([]byte, []int) {
+	return file_assistant_campaign_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetAllAssistantCampaignContactResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *GetAllAssistantCampaignContactResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetAllAssistantCampaignContactResponse) GetData() []*AssistantCampaignContact {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *GetAllAssistantCampaignContactResponse) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+func (x *GetAllAssistantCampaignContactResponse) GetPaginated() *Paginated {
+	if x != nil {
+		return x.Paginated
+	}
+	return nil
+}
+
+var File_assistant_campaign_proto protoreflect.FileDescriptor
+
+var file_assistant_campaign_proto_rawDesc = []byte{
+	0x0a, 0x18, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x2d, 0x63, 0x61, 0x6d, 0x70,
+	0x61, 0x69, 0x67, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x61, 0x73, 0x73, 0x69,
+	0x73, 0x74, 0x61, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x61, 0x6e, 0x79, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0xc9, 0x06, 0x0a, 0x11, 0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e,
+	0x74, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x12, 0x12, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x24, 0x0a, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x49, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x0b, 0x61, 0x73, 0x73, 0x69,
+	0x73, 0x74, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x10, 0x61, 0x73, 0x73, 0x69, 0x73,
+	0x74, 0x61, 0x6e, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x10, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x4e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x4e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6e,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b,
+	0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x26, 0x0a, 0x0e, 0x63,
+	0x61, 0x6c, 0x6c, 0x73, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x0e, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x12,
+	0x2a, 0x0a, 0x10, 0x63, 0x61, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x48, 0x6f, 0x75, 0x72, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x63, 0x61, 0x6c, 0x6c, 0x69,
+	0x6e, 0x67, 0x48, 0x6f, 0x75, 0x72, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x63,
+	0x61, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x48, 0x6f, 0x75, 0x72, 0x45, 0x6e, 0x64, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x61, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x48, 0x6f, 0x75, 0x72,
+	0x45, 0x6e, 0x64, 0x12, 0x38, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x20, 0x0a,
+	0x0b, 0x6d, 0x61, 0x78, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12,
+	0x2a, 0x0a, 0x10, 0x72, 0x65, 0x74, 0x72, 0x79, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x53, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x72, 0x65, 0x74, 0x72, 0x79,
+	0x44, 0x65, 0x6c, 0x61, 0x79, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x72,
+	0x65, 0x74, 0x72, 0x79, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x73, 0x18, 0x0f, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x74, 0x72, 0x79, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65,
+	0x73, 0x12, 0x44, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x10, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x5f,
+	0x61, 0x70, 0x69, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x43, 0x61, 0x6d,
+	0x70, 0x61, 0x69, 0x67, 0x6e, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x08, 0x70,
+	0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x20, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x18, 0x15, 0x20, 0x01,
+	0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42,
+	0x79, 0x12, 0x20, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x18, 0x16,
+	0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x42, 0x79, 0x12, 0x3c, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x44, 0x61,
+	0x74, 0x65, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74,
+	0x65, 0x12, 0x3c, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65,
+	0x18, 0x18, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x22,
+	0xd1, 0x01, 0x0a, 0x19, 0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x43, 0x61, 0x6d,
+	0x70, 0x61, 0x69, 0x67, 0x6e, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a,
+	0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01,
+	0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x1c, 0x0a, 0x07, 0x70, 0x65, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x07, 0x70, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x1c, 0x0a, 0x07, 0x64, 0x69, 0x61, 0x6c, 0x69, 0x6e, 0x67,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x07, 0x64, 0x69, 0x61, 0x6c,
+	0x69, 0x6e, 0x67, 0x12, 0x20, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70,
+	0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65,
+	0x64, 0x12, 0x20, 0x0a, 0x09, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x09, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x6c, 0x65, 0x64, 0x22, 0x8e, 0x04, 0x0a, 0x18, 0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e,
+	0x74, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74,
+	0x12, 0x12, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x34, 0x0a, 0x13, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e,
+	0x74, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x13, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74,
+	0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x6f,
+	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x6f,
+	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f,
+	0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f,
+	0x6e, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x74, 0x74, 0x65,
+	0x6d, 0x70, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x61, 0x74, 0x74, 0x65,
+	0x6d, 0x70, 0x74, 0x73, 0x12, 0x44, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x41, 0x74, 0x74, 0x65,
+	0x6d, 0x70, 0x74, 0x44, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x41,
+	0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x3c, 0x0a, 0x17, 0x61, 0x73,
+	0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52,
+	0x17, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x6c, 0x61, 0x73, 0x74,
+	0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6c,
+	0x61, 0x73, 0x74, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x61,
+	0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c,
+	0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x3c, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x3c, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x44, 0x61, 0x74, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x44, 0x61, 0x74, 0x65, 0x22, 0xc8, 0x04, 0x0a, 0x1e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41,
+	0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e,
+	0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x6f, 0x4e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x6f, 0x4e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x12, 0x57, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e,
+	0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x73, 0x73, 0x69,
+	0x73, 0x74, 0x61, 0x6e, 0x74, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x43, 0x6f, 0x6e,
+	0x74, 0x61, 0x63, 0x74, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x4b, 0x0a, 0x04,
+	0x61, 0x72, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x61, 0x73, 0x73,
+	0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69,
+	0x67, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x2e, 0x41, 0x72, 0x67, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x12, 0x54, 0x0a, 0x07, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x61, 0x73, 0x73,
+	0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69,
+	0x67, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x1a, 0x51, 0x0a, 0x0d, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2a,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x41, 0x6e, 0x79, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x4d,
+	0x0a, 0x09, 0x41, 0x72, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2a, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41,
+	0x6e, 0x79, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x50, 0x0a,
+	0x0c, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x2a, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x41, 0x6e, 0x79, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
+	0xbb, 0x04, 0x0a, 0x1e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x73, 0x73, 0x69, 0x73, 0x74,
+	0x61, 0x6e, 0x74, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x32, 0x0a, 0x09, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74,
+	0x61, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x41, 0x73, 0x73, 0x69,
+	0x73, 0x74, 0x61, 0x6e, 0x74, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x09, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x72,
+	0x6f, 0x6d, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x66, 0x72, 0x6f, 0x6d, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f,
+	0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0b, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x26, 0x0a, 0x0e,
+	0x63, 0x61, 0x6c, 0x6c, 0x73, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x50, 0x65, 0x72, 0x53, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65,
+	0x12, 0x2a, 0x0a, 0x10, 0x63, 0x61, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x48, 0x6f, 0x75, 0x72, 0x53,
+	0x74, 0x61, 0x72, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x63, 0x61, 0x6c, 0x6c,
+	0x69, 0x6e, 0x67, 0x48, 0x6f, 0x75, 0x72, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x26, 0x0a, 0x0e,
+	0x63, 0x61, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x48, 0x6f, 0x75, 0x72, 0x45, 0x6e, 0x64, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x61, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x48, 0x6f, 0x75,
+	0x72, 0x45, 0x6e, 0x64, 0x12, 0x38, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74,
+	0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x20,
+	0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73,
+	0x12, 0x2a, 0x0a, 0x10, 0x72, 0x65, 0x74, 0x72, 0x79, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x53, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x72, 0x65, 0x74, 0x72,
+	0x79, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x12, 0x24, 0x0a, 0x0d,
+	0x72, 0x65, 0x74, 0x72, 0x79, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x73, 0x18, 0x0c, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x74, 0x72, 0x79, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d,
+	0x65, 0x73, 0x12, 0x49, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x73, 0x18, 0x0d,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74,
+	0x5f, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x73, 0x73, 0x69, 0x73,
+	0x74, 0x61, 0x6e, 0x74, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x43, 0x6f, 0x6e, 0x74,
+	0x61, 0x63, 0x74, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x73, 0x22, 0x57, 0x0a,
+	0x1b, 0x47, 0x65, 0x74, 0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x43, 0x61, 0x6d,
+	0x70, 0x61, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x24, 0x0a, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x73,
+	0x74, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x75, 0x0a, 0x23, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67,
+	0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x24, 0x0a, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x49, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x0b, 0x61, 0x73, 0x73, 0x69,
+	0x73, 0x74, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0x5a, 0x0a,
+	0x1e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74,
+	0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x12, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x24, 0x0a, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74,
+	0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x0b, 0x61, 0x73,
+	0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x22, 0xa0, 0x01, 0x0a, 0x1c, 0x47, 0x65,
+	0x74, 0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69,
+	0x67, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x34, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61,
+	0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74,
+	0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1c,
+	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x96, 0x01, 0x0a,
+	0x1e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74,
+	0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x24, 0x0a, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74,
+	0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61,
+	0x74, 0x65, 0x52, 0x08, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x12, 0x27, 0x0a, 0x09,
+	0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x09, 0x2e, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x52, 0x09, 0x63, 0x72, 0x69, 0x74,
+	0x65, 0x72, 0x69, 0x61, 0x73, 0x22, 0xcd, 0x01, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c,
+	0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x34, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e,
+	0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x43,
+	0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x28, 0x0a, 0x09, 0x70,
+	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a,
+	0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x52, 0x09, 0x70, 0x61, 0x67, 0x69,
+	0x6e, 0x61, 0x74, 0x65, 0x64, 0x22, 0xd3, 0x01, 0x0a, 0x25, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c,
+	0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67,
+	0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x24, 0x0a, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74,
+	0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x34, 0x0a, 0x13, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61,
+	0x6e, 0x74, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x13, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e,
+	0x74, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x08, 0x70,
+	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e,
+	0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x52, 0x08, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
+	0x74, 0x65, 0x12, 0x27, 0x0a, 0x09, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61,
+	0x52, 0x09, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x73, 0x22, 0xdb, 0x01, 0x0a, 0x26,
+	0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x43,
+	0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x12, 0x3b, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x27, 0x2e, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x5f, 0x61,
+	0x70, 0x69, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x43, 0x61, 0x6d, 0x70,
+	0x61, 0x69, 0x67, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x12, 0x1c, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x06, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12,
+	0x28, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x52, 0x09,
+	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x42, 0x1c, 0x5a, 0x1a, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x61, 0x70, 0x69, 0x64, 0x61, 0x61, 0x69,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_assistant_campaign_proto_rawDescOnce sync.Once
+	file_assistant_campaign_proto_rawDescData = file_assistant_campaign_proto_rawDesc
+)
+
+func file_assistant_campaign_proto_rawDescGZIP() []byte {
+	file_assistant_campaign_proto_rawDescOnce.Do(func() {
+		file_assistant_campaign_proto_rawDescData = protoimpl.X.CompressGZIP(file_assistant_campaign_proto_rawDescData)
+	})
+	return file_assistant_campaign_proto_rawDescData
+}
+
+var file_assistant_campaign_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_assistant_campaign_proto_goTypes = []any{
+	(*AssistantCampaign)(nil),                      // 0: assistant_api.AssistantCampaign
+	(*AssistantCampaignProgress)(nil),              // 1: assistant_api.AssistantCampaignProgress
+	(*AssistantCampaignContact)(nil),               // 2: assistant_api.AssistantCampaignContact
+	(*CreateAssistantCampaignContact)(nil),         // 3: assistant_api.CreateAssistantCampaignContact
+	(*CreateAssistantCampaignRequest)(nil),         // 4: assistant_api.CreateAssistantCampaignRequest
+	(*GetAssistantCampaignRequest)(nil),            // 5: assistant_api.GetAssistantCampaignRequest
+	(*UpdateAssistantCampaignStateRequest)(nil),    // 6: assistant_api.UpdateAssistantCampaignStateRequest
+	(*DeleteAssistantCampaignRequest)(nil),         // 7: assistant_api.DeleteAssistantCampaignRequest
+	(*GetAssistantCampaignResponse)(nil),           // 8: assistant_api.GetAssistantCampaignResponse
+	(*GetAllAssistantCampaignRequest)(nil),         // 9: assistant_api.GetAllAssistantCampaignRequest
+	(*GetAllAssistantCampaignResponse)(nil),        // 10: assistant_api.GetAllAssistantCampaignResponse
+	(*GetAllAssistantCampaignContactRequest)(nil),  // 11: assistant_api.GetAllAssistantCampaignContactRequest
+	(*GetAllAssistantCampaignContactResponse)(nil), // 12: assistant_api.GetAllAssistantCampaignContactResponse
+	nil,                           // 13: assistant_api.CreateAssistantCampaignContact.MetadataEntry
+	nil,                           // 14: assistant_api.CreateAssistantCampaignContact.ArgsEntry
+	nil,                           // 15: assistant_api.CreateAssistantCampaignContact.OptionsEntry
+	(*timestamppb.Timestamp)(nil), // 16: google.protobuf.Timestamp
+	(*AssistantDefinition)(nil),   // 17: AssistantDefinition
+	(*Error)(nil),                 // 18: Error
+	(*Paginate)(nil),              // 19: Paginate
+	(*Criteria)(nil),              // 20: Criteria
+	(*Paginated)(nil),             // 21: Paginated
+	(*anypb.Any)(nil),             // 22: google.protobuf.Any
+}
+var file_assistant_campaign_proto_depIdxs = []int32{
+	16, // 0: assistant_api.AssistantCampaign.startDate:type_name -> google.protobuf.Timestamp
+	1,  // 1: assistant_api.AssistantCampaign.progress:type_name -> assistant_api.AssistantCampaignProgress
+	16, // 2: assistant_api.AssistantCampaign.createdDate:type_name -> google.protobuf.Timestamp
+	16, // 3: assistant_api.AssistantCampaign.updatedDate:type_name -> google.protobuf.Timestamp
+	16, // 4: assistant_api.AssistantCampaignContact.nextAttemptDate:type_name -> google.protobuf.Timestamp
+	16, // 5: assistant_api.AssistantCampaignContact.createdDate:type_name -> google.protobuf.Timestamp
+	16, // 6: assistant_api.AssistantCampaignContact.updatedDate:type_name -> google.protobuf.Timestamp
+	13, // 7: assistant_api.CreateAssistantCampaignContact.metadata:type_name -> assistant_api.CreateAssistantCampaignContact.MetadataEntry
+	14, // 8: assistant_api.CreateAssistantCampaignContact.args:type_name -> assistant_api.CreateAssistantCampaignContact.ArgsEntry
+	15, // 9: assistant_api.CreateAssistantCampaignContact.options:type_name -> assistant_api.CreateAssistantCampaignContact.OptionsEntry
+	17, // 10: assistant_api.CreateAssistantCampaignRequest.assistant:type_name -> AssistantDefinition
+	16, // 11: assistant_api.CreateAssistantCampaignRequest.startDate:type_name -> google.protobuf.Timestamp
+	3,  // 12: assistant_api.CreateAssistantCampaignRequest.contacts:type_name -> assistant_api.CreateAssistantCampaignContact
+	0,  // 13: assistant_api.GetAssistantCampaignResponse.data:type_name -> assistant_api.AssistantCampaign
+	18, // 14: assistant_api.GetAssistantCampaignResponse.error:type_name -> Error
+	19, // 15: assistant_api.GetAllAssistantCampaignRequest.paginate:type_name -> Paginate
+	20, // 16: assistant_api.GetAllAssistantCampaignRequest.criterias:type_name -> Criteria
+	0,  // 17: assistant_api.GetAllAssistantCampaignResponse.data:type_name -> assistant_api.AssistantCampaign
+	18, // 18: assistant_api.GetAllAssistantCampaignResponse.error:type_name -> Error
+	21, // 19: assistant_api.GetAllAssistantCampaignResponse.paginated:type_name -> Paginated
+	19, // 20: assistant_api.GetAllAssistantCampaignContactRequest.paginate:type_name -> Paginate
+	20, // 21: assistant_api.GetAllAssistantCampaignContactRequest.criterias:type_name -> Criteria
+	2,  // 22: assistant_api.GetAllAssistantCampaignContactResponse.data:type_name -> assistant_api.AssistantCampaignContact
+	18, // 23: assistant_api.GetAllAssistantCampaignContactResponse.error:type_name -> Error
+	21, // 24: assistant_api.GetAllAssistantCampaignContactResponse.paginated:type_name -> Paginated
+	22, // 25: assistant_api.CreateAssistantCampaignContact.MetadataEntry.value:type_name -> google.protobuf.Any
+	22, // 26: assistant_api.CreateAssistantCampaignContact.ArgsEntry.value:type_name -> google.protobuf.Any
+	22, // 27: assistant_api.CreateAssistantCampaignContact.OptionsEntry.value:type_name -> google.protobuf.Any
+	28, // [28:28] is the sub-list for method output_type
+	28, // [28:28] is the sub-list for method input_type
+	28, // [28:28] is the sub-list for extension type_name
+	28, // [28:28] is the sub-list for extension extendee
+	0,  // [0:28] is the sub-list for field type_name
+}
+
+func init() { file_assistant_campaign_proto_init() }
+func file_assistant_campaign_proto_init() {
+	if File_assistant_campaign_proto != nil {
+		return
+	}
+	file_common_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_assistant_campaign_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*AssistantCampaign); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_assistant_campaign_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*AssistantCampaignProgress); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_assistant_campaign_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*AssistantCampaignContact); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_assistant_campaign_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateAssistantCampaignContact); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_assistant_campaign_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateAssistantCampaignRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_assistant_campaign_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*GetAssistantCampaignRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_assistant_campaign_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateAssistantCampaignStateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_assistant_campaign_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteAssistantCampaignRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_assistant_campaign_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*GetAssistantCampaignResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_assistant_campaign_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*GetAllAssistantCampaignRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_assistant_campaign_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*GetAllAssistantCampaignResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_assistant_campaign_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*GetAllAssistantCampaignContactRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_assistant_campaign_proto_msgTypes[12].Exporter = func(v any, i int) any {
+			switch v := v.(*GetAllAssistantCampaignContactResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_assistant_campaign_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   16,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_assistant_campaign_proto_goTypes,
+		DependencyIndexes: file_assistant_campaign_proto_depIdxs,
+		MessageInfos:      file_assistant_campaign_proto_msgTypes,
+	}.Build()
+	File_assistant_campaign_proto = out.File
+	file_assistant_campaign_proto_rawDesc = nil
+	file_assistant_campaign_proto_goTypes = nil
+	file_assistant_campaign_proto_depIdxs = nil
+}
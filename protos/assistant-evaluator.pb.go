@@ -0,0 +1,1690 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        v3.20.3
+// source: assistant-evaluator.proto
+
+package protos
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// evaluator scoring every completed conversation of the assistant
+type AssistantEvaluator struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// llm_judge, tool_called, ended_by or latency
+	Type        string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Description string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Options     []*Metadata            `protobuf:"bytes,5,rep,name=options,proto3" json:"options,omitempty"`
+	AssistantId uint64                 `protobuf:"varint,10,opt,name=assistantId,proto3" json:"assistantId,omitempty"`
+	Status      string                 `protobuf:"bytes,12,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedBy   uint64                 `protobuf:"varint,13,opt,name=createdBy,proto3" json:"createdBy,omitempty"`
+	CreatedUser *User                  `protobuf:"bytes,14,opt,name=createdUser,proto3" json:"createdUser,omitempty"`
+	UpdatedBy   uint64                 `protobuf:"varint,15,opt,name=updatedBy,proto3" json:"updatedBy,omitempty"`
+	UpdatedUser *User                  `protobuf:"bytes,16,opt,name=updatedUser,proto3" json:"updatedUser,omitempty"`
+	CreatedDate *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=createdDate,proto3" json:"createdDate,omitempty"`
+	UpdatedDate *timestamppb.Timestamp `protobuf:"bytes,18,opt,name=updatedDate,proto3" json:"updatedDate,omitempty"`
+}
+
+func (x *AssistantEvaluator) Reset() {
+	*x = AssistantEvaluator{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_assistant_evaluator_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AssistantEvaluator) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssistantEvaluator) ProtoMessage() {}
+
+func (x *AssistantEvaluator) ProtoReflect() protoreflect.Message {
+	mi := &file_assistant_evaluator_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssistantEvaluator.ProtoReflect.Descriptor instead.
+func (*AssistantEvaluator) Descriptor() ([]byte, []int) {
+	return file_assistant_evaluator_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AssistantEvaluator) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AssistantEvaluator) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AssistantEvaluator) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *AssistantEvaluator) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *AssistantEvaluator) GetOptions() []*Metadata {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *AssistantEvaluator) GetAssistantId() uint64 {
+	if x != nil {
+		return x.AssistantId
+	}
+	return 0
+}
+
+func (x *AssistantEvaluator) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *AssistantEvaluator) GetCreatedBy() uint64 {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return 0
+}
+
+func (x *AssistantEvaluator) GetCreatedUser() *User {
+	if x != nil {
+		return x.CreatedUser
+	}
+	return nil
+}
+
+func (x *AssistantEvaluator) GetUpdatedBy() uint64 {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return 0
+}
+
+func (x *AssistantEvaluator) GetUpdatedUser() *User {
+	if x != nil {
+		return x.UpdatedUser
+	}
+	return nil
+}
+
+func (x *AssistantEvaluator) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+func (x *AssistantEvaluator) GetUpdatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedDate
+	}
+	return nil
+}
+
+type CreateAssistantEvaluatorRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string      `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Type        string      `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Description string      `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Options     []*Metadata `protobuf:"bytes,5,rep,name=options,proto3" json:"options,omitempty"`
+	AssistantId uint64      `protobuf:"varint,10,opt,name=assistantId,proto3" json:"assistantId,omitempty"`
+}
+
+func (x *CreateAssistantEvaluatorRequest) Reset() {
+	*x = CreateAssistantEvaluatorRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_assistant_evaluator_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateAssistantEvaluatorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAssistantEvaluatorRequest) ProtoMessage() {}
+
+func (x *CreateAssistantEvaluatorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_assistant_evaluator_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAssistantEvaluatorRequest.ProtoReflect.Descriptor instead.
+func (*CreateAssistantEvaluatorRequest) Descriptor() ([]byte, []int) {
+	return file_assistant_evaluator_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateAssistantEvaluatorRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateAssistantEvaluatorRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *CreateAssistantEvaluatorRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateAssistantEvaluatorRequest) GetOptions() []*Metadata {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *CreateAssistantEvaluatorRequest) GetAssistantId() uint64 {
+	if x != nil {
+		return x.AssistantId
+	}
+	return 0
+}
+
+type UpdateAssistantEvaluatorRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          uint64      `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string      `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Type        string      `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Description string      `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Options     []*Metadata `protobuf:"bytes,5,rep,name=options,proto3" json:"options,omitempty"`
+	AssistantId uint64      `protobuf:"varint,10,opt,name=assistantId,proto3" json:"assistantId,omitempty"`
+}
+
+func (x *UpdateAssistantEvaluatorRequest) Reset() {
+	*x = UpdateAssistantEvaluatorRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_assistant_evaluator_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateAssistantEvaluatorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAssistantEvaluatorRequest) ProtoMessage() {}
+
+func (x *UpdateAssistantEvaluatorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_assistant_evaluator_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAssistantEvaluatorRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAssistantEvaluatorRequest) Descriptor() ([]byte, []int) {
+	return file_assistant_evaluator_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *UpdateAssistantEvaluatorRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateAssistantEvaluatorRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateAssistantEvaluatorRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *UpdateAssistantEvaluatorRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpdateAssistantEvaluatorRequest) GetOptions() []*Metadata {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *UpdateAssistantEvaluatorRequest) GetAssistantId() uint64 {
+	if x != nil {
+		return x.AssistantId
+	}
+	return 0
+}
+
+type GetAssistantEvaluatorRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	AssistantId uint64 `protobuf:"varint,2,opt,name=assistantId,proto3" json:"assistantId,omitempty"`
+}
+
+func (x *GetAssistantEvaluatorRequest) Reset() {
+	*x = GetAssistantEvaluatorRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_assistant_evaluator_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAssistantEvaluatorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAssistantEvaluatorRequest) ProtoMessage() {}
+
+func (x *GetAssistantEvaluatorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_assistant_evaluator_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAssistantEvaluatorRequest.ProtoReflect.Descriptor instead.
+func (*GetAssistantEvaluatorRequest) Descriptor() ([]byte, []int) {
+	return file_assistant_evaluator_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetAssistantEvaluatorRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GetAssistantEvaluatorRequest) GetAssistantId() uint64 {
+	if x != nil {
+		return x.AssistantId
+	}
+	return 0
+}
+
+type DeleteAssistantEvaluatorRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	AssistantId uint64 `protobuf:"varint,2,opt,name=assistantId,proto3" json:"assistantId,omitempty"`
+}
+
+func (x *DeleteAssistantEvaluatorRequest) Reset() {
+	*x = DeleteAssistantEvaluatorRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_assistant_evaluator_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteAssistantEvaluatorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAssistantEvaluatorRequest) ProtoMessage() {}
+
+func (x *DeleteAssistantEvaluatorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_assistant_evaluator_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAssistantEvaluatorRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAssistantEvaluatorRequest) Descriptor() ([]byte, []int) {
+	return file_assistant_evaluator_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DeleteAssistantEvaluatorRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DeleteAssistantEvaluatorRequest) GetAssistantId() uint64 {
+	if x != nil {
+		return x.AssistantId
+	}
+	return 0
+}
+
+type GetAssistantEvaluatorResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code    int32               `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Success bool                `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	Data    *AssistantEvaluator `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Error   *Error              `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *GetAssistantEvaluatorResponse) Reset() {
+	*x = GetAssistantEvaluatorResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_assistant_evaluator_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAssistantEvaluatorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAssistantEvaluatorResponse) ProtoMessage() {}
+
+func (x *GetAssistantEvaluatorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_assistant_evaluator_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAssistantEvaluatorResponse.ProtoReflect.Descriptor instead.
+func (*GetAssistantEvaluatorResponse) Descriptor() ([]byte, []int) {
+	return file_assistant_evaluator_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetAssistantEvaluatorResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *GetAssistantEvaluatorResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetAssistantEvaluatorResponse) GetData() *AssistantEvaluator {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *GetAssistantEvaluatorResponse) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type GetAllAssistantEvaluatorRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AssistantId uint64      `protobuf:"varint,1,opt,name=assistantId,proto3" json:"assistantId,omitempty"`
+	Paginate    *Paginate   `protobuf:"bytes,2,opt,name=paginate,proto3" json:"paginate,omitempty"`
+	Criterias   []*Criteria `protobuf:"bytes,3,rep,name=criterias,proto3" json:"criterias,omitempty"`
+}
+
+func (x *GetAllAssistantEvaluatorRequest) Reset() {
+	*x = GetAllAssistantEvaluatorRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_assistant_evaluator_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAllAssistantEvaluatorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllAssistantEvaluatorRequest) ProtoMessage() {}
+
+func (x *GetAllAssistantEvaluatorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_assistant_evaluator_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllAssistantEvaluatorRequest.ProtoReflect.Descriptor instead.
+func (*GetAllAssistantEvaluatorRequest) Descriptor() ([]byte, []int) {
+	return file_assistant_evaluator_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetAllAssistantEvaluatorRequest) GetAssistantId() uint64 {
+	if x != nil {
+		return x.AssistantId
+	}
+	return 0
+}
+
+func (x *GetAllAssistantEvaluatorRequest) GetPaginate() *Paginate {
+	if x != nil {
+		return x.Paginate
+	}
+	return nil
+}
+
+func (x *GetAllAssistantEvaluatorRequest) GetCriterias() []*Criteria {
+	if x != nil {
+		return x.Criterias
+	}
+	return nil
+}
+
+type GetAllAssistantEvaluatorResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code      int32                 `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Success   bool                  `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	Data      []*AssistantEvaluator `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`
+	Error     *Error                `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	Paginated *Paginated            `protobuf:"bytes,5,opt,name=paginated,proto3" json:"paginated,omitempty"`
+}
+
+func (x *GetAllAssistantEvaluatorResponse) Reset() {
+	*x = GetAllAssistantEvaluatorResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_assistant_evaluator_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAllAssistantEvaluatorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllAssistantEvaluatorResponse) ProtoMessage() {}
+
+func (x *GetAllAssistantEvaluatorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_assistant_evaluator_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllAssistantEvaluatorResponse.ProtoReflect.Descriptor instead.
+func (*GetAllAssistantEvaluatorResponse) Descriptor() ([]byte, []int) {
+	return file_assistant_evaluator_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetAllAssistantEvaluatorResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *GetAllAssistantEvaluatorResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetAllAssistantEvaluatorResponse) GetData() []*AssistantEvaluator {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *GetAllAssistantEvaluatorResponse) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+func (x *GetAllAssistantEvaluatorResponse) GetPaginated() *Paginated {
+	if x != nil {
+		return x.Paginated
+	}
+	return nil
+}
+
+// outcome of an evaluator for a conversation
+type AssistantConversationEvaluation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                       uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	AssistantId              uint64                 `protobuf:"varint,2,opt,name=assistantId,proto3" json:"assistantId,omitempty"`
+	AssistantProviderModelId uint64                 `protobuf:"varint,3,opt,name=assistantProviderModelId,proto3" json:"assistantProviderModelId,omitempty"`
+	AssistantConversationId  uint64                 `protobuf:"varint,4,opt,name=assistantConversationId,proto3" json:"assistantConversationId,omitempty"`
+	AssistantEvaluatorId     uint64                 `protobuf:"varint,5,opt,name=assistantEvaluatorId,proto3" json:"assistantEvaluatorId,omitempty"`
+	Name                     string                 `protobuf:"bytes,6,opt,name=name,proto3" json:"name,omitempty"`
+	Type                     string                 `protobuf:"bytes,7,opt,name=type,proto3" json:"type,omitempty"`
+	Score                    float64                `protobuf:"fixed64,8,opt,name=score,proto3" json:"score,omitempty"`
+	Passed                   bool                   `protobuf:"varint,9,opt,name=passed,proto3" json:"passed,omitempty"`
+	Reason                   string                 `protobuf:"bytes,10,opt,name=reason,proto3" json:"reason,omitempty"`
+	CreatedDate              *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=createdDate,proto3" json:"createdDate,omitempty"`
+}
+
+func (x *AssistantConversationEvaluation) Reset() {
+	*x = AssistantConversationEvaluation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_assistant_evaluator_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AssistantConversationEvaluation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssistantConversationEvaluation) ProtoMessage() {}
+
+func (x *AssistantConversationEvaluation) ProtoReflect() protoreflect.Message {
+	mi := &file_assistant_evaluator_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssistantConversationEvaluation.ProtoReflect.Descriptor instead.
+func (*AssistantConversationEvaluation) Descriptor() ([]byte, []int) {
+	return file_assistant_evaluator_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *AssistantConversationEvaluation) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AssistantConversationEvaluation) GetAssistantId() uint64 {
+	if x != nil {
+		return x.AssistantId
+	}
+	return 0
+}
+
+func (x *AssistantConversationEvaluation) GetAssistantProviderModelId() uint64 {
+	if x != nil {
+		return x.AssistantProviderModelId
+	}
+	return 0
+}
+
+func (x *AssistantConversationEvaluation) GetAssistantConversationId() uint64 {
+	if x != nil {
+		return x.AssistantConversationId
+	}
+	return 0
+}
+
+func (x *AssistantConversationEvaluation) GetAssistantEvaluatorId() uint64 {
+	if x != nil {
+		return x.AssistantEvaluatorId
+	}
+	return 0
+}
+
+func (x *AssistantConversationEvaluation) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AssistantConversationEvaluation) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *AssistantConversationEvaluation) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *AssistantConversationEvaluation) GetPassed() bool {
+	if x != nil {
+		return x.Passed
+	}
+	return false
+}
+
+func (x *AssistantConversationEvaluation) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *AssistantConversationEvaluation) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+type GetAllAssistantConversationEvaluationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AssistantId             uint64      `protobuf:"varint,1,opt,name=assistantId,proto3" json:"assistantId,omitempty"`
+	AssistantConversationId uint64      `protobuf:"varint,2,opt,name=assistantConversationId,proto3" json:"assistantConversationId,omitempty"`
+	Paginate                *Paginate   `protobuf:"bytes,3,opt,name=paginate,proto3" json:"paginate,omitempty"`
+	Criterias               []*Criteria `protobuf:"bytes,4,rep,name=criterias,proto3" json:"criterias,omitempty"`
+}
+
+func (x *GetAllAssistantConversationEvaluationRequest) Reset() {
+	*x = GetAllAssistantConversationEvaluationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_assistant_evaluator_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAllAssistantConversationEvaluationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllAssistantConversationEvaluationRequest) ProtoMessage() {}
+
+func (x *GetAllAssistantConversationEvaluationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_assistant_evaluator_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllAssistantConversationEvaluationRequest.ProtoReflect.Descriptor instead.
+func (*GetAllAssistantConversationEvaluationRequest) Descriptor() ([]byte, []int) {
+	return file_assistant_evaluator_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetAllAssistantConversationEvaluationRequest) GetAssistantId() uint64 {
+	if x != nil {
+		return x.AssistantId
+	}
+	return 0
+}
+
+func (x *GetAllAssistantConversationEvaluationRequest) GetAssistantConversationId() uint64 {
+	if x != nil {
+		return x.AssistantConversationId
+	}
+	return 0
+}
+
+func (x *GetAllAssistantConversationEvaluationRequest) GetPaginate() *Paginate {
+	if x != nil {
+		return x.Paginate
+	}
+	return nil
+}
+
+func (x *GetAllAssistantConversationEvaluationRequest) GetCriterias() []*Criteria {
+	if x != nil {
+		return x.Criterias
+	}
+	return nil
+}
+
+type GetAllAssistantConversationEvaluationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code      int32                              `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Success   bool                               `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	Data      []*AssistantConversationEvaluation `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`
+	Error     *Error                             `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	Paginated *Paginated                         `protobuf:"bytes,5,opt,name=paginated,proto3" json:"paginated,omitempty"`
+}
+
+func (x *GetAllAssistantConversationEvaluationResponse) Reset() {
+	*x = GetAllAssistantConversationEvaluationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_assistant_evaluator_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAllAssistantConversationEvaluationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllAssistantConversationEvaluationResponse) ProtoMessage() {}
+
+func (x *GetAllAssistantConversationEvaluationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_assistant_evaluator_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllAssistantConversationEvaluationResponse.ProtoReflect.Descriptor instead.
+func (*GetAllAssistantConversationEvaluationResponse) Descriptor() ([]byte, []int) {
+	return file_assistant_evaluator_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetAllAssistantConversationEvaluationResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *GetAllAssistantConversationEvaluationResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetAllAssistantConversationEvaluationResponse) GetData() []*AssistantConversationEvaluation {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *GetAllAssistantConversationEvaluationResponse) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+func (x *GetAllAssistantConversationEvaluationResponse) GetPaginated() *Paginated {
+	if x != nil {
+		return x.Paginated
+	}
+	return nil
+}
+
+// pass rate and scores of the evaluators per assistant version over a date range
+type GetAssistantEvaluationSummaryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AssistantId              uint64                 `protobuf:"varint,1,opt,name=assistantId,proto3" json:"assistantId,omitempty"`
+	AssistantProviderModelId uint64                 `protobuf:"varint,2,opt,name=assistantProviderModelId,proto3" json:"assistantProviderModelId,omitempty"`
+	From                     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	To                       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+	Criterias                []*Criteria            `protobuf:"bytes,5,rep,name=criterias,proto3" json:"criterias,omitempty"`
+}
+
+func (x *GetAssistantEvaluationSummaryRequest) Reset() {
+	*x = GetAssistantEvaluationSummaryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_assistant_evaluator_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAssistantEvaluationSummaryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAssistantEvaluationSummaryRequest) ProtoMessage() {}
+
+func (x *GetAssistantEvaluationSummaryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_assistant_evaluator_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAssistantEvaluationSummaryRequest.ProtoReflect.Descriptor instead.
+func (*GetAssistantEvaluationSummaryRequest) Descriptor() ([]byte, []int) {
+	return file_assistant_evaluator_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetAssistantEvaluationSummaryRequest) GetAssistantId() uint64 {
+	if x != nil {
+		return x.AssistantId
+	}
+	return 0
+}
+
+func (x *GetAssistantEvaluationSummaryRequest) GetAssistantProviderModelId() uint64 {
+	if x != nil {
+		return x.AssistantProviderModelId
+	}
+	return 0
+}
+
+func (x *GetAssistantEvaluationSummaryRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *GetAssistantEvaluationSummaryRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *GetAssistantEvaluationSummaryRequest) GetCriterias() []*Criteria {
+	if x != nil {
+		return x.Criterias
+	}
+	return nil
+}
+
+type AssistantEvaluationSummary struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AssistantProviderModelId uint64  `protobuf:"varint,1,opt,name=assistantProviderModelId,proto3" json:"assistantProviderModelId,omitempty"`
+	AssistantEvaluatorId     uint64  `protobuf:"varint,2,opt,name=assistantEvaluatorId,proto3" json:"assistantEvaluatorId,omitempty"`
+	Name                     string  `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Type                     string  `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	Count                    uint64  `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`
+	Passed                   uint64  `protobuf:"varint,6,opt,name=passed,proto3" json:"passed,omitempty"`
+	PassRate                 float64 `protobuf:"fixed64,7,opt,name=passRate,proto3" json:"passRate,omitempty"`
+	AverageScore             float64 `protobuf:"fixed64,8,opt,name=averageScore,proto3" json:"averageScore,omitempty"`
+	MinScore                 float64 `protobuf:"fixed64,9,opt,name=minScore,proto3" json:"minScore,omitempty"`
+	MaxScore                 float64 `protobuf:"fixed64,10,opt,name=maxScore,proto3" json:"maxScore,omitempty"`
+}
+
+func (x *AssistantEvaluationSummary) Reset() {
+	*x = AssistantEvaluationSummary{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_assistant_evaluator_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AssistantEvaluationSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssistantEvaluationSummary) ProtoMessage() {}
+
+func (x *AssistantEvaluationSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_assistant_evaluator_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssistantEvaluationSummary.ProtoReflect.Descriptor instead.
+func (*AssistantEvaluationSummary) Descriptor() ([]byte, []int) {
+	return file_assistant_evaluator_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *AssistantEvaluationSummary) GetAssistantProviderModelId() uint64 {
+	if x != nil {
+		return x.AssistantProviderModelId
+	}
+	return 0
+}
+
+func (x *AssistantEvaluationSummary) GetAssistantEvaluatorId() uint64 {
+	if x != nil {
+		return x.AssistantEvaluatorId
+	}
+	return 0
+}
+
+func (x *AssistantEvaluationSummary) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AssistantEvaluationSummary) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *AssistantEvaluationSummary) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *AssistantEvaluationSummary) GetPassed() uint64 {
+	if x != nil {
+		return x.Passed
+	}
+	return 0
+}
+
+func (x *AssistantEvaluationSummary) GetPassRate() float64 {
+	if x != nil {
+		return x.PassRate
+	}
+	return 0
+}
+
+func (x *AssistantEvaluationSummary) GetAverageScore() float64 {
+	if x != nil {
+		return x.AverageScore
+	}
+	return 0
+}
+
+func (x *AssistantEvaluationSummary) GetMinScore() float64 {
+	if x != nil {
+		return x.MinScore
+	}
+	return 0
+}
+
+func (x *AssistantEvaluationSummary) GetMaxScore() float64 {
+	if x != nil {
+		return x.MaxScore
+	}
+	return 0
+}
+
+type GetAssistantEvaluationSummaryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code    int32                         `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Success bool                          `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	Data    []*AssistantEvaluationSummary `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`
+	Error   *Error                        `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *GetAssistantEvaluationSummaryResponse) Reset() {
+	*x = GetAssistantEvaluationSummaryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_assistant_evaluator_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAssistantEvaluationSummaryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAssistantEvaluationSummaryResponse) ProtoMessage() {}
+
+func (x *GetAssistantEvaluationSummaryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_assistant_evaluator_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAssistantEvaluationSummaryResponse.ProtoReflect.Descriptor instead.
+func (*GetAssistantEvaluationSummaryResponse) Descriptor() ([]byte, []int) {
+	return file_assistant_evaluator_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetAssistantEvaluationSummaryResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *GetAssistantEvaluationSummaryResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetAssistantEvaluationSummaryResponse) GetData() []*AssistantEvaluationSummary {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *GetAssistantEvaluationSummaryResponse) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+var File_assistant_evaluator_proto protoreflect.FileDescriptor
+
+var file_assistant_evaluator_proto_rawDesc = []byte{
+	0x0a, 0x19, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x2d, 0x65, 0x76, 0x61, 0x6c,
+	0x75, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x61, 0x73, 0x73,
+	0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0c, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe7, 0x03, 0x0a, 0x12, 0x41, 0x73,
+	0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x6f, 0x72,
+	0x12, 0x12, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23,
+	0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x09, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x24, 0x0a, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74,
+	0x49, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x0b, 0x61, 0x73,
+	0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x20, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x42, 0x79, 0x12, 0x27, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x55, 0x73,
+	0x65, 0x72, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x12, 0x20, 0x0a, 0x09,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x04, 0x42,
+	0x02, 0x30, 0x01, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x27,
+	0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x18, 0x10, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x0b, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x12, 0x3c, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x3c, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x44, 0x61, 0x74, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x44,
+	0x61, 0x74, 0x65, 0x22, 0xb6, 0x01, 0x0a, 0x1f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x73,
+	0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x6f, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
+	0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x23, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x09, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x07, 0x6f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x24, 0x0a, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74,
+	0x61, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52,
+	0x0b, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x22, 0xca, 0x01, 0x0a,
+	0x1f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74,
+	0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x12, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23,
+	0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x09, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x24, 0x0a, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74,
+	0x49, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x0b, 0x61, 0x73,
+	0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x58, 0x0a, 0x1c, 0x47, 0x65, 0x74,
+	0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74,
+	0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x24, 0x0a,
+	0x0b, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e,
+	0x74, 0x49, 0x64, 0x22, 0x5b, 0x0a, 0x1f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x73, 0x73,
+	0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x6f, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x24, 0x0a, 0x0b, 0x61, 0x73,
+	0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42,
+	0x02, 0x30, 0x01, 0x52, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x49, 0x64,
+	0x22, 0xa2, 0x01, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e,
+	0x74, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x12, 0x35, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21,
+	0x2e, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x41,
+	0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x6f,
+	0x72, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x97, 0x01, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c,
+	0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74,
+	0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0b, 0x61, 0x73, 0x73,
+	0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02,
+	0x30, 0x01, 0x52, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12,
+	0x25, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x09, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x52, 0x08, 0x70, 0x61,
+	0x67, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x12, 0x27, 0x0a, 0x09, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72,
+	0x69, 0x61, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x43, 0x72, 0x69, 0x74,
+	0x65, 0x72, 0x69, 0x61, 0x52, 0x09, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x73, 0x22,
+	0xcf, 0x01, 0x0a, 0x20, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x41, 0x73, 0x73, 0x69, 0x73, 0x74,
+	0x61, 0x6e, 0x74, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x12, 0x35, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x21, 0x2e, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69,
+	0x2e, 0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61,
+	0x74, 0x6f, 0x72, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x28, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x69, 0x6e,
+	0x61, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x50, 0x61, 0x67,
+	0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x52, 0x09, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x65,
+	0x64, 0x22, 0xbd, 0x03, 0x0a, 0x1f, 0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x43,
+	0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x61, 0x6c, 0x75,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x24, 0x0a, 0x0b, 0x61, 0x73, 0x73,
+	0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02,
+	0x30, 0x01, 0x52, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12,
+	0x3e, 0x0a, 0x18, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x18, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74,
+	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x49, 0x64, 0x12,
+	0x3c, 0x0a, 0x17, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x76,
+	0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04,
+	0x42, 0x02, 0x30, 0x01, 0x52, 0x17, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x43,
+	0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x36, 0x0a,
+	0x14, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61,
+	0x74, 0x6f, 0x72, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52,
+	0x14, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61,
+	0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x63,
+	0x6f, 0x72, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x73, 0x73, 0x65, 0x64, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x06, 0x70, 0x61, 0x73, 0x73, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x12, 0x3c, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x44, 0x61,
+	0x74, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74,
+	0x65, 0x22, 0xe2, 0x01, 0x0a, 0x2c, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x41, 0x73, 0x73, 0x69,
+	0x73, 0x74, 0x61, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x24, 0x0a, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x49,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x0b, 0x61, 0x73, 0x73,
+	0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x3c, 0x0a, 0x17, 0x61, 0x73, 0x73, 0x69,
+	0x73, 0x74, 0x61, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x17, 0x61,
+	0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
+	0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e,
+	0x61, 0x74, 0x65, 0x52, 0x08, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x12, 0x27, 0x0a,
+	0x09, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x09, 0x2e, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x52, 0x09, 0x63, 0x72, 0x69,
+	0x74, 0x65, 0x72, 0x69, 0x61, 0x73, 0x22, 0xe9, 0x01, 0x0a, 0x2d, 0x47, 0x65, 0x74, 0x41, 0x6c,
+	0x6c, 0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x42, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74,
+	0x5f, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x43, 0x6f,
+	0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x28, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x69,
+	0x6e, 0x61, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x50, 0x61,
+	0x67, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x52, 0x09, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x65, 0x64, 0x22, 0x91, 0x02, 0x0a, 0x24, 0x47, 0x65, 0x74, 0x41, 0x73, 0x73, 0x69, 0x73, 0x74,
+	0x61, 0x6e, 0x74, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x75, 0x6d,
+	0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0b, 0x61,
+	0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x42, 0x02, 0x30, 0x01, 0x52, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x49,
+	0x64, 0x12, 0x3e, 0x0a, 0x18, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x50, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x49, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x18, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61,
+	0x6e, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x49,
+	0x64, 0x12, 0x2e, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x66, 0x72, 0x6f,
+	0x6d, 0x12, 0x2a, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x27, 0x0a,
+	0x09, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x09, 0x2e, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x52, 0x09, 0x63, 0x72, 0x69,
+	0x74, 0x65, 0x72, 0x69, 0x61, 0x73, 0x22, 0xea, 0x02, 0x0a, 0x1a, 0x41, 0x73, 0x73, 0x69, 0x73,
+	0x74, 0x61, 0x6e, 0x74, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x75,
+	0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x3e, 0x0a, 0x18, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61,
+	0x6e, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x49,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x18, 0x61, 0x73, 0x73,
+	0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x4d, 0x6f,
+	0x64, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x36, 0x0a, 0x14, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61,
+	0x6e, 0x74, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x14, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61,
+	0x6e, 0x74, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x1a, 0x0a, 0x06, 0x70, 0x61, 0x73, 0x73, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x42,
+	0x02, 0x30, 0x01, 0x52, 0x06, 0x70, 0x61, 0x73, 0x73, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70,
+	0x61, 0x73, 0x73, 0x52, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x70,
+	0x61, 0x73, 0x73, 0x52, 0x61, 0x74, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x76, 0x65, 0x72, 0x61,
+	0x67, 0x65, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x61,
+	0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6d,
+	0x69, 0x6e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6d,
+	0x69, 0x6e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x61, 0x78, 0x53, 0x63,
+	0x6f, 0x72, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x53, 0x63,
+	0x6f, 0x72, 0x65, 0x22, 0xb2, 0x01, 0x0a, 0x25, 0x47, 0x65, 0x74, 0x41, 0x73, 0x73, 0x69, 0x73,
+	0x74, 0x61, 0x6e, 0x74, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x75,
+	0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x3d, 0x0a, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x61, 0x73, 0x73, 0x69,
+	0x73, 0x74, 0x61, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x73, 0x74,
+	0x61, 0x6e, 0x74, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x75, 0x6d,
+	0x6d, 0x61, 0x72, 0x79, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x1c, 0x5a, 0x1a, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x61, 0x70, 0x69, 0x64, 0x61, 0x61, 0x69, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_assistant_evaluator_proto_rawDescOnce sync.Once
+	file_assistant_evaluator_proto_rawDescData = file_assistant_evaluator_proto_rawDesc
+)
+
+func file_assistant_evaluator_proto_rawDescGZIP() []byte {
+	file_assistant_evaluator_proto_rawDescOnce.Do(func() {
+		file_assistant_evaluator_proto_rawDescData = protoimpl.X.CompressGZIP(file_assistant_evaluator_proto_rawDescData)
+	})
+	return file_assistant_evaluator_proto_rawDescData
+}
+
+var file_assistant_evaluator_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_assistant_evaluator_proto_goTypes = []any{
+	(*AssistantEvaluator)(nil),                            // 0: assistant_api.AssistantEvaluator
+	(*CreateAssistantEvaluatorRequest)(nil),               // 1: assistant_api.CreateAssistantEvaluatorRequest
+	(*UpdateAssistantEvaluatorRequest)(nil),               // 2: assistant_api.UpdateAssistantEvaluatorRequest
+	(*GetAssistantEvaluatorRequest)(nil),                  // 3: assistant_api.GetAssistantEvaluatorRequest
+	(*DeleteAssistantEvaluatorRequest)(nil),               // 4: assistant_api.DeleteAssistantEvaluatorRequest
+	(*GetAssistantEvaluatorResponse)(nil),                 // 5: assistant_api.GetAssistantEvaluatorResponse
+	(*GetAllAssistantEvaluatorRequest)(nil),               // 6: assistant_api.GetAllAssistantEvaluatorRequest
+	(*GetAllAssistantEvaluatorResponse)(nil),              // 7: assistant_api.GetAllAssistantEvaluatorResponse
+	(*AssistantConversationEvaluation)(nil),               // 8: assistant_api.AssistantConversationEvaluation
+	(*GetAllAssistantConversationEvaluationRequest)(nil),  // 9: assistant_api.GetAllAssistantConversationEvaluationRequest
+	(*GetAllAssistantConversationEvaluationResponse)(nil), // 10: assistant_api.GetAllAssistantConversationEvaluationResponse
+	(*GetAssistantEvaluationSummaryRequest)(nil),          // 11: assistant_api.GetAssistantEvaluationSummaryRequest
+	(*AssistantEvaluationSummary)(nil),                    // 12: assistant_api.AssistantEvaluationSummary
+	(*GetAssistantEvaluationSummaryResponse)(nil),         // 13: assistant_api.GetAssistantEvaluationSummaryResponse
+	(*Metadata)(nil),                                      // 14: Metadata
+	(*User)(nil),                                          // 15: User
+	(*timestamppb.Timestamp)(nil),                         // 16: google.protobuf.Timestamp
+	(*Error)(nil),                                         // 17: Error
+	(*Paginate)(nil),                                      // 18: Paginate
+	(*Criteria)(nil),                                      // 19: Criteria
+	(*Paginated)(nil),                                     // 20: Paginated
+}
+var file_assistant_evaluator_proto_depIdxs = []int32{
+	14, // 0: assistant_api.AssistantEvaluator.options:type_name -> Metadata
+	15, // 1: assistant_api.AssistantEvaluator.createdUser:type_name -> User
+	15, // 2: assistant_api.AssistantEvaluator.updatedUser:type_name -> User
+	16, // 3: assistant_api.AssistantEvaluator.createdDate:type_name -> google.protobuf.Timestamp
+	16, // 4: assistant_api.AssistantEvaluator.updatedDate:type_name -> google.protobuf.Timestamp
+	14, // 5: assistant_api.CreateAssistantEvaluatorRequest.options:type_name -> Metadata
+	14, // 6: assistant_api.UpdateAssistantEvaluatorRequest.options:type_name -> Metadata
+	0,  // 7: assistant_api.GetAssistantEvaluatorResponse.data:type_name -> assistant_api.AssistantEvaluator
+	17, // 8: assistant_api.GetAssistantEvaluatorResponse.error:type_name -> Error
+	18, // 9: assistant_api.GetAllAssistantEvaluatorRequest.paginate:type_name -> Paginate
+	19, // 10: assistant_api.GetAllAssistantEvaluatorRequest.criterias:type_name -> Criteria
+	0,  // 11: assistant_api.GetAllAssistantEvaluatorResponse.data:type_name -> assistant_api.AssistantEvaluator
+	17, // 12: assistant_api.GetAllAssistantEvaluatorResponse.error:type_name -> Error
+	20, // 13: assistant_api.GetAllAssistantEvaluatorResponse.paginated:type_name -> Paginated
+	16, // 14: assistant_api.AssistantConversationEvaluation.createdDate:type_name -> google.protobuf.Timestamp
+	18, // 15: assistant_api.GetAllAssistantConversationEvaluationRequest.paginate:type_name -> Paginate
+	19, // 16: assistant_api.GetAllAssistantConversationEvaluationRequest.criterias:type_name -> Criteria
+	8,  // 17: assistant_api.GetAllAssistantConversationEvaluationResponse.data:type_name -> assistant_api.AssistantConversationEvaluation
+	17, // 18: assistant_api.GetAllAssistantConversationEvaluationResponse.error:type_name -> Error
+	20, // 19: assistant_api.GetAllAssistantConversationEvaluationResponse.paginated:type_name -> Paginated
+	16, // 20: assistant_api.GetAssistantEvaluationSummaryRequest.from:type_name -> google.protobuf.Timestamp
+	16, // 21: assistant_api.GetAssistantEvaluationSummaryRequest.to:type_name -> google.protobuf.Timestamp
+	19, // 22: assistant_api.GetAssistantEvaluationSummaryRequest.criterias:type_name -> Criteria
+	12, // 23: assistant_api.GetAssistantEvaluationSummaryResponse.data:type_name -> assistant_api.AssistantEvaluationSummary
+	17, // 24: assistant_api.GetAssistantEvaluationSummaryResponse.error:type_name -> Error
+	25, // [25:25] is the sub-list for method output_type
+	25, // [25:25] is the sub-list for method input_type
+	25, // [25:25] is the sub-list for extension type_name
+	25, // [25:25] is the sub-list for extension extendee
+	0,  // [0:25] is the sub-list for field type_name
+}
+
+func init() { file_assistant_evaluator_proto_init() }
+func file_assistant_evaluator_proto_init() {
+	if File_assistant_evaluator_proto != nil {
+		return
+	}
+	file_common_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_assistant_evaluator_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*AssistantEvaluator); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_assistant_evaluator_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateAssistantEvaluatorRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_assistant_evaluator_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateAssistantEvaluatorRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_assistant_evaluator_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*GetAssistantEvaluatorRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_assistant_evaluator_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteAssistantEvaluatorRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_assistant_evaluator_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*GetAssistantEvaluatorResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_assistant_evaluator_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*GetAllAssistantEvaluatorRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_assistant_evaluator_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*GetAllAssistantEvaluatorResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_assistant_evaluator_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*AssistantConversationEvaluation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_assistant_evaluator_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*GetAllAssistantConversationEvaluationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_assistant_evaluator_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*GetAllAssistantConversationEvaluationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_assistant_evaluator_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*GetAssistantEvaluationSummaryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_assistant_evaluator_proto_msgTypes[12].Exporter = func(v any, i int) any {
+			switch v := v.(*AssistantEvaluationSummary); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_assistant_evaluator_proto_msgTypes[13].Exporter = func(v any, i int) any {
+			switch v := v.(*GetAssistantEvaluationSummaryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_assistant_evaluator_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   14,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_assistant_evaluator_proto_goTypes,
+		DependencyIndexes: file_assistant_evaluator_proto_depIdxs,
+		MessageInfos:      file_assistant_evaluator_proto_msgTypes,
+	}.Build()
+	File_assistant_evaluator_proto = out.File
+	file_assistant_evaluator_proto_rawDesc = nil
+	file_assistant_evaluator_proto_goTypes = nil
+	file_assistant_evaluator_proto_depIdxs = nil
+}
@@ -19,19 +19,23 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	EndpointService_GetEndpoint_FullMethodName                      = "/endpoint_api.EndpointService/GetEndpoint"
-	EndpointService_GetAllEndpoint_FullMethodName                   = "/endpoint_api.EndpointService/GetAllEndpoint"
-	EndpointService_GetAllEndpointProviderModel_FullMethodName      = "/endpoint_api.EndpointService/GetAllEndpointProviderModel"
-	EndpointService_UpdateEndpointVersion_FullMethodName            = "/endpoint_api.EndpointService/UpdateEndpointVersion"
-	EndpointService_CreateEndpoint_FullMethodName                   = "/endpoint_api.EndpointService/CreateEndpoint"
-	EndpointService_CreateEndpointProviderModel_FullMethodName      = "/endpoint_api.EndpointService/CreateEndpointProviderModel"
-	EndpointService_CreateEndpointCacheConfiguration_FullMethodName = "/endpoint_api.EndpointService/CreateEndpointCacheConfiguration"
-	EndpointService_CreateEndpointRetryConfiguration_FullMethodName = "/endpoint_api.EndpointService/CreateEndpointRetryConfiguration"
-	EndpointService_CreateEndpointTag_FullMethodName                = "/endpoint_api.EndpointService/CreateEndpointTag"
-	EndpointService_ForkEndpoint_FullMethodName                     = "/endpoint_api.EndpointService/ForkEndpoint"
-	EndpointService_UpdateEndpointDetail_FullMethodName             = "/endpoint_api.EndpointService/UpdateEndpointDetail"
-	EndpointService_GetAllEndpointLog_FullMethodName                = "/endpoint_api.EndpointService/GetAllEndpointLog"
-	EndpointService_GetEndpointLog_FullMethodName                   = "/endpoint_api.EndpointService/GetEndpointLog"
+	EndpointService_GetEndpoint_FullMethodName                               = "/endpoint_api.EndpointService/GetEndpoint"
+	EndpointService_GetAllEndpoint_FullMethodName                            = "/endpoint_api.EndpointService/GetAllEndpoint"
+	EndpointService_GetAllEndpointProviderModel_FullMethodName               = "/endpoint_api.EndpointService/GetAllEndpointProviderModel"
+	EndpointService_UpdateEndpointVersion_FullMethodName                     = "/endpoint_api.EndpointService/UpdateEndpointVersion"
+	EndpointService_CreateEndpoint_FullMethodName                            = "/endpoint_api.EndpointService/CreateEndpoint"
+	EndpointService_CreateEndpointProviderModel_FullMethodName               = "/endpoint_api.EndpointService/CreateEndpointProviderModel"
+	EndpointService_CreateEndpointCacheConfiguration_FullMethodName          = "/endpoint_api.EndpointService/CreateEndpointCacheConfiguration"
+	EndpointService_CreateEndpointRetryConfiguration_FullMethodName          = "/endpoint_api.EndpointService/CreateEndpointRetryConfiguration"
+	EndpointService_CreateEndpointResponseSchemaConfiguration_FullMethodName = "/endpoint_api.EndpointService/CreateEndpointResponseSchemaConfiguration"
+	EndpointService_CreateEndpointTag_FullMethodName                         = "/endpoint_api.EndpointService/CreateEndpointTag"
+	EndpointService_ForkEndpoint_FullMethodName                              = "/endpoint_api.EndpointService/ForkEndpoint"
+	EndpointService_PurgeEndpointCache_FullMethodName                        = "/endpoint_api.EndpointService/PurgeEndpointCache"
+	EndpointService_CreateEndpointTrafficSplit_FullMethodName                = "/endpoint_api.EndpointService/CreateEndpointTrafficSplit"
+	EndpointService_GetEndpointExperiment_FullMethodName                     = "/endpoint_api.EndpointService/GetEndpointExperiment"
+	EndpointService_UpdateEndpointDetail_FullMethodName                      = "/endpoint_api.EndpointService/UpdateEndpointDetail"
+	EndpointService_GetAllEndpointLog_FullMethodName                         = "/endpoint_api.EndpointService/GetAllEndpointLog"
+	EndpointService_GetEndpointLog_FullMethodName                            = "/endpoint_api.EndpointService/GetEndpointLog"
 )
 
 // EndpointServiceClient is the client API for EndpointService service.
@@ -47,8 +51,12 @@ type EndpointServiceClient interface {
 	// next gen
 	CreateEndpointCacheConfiguration(ctx context.Context, in *CreateEndpointCacheConfigurationRequest, opts ...grpc.CallOption) (*CreateEndpointCacheConfigurationResponse, error)
 	CreateEndpointRetryConfiguration(ctx context.Context, in *CreateEndpointRetryConfigurationRequest, opts ...grpc.CallOption) (*CreateEndpointRetryConfigurationResponse, error)
+	CreateEndpointResponseSchemaConfiguration(ctx context.Context, in *CreateEndpointResponseSchemaConfigurationRequest, opts ...grpc.CallOption) (*CreateEndpointResponseSchemaConfigurationResponse, error)
 	CreateEndpointTag(ctx context.Context, in *CreateEndpointTagRequest, opts ...grpc.CallOption) (*GetEndpointResponse, error)
 	ForkEndpoint(ctx context.Context, in *ForkEndpointRequest, opts ...grpc.CallOption) (*BaseResponse, error)
+	PurgeEndpointCache(ctx context.Context, in *PurgeEndpointCacheRequest, opts ...grpc.CallOption) (*BaseResponse, error)
+	CreateEndpointTrafficSplit(ctx context.Context, in *CreateEndpointTrafficSplitRequest, opts ...grpc.CallOption) (*CreateEndpointTrafficSplitResponse, error)
+	GetEndpointExperiment(ctx context.Context, in *GetEndpointExperimentRequest, opts ...grpc.CallOption) (*GetEndpointExperimentResponse, error)
 	UpdateEndpointDetail(ctx context.Context, in *UpdateEndpointDetailRequest, opts ...grpc.CallOption) (*GetEndpointResponse, error)
 	GetAllEndpointLog(ctx context.Context, in *GetAllEndpointLogRequest, opts ...grpc.CallOption) (*GetAllEndpointLogResponse, error)
 	GetEndpointLog(ctx context.Context, in *GetEndpointLogRequest, opts ...grpc.CallOption) (*GetEndpointLogResponse, error)
@@ -142,6 +150,16 @@ func (c *endpointServiceClient) CreateEndpointRetryConfiguration(ctx context.Con
 	return out, nil
 }
 
+func (c *endpointServiceClient) CreateEndpointResponseSchemaConfiguration(ctx context.Context, in *CreateEndpointResponseSchemaConfigurationRequest, opts ...grpc.CallOption) (*CreateEndpointResponseSchemaConfigurationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateEndpointResponseSchemaConfigurationResponse)
+	err := c.cc.Invoke(ctx, EndpointService_CreateEndpointResponseSchemaConfiguration_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *endpointServiceClient) CreateEndpointTag(ctx context.Context, in *CreateEndpointTagRequest, opts ...grpc.CallOption) (*GetEndpointResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetEndpointResponse)
@@ -162,6 +180,36 @@ func (c *endpointServiceClient) ForkEndpoint(ctx context.Context, in *ForkEndpoi
 	return out, nil
 }
 
+func (c *endpointServiceClient) PurgeEndpointCache(ctx context.Context, in *PurgeEndpointCacheRequest, opts ...grpc.CallOption) (*BaseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BaseResponse)
+	err := c.cc.Invoke(ctx, EndpointService_PurgeEndpointCache_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *endpointServiceClient) CreateEndpointTrafficSplit(ctx context.Context, in *CreateEndpointTrafficSplitRequest, opts ...grpc.CallOption) (*CreateEndpointTrafficSplitResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateEndpointTrafficSplitResponse)
+	err := c.cc.Invoke(ctx, EndpointService_CreateEndpointTrafficSplit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *endpointServiceClient) GetEndpointExperiment(ctx context.Context, in *GetEndpointExperimentRequest, opts ...grpc.CallOption) (*GetEndpointExperimentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetEndpointExperimentResponse)
+	err := c.cc.Invoke(ctx, EndpointService_GetEndpointExperiment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *endpointServiceClient) UpdateEndpointDetail(ctx context.Context, in *UpdateEndpointDetailRequest, opts ...grpc.CallOption) (*GetEndpointResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetEndpointResponse)
@@ -205,8 +253,12 @@ type EndpointServiceServer interface {
 	// next gen
 	CreateEndpointCacheConfiguration(context.Context, *CreateEndpointCacheConfigurationRequest) (*CreateEndpointCacheConfigurationResponse, error)
 	CreateEndpointRetryConfiguration(context.Context, *CreateEndpointRetryConfigurationRequest) (*CreateEndpointRetryConfigurationResponse, error)
+	CreateEndpointResponseSchemaConfiguration(context.Context, *CreateEndpointResponseSchemaConfigurationRequest) (*CreateEndpointResponseSchemaConfigurationResponse, error)
 	CreateEndpointTag(context.Context, *CreateEndpointTagRequest) (*GetEndpointResponse, error)
 	ForkEndpoint(context.Context, *ForkEndpointRequest) (*BaseResponse, error)
+	PurgeEndpointCache(context.Context, *PurgeEndpointCacheRequest) (*BaseResponse, error)
+	CreateEndpointTrafficSplit(context.Context, *CreateEndpointTrafficSplitRequest) (*CreateEndpointTrafficSplitResponse, error)
+	GetEndpointExperiment(context.Context, *GetEndpointExperimentRequest) (*GetEndpointExperimentResponse, error)
 	UpdateEndpointDetail(context.Context, *UpdateEndpointDetailRequest) (*GetEndpointResponse, error)
 	GetAllEndpointLog(context.Context, *GetAllEndpointLogRequest) (*GetAllEndpointLogResponse, error)
 	GetEndpointLog(context.Context, *GetEndpointLogRequest) (*GetEndpointLogResponse, error)
@@ -243,12 +295,24 @@ func (UnimplementedEndpointServiceServer) CreateEndpointCacheConfiguration(conte
 func (UnimplementedEndpointServiceServer) CreateEndpointRetryConfiguration(context.Context, *CreateEndpointRetryConfigurationRequest) (*CreateEndpointRetryConfigurationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateEndpointRetryConfiguration not implemented")
 }
+func (UnimplementedEndpointServiceServer) CreateEndpointResponseSchemaConfiguration(context.Context, *CreateEndpointResponseSchemaConfigurationRequest) (*CreateEndpointResponseSchemaConfigurationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateEndpointResponseSchemaConfiguration not implemented")
+}
 func (UnimplementedEndpointServiceServer) CreateEndpointTag(context.Context, *CreateEndpointTagRequest) (*GetEndpointResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateEndpointTag not implemented")
 }
 func (UnimplementedEndpointServiceServer) ForkEndpoint(context.Context, *ForkEndpointRequest) (*BaseResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ForkEndpoint not implemented")
 }
+func (UnimplementedEndpointServiceServer) PurgeEndpointCache(context.Context, *PurgeEndpointCacheRequest) (*BaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeEndpointCache not implemented")
+}
+func (UnimplementedEndpointServiceServer) CreateEndpointTrafficSplit(context.Context, *CreateEndpointTrafficSplitRequest) (*CreateEndpointTrafficSplitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateEndpointTrafficSplit not implemented")
+}
+func (UnimplementedEndpointServiceServer) GetEndpointExperiment(context.Context, *GetEndpointExperimentRequest) (*GetEndpointExperimentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEndpointExperiment not implemented")
+}
 func (UnimplementedEndpointServiceServer) UpdateEndpointDetail(context.Context, *UpdateEndpointDetailRequest) (*GetEndpointResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateEndpointDetail not implemented")
 }
@@ -422,6 +486,24 @@ func _EndpointService_CreateEndpointRetryConfiguration_Handler(srv interface{},
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EndpointService_CreateEndpointResponseSchemaConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateEndpointResponseSchemaConfigurationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EndpointServiceServer).CreateEndpointResponseSchemaConfiguration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EndpointService_CreateEndpointResponseSchemaConfiguration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EndpointServiceServer).CreateEndpointResponseSchemaConfiguration(ctx, req.(*CreateEndpointResponseSchemaConfigurationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _EndpointService_CreateEndpointTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateEndpointTagRequest)
 	if err := dec(in); err != nil {
@@ -458,6 +540,60 @@ func _EndpointService_ForkEndpoint_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EndpointService_PurgeEndpointCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeEndpointCacheRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EndpointServiceServer).PurgeEndpointCache(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EndpointService_PurgeEndpointCache_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EndpointServiceServer).PurgeEndpointCache(ctx, req.(*PurgeEndpointCacheRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EndpointService_CreateEndpointTrafficSplit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateEndpointTrafficSplitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EndpointServiceServer).CreateEndpointTrafficSplit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EndpointService_CreateEndpointTrafficSplit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EndpointServiceServer).CreateEndpointTrafficSplit(ctx, req.(*CreateEndpointTrafficSplitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EndpointService_GetEndpointExperiment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEndpointExperimentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EndpointServiceServer).GetEndpointExperiment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EndpointService_GetEndpointExperiment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EndpointServiceServer).GetEndpointExperiment(ctx, req.(*GetEndpointExperimentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _EndpointService_UpdateEndpointDetail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateEndpointDetailRequest)
 	if err := dec(in); err != nil {
@@ -551,6 +687,10 @@ var EndpointService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateEndpointRetryConfiguration",
 			Handler:    _EndpointService_CreateEndpointRetryConfiguration_Handler,
 		},
+		{
+			MethodName: "CreateEndpointResponseSchemaConfiguration",
+			Handler:    _EndpointService_CreateEndpointResponseSchemaConfiguration_Handler,
+		},
 		{
 			MethodName: "CreateEndpointTag",
 			Handler:    _EndpointService_CreateEndpointTag_Handler,
@@ -559,6 +699,18 @@ var EndpointService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ForkEndpoint",
 			Handler:    _EndpointService_ForkEndpoint_Handler,
 		},
+		{
+			MethodName: "PurgeEndpointCache",
+			Handler:    _EndpointService_PurgeEndpointCache_Handler,
+		},
+		{
+			MethodName: "CreateEndpointTrafficSplit",
+			Handler:    _EndpointService_CreateEndpointTrafficSplit_Handler,
+		},
+		{
+			MethodName: "GetEndpointExperiment",
+			Handler:    _EndpointService_GetEndpointExperiment_Handler,
+		},
 		{
 			MethodName: "UpdateEndpointDetail",
 			Handler:    _EndpointService_UpdateEndpointDetail_Handler,
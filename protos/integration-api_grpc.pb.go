@@ -21,6 +21,7 @@ const _ = grpc.SupportPackageIsVersion9
 const (
 	BedrockService_Embedding_FullMethodName        = "/integration_api.BedrockService/Embedding"
 	BedrockService_Chat_FullMethodName             = "/integration_api.BedrockService/Chat"
+	BedrockService_StreamChat_FullMethodName       = "/integration_api.BedrockService/StreamChat"
 	BedrockService_VerifyCredential_FullMethodName = "/integration_api.BedrockService/VerifyCredential"
 )
 
@@ -30,6 +31,7 @@ const (
 type BedrockServiceClient interface {
 	Embedding(ctx context.Context, in *EmbeddingRequest, opts ...grpc.CallOption) (*EmbeddingResponse, error)
 	Chat(ctx context.Context, in *ChatRequest, opts ...grpc.CallOption) (*ChatResponse, error)
+	StreamChat(ctx context.Context, in *ChatRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ChatResponse], error)
 	VerifyCredential(ctx context.Context, in *VerifyCredentialRequest, opts ...grpc.CallOption) (*VerifyCredentialResponse, error)
 }
 
@@ -61,6 +63,25 @@ func (c *bedrockServiceClient) Chat(ctx context.Context, in *ChatRequest, opts .
 	return out, nil
 }
 
+func (c *bedrockServiceClient) StreamChat(ctx context.Context, in *ChatRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ChatResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &BedrockService_ServiceDesc.Streams[0], BedrockService_StreamChat_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ChatRequest, ChatResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type BedrockService_StreamChatClient = grpc.ServerStreamingClient[ChatResponse]
+
 func (c *bedrockServiceClient) VerifyCredential(ctx context.Context, in *VerifyCredentialRequest, opts ...grpc.CallOption) (*VerifyCredentialResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(VerifyCredentialResponse)
@@ -77,6 +98,7 @@ func (c *bedrockServiceClient) VerifyCredential(ctx context.Context, in *VerifyC
 type BedrockServiceServer interface {
 	Embedding(context.Context, *EmbeddingRequest) (*EmbeddingResponse, error)
 	Chat(context.Context, *ChatRequest) (*ChatResponse, error)
+	StreamChat(*ChatRequest, grpc.ServerStreamingServer[ChatResponse]) error
 	VerifyCredential(context.Context, *VerifyCredentialRequest) (*VerifyCredentialResponse, error)
 }
 
@@ -93,6 +115,9 @@ func (UnimplementedBedrockServiceServer) Embedding(context.Context, *EmbeddingRe
 func (UnimplementedBedrockServiceServer) Chat(context.Context, *ChatRequest) (*ChatResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Chat not implemented")
 }
+func (UnimplementedBedrockServiceServer) StreamChat(*ChatRequest, grpc.ServerStreamingServer[ChatResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamChat not implemented")
+}
 func (UnimplementedBedrockServiceServer) VerifyCredential(context.Context, *VerifyCredentialRequest) (*VerifyCredentialResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method VerifyCredential not implemented")
 }
@@ -152,6 +177,17 @@ func _BedrockService_Chat_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BedrockService_StreamChat_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ChatRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BedrockServiceServer).StreamChat(m, &grpc.GenericServerStream[ChatRequest, ChatResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type BedrockService_StreamChatServer = grpc.ServerStreamingServer[ChatResponse]
+
 func _BedrockService_VerifyCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(VerifyCredentialRequest)
 	if err := dec(in); err != nil {
@@ -190,7 +226,13 @@ var BedrockService_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _BedrockService_VerifyCredential_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamChat",
+			Handler:       _BedrockService_StreamChat_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "integration-api.proto",
 }
 
@@ -1760,6 +1802,7 @@ var CohereService_ServiceDesc = grpc.ServiceDesc{
 
 const (
 	HuggingfaceService_Chat_FullMethodName             = "/integration_api.HuggingfaceService/Chat"
+	HuggingfaceService_StreamChat_FullMethodName       = "/integration_api.HuggingfaceService/StreamChat"
 	HuggingfaceService_VerifyCredential_FullMethodName = "/integration_api.HuggingfaceService/VerifyCredential"
 )
 
@@ -1768,6 +1811,7 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type HuggingfaceServiceClient interface {
 	Chat(ctx context.Context, in *ChatRequest, opts ...grpc.CallOption) (*ChatResponse, error)
+	StreamChat(ctx context.Context, in *ChatRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ChatResponse], error)
 	VerifyCredential(ctx context.Context, in *VerifyCredentialRequest, opts ...grpc.CallOption) (*VerifyCredentialResponse, error)
 }
 
@@ -1789,6 +1833,25 @@ func (c *huggingfaceServiceClient) Chat(ctx context.Context, in *ChatRequest, op
 	return out, nil
 }
 
+func (c *huggingfaceServiceClient) StreamChat(ctx context.Context, in *ChatRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ChatResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &HuggingfaceService_ServiceDesc.Streams[0], HuggingfaceService_StreamChat_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ChatRequest, ChatResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type HuggingfaceService_StreamChatClient = grpc.ServerStreamingClient[ChatResponse]
+
 func (c *huggingfaceServiceClient) VerifyCredential(ctx context.Context, in *VerifyCredentialRequest, opts ...grpc.CallOption) (*VerifyCredentialResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(VerifyCredentialResponse)
@@ -1804,6 +1867,7 @@ func (c *huggingfaceServiceClient) VerifyCredential(ctx context.Context, in *Ver
 // for forward compatibility.
 type HuggingfaceServiceServer interface {
 	Chat(context.Context, *ChatRequest) (*ChatResponse, error)
+	StreamChat(*ChatRequest, grpc.ServerStreamingServer[ChatResponse]) error
 	VerifyCredential(context.Context, *VerifyCredentialRequest) (*VerifyCredentialResponse, error)
 }
 
@@ -1817,6 +1881,9 @@ type UnimplementedHuggingfaceServiceServer struct{}
 func (UnimplementedHuggingfaceServiceServer) Chat(context.Context, *ChatRequest) (*ChatResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Chat not implemented")
 }
+func (UnimplementedHuggingfaceServiceServer) StreamChat(*ChatRequest, grpc.ServerStreamingServer[ChatResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamChat not implemented")
+}
 func (UnimplementedHuggingfaceServiceServer) VerifyCredential(context.Context, *VerifyCredentialRequest) (*VerifyCredentialResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method VerifyCredential not implemented")
 }
@@ -1858,6 +1925,17 @@ func _HuggingfaceService_Chat_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HuggingfaceService_StreamChat_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ChatRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(HuggingfaceServiceServer).StreamChat(m, &grpc.GenericServerStream[ChatRequest, ChatResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type HuggingfaceService_StreamChatServer = grpc.ServerStreamingServer[ChatResponse]
+
 func _HuggingfaceService_VerifyCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(VerifyCredentialRequest)
 	if err := dec(in); err != nil {
@@ -1892,7 +1970,13 @@ var HuggingfaceService_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _HuggingfaceService_VerifyCredential_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamChat",
+			Handler:       _HuggingfaceService_StreamChat_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "integration-api.proto",
 }
 
@@ -2177,7 +2261,9 @@ var StabilityAiService_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
+	TogetherAiService_Embedding_FullMethodName        = "/integration_api.TogetherAiService/Embedding"
 	TogetherAiService_Chat_FullMethodName             = "/integration_api.TogetherAiService/Chat"
+	TogetherAiService_StreamChat_FullMethodName       = "/integration_api.TogetherAiService/StreamChat"
 	TogetherAiService_VerifyCredential_FullMethodName = "/integration_api.TogetherAiService/VerifyCredential"
 )
 
@@ -2185,7 +2271,9 @@ const (
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type TogetherAiServiceClient interface {
+	Embedding(ctx context.Context, in *EmbeddingRequest, opts ...grpc.CallOption) (*EmbeddingResponse, error)
 	Chat(ctx context.Context, in *ChatRequest, opts ...grpc.CallOption) (*ChatResponse, error)
+	StreamChat(ctx context.Context, in *ChatRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ChatResponse], error)
 	VerifyCredential(ctx context.Context, in *VerifyCredentialRequest, opts ...grpc.CallOption) (*VerifyCredentialResponse, error)
 }
 
@@ -2197,6 +2285,16 @@ func NewTogetherAiServiceClient(cc grpc.ClientConnInterface) TogetherAiServiceCl
 	return &togetherAiServiceClient{cc}
 }
 
+func (c *togetherAiServiceClient) Embedding(ctx context.Context, in *EmbeddingRequest, opts ...grpc.CallOption) (*EmbeddingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EmbeddingResponse)
+	err := c.cc.Invoke(ctx, TogetherAiService_Embedding_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *togetherAiServiceClient) Chat(ctx context.Context, in *ChatRequest, opts ...grpc.CallOption) (*ChatResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ChatResponse)
@@ -2207,6 +2305,25 @@ func (c *togetherAiServiceClient) Chat(ctx context.Context, in *ChatRequest, opt
 	return out, nil
 }
 
+func (c *togetherAiServiceClient) StreamChat(ctx context.Context, in *ChatRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ChatResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &TogetherAiService_ServiceDesc.Streams[0], TogetherAiService_StreamChat_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ChatRequest, ChatResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TogetherAiService_StreamChatClient = grpc.ServerStreamingClient[ChatResponse]
+
 func (c *togetherAiServiceClient) VerifyCredential(ctx context.Context, in *VerifyCredentialRequest, opts ...grpc.CallOption) (*VerifyCredentialResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(VerifyCredentialResponse)
@@ -2221,7 +2338,9 @@ func (c *togetherAiServiceClient) VerifyCredential(ctx context.Context, in *Veri
 // All implementations should embed UnimplementedTogetherAiServiceServer
 // for forward compatibility.
 type TogetherAiServiceServer interface {
+	Embedding(context.Context, *EmbeddingRequest) (*EmbeddingResponse, error)
 	Chat(context.Context, *ChatRequest) (*ChatResponse, error)
+	StreamChat(*ChatRequest, grpc.ServerStreamingServer[ChatResponse]) error
 	VerifyCredential(context.Context, *VerifyCredentialRequest) (*VerifyCredentialResponse, error)
 }
 
@@ -2232,9 +2351,15 @@ type TogetherAiServiceServer interface {
 // pointer dereference when methods are called.
 type UnimplementedTogetherAiServiceServer struct{}
 
+func (UnimplementedTogetherAiServiceServer) Embedding(context.Context, *EmbeddingRequest) (*EmbeddingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Embedding not implemented")
+}
 func (UnimplementedTogetherAiServiceServer) Chat(context.Context, *ChatRequest) (*ChatResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Chat not implemented")
 }
+func (UnimplementedTogetherAiServiceServer) StreamChat(*ChatRequest, grpc.ServerStreamingServer[ChatResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamChat not implemented")
+}
 func (UnimplementedTogetherAiServiceServer) VerifyCredential(context.Context, *VerifyCredentialRequest) (*VerifyCredentialResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method VerifyCredential not implemented")
 }
@@ -2258,6 +2383,24 @@ func RegisterTogetherAiServiceServer(s grpc.ServiceRegistrar, srv TogetherAiServ
 	s.RegisterService(&TogetherAiService_ServiceDesc, srv)
 }
 
+func _TogetherAiService_Embedding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmbeddingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TogetherAiServiceServer).Embedding(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TogetherAiService_Embedding_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TogetherAiServiceServer).Embedding(ctx, req.(*EmbeddingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TogetherAiService_Chat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ChatRequest)
 	if err := dec(in); err != nil {
@@ -2276,6 +2419,17 @@ func _TogetherAiService_Chat_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TogetherAiService_StreamChat_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ChatRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TogetherAiServiceServer).StreamChat(m, &grpc.GenericServerStream[ChatRequest, ChatResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TogetherAiService_StreamChatServer = grpc.ServerStreamingServer[ChatResponse]
+
 func _TogetherAiService_VerifyCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(VerifyCredentialRequest)
 	if err := dec(in); err != nil {
@@ -2301,6 +2455,10 @@ var TogetherAiService_ServiceDesc = grpc.ServiceDesc{
 	ServiceName: "integration_api.TogetherAiService",
 	HandlerType: (*TogetherAiServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Embedding",
+			Handler:    _TogetherAiService_Embedding_Handler,
+		},
 		{
 			MethodName: "Chat",
 			Handler:    _TogetherAiService_Chat_Handler,
@@ -2310,11 +2468,20 @@ var TogetherAiService_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _TogetherAiService_VerifyCredential_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamChat",
+			Handler:       _TogetherAiService_StreamChat_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "integration-api.proto",
 }
 
 const (
+	DeepInfraService_Embedding_FullMethodName        = "/integration_api.DeepInfraService/Embedding"
+	DeepInfraService_Chat_FullMethodName             = "/integration_api.DeepInfraService/Chat"
+	DeepInfraService_StreamChat_FullMethodName       = "/integration_api.DeepInfraService/StreamChat"
 	DeepInfraService_VerifyCredential_FullMethodName = "/integration_api.DeepInfraService/VerifyCredential"
 )
 
@@ -2322,6 +2489,9 @@ const (
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type DeepInfraServiceClient interface {
+	Embedding(ctx context.Context, in *EmbeddingRequest, opts ...grpc.CallOption) (*EmbeddingResponse, error)
+	Chat(ctx context.Context, in *ChatRequest, opts ...grpc.CallOption) (*ChatResponse, error)
+	StreamChat(ctx context.Context, in *ChatRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ChatResponse], error)
 	VerifyCredential(ctx context.Context, in *VerifyCredentialRequest, opts ...grpc.CallOption) (*VerifyCredentialResponse, error)
 }
 
@@ -2333,6 +2503,45 @@ func NewDeepInfraServiceClient(cc grpc.ClientConnInterface) DeepInfraServiceClie
 	return &deepInfraServiceClient{cc}
 }
 
+func (c *deepInfraServiceClient) Embedding(ctx context.Context, in *EmbeddingRequest, opts ...grpc.CallOption) (*EmbeddingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EmbeddingResponse)
+	err := c.cc.Invoke(ctx, DeepInfraService_Embedding_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deepInfraServiceClient) Chat(ctx context.Context, in *ChatRequest, opts ...grpc.CallOption) (*ChatResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChatResponse)
+	err := c.cc.Invoke(ctx, DeepInfraService_Chat_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deepInfraServiceClient) StreamChat(ctx context.Context, in *ChatRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ChatResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &DeepInfraService_ServiceDesc.Streams[0], DeepInfraService_StreamChat_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ChatRequest, ChatResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type DeepInfraService_StreamChatClient = grpc.ServerStreamingClient[ChatResponse]
+
 func (c *deepInfraServiceClient) VerifyCredential(ctx context.Context, in *VerifyCredentialRequest, opts ...grpc.CallOption) (*VerifyCredentialResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(VerifyCredentialResponse)
@@ -2347,6 +2556,9 @@ func (c *deepInfraServiceClient) VerifyCredential(ctx context.Context, in *Verif
 // All implementations should embed UnimplementedDeepInfraServiceServer
 // for forward compatibility.
 type DeepInfraServiceServer interface {
+	Embedding(context.Context, *EmbeddingRequest) (*EmbeddingResponse, error)
+	Chat(context.Context, *ChatRequest) (*ChatResponse, error)
+	StreamChat(*ChatRequest, grpc.ServerStreamingServer[ChatResponse]) error
 	VerifyCredential(context.Context, *VerifyCredentialRequest) (*VerifyCredentialResponse, error)
 }
 
@@ -2357,6 +2569,15 @@ type DeepInfraServiceServer interface {
 // pointer dereference when methods are called.
 type UnimplementedDeepInfraServiceServer struct{}
 
+func (UnimplementedDeepInfraServiceServer) Embedding(context.Context, *EmbeddingRequest) (*EmbeddingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Embedding not implemented")
+}
+func (UnimplementedDeepInfraServiceServer) Chat(context.Context, *ChatRequest) (*ChatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Chat not implemented")
+}
+func (UnimplementedDeepInfraServiceServer) StreamChat(*ChatRequest, grpc.ServerStreamingServer[ChatResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamChat not implemented")
+}
 func (UnimplementedDeepInfraServiceServer) VerifyCredential(context.Context, *VerifyCredentialRequest) (*VerifyCredentialResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method VerifyCredential not implemented")
 }
@@ -2380,6 +2601,53 @@ func RegisterDeepInfraServiceServer(s grpc.ServiceRegistrar, srv DeepInfraServic
 	s.RegisterService(&DeepInfraService_ServiceDesc, srv)
 }
 
+func _DeepInfraService_Embedding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmbeddingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeepInfraServiceServer).Embedding(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DeepInfraService_Embedding_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeepInfraServiceServer).Embedding(ctx, req.(*EmbeddingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeepInfraService_Chat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeepInfraServiceServer).Chat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DeepInfraService_Chat_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeepInfraServiceServer).Chat(ctx, req.(*ChatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeepInfraService_StreamChat_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ChatRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DeepInfraServiceServer).StreamChat(m, &grpc.GenericServerStream[ChatRequest, ChatResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type DeepInfraService_StreamChatServer = grpc.ServerStreamingServer[ChatResponse]
+
 func _DeepInfraService_VerifyCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(VerifyCredentialRequest)
 	if err := dec(in); err != nil {
@@ -2405,12 +2673,648 @@ var DeepInfraService_ServiceDesc = grpc.ServiceDesc{
 	ServiceName: "integration_api.DeepInfraService",
 	HandlerType: (*DeepInfraServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Embedding",
+			Handler:    _DeepInfraService_Embedding_Handler,
+		},
+		{
+			MethodName: "Chat",
+			Handler:    _DeepInfraService_Chat_Handler,
+		},
 		{
 			MethodName: "VerifyCredential",
 			Handler:    _DeepInfraService_VerifyCredential_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamChat",
+			Handler:       _DeepInfraService_StreamChat_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "integration-api.proto",
+}
+
+const (
+	GroqService_Chat_FullMethodName             = "/integration_api.GroqService/Chat"
+	GroqService_StreamChat_FullMethodName       = "/integration_api.GroqService/StreamChat"
+	GroqService_VerifyCredential_FullMethodName = "/integration_api.GroqService/VerifyCredential"
+)
+
+// GroqServiceClient is the client API for GroqService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type GroqServiceClient interface {
+	Chat(ctx context.Context, in *ChatRequest, opts ...grpc.CallOption) (*ChatResponse, error)
+	StreamChat(ctx context.Context, in *ChatRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ChatResponse], error)
+	VerifyCredential(ctx context.Context, in *VerifyCredentialRequest, opts ...grpc.CallOption) (*VerifyCredentialResponse, error)
+}
+
+type groqServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGroqServiceClient(cc grpc.ClientConnInterface) GroqServiceClient {
+	return &groqServiceClient{cc}
+}
+
+func (c *groqServiceClient) Chat(ctx context.Context, in *ChatRequest, opts ...grpc.CallOption) (*ChatResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChatResponse)
+	err := c.cc.Invoke(ctx, GroqService_Chat_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groqServiceClient) StreamChat(ctx context.Context, in *ChatRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ChatResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &GroqService_ServiceDesc.Streams[0], GroqService_StreamChat_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ChatRequest, ChatResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GroqService_StreamChatClient = grpc.ServerStreamingClient[ChatResponse]
+
+func (c *groqServiceClient) VerifyCredential(ctx context.Context, in *VerifyCredentialRequest, opts ...grpc.CallOption) (*VerifyCredentialResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VerifyCredentialResponse)
+	err := c.cc.Invoke(ctx, GroqService_VerifyCredential_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GroqServiceServer is the server API for GroqService service.
+// All implementations should embed UnimplementedGroqServiceServer
+// for forward compatibility.
+type GroqServiceServer interface {
+	Chat(context.Context, *ChatRequest) (*ChatResponse, error)
+	StreamChat(*ChatRequest, grpc.ServerStreamingServer[ChatResponse]) error
+	VerifyCredential(context.Context, *VerifyCredentialRequest) (*VerifyCredentialResponse, error)
+}
+
+// UnimplementedGroqServiceServer should be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedGroqServiceServer struct{}
+
+func (UnimplementedGroqServiceServer) Chat(context.Context, *ChatRequest) (*ChatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Chat not implemented")
+}
+func (UnimplementedGroqServiceServer) StreamChat(*ChatRequest, grpc.ServerStreamingServer[ChatResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamChat not implemented")
+}
+func (UnimplementedGroqServiceServer) VerifyCredential(context.Context, *VerifyCredentialRequest) (*VerifyCredentialResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyCredential not implemented")
+}
+func (UnimplementedGroqServiceServer) testEmbeddedByValue() {}
+
+// UnsafeGroqServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GroqServiceServer will
+// result in compilation errors.
+type UnsafeGroqServiceServer interface {
+	mustEmbedUnimplementedGroqServiceServer()
+}
+
+func RegisterGroqServiceServer(s grpc.ServiceRegistrar, srv GroqServiceServer) {
+	// If the following call pancis, it indicates UnimplementedGroqServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&GroqService_ServiceDesc, srv)
+}
+
+func _GroqService_Chat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroqServiceServer).Chat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroqService_Chat_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroqServiceServer).Chat(ctx, req.(*ChatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroqService_StreamChat_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ChatRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GroqServiceServer).StreamChat(m, &grpc.GenericServerStream[ChatRequest, ChatResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GroqService_StreamChatServer = grpc.ServerStreamingServer[ChatResponse]
+
+func _GroqService_VerifyCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyCredentialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroqServiceServer).VerifyCredential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroqService_VerifyCredential_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroqServiceServer).VerifyCredential(ctx, req.(*VerifyCredentialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// GroqService_ServiceDesc is the grpc.ServiceDesc for GroqService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var GroqService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "integration_api.GroqService",
+	HandlerType: (*GroqServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Chat",
+			Handler:    _GroqService_Chat_Handler,
+		},
+		{
+			MethodName: "VerifyCredential",
+			Handler:    _GroqService_VerifyCredential_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamChat",
+			Handler:       _GroqService_StreamChat_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "integration-api.proto",
+}
+
+const (
+	FireworksService_Embedding_FullMethodName        = "/integration_api.FireworksService/Embedding"
+	FireworksService_Chat_FullMethodName             = "/integration_api.FireworksService/Chat"
+	FireworksService_StreamChat_FullMethodName       = "/integration_api.FireworksService/StreamChat"
+	FireworksService_VerifyCredential_FullMethodName = "/integration_api.FireworksService/VerifyCredential"
+)
+
+// FireworksServiceClient is the client API for FireworksService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type FireworksServiceClient interface {
+	Embedding(ctx context.Context, in *EmbeddingRequest, opts ...grpc.CallOption) (*EmbeddingResponse, error)
+	Chat(ctx context.Context, in *ChatRequest, opts ...grpc.CallOption) (*ChatResponse, error)
+	StreamChat(ctx context.Context, in *ChatRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ChatResponse], error)
+	VerifyCredential(ctx context.Context, in *VerifyCredentialRequest, opts ...grpc.CallOption) (*VerifyCredentialResponse, error)
+}
+
+type fireworksServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewFireworksServiceClient(cc grpc.ClientConnInterface) FireworksServiceClient {
+	return &fireworksServiceClient{cc}
+}
+
+func (c *fireworksServiceClient) Embedding(ctx context.Context, in *EmbeddingRequest, opts ...grpc.CallOption) (*EmbeddingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EmbeddingResponse)
+	err := c.cc.Invoke(ctx, FireworksService_Embedding_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fireworksServiceClient) Chat(ctx context.Context, in *ChatRequest, opts ...grpc.CallOption) (*ChatResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChatResponse)
+	err := c.cc.Invoke(ctx, FireworksService_Chat_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fireworksServiceClient) StreamChat(ctx context.Context, in *ChatRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ChatResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &FireworksService_ServiceDesc.Streams[0], FireworksService_StreamChat_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ChatRequest, ChatResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type FireworksService_StreamChatClient = grpc.ServerStreamingClient[ChatResponse]
+
+func (c *fireworksServiceClient) VerifyCredential(ctx context.Context, in *VerifyCredentialRequest, opts ...grpc.CallOption) (*VerifyCredentialResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VerifyCredentialResponse)
+	err := c.cc.Invoke(ctx, FireworksService_VerifyCredential_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// FireworksServiceServer is the server API for FireworksService service.
+// All implementations should embed UnimplementedFireworksServiceServer
+// for forward compatibility.
+type FireworksServiceServer interface {
+	Embedding(context.Context, *EmbeddingRequest) (*EmbeddingResponse, error)
+	Chat(context.Context, *ChatRequest) (*ChatResponse, error)
+	StreamChat(*ChatRequest, grpc.ServerStreamingServer[ChatResponse]) error
+	VerifyCredential(context.Context, *VerifyCredentialRequest) (*VerifyCredentialResponse, error)
+}
+
+// UnimplementedFireworksServiceServer should be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedFireworksServiceServer struct{}
+
+func (UnimplementedFireworksServiceServer) Embedding(context.Context, *EmbeddingRequest) (*EmbeddingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Embedding not implemented")
+}
+func (UnimplementedFireworksServiceServer) Chat(context.Context, *ChatRequest) (*ChatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Chat not implemented")
+}
+func (UnimplementedFireworksServiceServer) StreamChat(*ChatRequest, grpc.ServerStreamingServer[ChatResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamChat not implemented")
+}
+func (UnimplementedFireworksServiceServer) VerifyCredential(context.Context, *VerifyCredentialRequest) (*VerifyCredentialResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyCredential not implemented")
+}
+func (UnimplementedFireworksServiceServer) testEmbeddedByValue() {}
+
+// UnsafeFireworksServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to FireworksServiceServer will
+// result in compilation errors.
+type UnsafeFireworksServiceServer interface {
+	mustEmbedUnimplementedFireworksServiceServer()
+}
+
+func RegisterFireworksServiceServer(s grpc.ServiceRegistrar, srv FireworksServiceServer) {
+	// If the following call pancis, it indicates UnimplementedFireworksServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&FireworksService_ServiceDesc, srv)
+}
+
+func _FireworksService_Embedding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmbeddingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FireworksServiceServer).Embedding(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FireworksService_Embedding_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FireworksServiceServer).Embedding(ctx, req.(*EmbeddingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FireworksService_Chat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FireworksServiceServer).Chat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FireworksService_Chat_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FireworksServiceServer).Chat(ctx, req.(*ChatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FireworksService_StreamChat_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ChatRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(FireworksServiceServer).StreamChat(m, &grpc.GenericServerStream[ChatRequest, ChatResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type FireworksService_StreamChatServer = grpc.ServerStreamingServer[ChatResponse]
+
+func _FireworksService_VerifyCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyCredentialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FireworksServiceServer).VerifyCredential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FireworksService_VerifyCredential_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FireworksServiceServer).VerifyCredential(ctx, req.(*VerifyCredentialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// FireworksService_ServiceDesc is the grpc.ServiceDesc for FireworksService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var FireworksService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "integration_api.FireworksService",
+	HandlerType: (*FireworksServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Embedding",
+			Handler:    _FireworksService_Embedding_Handler,
+		},
+		{
+			MethodName: "Chat",
+			Handler:    _FireworksService_Chat_Handler,
+		},
+		{
+			MethodName: "VerifyCredential",
+			Handler:    _FireworksService_VerifyCredential_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamChat",
+			Handler:       _FireworksService_StreamChat_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "integration-api.proto",
+}
+
+const (
+	OpenAiCompatibleService_Embedding_FullMethodName        = "/integration_api.OpenAiCompatibleService/Embedding"
+	OpenAiCompatibleService_Chat_FullMethodName             = "/integration_api.OpenAiCompatibleService/Chat"
+	OpenAiCompatibleService_StreamChat_FullMethodName       = "/integration_api.OpenAiCompatibleService/StreamChat"
+	OpenAiCompatibleService_VerifyCredential_FullMethodName = "/integration_api.OpenAiCompatibleService/VerifyCredential"
+)
+
+// OpenAiCompatibleServiceClient is the client API for OpenAiCompatibleService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// self hosted or any other server exposing openai compatible api (vllm, ollama),
+// base url of server is given with credential as url
+type OpenAiCompatibleServiceClient interface {
+	Embedding(ctx context.Context, in *EmbeddingRequest, opts ...grpc.CallOption) (*EmbeddingResponse, error)
+	Chat(ctx context.Context, in *ChatRequest, opts ...grpc.CallOption) (*ChatResponse, error)
+	StreamChat(ctx context.Context, in *ChatRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ChatResponse], error)
+	VerifyCredential(ctx context.Context, in *VerifyCredentialRequest, opts ...grpc.CallOption) (*VerifyCredentialResponse, error)
+}
+
+type openAiCompatibleServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewOpenAiCompatibleServiceClient(cc grpc.ClientConnInterface) OpenAiCompatibleServiceClient {
+	return &openAiCompatibleServiceClient{cc}
+}
+
+func (c *openAiCompatibleServiceClient) Embedding(ctx context.Context, in *EmbeddingRequest, opts ...grpc.CallOption) (*EmbeddingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EmbeddingResponse)
+	err := c.cc.Invoke(ctx, OpenAiCompatibleService_Embedding_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *openAiCompatibleServiceClient) Chat(ctx context.Context, in *ChatRequest, opts ...grpc.CallOption) (*ChatResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChatResponse)
+	err := c.cc.Invoke(ctx, OpenAiCompatibleService_Chat_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *openAiCompatibleServiceClient) StreamChat(ctx context.Context, in *ChatRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ChatResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &OpenAiCompatibleService_ServiceDesc.Streams[0], OpenAiCompatibleService_StreamChat_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ChatRequest, ChatResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type OpenAiCompatibleService_StreamChatClient = grpc.ServerStreamingClient[ChatResponse]
+
+func (c *openAiCompatibleServiceClient) VerifyCredential(ctx context.Context, in *VerifyCredentialRequest, opts ...grpc.CallOption) (*VerifyCredentialResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VerifyCredentialResponse)
+	err := c.cc.Invoke(ctx, OpenAiCompatibleService_VerifyCredential_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// OpenAiCompatibleServiceServer is the server API for OpenAiCompatibleService service.
+// All implementations should embed UnimplementedOpenAiCompatibleServiceServer
+// for forward compatibility.
+//
+// self hosted or any other server exposing openai compatible api (vllm, ollama),
+// base url of server is given with credential as url
+type OpenAiCompatibleServiceServer interface {
+	Embedding(context.Context, *EmbeddingRequest) (*EmbeddingResponse, error)
+	Chat(context.Context, *ChatRequest) (*ChatResponse, error)
+	StreamChat(*ChatRequest, grpc.ServerStreamingServer[ChatResponse]) error
+	VerifyCredential(context.Context, *VerifyCredentialRequest) (*VerifyCredentialResponse, error)
+}
+
+// UnimplementedOpenAiCompatibleServiceServer should be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedOpenAiCompatibleServiceServer struct{}
+
+func (UnimplementedOpenAiCompatibleServiceServer) Embedding(context.Context, *EmbeddingRequest) (*EmbeddingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Embedding not implemented")
+}
+func (UnimplementedOpenAiCompatibleServiceServer) Chat(context.Context, *ChatRequest) (*ChatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Chat not implemented")
+}
+func (UnimplementedOpenAiCompatibleServiceServer) StreamChat(*ChatRequest, grpc.ServerStreamingServer[ChatResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamChat not implemented")
+}
+func (UnimplementedOpenAiCompatibleServiceServer) VerifyCredential(context.Context, *VerifyCredentialRequest) (*VerifyCredentialResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyCredential not implemented")
+}
+func (UnimplementedOpenAiCompatibleServiceServer) testEmbeddedByValue() {}
+
+// UnsafeOpenAiCompatibleServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to OpenAiCompatibleServiceServer will
+// result in compilation errors.
+type UnsafeOpenAiCompatibleServiceServer interface {
+	mustEmbedUnimplementedOpenAiCompatibleServiceServer()
+}
+
+func RegisterOpenAiCompatibleServiceServer(s grpc.ServiceRegistrar, srv OpenAiCompatibleServiceServer) {
+	// If the following call pancis, it indicates UnimplementedOpenAiCompatibleServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&OpenAiCompatibleService_ServiceDesc, srv)
+}
+
+func _OpenAiCompatibleService_Embedding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmbeddingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpenAiCompatibleServiceServer).Embedding(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OpenAiCompatibleService_Embedding_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpenAiCompatibleServiceServer).Embedding(ctx, req.(*EmbeddingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OpenAiCompatibleService_Chat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpenAiCompatibleServiceServer).Chat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OpenAiCompatibleService_Chat_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpenAiCompatibleServiceServer).Chat(ctx, req.(*ChatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OpenAiCompatibleService_StreamChat_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ChatRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(OpenAiCompatibleServiceServer).StreamChat(m, &grpc.GenericServerStream[ChatRequest, ChatResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type OpenAiCompatibleService_StreamChatServer = grpc.ServerStreamingServer[ChatResponse]
+
+func _OpenAiCompatibleService_VerifyCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyCredentialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpenAiCompatibleServiceServer).VerifyCredential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OpenAiCompatibleService_VerifyCredential_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpenAiCompatibleServiceServer).VerifyCredential(ctx, req.(*VerifyCredentialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// OpenAiCompatibleService_ServiceDesc is the grpc.ServiceDesc for OpenAiCompatibleService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var OpenAiCompatibleService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "integration_api.OpenAiCompatibleService",
+	HandlerType: (*OpenAiCompatibleServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Embedding",
+			Handler:    _OpenAiCompatibleService_Embedding_Handler,
+		},
+		{
+			MethodName: "Chat",
+			Handler:    _OpenAiCompatibleService_Chat_Handler,
+		},
+		{
+			MethodName: "VerifyCredential",
+			Handler:    _OpenAiCompatibleService_VerifyCredential_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamChat",
+			Handler:       _OpenAiCompatibleService_StreamChat_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "integration-api.proto",
 }
 
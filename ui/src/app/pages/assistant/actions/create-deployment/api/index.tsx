@@ -84,7 +84,8 @@ const ConfigureAssistantApiDeployment: FC<{ assistantId: string }> = ({
     messageOnError: undefined,
     idealTimeout: '5000',
     idealMessage: 'Are you there?',
-    maxCallDuration: '10000',
+    idealTimeoutRetry: '1',
+    maxCallDuration: '600000',
   });
 
   /**
@@ -134,6 +135,7 @@ const ConfigureAssistantApiDeployment: FC<{ assistantId: string }> = ({
             messageOnError: deployment?.getMistake(),
             idealTimeout: deployment?.getIdealtimeout(),
             idealMessage: deployment?.getIdealtimeoutmessage(),
+            idealTimeoutRetry: deployment?.getIdealtimeoutretry()?.toString(),
             maxCallDuration: deployment?.getMaxsessionduration(),
           });
 
@@ -233,6 +235,10 @@ const ConfigureAssistantApiDeployment: FC<{ assistantId: string }> = ({
       deployment.setIdealtimeout(experienceConfig?.idealTimeout);
     if (experienceConfig?.idealMessage)
       deployment.setIdealtimeoutmessage(experienceConfig?.idealMessage);
+    if (experienceConfig?.idealTimeoutRetry)
+      deployment.setIdealtimeoutretry(
+        parseInt(experienceConfig?.idealTimeoutRetry),
+      );
     if (experienceConfig?.maxCallDuration)
       deployment.setMaxsessionduration(experienceConfig?.maxCallDuration);
 
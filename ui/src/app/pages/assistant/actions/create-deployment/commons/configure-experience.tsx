@@ -13,6 +13,7 @@ export interface ExperienceConfig {
   messageOnError?: string;
   idealTimeout?: string;
   idealMessage?: string;
+  idealTimeoutRetry?: string;
   maxCallDuration?: string;
 }
 
@@ -25,6 +26,7 @@ export const ConfigureExperience: FC<{
     messageOnError,
     idealTimeout,
     idealMessage,
+    idealTimeoutRetry,
     maxCallDuration,
   } = experienceConfig;
 
@@ -56,6 +58,12 @@ export const ConfigureExperience: FC<{
       idealTimeout: idealTimeout,
     });
   };
+  const onChangeIdealTimeoutRetry = (retry: string) => {
+    setExperienceConfig({
+      ...experienceConfig,
+      idealTimeoutRetry: retry,
+    });
+  };
   const onChangeMaxCallDuration = (duration: string) => {
     setExperienceConfig({
       ...experienceConfig,
@@ -138,20 +146,45 @@ export const ConfigureExperience: FC<{
                 responded.
               </InputHelper>
             </FieldSet>
+            <FieldSet>
+              <FormLabel>Idle Message Retry</FormLabel>
+              <div className="flex space-x-2 justify-center items-center">
+                <Slider
+                  min={1}
+                  max={5}
+                  step={1}
+                  value={idealTimeoutRetry && parseInt(idealTimeoutRetry)}
+                  onSlide={(v: number) => {
+                    onChangeIdealTimeoutRetry(v.toString());
+                  }}
+                />
+                <Input
+                  className="bg-light-background w-16"
+                  value={idealTimeoutRetry}
+                  onChange={e => {
+                    onChangeIdealTimeoutRetry(e.target.value);
+                  }}
+                />
+              </div>
+              <InputHelper>
+                Number of times the idle message is spoken before the session
+                is ended (1-5).
+              </InputHelper>
+            </FieldSet>
             <FieldSet>
               <FormLabel>Maximum Session Duration (millisecond)</FormLabel>
               <div className="flex space-x-2 justify-center items-center">
                 <Slider
-                  min={5000}
-                  max={15000}
-                  step={1000}
+                  min={60000}
+                  max={3600000}
+                  step={60000}
                   value={maxCallDuration && parseInt(maxCallDuration)}
                   onSlide={(v: number) => {
                     onChangeMaxCallDuration(v.toString());
                   }}
                 />
                 <Input
-                  className="bg-light-background w-16"
+                  className="bg-light-background w-20"
                   value={maxCallDuration}
                   onChange={e => {
                     onChangeMaxCallDuration(e.target.value);
@@ -159,8 +192,9 @@ export const ConfigureExperience: FC<{
                 />
               </div>
               <InputHelper>
-                Maximum Session Duration. Set the time limit for sessions values
-                it should be between 5000ms and 15000ms.
+                Maximum Session Duration. The conversation is ended when the
+                session runs longer, it should be between 60000ms and
+                3600000ms.
               </InputHelper>
             </FieldSet>
           </div>
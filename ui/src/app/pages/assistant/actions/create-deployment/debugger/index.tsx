@@ -103,7 +103,8 @@ const ConfigureAssistantDebuggerDeployment: FC<{ assistantId: string }> = ({
     messageOnError: undefined,
     idealTimeout: '5000',
     idealMessage: 'Are you there?',
-    maxCallDuration: '10000',
+    idealTimeoutRetry: '1',
+    maxCallDuration: '600000',
   });
 
   /**
@@ -151,6 +152,7 @@ const ConfigureAssistantDebuggerDeployment: FC<{ assistantId: string }> = ({
             messageOnError: deployment?.getMistake(),
             idealTimeout: deployment?.getIdealtimeout(),
             idealMessage: deployment?.getIdealtimeoutmessage(),
+            idealTimeoutRetry: deployment?.getIdealtimeoutretry()?.toString(),
             maxCallDuration: deployment?.getMaxsessionduration(),
           });
 
@@ -248,6 +250,10 @@ const ConfigureAssistantDebuggerDeployment: FC<{ assistantId: string }> = ({
       deployment.setIdealtimeout(experienceConfig?.idealTimeout);
     if (experienceConfig?.idealMessage)
       deployment.setIdealtimeoutmessage(experienceConfig?.idealMessage);
+    if (experienceConfig?.idealTimeoutRetry)
+      deployment.setIdealtimeoutretry(
+        parseInt(experienceConfig?.idealTimeoutRetry),
+      );
     if (experienceConfig?.maxCallDuration)
       deployment.setMaxsessionduration(experienceConfig?.maxCallDuration);
 
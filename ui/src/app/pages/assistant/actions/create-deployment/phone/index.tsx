@@ -76,7 +76,8 @@ const ConfigureAssistantCallDeployment: FC<{ assistantId: string }> = ({
     messageOnError: undefined,
     idealTimeout: '5000',
     idealMessage: 'Are you there?',
-    maxCallDuration: '10000',
+    idealTimeoutRetry: '1',
+    maxCallDuration: '600000',
   });
 
   const [telephonyConfig, setTelephonyConfig] = useState<{
@@ -146,6 +147,7 @@ const ConfigureAssistantCallDeployment: FC<{ assistantId: string }> = ({
             messageOnError: deployment?.getMistake(),
             idealTimeout: deployment?.getIdealtimeout(),
             idealMessage: deployment?.getIdealtimeoutmessage(),
+            idealTimeoutRetry: deployment?.getIdealtimeoutretry()?.toString(),
             maxCallDuration: deployment?.getMaxsessionduration(),
           });
 
@@ -284,6 +286,10 @@ const ConfigureAssistantCallDeployment: FC<{ assistantId: string }> = ({
       deployment.setIdealtimeout(experienceConfig?.idealTimeout);
     if (experienceConfig?.idealMessage)
       deployment.setIdealtimeoutmessage(experienceConfig?.idealMessage);
+    if (experienceConfig?.idealTimeoutRetry)
+      deployment.setIdealtimeoutretry(
+        parseInt(experienceConfig?.idealTimeoutRetry),
+      );
     if (experienceConfig?.maxCallDuration)
       deployment.setMaxsessionduration(experienceConfig?.maxCallDuration);
 
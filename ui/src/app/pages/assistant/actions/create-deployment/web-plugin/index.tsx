@@ -122,7 +122,8 @@ const ConfigureAssistantWebDeployment: FC<{ assistantId: string }> = ({
       messageOnError: undefined,
       idealTimeout: '5000',
       idealMessage: 'Are you there?',
-      maxCallDuration: '10000',
+      idealTimeoutRetry: '1',
+      maxCallDuration: '600000',
       suggestions: [],
     });
 
@@ -156,6 +157,7 @@ const ConfigureAssistantWebDeployment: FC<{ assistantId: string }> = ({
             messageOnError: deployment?.getMistake(),
             idealTimeout: deployment?.getIdealtimeout(),
             idealMessage: deployment?.getIdealtimeoutmessage(),
+            idealTimeoutRetry: deployment?.getIdealtimeoutretry()?.toString(),
             maxCallDuration: deployment?.getMaxsessionduration(),
           });
 
@@ -265,6 +267,10 @@ const ConfigureAssistantWebDeployment: FC<{ assistantId: string }> = ({
       webDeployment.setIdealtimeout(experienceConfig?.idealTimeout);
     if (experienceConfig?.idealMessage)
       webDeployment.setIdealtimeoutmessage(experienceConfig?.idealMessage);
+    if (experienceConfig?.idealTimeoutRetry)
+      webDeployment.setIdealtimeoutretry(
+        parseInt(experienceConfig?.idealTimeoutRetry),
+      );
     if (experienceConfig?.maxCallDuration)
       webDeployment.setMaxsessionduration(experienceConfig?.maxCallDuration);
 
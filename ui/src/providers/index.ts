@@ -43,6 +43,7 @@ export const PRONUNCIATION_DICTIONARIES = [
   'currency',
   'date',
   'time',
+  'ordinal',
   'numeral',
   'address',
   'url',